@@ -5,12 +5,14 @@ import (
 )
 
 type Authz struct {
-	users UsersResource
+	users  UsersResource
+	tokens TokensResource
 }
 
 func NewAuth(b *bigip.BigIP) Authz {
 	return Authz{
-		users: UsersResource{b: b},
+		users:  UsersResource{b: b},
+		tokens: TokensResource{b: b},
 	}
 }
 
@@ -18,5 +20,9 @@ func (auth Authz) Users() *UsersResource {
 	return &auth.users
 }
 
+func (auth Authz) Tokens() *TokensResource {
+	return &auth.tokens
+}
+
 // AuthzManager is a commonly used bigip.GetBaseResource(), providing a large number of api resource types
 const AuthzManager = "authz"
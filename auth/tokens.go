@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
+	"time"
+)
+
+// MaxTokenTimeout is the longest lifetime BIG-IP accepts for a token.
+const MaxTokenTimeout = bigip.MaxTokenTimeout
+
+type TokenList struct {
+	Items            []Token `json:"items,omitempty"`
+	Generation       int     `json:"generation"`
+	LastUpdateMicros int     `json:"lastUpdateMicros"`
+	Kind             string  `json:"kind"`
+	SelfLink         string  `json:"selfLink"`
+}
+
+type Token struct {
+	Token            string        `json:"token,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	UserName         string        `json:"userName,omitempty"`
+	AuthProviderName string        `json:"authProviderName,omitempty"`
+	GroupReferences  []interface{} `json:"groupReferences,omitempty"`
+	Timeout          int           `json:"timeout,omitempty"`
+	StartTime        string        `json:"startTime,omitempty"`
+	Address          string        `json:"address,omitempty"`
+	Partition        string        `json:"partition,omitempty"`
+	Generation       int           `json:"generation,omitempty"`
+	LastUpdateMicros int64         `json:"lastUpdateMicros,omitempty"`
+	ExpirationMicros int64         `json:"expirationMicros,omitempty"`
+	Kind             string        `json:"kind,omitempty"`
+	SelfLink         string        `json:"selfLink,omitempty"`
+}
+
+// Expiration returns the time at which the token expires.
+func (t *Token) Expiration() time.Time {
+	return time.UnixMicro(t.ExpirationMicros)
+}
+
+type TokensResource struct {
+	b *bigip.BigIP
+}
+
+// TokensEndpoint is the base path of the authentication tokens.
+const TokensEndpoint = "tokens"
+
+// collection returns the typed collection backing TokensResource.
+func (tr *TokensResource) collection() *bigip.Collection[Token, TokenList] {
+	return bigip.NewCollection[Token, TokenList](tr.b, AuthzManager, TokensEndpoint).WithCategory(bigip.GetShareResource()).WithoutPartition()
+}
+
+// List the active tokens. Administrators see the tokens of all users.
+func (tr *TokensResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TokenList, error) {
+	return tr.collection().List(ctx, opts...)
+}
+
+// Get a single token.
+func (tr *TokensResource) Get(ctx context.Context, token string) (*Token, error) {
+	return tr.collection().Get(ctx, token)
+}
+
+// SetTimeout changes the lifetime of a token, counted from its start time.
+// BIG-IP accepts up to MaxTokenTimeout, a longer timeout is lowered to it.
+func (tr *TokensResource) SetTimeout(ctx context.Context, token string, timeout time.Duration) error {
+	if timeout > MaxTokenTimeout {
+		timeout = MaxTokenTimeout
+	}
+	return tr.collection().Patch(ctx, token, map[string]int64{"timeout": int64(timeout.Seconds())})
+}
+
+// Delete revokes a token.
+func (tr *TokensResource) Delete(ctx context.Context, token string) error {
+	return tr.collection().Delete(ctx, token)
+}
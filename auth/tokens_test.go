@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testToken = `{"token":"ABCDEF","name":"ABCDEF","userName":"admin","timeout":1200,"expirationMicros":1700000000000000}`
+
+// newTokensServer fakes /mgmt/shared/authz/tokens and records the requests it receives.
+func newTokensServer(t *testing.T) (*bigip.BigIP, *[]string) {
+	var log []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		log = append(log, r.Method+" "+r.URL.RequestURI()+" "+string(body))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /mgmt/shared/authz/tokens":
+			w.Write([]byte(`{"items":[` + testToken + `],"kind":"shared:authz:tokens:authtokencollectionstate"}`))
+		case "GET /mgmt/shared/authz/tokens/ABCDEF", "PATCH /mgmt/shared/authz/tokens/ABCDEF", "DELETE /mgmt/shared/authz/tokens/ABCDEF":
+			w.Write([]byte(testToken))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Token not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := bigip.NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b, &log
+}
+
+func TestTokensList(t *testing.T) {
+	b, _ := newTokensServer(t)
+	list, err := NewAuth(b).Tokens().List(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].UserName != "admin" {
+		t.Errorf("Unexpected tokens %+v", list.Items)
+	}
+}
+
+func TestTokensGet(t *testing.T) {
+	b, _ := newTokensServer(t)
+	token, err := NewAuth(b).Tokens().Get(context.Background(), "ABCDEF")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token.Timeout != 1200 || !token.Expiration().Equal(time.UnixMicro(1700000000000000)) {
+		t.Errorf("Unexpected token %+v", token)
+	}
+	if _, err := NewAuth(b).Tokens().Get(context.Background(), "UNKNOWN"); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestTokensSetTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		expected string
+	}{
+		{"within the limit", time.Hour, "PATCH /mgmt/shared/authz/tokens/ABCDEF {\"timeout\":3600}"},
+		{"above the limit", 24 * time.Hour, "PATCH /mgmt/shared/authz/tokens/ABCDEF {\"timeout\":36000}"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, log := newTokensServer(t)
+			if err := NewAuth(b).Tokens().SetTimeout(context.Background(), "ABCDEF", test.timeout); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if last := (*log)[len(*log)-1]; last != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, last)
+			}
+		})
+	}
+}
+
+func TestTokensDelete(t *testing.T) {
+	b, log := newTokensServer(t)
+	if err := NewAuth(b).Tokens().Delete(context.Background(), "ABCDEF"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected, last := "DELETE /mgmt/shared/authz/tokens/ABCDEF ", (*log)[len(*log)-1]; last != expected {
+		t.Errorf("Expected %q, got %q", expected, last)
+	}
+}
+
+func TestTokensInPartition(t *testing.T) {
+	b, log := newTokensServer(t)
+	// Tokens do not belong to a partition, so a restricted BigIP neither filters nor qualifies them.
+	tokens := NewAuth(b.InPartition("Tenant_A")).Tokens()
+	if _, err := tokens.List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := tokens.Get(context.Background(), "ABCDEF"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"GET /mgmt/shared/authz/tokens ", "GET /mgmt/shared/authz/tokens/ABCDEF "}
+	if len(*log) != len(expected) || (*log)[0] != expected[0] || (*log)[1] != expected[1] {
+		t.Errorf("Expected requests %q, got %q", expected, *log)
+	}
+}
@@ -32,9 +32,9 @@ type UsersResource struct {
 // UserEndpoint is the base path of the authz API.
 const UserEndpoint = "users"
 
-func (ur *UsersResource) List() (*UsersList, error) {
+func (ur *UsersResource) List(ctx context.Context) (*UsersList, error) {
 	res, err := ur.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetShareResource()).
-		ManagerName(AuthzManager).Resource(UserEndpoint).DoRaw(context.Background())
+		ManagerName(AuthzManager).Resource(UserEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,11 +2,9 @@ package bigip
 
 import (
 	"bytes"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip/rest"
-	"log"
 	"net/http"
 	"net/url"
 	"time"
@@ -17,55 +15,32 @@ const TimeFormat = "2006-01-02T15:04:05.000-0700"
 // DefaultTimeout defines the default timeout for HTTP clients.
 var DefaultTimeout time.Duration = 60
 
+// loginTimeout bounds the requests made to log in and to set the lifetime of a token.
+var loginTimeout = 30 * time.Second
+
 // BigIP struct contains a pointer to the RESTClient
 type BigIP struct {
 	RestClient *rest.RESTClient
+	// tokenSource is set for sessions created with NewToken, so that Logout can revoke the token.
+	tokenSource *TokenSource
+	// version caches the TMOS version of the device, see Version.
+	version *versionCache
+	// partition is the partition the BigIP is restricted to, see InPartition.
+	partition string
+	// defaultPartition qualifies bare names without restricting the BigIP, see WithDefaultPartition.
+	defaultPartition string
 }
 
 // NewSession creates a new BigIP structure initialized with a username and password.
-func NewSession(host, username, password string) (*BigIP, error) {
-	config := &rest.Config{
-		Host:     host,
-		Username: username,
-		Password: password,
-		ContentConfig: rest.ContentConfig{
-			ContentType: "application/json",
-		},
-	}
-
-	restClient, err := restClientFor(config)
-	if err != nil {
-		return nil, err
-	}
-
-	return &BigIP{
-		RestClient: restClient,
-	}, nil
+// It is a shorthand for New(host, WithBasicAuth(username, password), options...).
+func NewSession(host, username, password string, options ...Option) (*BigIP, error) {
+	return New(host, append([]Option{WithBasicAuth(username, password)}, options...)...)
 }
 
-// NewToken retrieves a login token from a new BigIP structure with token authentication
+// NewToken retrieves a login token from a new BigIP structure with token authentication.
+// It is a shorthand for New(host, WithTokenAuth(username, password), WithLoginProvider(loginProviderName), options...).
 func NewToken(host, username, password, loginProviderName string, options ...Option) (*BigIP, error) {
-	auth := newAuthPayload(host, username, password, loginProviderName, options...)
-	token, _, err := auth.generateToken()
-	if err != nil {
-		log.Fatalf("generation token failed %v\n", err)
-	}
-	config := &rest.Config{
-		Host: host,
-		ContentConfig: rest.ContentConfig{
-			ContentType: "application/json",
-		},
-		BearerToken: token,
-	}
-
-	restClient, err := restClientFor(config)
-	if err != nil {
-		return nil, err
-	}
-
-	return &BigIP{
-		RestClient: restClient,
-	}, nil
+	return New(host, append([]Option{WithTokenAuth(username, password), WithLoginProvider(loginProviderName)}, options...)...)
 }
 
 // restClientFor is a helper function that creates a new REST client for the given config.
@@ -77,51 +52,53 @@ func restClientFor(config *rest.Config) (*rest.RESTClient, error) {
 	return rest.RESTClientForConfigAndClient(config, httpClient)
 }
 
-// Option is a custom type that handles options
-type Option func(payload *authPayload)
-
 // authPayload contains authentication related information such as hostname, username, password, etc.
 type authPayload struct {
 	Host              string        `json:"host"`
 	UserName          string        `json:"username"`
 	Password          string        `json:"password"`
 	LoginProviderName string        `json:"loginProviderName"`
-	Timeout           time.Duration `json:"timeout"`
+	Timeout           time.Duration `json:"-"`
 	token             string
+	tokenIssuedAt     time.Time
 	tokenExpiresAt    time.Time
-	Client            *http.Client `json:"client"`
+	// config holds the TLS, proxy and transport settings used to log in.
+	config *rest.Config
+	Client *http.Client `json:"-"`
 }
 
-// WithTimeout is an Option type function used for setting the timeout
-func WithTimeout(timeout time.Duration) Option {
-	return func(auth *authPayload) {
-		auth.Timeout = timeout
+// newAuthPayload creates a new authPayload from the credentials and settings of the options.
+func newAuthPayload(o *options) *authPayload {
+	return &authPayload{
+		Host:              o.config.Host,
+		UserName:          o.username,
+		Password:          o.password,
+		LoginProviderName: o.loginProvider,
+		Timeout:           o.tokenTimeout,
+		config:            &o.config,
 	}
 }
 
-// newAuthPayload creates a new authPayload based on the given hostname, username, password, and loginProviderName among other things.
-func newAuthPayload(host, username, password, loginProviderName string, options ...Option) *authPayload {
-	auth := &authPayload{
-		Host:              host,
-		UserName:          username,
-		Password:          password,
-		LoginProviderName: loginProviderName,
-		Timeout:           DefaultTimeout,
-		Client: &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: true,
-				},
-			},
-		},
-	}
-
-	// Apply any incoming options
-	for _, opt := range options {
-		opt(auth)
-	}
-
-	return auth
+// httpClient returns the client used to log in, built with the settings of the options
+// but without credentials.
+func (auth *authPayload) httpClient() (*http.Client, error) {
+	if auth.Client != nil {
+		return auth.Client, nil
+	}
+	config := rest.Config{}
+	if auth.config != nil {
+		config = *auth.config
+	}
+	config.Username, config.Password, config.BearerToken, config.TokenSource = "", "", "", nil
+	if config.Timeout == 0 {
+		config.Timeout = loginTimeout
+	}
+	client, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	auth.Client = client
+	return auth.Client, nil
 }
 
 // newHTTPRequest is a helper function for creating new HTTP requests.
@@ -183,7 +160,11 @@ func (auth *authPayload) generateToken() (string, time.Time, error) {
 	if err != nil {
 		return "", time.Time{}, err
 	}
-	resp, err := auth.Client.Do(req)
+	client, err := auth.httpClient()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -205,10 +186,48 @@ func (auth *authPayload) generateToken() (string, time.Time, error) {
 		return "", time.Time{}, fmt.Errorf("failed to parse token start time: %v", err)
 	}
 
-	expiresAt := startTime.Add(time.Duration(token.Token.Timeout-token.Token.Timeout+int(auth.Timeout.Seconds())) * time.Second)
+	lifetime := time.Duration(token.Token.Timeout) * time.Second
+	if auth.Timeout > 0 && auth.Timeout != lifetime {
+		if err := auth.setTokenTimeout(token.Token.Token, auth.Timeout); err != nil {
+			return "", time.Time{}, err
+		}
+		lifetime = auth.Timeout
+	}
+	expiresAt := startTime.Add(lifetime)
 
 	auth.token = token.Token.Token
+	auth.tokenIssuedAt = startTime
 	auth.tokenExpiresAt = expiresAt
 
 	return token.Token.Token, expiresAt, nil
 }
+
+// setTokenTimeout changes the lifetime of the given token.
+func (auth *authPayload) setTokenTimeout(token string, timeout time.Duration) error {
+	data, err := json.Marshal(map[string]int64{"timeout": int64(timeout.Seconds())})
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %v", err)
+	}
+	rawURL, basePath, _ := rest.DefaultServerURL(auth.Host, "/mgmt/shared/authz/tokens/"+token)
+	req, err := http.NewRequest(http.MethodPatch, rawURL.String()+basePath, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-F5-Auth-Token", token)
+
+	client, err := auth.httpClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("failed to set token timeout: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package bigiptest
+
+import "sync"
+
+// Call is a call of a method of a mock, with its arguments. The arguments of a variadic
+// parameter are held in a slice.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// CallRecorder records the calls of a mock. The generated mocks of the ltm, gtm, net and
+// sys packages embed it:
+//
+//	pools := &ltmmock.PoolAPI{}
+//	pools.GetFunc = func(ctx context.Context, fullPathName string) (*ltm.Pool, error) {
+//		return &ltm.Pool{FullPath: fullPathName}, nil
+//	}
+//	service.Drain(ctx, pools, "/Common/web")
+//	if calls := pools.CallsTo("Patch"); len(calls) != 1 {
+//		t.Errorf("Expected one patch, got %v", calls)
+//	}
+//
+// It is safe for concurrent use.
+type CallRecorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record records a call of method.
+func (r *CallRecorder) Record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls, in order.
+func (r *CallRecorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of method, in order.
+func (r *CallRecorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *CallRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,64 @@
+package bigiptest_test
+
+import (
+	"context"
+	"errors"
+	"github.com/lefeck/go-bigip/ltm"
+	"github.com/lefeck/go-bigip/ltm/ltmmock"
+	"github.com/lefeck/go-bigip/rest"
+	"testing"
+)
+
+// drain is a service function depending only on ltm.PoolAPI, so that it can be tested with a mock.
+func drain(ctx context.Context, pools ltm.PoolAPI, fullPathName string) error {
+	pool, err := pools.Get(ctx, fullPathName)
+	if err != nil {
+		return err
+	}
+	if pool.MinActiveMembers == 0 {
+		return nil
+	}
+	return pools.Patch(ctx, fullPathName, map[string]int{"minActiveMembers": 0})
+}
+
+func TestMock(t *testing.T) {
+	ctx := context.Background()
+	pools := &ltmmock.PoolAPI{}
+	pools.GetFunc = func(ctx context.Context, fullPathName string) (*ltm.Pool, error) {
+		return &ltm.Pool{FullPath: fullPathName, MinActiveMembers: 2}, nil
+	}
+	if err := drain(ctx, pools, "/Common/web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	calls := pools.Calls()
+	if len(calls) != 2 || calls[0].Method != "Get" || calls[1].Method != "Patch" {
+		t.Fatalf("Expected Get and Patch, got %v", calls)
+	}
+	if calls[1].Args[1] != "/Common/web" {
+		t.Errorf("Expected /Common/web, got %v", calls[1].Args[1])
+	}
+	if len(pools.CallsTo("Patch")) != 1 {
+		t.Errorf("Expected one Patch, got %v", pools.CallsTo("Patch"))
+	}
+
+	// Methods without a function return zero values.
+	pools.Reset()
+	if list, err := pools.List(ctx, &rest.ListOptions{Top: 10}); list != nil || err != nil {
+		t.Errorf("Expected zero values, got %v and %v", list, err)
+	}
+	if args := pools.CallsTo("List")[0].Args; len(args) != 2 || len(args[1].([]*rest.ListOptions)) != 1 {
+		t.Errorf("Expected the variadic arguments to be recorded, got %v", args)
+	}
+
+	notFound := errors.New("not found")
+	lb := &ltmmock.LTMAPI{}
+	lb.PoolFunc = func() ltm.PoolAPI {
+		return &ltmmock.PoolAPI{GetFunc: func(ctx context.Context, fullPathName string) (*ltm.Pool, error) {
+			return nil, notFound
+		}}
+	}
+	if err := drain(ctx, lb.Pool(), "/Common/web"); !errors.Is(err, notFound) {
+		t.Errorf("Expected %v, got %v", notFound, err)
+	}
+}
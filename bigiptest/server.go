@@ -0,0 +1,858 @@
+// Package bigiptest provides an in-memory BIG-IP for tests, so that code built on the
+// ltm, gtm and net packages can run end-to-end without a device:
+//
+//	server := bigiptest.NewServer()
+//	defer server.Close()
+//	b, err := bigip.NewSession(server.URL, bigiptest.DefaultUsername, bigiptest.DefaultPassword)
+//	err = ltm.New(b).Pool().Create(ctx, ltm.Pool{Name: "web"})
+//
+// The server implements every collection of /mgmt/tm generically: objects are created,
+// read, listed, replaced, patched and deleted by full path, whatever their type.
+// It does not validate the properties of the objects and does not implement transactions,
+// tasks or commands such as /mgmt/tm/util/bash.
+package bigiptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultUsername and DefaultPassword are the credentials accepted by a Server.
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+	// DefaultVersion is the TMOS version reported by a Server.
+	DefaultVersion = "16.1.3"
+	// DefaultTokenTimeout is the lifetime of the tokens issued by a Server.
+	DefaultTokenTimeout = 20 * time.Minute
+	// DefaultPartition is the partition of the objects created without one.
+	DefaultPartition = "Common"
+)
+
+// timeFormat is the format of the start time of a token, see bigip.TimeFormat.
+const timeFormat = "2006-01-02T15:04:05.000-0700"
+
+// Option configures a Server.
+type Option func(s *Server)
+
+// WithCredentials sets the username and password accepted by the server.
+func WithCredentials(username, password string) Option {
+	return func(s *Server) {
+		s.username, s.password = username, password
+	}
+}
+
+// WithVersion sets the TMOS version reported by /mgmt/tm/sys/version and in the selfLink of objects.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithTokenTimeout sets the lifetime of the tokens issued by /mgmt/shared/authn/login.
+func WithTokenTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.tokenTimeout = timeout
+	}
+}
+
+// Server is an httptest.Server that behaves like the REST API of a BIG-IP.
+//
+// A path segment of /mgmt/tm is the name of an object if it starts with "~", such as
+// ~Common~web, or if it follows a collection and is not a collection itself, so that a
+// missing object is not found whether or not its collection is empty. Paths that group
+// collections, such as ltm/monitor, are not collections. Bare names are qualified with
+// the Common partition. The segments
+// after an object name are a sub-collection, such as the members of a pool, and "stats"
+// returns the statistics of an object or a collection.
+//
+// Properties given with their tmsh names, such as file-name, are saved in camelCase, and
+// the enabled and disabled properties of an object exclude each other.
+//
+// Every change increments the generation of the server, which becomes the generation of
+// the changed object, as on BIG-IP.
+type Server struct {
+	*httptest.Server
+
+	username     string
+	password     string
+	version      string
+	tokenTimeout time.Duration
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	generation  int64
+	tokens      map[string]time.Time
+	logins      int
+	requests    int
+	latency     time.Duration
+	failures    []int
+}
+
+// collection holds the objects of a collection, by full path.
+type collection struct {
+	objects map[string]map[string]interface{}
+	stats   map[string]map[string]interface{}
+}
+
+// NewServer starts a Server. It must be closed with Close.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		username:     DefaultUsername,
+		password:     DefaultPassword,
+		version:      DefaultVersion,
+		tokenTimeout: DefaultTokenTimeout,
+		collections:  map[string]*collection{},
+		tokens:       map[string]time.Time{},
+	}
+	for _, option := range options {
+		option(s)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetLatency delays every response by d, to test timeouts and deadlines.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// FailNext answers the next n requests with the given status code, such as
+// http.StatusServiceUnavailable, without handling them.
+func (s *Server) FailNext(n int, statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := 0; i < n; i++ {
+		s.failures = append(s.failures, statusCode)
+	}
+}
+
+// ExpireTokens expires every token issued so far, so that the requests using them are
+// rejected with 401 until the client logs in again.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token := range s.tokens {
+		s.tokens[token] = time.Time{}
+	}
+}
+
+// Requests returns the number of requests received by the server, including failed ones.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Logins returns the number of successful logins.
+func (s *Server) Logins() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+// Add creates an object in a collection, such as "ltm/pool" or
+// "ltm/pool/~Common~web/members", and returns it as the server stores it.
+// object is any value that marshals to a JSON object with a name.
+func (s *Server) Add(collectionPath string, object interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	target, err := s.resolve(collectionPath)
+	if err != nil {
+		return nil, err
+	}
+	if target.instance != "" || target.stats {
+		return nil, fmt.Errorf("%s is not a collection", collectionPath)
+	}
+	created, status, err := s.create(target.collection, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s (code: %d)", err, status)
+	}
+	return clone(created), nil
+}
+
+// Get returns a copy of the object identified by its full path in a collection.
+func (s *Server) Get(collectionPath, fullPath string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	target, err := s.resolve(collectionPath)
+	if err != nil {
+		return nil, false
+	}
+	object, ok := s.object(target.collection, qualified(fullPath))
+	if !ok {
+		return nil, false
+	}
+	return clone(object), true
+}
+
+// SetStats sets the statistics of an object, which are returned by <object>/stats and
+// <collection>/stats. Numbers are returned as {"value": n} and other values as
+// {"description": "..."}, as BIG-IP does.
+func (s *Server) SetStats(collectionPath, fullPath string, stats map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	target, err := s.resolve(collectionPath)
+	if err != nil {
+		return err
+	}
+	fullPath = qualified(fullPath)
+	if _, ok := s.object(target.collection, fullPath); !ok {
+		return fmt.Errorf("%s was not found in %s", fullPath, collectionPath)
+	}
+	entries := map[string]interface{}{}
+	for name, value := range stats {
+		switch value.(type) {
+		case int, int32, int64, uint, uint32, uint64, float32, float64:
+			entries[name] = map[string]interface{}{"value": value}
+		default:
+			entries[name] = map[string]interface{}{"description": fmt.Sprint(value)}
+		}
+	}
+	s.collections[target.collection].stats[fullPath] = entries
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	latency := s.latency
+	var failure int
+	if len(s.failures) != 0 {
+		failure, s.failures = s.failures[0], s.failures[1:]
+	}
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if failure != 0 {
+		writeError(w, failure, http.StatusText(failure))
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "Found invalid JSON body in the request.")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.URL.Path == "/mgmt/shared/authn/login" && r.Method == http.MethodPost:
+		s.login(w, body)
+	case !s.authenticated(r):
+		writeError(w, http.StatusUnauthorized, "Authorization failed: no user authentication header or token detected.")
+	case strings.HasPrefix(r.URL.Path, "/mgmt/shared/authz/tokens/"):
+		s.serveToken(w, r, strings.TrimPrefix(r.URL.Path, "/mgmt/shared/authz/tokens/"), body)
+	case r.URL.Path == "/mgmt/tm/sys/version" && r.Method == http.MethodGet:
+		s.serveVersion(w)
+	case strings.HasPrefix(r.URL.Path, "/mgmt/tm/"):
+		s.serveTM(w, r, body)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Public URI path not registered: %s", r.URL.Path))
+	}
+}
+
+// authenticated reports whether the request holds the credentials or a valid token. s.mu must be held.
+func (s *Server) authenticated(r *http.Request) bool {
+	if token := r.Header.Get("X-F5-Auth-Token"); token != "" {
+		expiry, ok := s.tokens[token]
+		return ok && time.Now().Before(expiry)
+	}
+	username, password, ok := r.BasicAuth()
+	return ok && username == s.username && password == s.password
+}
+
+// login issues a token for the credentials of the body. s.mu must be held.
+func (s *Server) login(w http.ResponseWriter, body map[string]interface{}) {
+	if body["username"] != s.username || body["password"] != s.password {
+		writeError(w, http.StatusUnauthorized, "Authentication failed.")
+		return
+	}
+	s.logins++
+	token := fmt.Sprintf("TOKEN%06d", s.logins)
+	now := time.Now()
+	s.tokens[token] = now.Add(s.tokenTimeout)
+	provider, _ := body["loginProviderName"].(string)
+	if provider == "" {
+		provider = "tmos"
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"username":          s.username,
+		"loginProviderName": provider,
+		"token":             s.tokenObject(token, now),
+	})
+}
+
+// tokenObject returns the description of a token. s.mu must be held.
+func (s *Server) tokenObject(token string, start time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"token":            token,
+		"name":             token,
+		"userName":         s.username,
+		"timeout":          int(s.tokens[token].Sub(start) / time.Second),
+		"startTime":        start.Format(timeFormat),
+		"expirationMicros": s.tokens[token].UnixMicro(),
+		"kind":             "shared:authz:tokens:authtokenitemstate",
+		"selfLink":         "https://localhost/mgmt/shared/authz/tokens/" + token,
+	}
+}
+
+// serveToken reads, changes the timeout of, or revokes a token. s.mu must be held.
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request, token string, body map[string]interface{}) {
+	if _, ok := s.tokens[token]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Token %s does not exist.", token))
+		return
+	}
+	now := time.Now()
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch:
+		timeout, _ := body["timeout"].(float64)
+		s.tokens[token] = now.Add(time.Duration(timeout) * time.Second)
+	case http.MethodDelete:
+		delete(s.tokens, token)
+		w.WriteHeader(http.StatusOK)
+		return
+	default:
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.tokenObject(token, now))
+}
+
+// serveVersion returns the version of the server in the format of /mgmt/tm/sys/version.
+func (s *Server) serveVersion(w http.ResponseWriter) {
+	entries := map[string]interface{}{}
+	for name, value := range map[string]string{"Product": "BIG-IP", "Title": "Main Package", "Version": s.version} {
+		entries[name] = map[string]interface{}{"description": value}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"kind":     "tm:sys:version:versionstats",
+		"selfLink": "https://localhost/mgmt/tm/sys/version?ver=" + s.version,
+		"entries": map[string]interface{}{
+			"https://localhost/mgmt/tm/sys/version/0": map[string]interface{}{
+				"nestedStats": map[string]interface{}{"entries": entries},
+			},
+		},
+	})
+}
+
+// target is the collection, object or statistics designated by a path of /mgmt/tm.
+type target struct {
+	// collection is the path of the collection, with the names of objects in "~" form,
+	// for example "ltm/pool/~Common~web/members".
+	collection string
+	// instance is the full path of the object, or empty for the collection itself.
+	instance string
+	stats    bool
+}
+
+// resolve splits a path of /mgmt/tm into a target. An error is returned for an invalid
+// name, or if a sub-collection belongs to an object that does not exist. s.mu must be held.
+func (s *Server) resolve(path string) (target, error) {
+	path = strings.Trim(strings.TrimPrefix(strings.TrimPrefix(path, "/"), "mgmt/tm/"), "/")
+	segments := strings.Split(path, "/")
+	var t target
+	var key []string
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+			return target{}, fmt.Errorf("invalid path %s", path)
+		case segment == "stats" && i == len(segments)-1:
+			t.stats = true
+		case t.instance == "" && len(key) >= 2 && s.isInstance(strings.Join(key, "/"), segment):
+			p, err := rest.ParsePath(segment)
+			if err != nil {
+				return target{}, err
+			}
+			t.instance = p.WithPartition(DefaultPartition).String()
+		default:
+			if t.instance != "" {
+				if _, ok := s.object(strings.Join(key, "/"), t.instance); !ok {
+					return target{}, &notFoundError{strings.Join(key, "/"), t.instance}
+				}
+				key = append(key, tilde(t.instance))
+				t.instance = ""
+			}
+			key = append(key, segment)
+		}
+	}
+	t.collection = strings.Join(key, "/")
+	if t.collection == "" {
+		return target{}, fmt.Errorf("invalid path %s", path)
+	}
+	return t, nil
+}
+
+// groups are the paths of /mgmt/tm that contain collections, such as the types of
+// ltm/monitor, rather than objects.
+var groups = map[string]bool{
+	"ltm/data-group":  true,
+	"ltm/monitor":     true,
+	"ltm/persistence": true,
+	"ltm/profile":     true,
+	"gtm/monitor":     true,
+	"gtm/pool":        true,
+	"gtm/wideip":      true,
+	"net/tunnels":     true,
+	"sys/application": true,
+	"sys/crypto":      true,
+	"sys/disk":        true,
+	"sys/ecm":         true,
+	"sys/file":        true,
+	"sys/ipfix":       true,
+	"sys/performance": true,
+	"sys/pfman":       true,
+	"sys/raid":        true,
+	"sys/software":    true,
+}
+
+// isInstance reports whether segment is the name of an object of the collection key. s.mu must be held.
+func (s *Server) isInstance(key, segment string) bool {
+	if strings.HasPrefix(segment, "~") {
+		return true
+	}
+	if _, ok := s.collections[key+"/"+segment]; ok {
+		return false
+	}
+	return !groups[key]
+}
+
+// object returns the object of a collection. s.mu must be held.
+func (s *Server) object(key, fullPath string) (map[string]interface{}, bool) {
+	c, ok := s.collections[key]
+	if !ok {
+		return nil, false
+	}
+	object, ok := c.objects[fullPath]
+	return object, ok
+}
+
+// notFoundError is returned for an object that does not exist.
+type notFoundError struct {
+	collection, fullPath string
+}
+
+// Error implements the errors.Error interface
+func (err *notFoundError) Error() string {
+	return fmt.Sprintf("01020036:3: The requested %s (%s) was not found.", typeName(err.collection), err.fullPath)
+}
+
+func (s *Server) serveTM(w http.ResponseWriter, r *http.Request, body map[string]interface{}) {
+	body = camelCase(body)
+	t, err := s.resolve(r.URL.Path)
+	if err != nil {
+		if _, ok := err.(*notFoundError); ok {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	switch {
+	case t.stats && r.Method == http.MethodGet:
+		s.serveStats(w, t)
+	case t.stats:
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+	case t.instance == "" && r.Method == http.MethodGet:
+		s.list(w, r, t.collection)
+	case t.instance == "" && r.Method == http.MethodPost:
+		data, _ := json.Marshal(body)
+		object, status, err := s.create(t.collection, data)
+		if err != nil {
+			writeError(w, status, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, object)
+	case t.instance == "":
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+	default:
+		s.serveObject(w, r, t, body)
+	}
+}
+
+// serveObject reads, replaces, patches or deletes an object. s.mu must be held.
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, t target, body map[string]interface{}) {
+	object, ok := s.object(t.collection, t.instance)
+	if !ok {
+		writeError(w, http.StatusNotFound, (&notFoundError{t.collection, t.instance}).Error())
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("expandSubcollections") == "true" {
+			object = s.expand(t.collection, t.instance, object)
+		}
+		writeJSON(w, http.StatusOK, selectFields(object, r.URL.Query().Get("$select")))
+	case http.MethodPut, http.MethodPatch:
+		if name, ok := body["name"].(string); ok && qualified(name) != t.instance && name != object["name"] {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("The name %s of the body does not match %s.", name, t.instance))
+			return
+		}
+		updated := body
+		if r.Method == http.MethodPatch {
+			updated = clone(object)
+			for key, value := range body {
+				updated[key] = value
+			}
+		}
+		for _, key := range []string{"kind", "name", "partition", "subPath", "fullPath", "selfLink"} {
+			if value, ok := object[key]; ok {
+				updated[key] = value
+			}
+		}
+		setState(r.Method, object, updated, body)
+		s.generation++
+		updated["generation"] = s.generation
+		s.collections[t.collection].objects[t.instance] = updated
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		s.delete(t.collection, t.instance)
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+	}
+}
+
+// camelCase renames the properties of a body given with their tmsh names, such as
+// file-name, to the names BIG-IP returns, such as fileName.
+func camelCase(body map[string]interface{}) map[string]interface{} {
+	for key, value := range body {
+		if !strings.Contains(key, "-") {
+			continue
+		}
+		words := strings.Split(key, "-")
+		for i := 1; i < len(words); i++ {
+			if words[i] != "" {
+				words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+			}
+		}
+		delete(body, key)
+		body[strings.Join(words, "")] = value
+	}
+	return body
+}
+
+// setState keeps the enabled and disabled properties of an updated object consistent, as
+// BIG-IP does: setting one of them clears the other, and an object that had one of them
+// and is replaced by a body without either is enabled again.
+func setState(method string, object, updated, body map[string]interface{}) {
+	switch {
+	case body["disabled"] == true:
+		delete(updated, "enabled")
+	case body["enabled"] == true:
+		delete(updated, "disabled")
+	case method == http.MethodPut && (object["enabled"] != nil || object["disabled"] != nil):
+		delete(updated, "disabled")
+		updated["enabled"] = true
+	}
+}
+
+// create adds an object to a collection. It returns the status code of the error, if any. s.mu must be held.
+func (s *Server) create(key string, data []byte) (map[string]interface{}, int, error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	name, _ := object["name"].(string)
+	if name == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf("01070734:3: Configuration error: the name of the %s is missing.", typeName(key))
+	}
+	p, err := rest.ParsePath(name)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if !p.IsFull() {
+		partition, _ := object["partition"].(string)
+		if partition == "" {
+			partition = DefaultPartition
+		}
+		subPath, _ := object["subPath"].(string)
+		p = rest.NewPath(partition, subPath, name)
+	}
+	fullPath := p.String()
+	c, ok := s.collections[key]
+	if !ok {
+		c = &collection{objects: map[string]map[string]interface{}{}, stats: map[string]map[string]interface{}{}}
+		s.collections[key] = c
+	}
+	if _, exists := c.objects[fullPath]; exists {
+		return nil, http.StatusConflict, fmt.Errorf("01020066:3: The requested %s (%s) already exists in partition %s.", typeName(key), fullPath, p.Partition)
+	}
+
+	s.generation++
+	object["name"] = p.FullName()
+	object["partition"] = p.Partition
+	if p.SubPath != "" {
+		object["subPath"] = p.SubPath
+	}
+	object["fullPath"] = fullPath
+	object["kind"] = kind(key, "state")
+	object["selfLink"] = s.link(key, fullPath)
+	object["generation"] = s.generation
+	c.objects[fullPath] = object
+	return object, http.StatusOK, nil
+}
+
+// delete removes an object, its statistics and its sub-collections. s.mu must be held.
+func (s *Server) delete(key, fullPath string) {
+	s.generation++
+	delete(s.collections[key].objects, fullPath)
+	delete(s.collections[key].stats, fullPath)
+	prefix := key + "/" + tilde(fullPath) + "/"
+	for sub := range s.collections {
+		if strings.HasPrefix(sub, prefix) {
+			delete(s.collections, sub)
+		}
+	}
+}
+
+// list writes the objects of a collection, sorted by full path, applying the $filter,
+// $select, $top and $skip query parameters. s.mu must be held.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, key string) {
+	query := r.URL.Query()
+	matches, err := filter(query.Get("$filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	items := []interface{}{}
+	for _, fullPath := range s.fullPaths(key) {
+		object := s.collections[key].objects[fullPath]
+		if query.Get("expandSubcollections") == "true" {
+			object = s.expand(key, fullPath, object)
+		}
+		if matches(object) {
+			items = append(items, selectFields(object, query.Get("$select")))
+		}
+	}
+
+	response := map[string]interface{}{
+		"kind":     kind(key, "collectionstate"),
+		"selfLink": s.link(key, ""),
+	}
+	skip, _ := strconv.Atoi(query.Get("$skip"))
+	top, _ := strconv.Atoi(query.Get("$top"))
+	if top > 0 {
+		total := len(items)
+		if skip > total {
+			skip = total
+		}
+		end := skip + top
+		if end > total {
+			end = total
+		}
+		items = items[skip:end]
+		response["totalItems"] = total
+		response["currentItemCount"] = len(items)
+		response["itemsPerPage"] = top
+		response["startIndex"] = skip + 1
+		response["pageIndex"] = skip/top + 1
+		response["totalPages"] = (total + top - 1) / top
+		if end < total {
+			next := url.Values{}
+			for name, values := range query {
+				next[name] = values
+			}
+			next.Set("$skip", strconv.Itoa(end))
+			response["nextLink"] = "https://localhost/mgmt/tm/" + key + "?" + next.Encode()
+		}
+	}
+	response["items"] = items
+	writeJSON(w, http.StatusOK, response)
+}
+
+// fullPaths returns the full paths of the objects of a collection, sorted. s.mu must be held.
+func (s *Server) fullPaths(key string) []string {
+	c, ok := s.collections[key]
+	if !ok {
+		return nil
+	}
+	fullPaths := make([]string, 0, len(c.objects))
+	for fullPath := range c.objects {
+		fullPaths = append(fullPaths, fullPath)
+	}
+	sort.Strings(fullPaths)
+	return fullPaths
+}
+
+// expand returns a copy of an object with the objects of its sub-collections, as
+// BIG-IP does with expandSubcollections=true. s.mu must be held.
+func (s *Server) expand(key, fullPath string, object map[string]interface{}) map[string]interface{} {
+	prefix := key + "/" + tilde(fullPath) + "/"
+	expanded := clone(object)
+	for sub := range s.collections {
+		name := strings.TrimPrefix(sub, prefix)
+		if name == sub || strings.Contains(name, "/") {
+			continue
+		}
+		items := []interface{}{}
+		for _, itemPath := range s.fullPaths(sub) {
+			items = append(items, s.collections[sub].objects[itemPath])
+		}
+		expanded[name+"Reference"] = map[string]interface{}{
+			"link":            s.link(sub, ""),
+			"isSubcollection": true,
+			"items":           items,
+		}
+	}
+	return expanded
+}
+
+// serveStats writes the statistics of an object or of the objects of a collection. s.mu must be held.
+func (s *Server) serveStats(w http.ResponseWriter, t target) {
+	fullPaths := []string{t.instance}
+	if t.instance == "" {
+		fullPaths = s.fullPaths(t.collection)
+	} else if _, ok := s.object(t.collection, t.instance); !ok {
+		writeError(w, http.StatusNotFound, (&notFoundError{t.collection, t.instance}).Error())
+		return
+	}
+	entries := map[string]interface{}{}
+	for _, fullPath := range fullPaths {
+		stats := map[string]interface{}{}
+		for name, value := range s.collections[t.collection].stats[fullPath] {
+			stats[name] = value
+		}
+		stats["tmName"] = map[string]interface{}{"description": fullPath}
+		link := strings.Replace(s.link(t.collection, fullPath), "?ver=", "/stats?ver=", 1)
+		entries[link] = map[string]interface{}{"nestedStats": map[string]interface{}{"entries": stats}}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"kind":     kind(t.collection, "stats"),
+		"selfLink": strings.Replace(s.link(t.collection, t.instance), "?ver=", "/stats?ver=", 1),
+		"entries":  entries,
+	})
+}
+
+// link returns the selfLink of a collection, or of one of its objects.
+func (s *Server) link(key, fullPath string) string {
+	link := "https://localhost/mgmt/tm/" + key
+	if fullPath != "" {
+		link += "/" + tilde(fullPath)
+	}
+	return link + "?ver=" + s.version
+}
+
+// filter parses a $filter made of "<property> eq <value>" expressions joined with "and",
+// such as "partition eq Common" or "(partition eq Common) and (name eq web)", the only
+// forms used by this library.
+func filter(expr string) (func(object map[string]interface{}) bool, error) {
+	type condition struct{ property, value string }
+	var conditions []condition
+	if expr != "" {
+		for _, term := range strings.Split(expr, " and ") {
+			fields := strings.Fields(strings.Trim(term, "()"))
+			if len(fields) != 3 || fields[1] != "eq" {
+				return nil, fmt.Errorf("unsupported $filter %q", expr)
+			}
+			conditions = append(conditions, condition{fields[0], strings.Trim(fields[2], `'"`)})
+		}
+	}
+	return func(object map[string]interface{}) bool {
+		for _, c := range conditions {
+			if fmt.Sprint(object[c.property]) != c.value {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// selectFields returns the given comma separated properties of an object, or the object if there are none.
+func selectFields(object map[string]interface{}, fields string) map[string]interface{} {
+	if fields == "" {
+		return object
+	}
+	selected := map[string]interface{}{}
+	for _, field := range strings.Split(fields, ",") {
+		if value, ok := object[field]; ok {
+			selected[field] = value
+		}
+	}
+	return selected
+}
+
+// kind returns the kind of a collection path for the given suffix, for example
+// "tm:ltm:pool:poolstate" for ltm/pool and "state".
+func kind(key, suffix string) string {
+	var types []string
+	for _, segment := range strings.Split(key, "/") {
+		if !strings.HasPrefix(segment, "~") {
+			types = append(types, segment)
+		}
+	}
+	return "tm:" + strings.Join(types, ":") + ":" + types[len(types)-1] + suffix
+}
+
+// typeName returns the name of the type of the objects of a collection, as used in error messages.
+func typeName(key string) string {
+	name := key[strings.LastIndex(key, "/")+1:]
+	if name == "" {
+		return "object"
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// qualified returns the full path of a name, qualifying a bare name with the Common partition.
+func qualified(name string) string {
+	p, err := rest.ParsePath(name)
+	if err != nil {
+		return name
+	}
+	return p.WithPartition(DefaultPartition).String()
+}
+
+// tilde returns a full path in the "~" form used in URLs.
+func tilde(fullPath string) string {
+	return strings.ReplaceAll(fullPath, "/", "~")
+}
+
+// clone returns a deep copy of a JSON object.
+func clone(object map[string]interface{}) map[string]interface{} {
+	data, _ := json.Marshal(object)
+	var copied map[string]interface{}
+	json.Unmarshal(data, &copied)
+	return copied
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format of BIG-IP.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"code":       statusCode,
+		"message":    message,
+		"errorStack": []string{},
+		"apiError":   3,
+	})
+}
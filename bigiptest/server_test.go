@@ -0,0 +1,249 @@
+package bigiptest
+
+import (
+	"context"
+	"errors"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/ltm"
+	"github.com/lefeck/go-bigip/ltm/monitor"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newSession(t *testing.T, s *Server, options ...bigip.Option) *bigip.BigIP {
+	t.Helper()
+	b, err := bigip.NewSession(s.URL, DefaultUsername, DefaultPassword, options...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestServerCRUD(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	pools := ltm.New(newSession(t, s)).Pool()
+
+	if err := pools.Create(ctx, ltm.Pool{Name: "web", LoadBalancingMode: "round-robin"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := pools.Create(ctx, ltm.Pool{Name: "api", Partition: "Tenant_A"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := pools.Create(ctx, ltm.Pool{Name: "web"}); !rest.IsConflict(err) {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+
+	pool, err := pools.Get(ctx, "/Common/web")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pool.FullPath != "/Common/web" || pool.LoadBalancingMode != "round-robin" || pool.Kind != "tm:ltm:pool:poolstate" {
+		t.Errorf("Unexpected pool %+v", pool)
+	}
+	generation := pool.Generation
+
+	if err := pools.Patch(ctx, "/Common/web", map[string]string{"description": "patched"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pool, _ = pools.Get(ctx, "web"); pool.Description != "patched" || pool.LoadBalancingMode != "round-robin" || pool.Generation <= generation {
+		t.Errorf("Expected a patched pool with a new generation, got %+v", pool)
+	}
+	if err := pools.UpdateIfUnchanged(ctx, "/Common/web", ltm.Pool{Name: "web", Generation: generation}); !errors.Is(err, bigip.ErrGenerationConflict) {
+		t.Errorf("Expected a generation conflict, got %v", err)
+	}
+
+	list, err := pools.List(ctx, &rest.ListOptions{Partition: "Tenant_A", Filter: "name eq api"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].FullPath != "/Tenant_A/api" {
+		t.Errorf("Expected only /Tenant_A/api, got %+v", list.Items)
+	}
+	var names []string
+	if err := pools.Each(ctx, func(item *ltm.Pool) error {
+		names = append(names, item.FullPath)
+		return nil
+	}, &rest.ListOptions{Top: 1}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(names) != 2 {
+		t.Errorf("Expected 2 pools over 2 pages, got %v", names)
+	}
+
+	if err := pools.Delete(ctx, "/Common/web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := pools.Get(ctx, "/Common/web"); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestServerSubcollectionsAndStats(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	b := newSession(t, s)
+
+	if _, err := s.Add("ltm/pool", map[string]string{"name": "web"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := ltm.New(b).PoolMembers().Create(ctx, "/Common/web", ltm.PoolMembers{Name: "10.1.1.5%2:80"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := ltm.New(b).PoolMembers().Create(ctx, "/Common/missing", ltm.PoolMembers{Name: "10.1.1.6:80"}); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	member, err := ltm.New(b).PoolMembers().Get(ctx, "/Common/web", "/Common/10.1.1.5%2:80")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if member.FullPath != "/Common/10.1.1.5%2:80" {
+		t.Errorf("Expected /Common/10.1.1.5%%2:80, got %s", member.FullPath)
+	}
+	if _, ok := s.Get("ltm/pool/~Common~web/members", "/Common/10.1.1.5%2:80"); !ok {
+		t.Error("Expected the member to be stored")
+	}
+
+	if err := s.SetStats("ltm/pool", "/Common/web", map[string]interface{}{"activeMemberCnt": 1, "status.availabilityState": "available"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	stats, err := ltm.New(b).PoolStats().GetPoolStats(ctx, "/Common/web")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, entry := range stats.Entries {
+		if entry.NestedPoolStats.Entries.ActiveMemberCnt.Value != 1 {
+			t.Errorf("Expected 1 active member, got %+v", entry.NestedPoolStats.Entries.ActiveMemberCnt)
+		}
+	}
+	if len(stats.Entries) != 1 {
+		t.Errorf("Expected 1 stats entry, got %d", len(stats.Entries))
+	}
+
+	// Deleting the pool deletes its members.
+	if err := ltm.New(b).Pool().Delete(ctx, "/Common/web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := s.Get("ltm/pool/~Common~web/members", "/Common/10.1.1.5%2:80"); ok {
+		t.Error("Expected the member to be deleted with its pool")
+	}
+
+	v, err := b.Version(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v.String() != DefaultVersion {
+		t.Errorf("Expected %s, got %s", DefaultVersion, v)
+	}
+}
+
+func TestServerEmptyCollections(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	b := newSession(t, s)
+	pools := ltm.New(b).Pool()
+
+	if _, err := pools.Get(ctx, "web"); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	if err := pools.Delete(ctx, "web"); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	if _, err := ltm.New(b).PoolMembers().List(ctx, "web"); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+
+	list, err := monitor.NewMonitor(b).HTTP().List(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list.Items) != 0 {
+		t.Errorf("Expected no monitors, got %+v", list.Items)
+	}
+	if _, err := monitor.NewMonitor(b).HTTP().Get(ctx, "custom"); !rest.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestServerProperties(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	b := newSession(t, s)
+	virtuals := ltm.New(b).Virtual()
+
+	if err := virtuals.Create(ctx, ltm.VirtualServer{Name: "web", Enabled: true}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := virtuals.Disable(ctx, "web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v, err := virtuals.Get(ctx, "web"); err != nil || v.Enabled || !v.Disabled {
+		t.Errorf("Expected a disabled virtual server, got %+v, %v", v, err)
+	}
+	if err := virtuals.Update(ctx, "web", ltm.VirtualServer{Name: "web", Description: "replaced"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v, err := virtuals.Get(ctx, "web"); err != nil || !v.Enabled || v.Disabled {
+		t.Errorf("Expected a replaced virtual server to be enabled, got %+v, %v", v, err)
+	}
+
+	if err := ltm.New(b).IFile().Create(ctx, "data", "/var/tmp/data.txt"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if f, err := ltm.New(b).IFile().Get(ctx, "data"); err != nil || f.FileName != "/var/tmp/data.txt" {
+		t.Errorf("Expected the file name given as file-name, got %+v, %v", f, err)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+
+	b, err := bigip.NewToken(s.URL, DefaultUsername, DefaultPassword, "tmos")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pools := ltm.New(b).Pool()
+	if _, err := pools.List(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	s.ExpireTokens()
+	if _, err := pools.List(ctx); err != nil {
+		t.Fatalf("Expected a new login after the token expired, got %v", err)
+	}
+	if s.Logins() != 2 {
+		t.Errorf("Expected 2 logins, got %d", s.Logins())
+	}
+
+	if _, err := bigip.NewToken(s.URL, DefaultUsername, "wrong", "tmos"); err == nil {
+		t.Error("Expected an error for wrong credentials")
+	}
+	anonymous, _ := bigip.New(s.URL)
+	if _, err := ltm.New(anonymous).Pool().List(ctx); !rest.IsUnauthorized(err) {
+		t.Errorf("Expected an unauthorized error, got %v", err)
+	}
+
+	s.FailNext(1, http.StatusServiceUnavailable)
+	if _, err := pools.List(ctx); rest.StatusCode(err) != http.StatusServiceUnavailable {
+		t.Errorf("Expected a 503 error, got %v", err)
+	}
+	s.FailNext(1, http.StatusServiceUnavailable)
+	retrying := ltm.New(newSession(t, s, bigip.WithRetry(&rest.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}))).Pool()
+	if _, err := retrying.List(ctx); err != nil {
+		t.Errorf("Expected the 503 to be retried, got %v", err)
+	}
+
+	s.SetLatency(100 * time.Millisecond)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := pools.List(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected a deadline error, got %v", err)
+	}
+}
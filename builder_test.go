@@ -0,0 +1,78 @@
+package bigip
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setOnce are the rest.Request builders that fail the request when they are called twice.
+var setOnce = map[string]bool{
+	"ResourceCategory":    true,
+	"ManagerName":         true,
+	"Resource":            true,
+	"ResourceInstance":    true,
+	"SubResource":         true,
+	"SubResourceInstance": true,
+	"SubStatsResource":    true,
+}
+
+// TestBuildersCalledOnce checks that no request chain of the repository calls a builder
+// of setOnce twice, since such a request always fails with an "already set" error.
+func TestBuildersCalledOnce(t *testing.T) {
+	fset := token.NewFileSet()
+	err := filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			// The examples are not part of the module build.
+			if info.Name() == "example" || info.Name() == "testdata" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// Tests may chain a builder twice on purpose to check the error.
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || !setOnce[sel.Sel.Name] {
+				return true
+			}
+			for x := sel.X; ; {
+				inner, ok := x.(*ast.CallExpr)
+				if !ok {
+					break
+				}
+				innerSel, ok := inner.Fun.(*ast.SelectorExpr)
+				if !ok {
+					break
+				}
+				if innerSel.Sel.Name == sel.Sel.Name {
+					t.Errorf("%s: %s is called twice in the same request", fset.Position(sel.Sel.Pos()), sel.Sel.Name)
+					break
+				}
+				x = innerSel.X
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
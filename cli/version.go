@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/lefeck/go-bigip"
 )
 
@@ -38,17 +39,27 @@ type VersionStatsResoure struct {
 const VersionEndpoint = "version"
 
 // Show bigip device version
-func (vsr *VersionStatsResoure) Show() (*VersionStats, error) {
+func (vsr *VersionStatsResoure) Show(ctx context.Context) (*VersionStats, error) {
 	var vs *VersionStats
 	res, err := vsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(CliManager).
-		Resource(VersionEndpoint).DoRaw(context.Background())
+		Resource(VersionEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if err := json.Unmarshal(res, &vs); err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
 	}
 
 	return vs, nil
 }
+
+// Active returns the active TMOS version reported in the stats, see also bigip.BigIP.Version.
+func (vs *VersionStats) Active() (bigip.Version, error) {
+	for _, entry := range vs.Entries {
+		if active := entry.NestedStats.EntriesMenu.Active.Description; active != "" {
+			return bigip.ParseVersion(active)
+		}
+	}
+	return bigip.Version{}, fmt.Errorf("no active version found")
+}
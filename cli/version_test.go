@@ -1,12 +1,40 @@
 package cli
 
 import (
+	"context"
 	"github.com/lefeck/go-bigip"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
+// versionResponse is the reply of BIG-IP to GET /mgmt/tm/cli/version.
+const versionResponse = `{
+  "kind": "tm:cli:version:versionstats",
+  "selfLink": "https://localhost/mgmt/tm/cli/version",
+  "entries": {
+    "https://localhost/mgmt/tm/cli/version/0": {
+      "nestedStats": {
+        "entries": {
+          "active": {"description": "16.1.3"},
+          "latest": {"description": "16.1.0"},
+          "supported": {"description": "12.1.0 13.0.0 13.1.0 14.0.0 14.1.0 15.0.0 15.1.0 16.0.0 16.1.0"}
+        }
+      }
+    }
+  }
+}`
+
 func TestVersionStatsResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/mgmt/tm/cli/version" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(versionResponse))
+	}))
+	defer server.Close()
+	bigIP, err := bigip.NewSession(server.URL, "admin", "admin")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -15,15 +43,15 @@ func TestVersionStatsResource(t *testing.T) {
 	}
 
 	// Show BIG-IP device version
-	versionStats, err := versionStatsResource.Show()
+	versionStats, err := versionStatsResource.Show(context.Background())
 	if err != nil {
 		t.Fatalf("Error getting BIG-IP device version: %v", err)
 	}
 
 	// Validate properties
 	entries := versionStats.Entries
-	if entries == nil {
-		t.Error("Entries of VersionStats is nil")
+	if len(entries) != 1 {
+		t.Errorf("Expected 1 entry of VersionStats, got %d", len(entries))
 	}
 
 	for _, entry := range entries {
@@ -0,0 +1,243 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"strings"
+)
+
+// Collection is a typed client for a BIG-IP collection endpoint such as
+// /mgmt/tm/ltm/pool or /mgmt/tm/ltm/monitor/http.
+// T is the type of a single item and L the type of the list returned by the collection.
+type Collection[T any, L any] struct {
+	b         *BigIP
+	category  string
+	manager   string
+	resources []string
+	partition string
+	// unpartitioned is set for the objects that do not belong to a partition.
+	unpartitioned bool
+	// minVersion is the first TMOS version providing the collection.
+	minVersion Version
+}
+
+// NewCollection creates a Collection for /mgmt/tm/<manager>/<resources...>, for example:
+//
+//	bigip.NewCollection[HTTP, HTTPList](b, "ltm", "monitor", "http")
+func NewCollection[T any, L any](b *BigIP, manager string, resources ...string) *Collection[T, L] {
+	return &Collection[T, L]{
+		b:         b,
+		category:  GetTMResource(),
+		manager:   manager,
+		resources: resources,
+		partition: b.partition,
+	}
+}
+
+// WithCategory returns a copy of the collection living under another resource category,
+// such as GetCMResource() or GetShareResource().
+func (c *Collection[T, L]) WithCategory(category string) *Collection[T, L] {
+	cc := *c
+	cc.category = category
+	return &cc
+}
+
+// WithPartition returns a copy of the collection scoped to a partition.
+// List only returns the items of the partition, and bare names are qualified as /<partition>/<name>.
+// The collections of a BigIP restricted with InPartition are already scoped to its partition.
+func (c *Collection[T, L]) WithPartition(partition string) *Collection[T, L] {
+	cc := *c
+	cc.partition = strings.Trim(partition, "/")
+	cc.unpartitioned = false
+	return &cc
+}
+
+// WithoutPartition returns a copy of the collection for objects that do not belong to a
+// partition, such as sys/ucs or shared/authz/tokens. Even for a BigIP restricted with
+// InPartition, List does not filter the items and names are used as given.
+func (c *Collection[T, L]) WithoutPartition() *Collection[T, L] {
+	cc := *c
+	cc.partition = ""
+	cc.unpartitioned = true
+	return &cc
+}
+
+// WithMinVersion returns a copy of the collection that only exists from TMOS min on.
+// Its methods then return an *UnsupportedError on older devices, before sending any request.
+func (c *Collection[T, L]) WithMinVersion(min Version) *Collection[T, L] {
+	cc := *c
+	cc.minVersion = min
+	return &cc
+}
+
+// Partition returns the partition the collection is scoped to, if any.
+func (c *Collection[T, L]) Partition() string {
+	return c.partition
+}
+
+// List all the items of the collection. The optional opts select the returned
+// properties, filter the items or page through the collection.
+func (c *Collection[T, L]) List(ctx context.Context, opts ...*rest.ListOptions) (*L, error) {
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	if c.partition != "" {
+		opts = append([]*rest.ListOptions{{Partition: c.partition}}, opts...)
+	}
+	res, err := c.request(http.MethodGet).ListOptions(opts...).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var items L
+	if err := json.Unmarshal(res, &items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &items, nil
+}
+
+// Get a single item identified by its full path name.
+func (c *Collection[T, L]) Get(ctx context.Context, fullPathName string) (*T, error) {
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	res, err := c.doInstance(ctx, http.MethodGet, fullPathName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var item T
+	if err := json.Unmarshal(res, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &item, nil
+}
+
+// Exists reports whether an item identified by its full path name exists.
+func (c *Collection[T, L]) Exists(ctx context.Context, fullPathName string) (bool, error) {
+	if err := c.check(ctx); err != nil {
+		return false, err
+	}
+	_, err := c.doInstance(ctx, http.MethodGet, fullPathName, nil)
+	if rest.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Create a new item.
+func (c *Collection[T, L]) Create(ctx context.Context, item T) error {
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	if !c.unpartitioned {
+		if data, err = c.b.RestrictBody(data); err != nil {
+			return err
+		}
+	}
+	_, err = c.request(http.MethodPost).Body(data).DoRaw(ctx)
+	return err
+}
+
+// Update replaces the item identified by its full path name.
+func (c *Collection[T, L]) Update(ctx context.Context, fullPathName string, item T) error {
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = c.doInstance(ctx, http.MethodPut, fullPathName, data)
+	return err
+}
+
+// Patch changes only the given fields of the item identified by its full path name.
+// See PatchBody for the accepted types of fields.
+func (c *Collection[T, L]) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	data, err := PatchBody(fields)
+	if err != nil {
+		return err
+	}
+	_, err = c.doInstance(ctx, http.MethodPatch, fullPathName, data)
+	return err
+}
+
+// Delete the item identified by its full path name.
+func (c *Collection[T, L]) Delete(ctx context.Context, fullPathName string) error {
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	_, err := c.doInstance(ctx, http.MethodDelete, fullPathName, nil)
+	return err
+}
+
+// check returns a *PartitionError if the collection is scoped to another partition than
+// the BigIP, and an *UnsupportedError if the device is older than the collection.
+func (c *Collection[T, L]) check(ctx context.Context) error {
+	if c.b.partition != "" && !c.unpartitioned && c.partition != c.b.partition {
+		return &PartitionError{Partition: c.b.partition, Path: "/" + c.partition}
+	}
+	if c.minVersion.IsZero() {
+		return nil
+	}
+	return c.b.RequireVersion(ctx, strings.Join(append([]string{c.manager}, c.resources...), "/"), c.minVersion)
+}
+
+// request begins a request against the collection itself.
+func (c *Collection[T, L]) request(verb string) *rest.Request {
+	req := c.b.RestClient.Verb(verb).Prefix(GetBaseResource()).ResourceCategory(c.category).ManagerName(c.manager)
+	if len(c.resources) > 0 {
+		req = req.Resource(c.resources[0])
+	}
+	if len(c.resources) > 1 {
+		req = req.SubResource(c.resources[1:]...)
+	}
+	return req
+}
+
+// instance begins a request against a single item of the collection. It returns
+// a *PartitionError for an item outside the partition of a restricted BigIP.
+func (c *Collection[T, L]) instance(verb, fullPathName string) (*rest.Request, error) {
+	if !c.unpartitioned {
+		var err error
+		if fullPathName, err = c.b.Qualify(c.qualify(fullPathName)); err != nil {
+			return nil, err
+		}
+	}
+	req := c.request(verb)
+	if len(c.resources) > 1 {
+		return req.SubResourceInstance(fullPathName), nil
+	}
+	return req.ResourceInstance(fullPathName), nil
+}
+
+// doInstance sends a request against a single item of the collection.
+func (c *Collection[T, L]) doInstance(ctx context.Context, verb, fullPathName string, body []byte) ([]byte, error) {
+	req, err := c.instance(verb, fullPathName)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req = req.Body(body)
+	}
+	return req.DoRaw(ctx)
+}
+
+// qualify prefixes a bare name with the partition of the collection.
+func (c *Collection[T, L]) qualify(name string) string {
+	return qualify(c.partition, name)
+}
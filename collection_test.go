@@ -0,0 +1,100 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testPool struct {
+	Name      string `json:"name,omitempty"`
+	Partition string `json:"partition,omitempty"`
+	Monitor   string `json:"monitor,omitempty"`
+}
+
+type testPoolList struct {
+	Items []testPool `json:"items,omitempty"`
+}
+
+func TestCollection(t *testing.T) {
+	type call struct {
+		method, path, query, body string
+	}
+	var calls []call
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, call{r.Method, r.URL.EscapedPath(), r.URL.RawQuery, string(body)})
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/mgmt/tm/ltm/monitor/http/~Common~missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"not found"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/mgmt/tm/ltm/monitor/http":
+			json.NewEncoder(w).Encode(testPoolList{Items: []testPool{{Name: "http", Partition: "Common"}}})
+		default:
+			json.NewEncoder(w).Encode(testPool{Name: "web", Partition: "Common"})
+		}
+	}))
+	defer server.Close()
+
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	c := NewCollection[testPool, testPoolList](b, "ltm", "monitor", "http")
+
+	list, err := c.List(ctx)
+	if err != nil || len(list.Items) != 1 {
+		t.Fatalf("Unexpected list result %v: %v", list, err)
+	}
+	item, err := c.Get(ctx, "/Common/web")
+	if err != nil || item.Name != "web" {
+		t.Fatalf("Unexpected get result %v: %v", item, err)
+	}
+	if err := c.Create(ctx, testPool{Name: "web"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := c.Update(ctx, "/Common/web", testPool{Name: "web", Monitor: "http"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := c.Patch(ctx, "/Common/web", map[string]string{"monitor": "tcp"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := c.Delete(ctx, "/Common/web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	exists, err := c.Exists(ctx, "/Common/missing")
+	if err != nil || exists {
+		t.Fatalf("Expected missing item not to exist, got %v: %v", exists, err)
+	}
+	if _, err := c.WithPartition("Tenant_A").List(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := c.WithPartition("Tenant_A").Get(ctx, "web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []call{
+		{http.MethodGet, "/mgmt/tm/ltm/monitor/http", "", ""},
+		{http.MethodGet, "/mgmt/tm/ltm/monitor/http/~Common~web", "", ""},
+		{http.MethodPost, "/mgmt/tm/ltm/monitor/http", "", `{"name":"web"}`},
+		{http.MethodPut, "/mgmt/tm/ltm/monitor/http/~Common~web", "", `{"name":"web","monitor":"http"}`},
+		{http.MethodPatch, "/mgmt/tm/ltm/monitor/http/~Common~web", "", `{"monitor":"tcp"}`},
+		{http.MethodDelete, "/mgmt/tm/ltm/monitor/http/~Common~web", "", ""},
+		{http.MethodGet, "/mgmt/tm/ltm/monitor/http/~Common~missing", "", ""},
+		{http.MethodGet, "/mgmt/tm/ltm/monitor/http", "%24filter=partition+eq+Tenant_A", ""},
+		{http.MethodGet, "/mgmt/tm/ltm/monitor/http/~Tenant_A~web", "", ""},
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected %d calls, got %d: %v", len(expected), len(calls), calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Expected call %v, got %v", expected[i], calls[i])
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DefaultModifyAttempts is the number of times Modify tries to apply a change
+// before giving up on concurrent modifications.
+const DefaultModifyAttempts = 5
+
+// ErrGenerationConflict is matched by errors.Is for every *GenerationConflictError.
+var ErrGenerationConflict = errors.New("generation conflict")
+
+// GenerationConflictError is returned by a conditional update when the object was modified
+// since it was read, that is when its generation on the device differs from the expected one.
+type GenerationConflictError struct {
+	FullPath string
+	// Expected is the generation of the object that was read and Actual the one on the device.
+	Expected int64
+	Actual   int64
+}
+
+// Error implements the errors.Error interface
+func (err *GenerationConflictError) Error() string {
+	return fmt.Sprintf("%s was modified concurrently: expected generation %d, found %d", err.FullPath, err.Expected, err.Actual)
+}
+
+// Is reports whether target is ErrGenerationConflict.
+func (err *GenerationConflictError) Is(target error) bool {
+	return target == ErrGenerationConflict
+}
+
+// generationOf returns the generation property of a JSON object.
+func generationOf(data []byte) (int64, error) {
+	var object struct {
+		Generation int64 `json:"generation"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return object.Generation, nil
+}
+
+// checkGeneration returns a *GenerationConflictError if the generation of the item on the
+// device is not the expected one.
+func (c *Collection[T, L]) checkGeneration(ctx context.Context, fullPathName string, expected int64) error {
+	res, err := c.doInstance(ctx, http.MethodGet, fullPathName, nil)
+	if err != nil {
+		return err
+	}
+	actual, err := generationOf(res)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return &GenerationConflictError{FullPath: c.qualify(fullPathName), Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// UpdateIfUnchanged replaces the item identified by its full path name only if its generation
+// on the device is still the Generation of item, as returned by Get. Otherwise it returns
+// a *GenerationConflictError and nothing is changed.
+//
+// The check is not atomic: BIG-IP has no conditional requests, so the generation is read
+// with a GET right before the PUT. This detects the changes made since the item was read,
+// but a change made between the GET and the PUT is overwritten.
+//
+// Only the ltm pool, virtual, node and internal data group resources expose UpdateIfUnchanged
+// and Modify. For another type, call them on its collection, built with NewCollection.
+func (c *Collection[T, L]) UpdateIfUnchanged(ctx context.Context, fullPathName string, item T) error {
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	generation, err := generationOf(data)
+	if err != nil {
+		return err
+	}
+	if err := c.checkGeneration(ctx, fullPathName, generation); err != nil {
+		return err
+	}
+	_, err = c.doInstance(ctx, http.MethodPut, fullPathName, data)
+	return err
+}
+
+// Modify reads the item identified by its full path name, calls mutate to change it and
+// writes it back with UpdateIfUnchanged. If the item was modified concurrently, it is read
+// again and mutate is called on the new version, up to DefaultModifyAttempts times.
+// mutate must therefore only depend on the item it is given.
+func (c *Collection[T, L]) Modify(ctx context.Context, fullPathName string, mutate func(item *T) error) error {
+	var err error
+	for attempt := 0; attempt < DefaultModifyAttempts; attempt++ {
+		var item *T
+		if item, err = c.Get(ctx, fullPathName); err != nil {
+			return err
+		}
+		if err = mutate(item); err != nil {
+			return err
+		}
+		if err = c.UpdateIfUnchanged(ctx, fullPathName, *item); !errors.Is(err, ErrGenerationConflict) {
+			return err
+		}
+	}
+	return fmt.Errorf("giving up after %d attempts: %w", DefaultModifyAttempts, err)
+}
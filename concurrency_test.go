@@ -0,0 +1,114 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type testGenerationPool struct {
+	Name       string `json:"name,omitempty"`
+	Generation int64  `json:"generation,omitempty"`
+	Monitor    string `json:"monitor,omitempty"`
+}
+
+// generationServer serves a single pool whose generation is bumped on every change.
+type generationServer struct {
+	mu   sync.Mutex
+	pool testGenerationPool
+	puts int
+	// onGet is called after every GET, for example to simulate a concurrent change.
+	onGet func(s *generationServer)
+}
+
+func (s *generationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path != "/mgmt/tm/ltm/pool/~Common~web" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(s.pool)
+		if s.onGet != nil {
+			s.onGet(s)
+		}
+	case http.MethodPut, http.MethodPatch:
+		var pool testGenerationPool
+		json.NewDecoder(r.Body).Decode(&pool)
+		s.pool.Monitor = pool.Monitor
+		s.pool.Generation++
+		s.puts++
+		json.NewEncoder(w).Encode(s.pool)
+	}
+}
+
+func TestUpdateIfUnchanged(t *testing.T) {
+	gs := &generationServer{pool: testGenerationPool{Name: "web", Generation: 7}}
+	server := httptest.NewServer(gs)
+	defer server.Close()
+	b, _ := New(server.URL, WithBasicAuth("admin", "admin"))
+	pools := NewCollection[testGenerationPool, struct{}](b, "ltm", "pool")
+
+	ctx := context.Background()
+	pool, err := pools.Get(ctx, "/Common/web")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pool.Monitor = "/Common/http"
+	if err := pools.UpdateIfUnchanged(ctx, "/Common/web", *pool); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// pool is now stale.
+	pool.Monitor = "/Common/tcp"
+	err = pools.UpdateIfUnchanged(ctx, "/Common/web", *pool)
+	var conflict *GenerationConflictError
+	if !errors.As(err, &conflict) || conflict.Expected != 7 || conflict.Actual != 8 || !errors.Is(err, ErrGenerationConflict) {
+		t.Fatalf("Expected a GenerationConflictError, got %v", err)
+	}
+	if gs.puts != 1 || gs.pool.Monitor != "/Common/http" {
+		t.Errorf("Expected only the first update to be applied, got %d updates and monitor %s", gs.puts, gs.pool.Monitor)
+	}
+}
+
+func TestModify(t *testing.T) {
+	gs := &generationServer{pool: testGenerationPool{Name: "web", Generation: 1, Monitor: "/Common/http"}}
+	// Another job changes the pool right after the first read.
+	gs.onGet = func(s *generationServer) {
+		s.pool.Generation++
+		s.onGet = nil
+	}
+	server := httptest.NewServer(gs)
+	defer server.Close()
+	b, _ := New(server.URL, WithBasicAuth("admin", "admin"))
+	pools := NewCollection[testGenerationPool, struct{}](b, "ltm", "pool")
+
+	var seen []int64
+	err := pools.Modify(context.Background(), "/Common/web", func(pool *testGenerationPool) error {
+		seen = append(seen, pool.Generation)
+		pool.Monitor += " and /Common/tcp"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(seen) != 2 || seen[1] != 2 {
+		t.Errorf("Expected the mutation to be applied again on generation 2, got %v", seen)
+	}
+	if gs.puts != 1 || gs.pool.Monitor != "/Common/http and /Common/tcp" {
+		t.Errorf("Expected a single update, got %d updates and monitor %s", gs.puts, gs.pool.Monitor)
+	}
+
+	// The pool keeps changing: Modify gives up.
+	gs.onGet = func(s *generationServer) { s.pool.Generation++ }
+	err = pools.Modify(context.Background(), "/Common/web", func(pool *testGenerationPool) error { return nil })
+	if !errors.Is(err, ErrGenerationConflict) {
+		t.Errorf("Expected a GenerationConflictError, got %v", err)
+	}
+}
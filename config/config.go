@@ -0,0 +1,415 @@
+// Package config loads a multi-device configuration file, in the spirit of kubeconfig,
+// and builds BigIP clients from its named contexts.
+//
+// The file is JSON and lists devices, credentials and contexts pairing a device with
+// a credential:
+//
+//	{
+//	  "currentContext": "dc1",
+//	  "devices": [
+//	    {"name": "dc1-a", "device": {"host": "10.1.0.10", "tls": {"caFile": "ca.pem"}}}
+//	  ],
+//	  "credentials": [
+//	    {"name": "automation", "credential": {"username": "automation", "passwordEnv": "DC1_PASSWORD", "authMode": "token"}}
+//	  ],
+//	  "contexts": [
+//	    {"name": "dc1", "context": {"device": "dc1-a", "credential": "automation", "partition": "Common"}}
+//	  ]
+//	}
+//
+// Relative file paths are resolved from the directory of the configuration file.
+package config
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/transport"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Environment variables read by the loader.
+const (
+	// EnvConfig is the path of the configuration file, DefaultPath() if not set.
+	EnvConfig = "BIGIP_CONFIG"
+	// EnvContext selects the context used when none is given, instead of currentContext.
+	EnvContext = "BIGIP_CONTEXT"
+	// EnvHost, EnvUsername, EnvPassword and EnvPartition override the values of the selected context.
+	EnvHost      = "BIGIP_HOST"
+	EnvUsername  = "BIGIP_USERNAME"
+	EnvPassword  = "BIGIP_PASSWORD"
+	EnvPartition = "BIGIP_PARTITION"
+)
+
+// Authentication modes of a credential.
+const (
+	AuthModeBasic = "basic"
+	AuthModeToken = "token"
+)
+
+// Config holds the devices, credentials and contexts of a configuration file.
+type Config struct {
+	CurrentContext string            `json:"currentContext,omitempty"`
+	Devices        []NamedDevice     `json:"devices,omitempty"`
+	Credentials    []NamedCredential `json:"credentials,omitempty"`
+	Contexts       []NamedContext    `json:"contexts,omitempty"`
+
+	// dir is the directory relative file paths are resolved from.
+	dir string
+}
+
+type NamedDevice struct {
+	Name   string `json:"name"`
+	Device Device `json:"device"`
+}
+
+// Device describes how to reach a BIG-IP.
+type Device struct {
+	// Host is a host, host:port pair or URL.
+	Host string `json:"host"`
+	TLS  TLS    `json:"tls,omitempty"`
+	// Timeout is the maximum duration of a request, such as "30s".
+	Timeout string `json:"timeout,omitempty"`
+	// Proxy is the URL of an HTTP proxy.
+	Proxy string `json:"proxy,omitempty"`
+}
+
+// TLS holds the TLS settings of a device.
+type TLS struct {
+	Insecure     bool     `json:"insecure,omitempty"`
+	ServerName   string   `json:"serverName,omitempty"`
+	CAFile       string   `json:"caFile,omitempty"`
+	CAData       string   `json:"caData,omitempty"`
+	MinVersion   string   `json:"minVersion,omitempty"`
+	PinnedSHA256 []string `json:"pinnedSHA256,omitempty"`
+}
+
+type NamedCredential struct {
+	Name       string     `json:"name"`
+	Credential Credential `json:"credential"`
+}
+
+// Credential holds how to authenticate. The username and password can be given
+// inline, or referenced from a file or an environment variable.
+type Credential struct {
+	Username     string `json:"username,omitempty"`
+	UsernameFile string `json:"usernameFile,omitempty"`
+	UsernameEnv  string `json:"usernameEnv,omitempty"`
+	Password     string `json:"password,omitempty"`
+	PasswordFile string `json:"passwordFile,omitempty"`
+	PasswordEnv  string `json:"passwordEnv,omitempty"`
+	// AuthMode is AuthModeBasic (the default) or AuthModeToken.
+	AuthMode string `json:"authMode,omitempty"`
+	// LoginProvider is the login provider of token authentication.
+	LoginProvider string `json:"loginProvider,omitempty"`
+	// ClientCertificate and ClientKey are the files of a TLS client certificate.
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	ClientKey         string `json:"clientKey,omitempty"`
+}
+
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+// Context pairs a device with a credential.
+type Context struct {
+	Device     string `json:"device"`
+	Credential string `json:"credential"`
+	// Partition is the default partition of the context, used to qualify bare names.
+	Partition string `json:"partition,omitempty"`
+	// RestrictToPartition restricts the client to Partition, see bigip.BigIP.InPartition.
+	RestrictToPartition bool `json:"restrictToPartition,omitempty"`
+}
+
+// ResolvedContext is a context with its device and credential looked up, the credential
+// references read and the environment overrides applied.
+type ResolvedContext struct {
+	Name      string
+	Device    Device
+	Username  string
+	Password  string
+	Partition string
+	// RestrictToPartition is copied from the context.
+	RestrictToPartition bool
+	AuthMode            string
+	// LoginProvider, ClientCertificate and ClientKey are copied from the credential.
+	LoginProvider     string
+	ClientCertificate string
+	ClientKey         string
+}
+
+// DefaultPath returns the default location of the configuration file, ~/.bigip/config.
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".bigip", "config")
+	}
+	return filepath.Join(home, ".bigip", "config")
+}
+
+// Load reads the configuration file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	config.dir = filepath.Dir(path)
+	return config, nil
+}
+
+// LoadDefault reads the configuration file named by $BIGIP_CONFIG, or DefaultPath() if it is not set.
+func LoadDefault() (*Config, error) {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return Load(path)
+	}
+	return Load(DefaultPath())
+}
+
+// Parse decodes a configuration and checks the names are unique.
+func Parse(data []byte) (*Config, error) {
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// validate checks that names are unique and that contexts reference existing entries.
+func (c *Config) validate() error {
+	devices, credentials, contexts := map[string]bool{}, map[string]bool{}, map[string]bool{}
+	for _, d := range c.Devices {
+		if d.Name == "" || devices[d.Name] {
+			return fmt.Errorf("device name %q is empty or duplicated", d.Name)
+		}
+		devices[d.Name] = true
+	}
+	for _, cr := range c.Credentials {
+		if cr.Name == "" || credentials[cr.Name] {
+			return fmt.Errorf("credential name %q is empty or duplicated", cr.Name)
+		}
+		credentials[cr.Name] = true
+	}
+	for _, ctx := range c.Contexts {
+		if ctx.Name == "" || contexts[ctx.Name] {
+			return fmt.Errorf("context name %q is empty or duplicated", ctx.Name)
+		}
+		contexts[ctx.Name] = true
+		if !devices[ctx.Context.Device] {
+			return fmt.Errorf("context %q references unknown device %q", ctx.Name, ctx.Context.Device)
+		}
+		if ctx.Context.Credential != "" && !credentials[ctx.Context.Credential] {
+			return fmt.Errorf("context %q references unknown credential %q", ctx.Name, ctx.Context.Credential)
+		}
+	}
+	if c.CurrentContext != "" && !contexts[c.CurrentContext] {
+		return fmt.Errorf("current context %q does not exist", c.CurrentContext)
+	}
+	return nil
+}
+
+// ContextNames returns the names of the contexts in alphabetical order.
+func (c *Config) ContextNames() []string {
+	names := make([]string, 0, len(c.Contexts))
+	for _, ctx := range c.Contexts {
+		names = append(names, ctx.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Resolve looks up the named context. If name is empty, $BIGIP_CONTEXT or else
+// currentContext is used. The BIGIP_HOST, BIGIP_USERNAME, BIGIP_PASSWORD and
+// BIGIP_PARTITION environment variables override the values of the context.
+func (c *Config) Resolve(name string) (*ResolvedContext, error) {
+	if name == "" {
+		name = os.Getenv(EnvContext)
+	}
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, fmt.Errorf("no context given and no current context set")
+	}
+
+	var context *Context
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			context = &c.Contexts[i].Context
+		}
+	}
+	if context == nil {
+		return nil, fmt.Errorf("context %q does not exist", name)
+	}
+
+	resolved := &ResolvedContext{Name: name, Partition: context.Partition, RestrictToPartition: context.RestrictToPartition, AuthMode: AuthModeBasic}
+	for _, d := range c.Devices {
+		if d.Name == context.Device {
+			resolved.Device = d.Device
+		}
+	}
+	for _, cr := range c.Credentials {
+		if cr.Name != context.Credential {
+			continue
+		}
+		var err error
+		if resolved.Username, err = c.reference(EnvUsername, cr.Credential.Username, cr.Credential.UsernameFile, cr.Credential.UsernameEnv); err != nil {
+			return nil, fmt.Errorf("credential %q: username: %w", cr.Name, err)
+		}
+		if resolved.Password, err = c.reference(EnvPassword, cr.Credential.Password, cr.Credential.PasswordFile, cr.Credential.PasswordEnv); err != nil {
+			return nil, fmt.Errorf("credential %q: password: %w", cr.Name, err)
+		}
+		if cr.Credential.AuthMode != "" {
+			resolved.AuthMode = cr.Credential.AuthMode
+		}
+		resolved.LoginProvider = cr.Credential.LoginProvider
+		resolved.ClientCertificate = c.path(cr.Credential.ClientCertificate)
+		resolved.ClientKey = c.path(cr.Credential.ClientKey)
+	}
+	resolved.Device.TLS.CAFile = c.path(resolved.Device.TLS.CAFile)
+
+	for env, value := range map[string]*string{
+		EnvHost:      &resolved.Device.Host,
+		EnvUsername:  &resolved.Username,
+		EnvPassword:  &resolved.Password,
+		EnvPartition: &resolved.Partition,
+	} {
+		if v, ok := os.LookupEnv(env); ok {
+			*value = v
+		}
+	}
+
+	if resolved.Device.Host == "" {
+		return nil, fmt.Errorf("context %q has no host", name)
+	}
+	if resolved.AuthMode != AuthModeBasic && resolved.AuthMode != AuthModeToken {
+		return nil, fmt.Errorf("context %q: unknown auth mode %q", name, resolved.AuthMode)
+	}
+	return resolved, nil
+}
+
+// reference returns the inline value, or reads it from a file or an environment variable.
+// Nothing is read when the override variable is set, as it replaces the value anyway.
+func (c *Config) reference(override, value, file, env string) (string, error) {
+	if v, ok := os.LookupEnv(override); ok {
+		return v, nil
+	}
+	switch {
+	case file != "":
+		data, err := os.ReadFile(c.path(file))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	case env != "":
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", env)
+		}
+		return v, nil
+	}
+	return value, nil
+}
+
+// path resolves a relative path from the directory of the configuration file.
+func (c *Config) path(p string) string {
+	if p == "" || filepath.IsAbs(p) || c.dir == "" {
+		return p
+	}
+	return filepath.Join(c.dir, p)
+}
+
+// Options returns the bigip options of the named context, see Resolve.
+func (c *Config) Options(name string) ([]bigip.Option, error) {
+	resolved, err := c.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.Options()
+}
+
+// Client builds a BigIP client for the named context, see Resolve. The extra
+// options are applied after the ones of the context. The partition of the context
+// qualifies bare names, see bigip.WithDefaultPartition, and the client is only
+// restricted to it with restrictToPartition, see bigip.BigIP.InPartition.
+func (c *Config) Client(name string, opts ...bigip.Option) (*bigip.BigIP, error) {
+	resolved, err := c.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	options, err := resolved.Options()
+	if err != nil {
+		return nil, err
+	}
+	b, err := bigip.New(resolved.Device.Host, append(options, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	if resolved.RestrictToPartition && resolved.Partition != "" {
+		return b.InPartition(resolved.Partition), nil
+	}
+	return b, nil
+}
+
+// Options converts the context into bigip options. Unlike NewSession, the device
+// certificate is verified unless the TLS settings set insecure.
+func (r *ResolvedContext) Options() ([]bigip.Option, error) {
+	tlsConfig := transport.TLSConfig{
+		Insecure:     r.Device.TLS.Insecure,
+		ServerName:   r.Device.TLS.ServerName,
+		CAFile:       r.Device.TLS.CAFile,
+		CAData:       []byte(r.Device.TLS.CAData),
+		CertFile:     r.ClientCertificate,
+		KeyFile:      r.ClientKey,
+		PinnedSHA256: r.Device.TLS.PinnedSHA256,
+	}
+	switch r.Device.TLS.MinVersion {
+	case "":
+	case "1.2":
+		tlsConfig.MinVersion = tls.VersionTLS12
+	case "1.3":
+		tlsConfig.MinVersion = tls.VersionTLS13
+	default:
+		return nil, fmt.Errorf("context %q: unsupported TLS version %q", r.Name, r.Device.TLS.MinVersion)
+	}
+	options := []bigip.Option{bigip.WithTLSConfig(tlsConfig)}
+
+	switch r.AuthMode {
+	case AuthModeToken:
+		options = append(options, bigip.WithTokenAuth(r.Username, r.Password), bigip.WithLoginProvider(r.LoginProvider))
+	default:
+		options = append(options, bigip.WithBasicAuth(r.Username, r.Password))
+	}
+
+	if r.Device.Timeout != "" {
+		timeout, err := time.ParseDuration(r.Device.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("context %q: invalid timeout: %w", r.Name, err)
+		}
+		options = append(options, bigip.WithRequestTimeout(timeout))
+	}
+	if r.Device.Proxy != "" {
+		proxyURL, err := url.Parse(r.Device.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("context %q: invalid proxy: %w", r.Name, err)
+		}
+		options = append(options, bigip.WithProxy(proxyURL))
+	}
+	if r.Partition != "" {
+		options = append(options, bigip.WithDefaultPartition(r.Partition))
+	}
+	return options, nil
+}
@@ -0,0 +1,203 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testConfig = `{
+  "currentContext": "dc1",
+  "devices": [
+    {"name": "dc1-a", "device": {"host": "10.1.0.10", "tls": {"caFile": "ca.pem", "minVersion": "1.3"}}},
+    {"name": "lab", "device": {"host": "10.9.0.10", "tls": {"insecure": true}, "timeout": "10s"}}
+  ],
+  "credentials": [
+    {"name": "automation", "credential": {"username": "automation", "passwordFile": "password", "authMode": "token"}},
+    {"name": "lab", "credential": {"username": "admin", "passwordEnv": "TEST_LAB_PASSWORD"}}
+  ],
+  "contexts": [
+    {"name": "dc1", "context": {"device": "dc1-a", "credential": "automation", "partition": "Common"}},
+    {"name": "lab", "context": {"device": "lab", "credential": "lab", "partition": "lab"}}
+  ]
+}`
+
+func writeConfig(t *testing.T, data string) string {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "password"), []byte("s3cret\n"), 0600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return path
+}
+
+func TestResolve(t *testing.T) {
+	path := writeConfig(t, testConfig)
+	t.Setenv(EnvContext, "")
+	t.Setenv("TEST_LAB_PASSWORD", "lab-password")
+
+	config, err := Load(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if names := config.ContextNames(); !reflect.DeepEqual(names, []string{"dc1", "lab"}) {
+		t.Errorf("Expected contexts [dc1 lab], got %v", names)
+	}
+
+	current, err := config.Resolve("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if current.Name != "dc1" || current.Device.Host != "10.1.0.10" || current.Username != "automation" ||
+		current.Password != "s3cret" || current.AuthMode != AuthModeToken || current.Partition != "Common" {
+		t.Errorf("Unexpected current context: %+v", current)
+	}
+	if want := filepath.Join(filepath.Dir(path), "ca.pem"); current.Device.TLS.CAFile != want {
+		t.Errorf("Expected CA file %s, got %s", want, current.Device.TLS.CAFile)
+	}
+
+	lab, err := config.Resolve("lab")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if lab.Password != "lab-password" || lab.AuthMode != AuthModeBasic {
+		t.Errorf("Unexpected lab context: %+v", lab)
+	}
+
+	if _, err := config.Resolve("missing"); err == nil {
+		t.Error("Expected an error for an unknown context")
+	}
+}
+
+func TestResolveEnvironmentOverrides(t *testing.T) {
+	config, err := Load(writeConfig(t, testConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	t.Setenv(EnvContext, "lab")
+	t.Setenv(EnvHost, "10.9.0.11")
+	t.Setenv(EnvUsername, "operator")
+	t.Setenv(EnvPassword, "override")
+	t.Setenv(EnvPartition, "Common")
+
+	resolved, err := config.Resolve("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resolved.Name != "lab" || resolved.Device.Host != "10.9.0.11" || resolved.Username != "operator" ||
+		resolved.Password != "override" || resolved.Partition != "Common" {
+		t.Errorf("Expected the environment to override the context, got %+v", resolved)
+	}
+}
+
+func TestResolveMissingPasswordEnv(t *testing.T) {
+	config, err := Load(writeConfig(t, testConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	os.Unsetenv("TEST_LAB_PASSWORD")
+	if _, err := config.Resolve("lab"); err == nil {
+		t.Error("Expected an error for an unset password variable")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"unknown device":     `{"contexts": [{"name": "a", "context": {"device": "missing"}}]}`,
+		"duplicated device":  `{"devices": [{"name": "a", "device": {"host": "h"}}, {"name": "a", "device": {"host": "h"}}]}`,
+		"unknown current":    `{"currentContext": "missing"}`,
+		"invalid json":       `{`,
+		"unknown credential": `{"devices": [{"name": "a", "device": {"host": "h"}}], "contexts": [{"name": "a", "context": {"device": "a", "credential": "missing"}}]}`,
+	} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "lab-password" {
+			t.Errorf("Expected basic authentication, got %q", r.Header.Get("Authorization"))
+		}
+		requests = append(requests, r.URL.Path+" "+r.URL.Query().Get("$filter"))
+		if strings.HasSuffix(r.URL.Path, "/pool") {
+			w.Write([]byte(`{"items":[]}`))
+			return
+		}
+		w.Write([]byte(`{"name":"web"}`))
+	}))
+	defer server.Close()
+
+	config, err := Load(writeConfig(t, testConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	t.Setenv("TEST_LAB_PASSWORD", "lab-password")
+	t.Setenv(EnvHost, server.URL)
+	type pool struct {
+		Name string `json:"name"`
+	}
+	type poolList struct {
+		Items []pool `json:"items"`
+	}
+
+	// The partition of the context only qualifies bare names.
+	b, err := config.Client("lab")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if b.Partition() != "" || b.DefaultPartition() != "lab" {
+		t.Errorf("Expected lab to be the default partition only, got %q and %q", b.Partition(), b.DefaultPartition())
+	}
+	pools := bigip.NewCollection[pool, poolList](b, "ltm", "pool")
+	if _, err := pools.List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := pools.Get(context.Background(), "web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := pools.Get(context.Background(), "/Common/web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"/mgmt/tm/ltm/pool ", "/mgmt/tm/ltm/pool/~lab~web ", "/mgmt/tm/ltm/pool/~Common~web "}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("Expected %q, got %q", expected, requests)
+	}
+
+	// restrictToPartition restricts the client to the partition.
+	for i := range config.Contexts {
+		config.Contexts[i].Context.RestrictToPartition = true
+	}
+	requests = nil
+	b, err = config.Client("lab")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if b.Partition() != "lab" {
+		t.Errorf("Expected the client to be restricted to lab, got %q", b.Partition())
+	}
+	pools = bigip.NewCollection[pool, poolList](b, "ltm", "pool")
+	if _, err := pools.List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var partitionErr *bigip.PartitionError
+	if _, err := pools.Get(context.Background(), "/Common/web"); !errors.As(err, &partitionErr) {
+		t.Errorf("Expected a PartitionError, got %v", err)
+	}
+	if fmt.Sprint(requests) != fmt.Sprint([]string{"/mgmt/tm/ltm/pool partition eq lab"}) {
+		t.Errorf("Expected the partition of the context to be filtered, got %q", requests)
+	}
+}
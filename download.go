@@ -0,0 +1,212 @@
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultDownloadChunkSize is the size of the chunks requested when DownloadOptions.ChunkSize is not set.
+const DefaultDownloadChunkSize = 512 * 1024
+
+// DefaultDownloadRetries is the number of times a failed chunk is requested again when DownloadOptions.Retries is not set.
+const DefaultDownloadRetries = 3
+
+// downloadRetryDelay is the delay before a failed chunk is requested again. It doubles after every attempt.
+var downloadRetryDelay = time.Second
+
+var (
+	// files are read from /var/config/rest/bulk.
+	bulkDownloads = transferEndpoint{GetShareResource(), "file-transfer", "bulk"}
+	// files are read from /shared/images.
+	softwareImageDownloads = transferEndpoint{GetCMResource(), "autodeploy", "software-image-downloads"}
+	// files are read from /var/local/ucs.
+	ucsDownloads = transferEndpoint{GetShareResource(), "file-transfer", "ucs-downloads"}
+	// files are read from /var/tmp.
+	qkviewDownloads = transferEndpoint{GetCMResource(), "autodeploy", "qkview-downloads"}
+)
+
+// DownloadProgress reports the progress of a download after every chunk.
+type DownloadProgress struct {
+	Name     string
+	Received int64
+	// Total is the size of the file, as reported by BIG-IP with the first chunk.
+	Total int64
+}
+
+// DownloadOptions controls how a file is downloaded.
+type DownloadOptions struct {
+	// ChunkSize is the size of every chunk, DefaultDownloadChunkSize if zero.
+	ChunkSize int64
+	// Retries is the number of times a failed chunk is requested again, DefaultDownloadRetries if zero.
+	// A negative value disables retries.
+	Retries int
+	// Checksum is the expected hex encoded digest of the file. If set and the digest
+	// of the downloaded content differs, a *ChecksumError is returned.
+	Checksum string
+	// Hash computes the digest of the file, sha256.New if nil.
+	Hash func() hash.Hash
+	// Progress is called after every chunk is written.
+	Progress func(progress DownloadProgress)
+}
+
+// DownloadResult describes a downloaded file.
+type DownloadResult struct {
+	Size int64
+	// Checksum is the hex encoded digest of the file computed with DownloadOptions.Hash.
+	Checksum string
+}
+
+// ChecksumError is returned when the digest of a downloaded file does not match the expected one.
+type ChecksumError struct {
+	Name     string
+	Expected string
+	Actual   string
+}
+
+// Error implements the errors.Error interface
+func (err *ChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %s: expected %s, got %s", err.Name, err.Expected, err.Actual)
+}
+
+// Download writes the file /var/config/rest/bulk/<name>, served by /mgmt/shared/file-transfer/bulk, to w.
+func (b *BigIP) Download(ctx context.Context, name string, w io.Writer, opts *DownloadOptions) (*DownloadResult, error) {
+	return b.download(ctx, bulkDownloads, name, w, opts)
+}
+
+// DownloadImage writes the software image /shared/images/<name> to w.
+func (b *BigIP) DownloadImage(ctx context.Context, name string, w io.Writer, opts *DownloadOptions) (*DownloadResult, error) {
+	return b.download(ctx, softwareImageDownloads, name, w, opts)
+}
+
+// DownloadUCS writes the UCS archive /var/local/ucs/<name> to w, for example
+// after saving it with sys.UCSResource.Save:
+//
+//	err := sys.New(b).UCS().Save(ctx, "nightly.ucs")
+//	...
+//	f, err := os.Create("nightly.ucs")
+//	...
+//	_, err = b.DownloadUCS(ctx, "nightly.ucs", f, nil)
+func (b *BigIP) DownloadUCS(ctx context.Context, name string, w io.Writer, opts *DownloadOptions) (*DownloadResult, error) {
+	return b.download(ctx, ucsDownloads, name, w, opts)
+}
+
+// DownloadQkview writes the qkview /var/tmp/<name> to w.
+func (b *BigIP) DownloadQkview(ctx context.Context, name string, w io.Writer, opts *DownloadOptions) (*DownloadResult, error) {
+	return b.download(ctx, qkviewDownloads, name, w, opts)
+}
+
+// download requests the file in chunks and writes them to w in order.
+func (b *BigIP) download(ctx context.Context, target transferEndpoint, name string, w io.Writer, opts *DownloadOptions) (*DownloadResult, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	if msgs := rest.IsValidPathSegmentName(name); len(msgs) != 0 {
+		return nil, fmt.Errorf("invalid file name %q: %v", name, msgs)
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultDownloadChunkSize
+	}
+	retries := opts.Retries
+	if retries == 0 {
+		retries = DefaultDownloadRetries
+	}
+	h := sha256.New()
+	if opts.Hash != nil {
+		h = opts.Hash()
+	}
+
+	var offset int64
+	total := int64(-1)
+	buf := &bytes.Buffer{}
+	for total < 0 || offset < total {
+		var size int64
+		var err error
+		for attempt := 0; ; attempt++ {
+			buf.Reset()
+			size, err = b.downloadChunk(ctx, target, name, buf, offset, chunkSize)
+			if err == nil || attempt >= retries || ctx.Err() != nil {
+				break
+			}
+			t := time.NewTimer(downloadRetryDelay << attempt)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+			case <-t.C:
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to download %s at offset %d: %w", name, offset, err)
+		}
+		if buf.Len() == 0 && size != 0 {
+			return nil, fmt.Errorf("failed to download %s at offset %d: empty chunk", name, offset)
+		}
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return nil, err
+		}
+		h.Write(buf.Bytes())
+		offset += int64(buf.Len())
+		total = size
+		if opts.Progress != nil {
+			opts.Progress(DownloadProgress{Name: name, Received: offset, Total: total})
+		}
+	}
+
+	checksum := hex.EncodeToString(h.Sum(nil))
+	if opts.Checksum != "" && !strings.EqualFold(opts.Checksum, checksum) {
+		return nil, &ChecksumError{Name: name, Expected: opts.Checksum, Actual: checksum}
+	}
+	return &DownloadResult{Size: offset, Checksum: checksum}, nil
+}
+
+// downloadChunk requests chunkSize bytes from offset and copies them into buf.
+// It returns the size of the whole file.
+//
+// The file transfer workers of BIG-IP read the range from the Content-Range request
+// header, the standard Range header is sent as well.
+func (b *BigIP) downloadChunk(ctx context.Context, target transferEndpoint, name string, buf *bytes.Buffer, offset, chunkSize int64) (int64, error) {
+	end := offset + chunkSize - 1
+	resp, err := b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(target.category).
+		ManagerName(target.manager).Resource(target.resource).ResourceInstance(name).
+		SetHeader("Range", fmt.Sprintf("bytes=%d-%d", offset, end)).
+		SetHeader("Content-Range", fmt.Sprintf("%d-%d/0", offset, end)).
+		StreamResponse(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPartialContent && resp.Header.Get("Content-Range") == "" {
+		// The range was ignored and the whole file is returned at once.
+		if offset != 0 {
+			return 0, fmt.Errorf("range %d-%d is not supported", offset, end)
+		}
+		if _, err := io.Copy(buf, resp.Body); err != nil {
+			return 0, err
+		}
+		return int64(buf.Len()), nil
+	}
+	if _, err := io.Copy(buf, io.LimitReader(resp.Body, chunkSize+1)); err != nil {
+		return 0, err
+	}
+
+	var start, last, total int64
+	contentRange := strings.TrimPrefix(resp.Header.Get("Content-Range"), "bytes ")
+	if _, err := fmt.Sscanf(contentRange, "%d-%d/%d", &start, &last, &total); err != nil {
+		return 0, fmt.Errorf("invalid Content-Range %q: %w", contentRange, err)
+	}
+	if start != offset || int64(buf.Len()) != last-start+1 {
+		return 0, fmt.Errorf("unexpected Content-Range %q for %d bytes at offset %d", contentRange, buf.Len(), offset)
+	}
+	return total, nil
+}
@@ -0,0 +1,112 @@
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newDownloadServer serves content in the ranges requested with Content-Range.
+// Requests listed in failures (by their index) are answered with a 503.
+func newDownloadServer(t *testing.T, path, content string, failures ...int) *BigIP {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { requests++ }()
+		if r.Method != http.MethodGet || r.URL.Path != path {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		for _, failure := range failures {
+			if failure == requests {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		}
+		var start, end int
+		fmt.Sscanf(r.Header.Get("Content-Range"), "%d-%d/0", &start, &end)
+		if end >= len(content) {
+			end = len(content) - 1
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("%d-%d/%d", start, end, len(content)))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(content[start : end+1]))
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestDownload(t *testing.T) {
+	delay := downloadRetryDelay
+	downloadRetryDelay = time.Millisecond
+	t.Cleanup(func() { downloadRetryDelay = delay })
+	content := strings.Repeat("0123456789", 25)
+	sum := sha256.Sum256([]byte(content))
+	b := newDownloadServer(t, "/mgmt/shared/file-transfer/ucs-downloads/nightly.ucs", content, 1)
+
+	var file bytes.Buffer
+	var progress []string
+	result, err := b.DownloadUCS(context.Background(), "nightly.ucs", &file, &DownloadOptions{
+		ChunkSize: 100,
+		Checksum:  hex.EncodeToString(sum[:]),
+		Progress:  func(p DownloadProgress) { progress = append(progress, fmt.Sprintf("%d/%d", p.Received, p.Total)) },
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if file.String() != content {
+		t.Errorf("Expected the downloaded file to match the content, got %q", file.String())
+	}
+	if fmt.Sprint(progress) != "[100/250 200/250 250/250]" {
+		t.Errorf("Unexpected progress %v", progress)
+	}
+	if result.Size != 250 || result.Checksum != hex.EncodeToString(sum[:]) {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	b := newDownloadServer(t, "/mgmt/shared/file-transfer/bulk/report.txt", "corrupted")
+
+	_, err := b.Download(context.Background(), "report.txt", &bytes.Buffer{}, &DownloadOptions{Checksum: "00"})
+	var checksumErr *ChecksumError
+	if !errors.As(err, &checksumErr) {
+		t.Fatalf("Expected a ChecksumError, got %v", err)
+	}
+	if checksumErr.Expected != "00" {
+		t.Errorf("Expected checksum 00, got %s", checksumErr.Expected)
+	}
+}
+
+func TestDownloadRangeIgnored(t *testing.T) {
+	content := strings.Repeat("0123456789", 25)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The range headers are ignored and the whole file is returned.
+		w.Write([]byte(content))
+	}))
+	defer server.Close()
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var file bytes.Buffer
+	result, err := b.Download(context.Background(), "report.txt", &file, &DownloadOptions{ChunkSize: 100})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if file.String() != content || result.Size != 250 {
+		t.Errorf("Expected the whole file of 250 bytes, got %d bytes", result.Size)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/lefeck/go-bigip"
 	"github.com/lefeck/go-bigip/gtm"
@@ -26,12 +27,12 @@ func (bs *bigipGTM) initGTM() {
 
 func (bs *bigipGTM) ListDataCenter() {
 	bg := gtm.New(bs.bigIP)
-	dataList, _ := bg.Datacenter().List()
+	dataList, _ := bg.Datacenter().List(context.Background())
 	//fmt.Println(addrList)
 
 	for _, icmp := range dataList.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.Datacenter().Get(fullpath)
+		item, err := bg.Datacenter().Get(context.Background(), fullpath)
 		if err != nil {
 			panic(err)
 		}
@@ -44,7 +45,7 @@ func (bs *bigipGTM) CreateDataCenter() {
 		Name: "dc-mobile",
 	}
 	bg := gtm.New(bs.bigIP)
-	_ = bg.Datacenter().Create(dc)
+	_ = bg.Datacenter().Create(context.Background(), dc)
 	//fmt.Println(addrList)
 }
 
@@ -55,8 +56,8 @@ func (bs *bigipGTM) UpdateDataCenter() {
 		Enabled:  false,
 	}
 	bg := gtm.New(bs.bigIP)
-	_ = bg.Datacenter().Update(name, dc)
-	item, err := bg.Datacenter().Get(name)
+	_ = bg.Datacenter().Update(context.Background(), name, dc)
+	item, err := bg.Datacenter().Get(context.Background(), name)
 	if err != nil {
 		panic(err)
 	}
@@ -67,11 +68,11 @@ func (bs *bigipGTM) DeleteDataCenter() {
 	name := "dc-mobile"
 
 	bg := gtm.New(bs.bigIP)
-	err := bg.Datacenter().Delete(name)
+	err := bg.Datacenter().Delete(context.Background(), name)
 	if err != nil {
 		log.Fatalf("error : %s\n", err)
 	}
-	item, err := bg.Datacenter().Get(name)
+	item, err := bg.Datacenter().Get(context.Background(), name)
 	if err != nil {
 		log.Fatalf("error : %s\n", err)
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
@@ -85,7 +86,7 @@ type bigipTest struct {
 
 func (bs *bigipTest) init() {
 	//b, err := bigip.NewSession("192.168.13.91", "admin", "MsTac@2001")
-	//optionTimeout := bigip.WithTimeout(1200)
+	//optionTimeout := bigip.WithTimeout(1200 * time.Second)
 
 	b, err := bigip.NewToken("192.168.13.91", "admin", "MsTac@2001", "local")
 	//b, err := bigip.NewToken("192.168.13.91", "admin", "MsTac@2001", "local", optionTimeout)
@@ -97,12 +98,12 @@ func (bs *bigipTest) init() {
 
 func (bs *bigipTest) ListTrafficMatchingCriteria() {
 	bg := ltm.New(bs.bigIP)
-	routeDomainList, _ := bg.TrafficMatchingCriteria().List()
+	routeDomainList, _ := bg.TrafficMatchingCriteria().List(context.Background())
 	fmt.Println(routeDomainList)
 
 	for _, icmp := range routeDomainList.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.TrafficMatchingCriteria().Get(fullpath)
+		item, err := bg.TrafficMatchingCriteria().Get(context.Background(), fullpath)
 		if err != nil {
 			log.Fatalf("route domain get failed %v\n", err)
 		}
@@ -113,7 +114,7 @@ func (bs *bigipTest) ListTrafficMatchingCriteria() {
 
 func (bs *bigipTest) ListTrafficMatchingCriteriaName() {
 	bg := ltm.New(bs.bigIP)
-	routeDomainList, _ := bg.TrafficMatchingCriteria().ListName()
+	routeDomainList, _ := bg.TrafficMatchingCriteria().ListName(context.Background())
 	fmt.Println(routeDomainList)
 }
 
@@ -134,7 +135,7 @@ func (bs *bigipTest) CreateTrafficMatchingCriteria() {
 		SourcePortInline:    0,
 	}
 
-	err := bg.TrafficMatchingCriteria().Create(item)
+	err := bg.TrafficMatchingCriteria().Create(context.Background(), item)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -158,7 +159,7 @@ func (bs *bigipTest) UpdateTrafficMatchingCriteria() {
 		SourcePortInline:    2,
 	}
 
-	err := bg.TrafficMatchingCriteria().Update(name, item)
+	err := bg.TrafficMatchingCriteria().Update(context.Background(), name, item)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -166,12 +167,12 @@ func (bs *bigipTest) UpdateTrafficMatchingCriteria() {
 
 func (bs *bigipTest) ListNetPortList() {
 	bg := bgnet.New(bs.bigIP)
-	routeDomainList, _ := bg.PortList().List()
+	routeDomainList, _ := bg.PortList().List(context.Background())
 	fmt.Println(routeDomainList)
 
 	for _, icmp := range routeDomainList.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.PortList().Get(fullpath)
+		item, err := bg.PortList().Get(context.Background(), fullpath)
 		if err != nil {
 			log.Fatalf("route domain get failed %v\n", err)
 		}
@@ -193,17 +194,17 @@ func (bs *bigipTest) createNetPortList() {
 			},
 		},
 	}
-	_ = bg.PortList().Create(item)
+	_ = bg.PortList().Create(context.Background(), item)
 }
 
 func (bs *bigipTest) ListNetRouteDomain() {
 	bg := bgnet.New(bs.bigIP)
-	routeDomainList, _ := bg.RouteDomain().List()
+	routeDomainList, _ := bg.RouteDomain().List(context.Background())
 	//fmt.Println(routeDomainList)
 
 	for _, icmp := range routeDomainList.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.RouteDomain().Get(fullpath)
+		item, err := bg.RouteDomain().Get(context.Background(), fullpath)
 		if err != nil {
 			log.Fatalf("route domain get failed %v\n", err)
 		}
@@ -214,11 +215,11 @@ func (bs *bigipTest) ListNetRouteDomain() {
 
 func (bs *bigipTest) listVirtualServer() {
 	bg := ltm.New(bs.bigIP)
-	vs, _ := bg.Virtual().List()
+	vs, _ := bg.Virtual().List(context.Background())
 	fmt.Println(vs.Items)
 	for _, va := range vs.Items {
 		name := va.FullPath
-		vs, err := bg.Virtual().Get(name)
+		vs, err := bg.Virtual().Get(context.Background(), name)
 		if err != nil {
 			panic(err)
 		}
@@ -228,7 +229,7 @@ func (bs *bigipTest) listVirtualServer() {
 		des := removePort(vs.Destination)
 		addrs, _ := extractAddressWithoutPort(vs.Destination)
 		fmt.Println(addrs)
-		addr, err := bg.VirtualAddress().GetAddressByVirtualServerName(des)
+		addr, err := bg.VirtualAddress().GetAddressByVirtualServerName(context.Background(), des)
 		if err != nil {
 			panic(err)
 		}
@@ -266,12 +267,12 @@ func extractAddressWithoutPort(vsAddress string) (string, error) {
 
 func (bs *bigipTest) ListNetAddressList() {
 	bg := bgnet.New(bs.bigIP)
-	addrList, _ := bg.AddressList().List()
+	addrList, _ := bg.AddressList().List(context.Background())
 	fmt.Println(addrList)
 
 	for _, icmp := range addrList.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.AddressList().Get(fullpath)
+		item, err := bg.AddressList().Get(context.Background(), fullpath)
 		if err != nil {
 			panic(err)
 		}
@@ -281,12 +282,12 @@ func (bs *bigipTest) ListNetAddressList() {
 
 func (bs *bigipTest) ListSysServiceList() {
 	bg := sys.New(bs.bigIP)
-	addrList, _ := bg.Service().List()
+	addrList, _ := bg.Service().List(context.Background())
 	//fmt.Println(addrList)
 
 	for _, service := range addrList.Items {
 		fullpath := service.FullPath
-		item, err := bg.Service().Get(fullpath)
+		item, err := bg.Service().Get(context.Background(), fullpath)
 		if err != nil {
 			panic(err)
 		}
@@ -296,12 +297,12 @@ func (bs *bigipTest) ListSysServiceList() {
 
 func (bs *bigipTest) ListICMP() {
 	bg := ltm.New(bs.bigIP)
-	icmpList, _ := bg.Monitor().ICMP().List()
+	icmpList, _ := bg.Monitor().ICMP().List(context.Background())
 	fmt.Println(icmpList)
 
 	for _, icmp := range icmpList.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.Monitor().ICMP().Get(fullpath)
+		item, err := bg.Monitor().ICMP().Get(context.Background(), fullpath)
 		if err != nil {
 			panic(err)
 		}
@@ -311,13 +312,13 @@ func (bs *bigipTest) ListICMP() {
 
 func (bs *bigipTest) ListProfileFastHttp() {
 	bg := ltm.New(bs.bigIP)
-	fasthttp, _ := bg.Profile().FastHTTP().List()
+	fasthttp, _ := bg.Profile().FastHTTP().List(context.Background())
 
 	fmt.Println(fasthttp)
 
 	for _, icmp := range fasthttp.Items {
 		fullpath := icmp.FullPath
-		item, err := bg.Profile().FastHTTP().Get(fullpath)
+		item, err := bg.Profile().FastHTTP().Get(context.Background(), fullpath)
 		if err != nil {
 			panic(err)
 		}
@@ -333,7 +334,7 @@ func (bs *bigipTest) CreateICMP() {
 		Timeout:       50,
 		AdaptiveLimit: 100,
 	}
-	if err := bg.Monitor().ICMP().Create(item); err != nil {
+	if err := bg.Monitor().ICMP().Create(context.Background(), item); err != nil {
 		panic(err)
 	}
 }
@@ -347,7 +348,7 @@ func (bs *bigipTest) UpdateICMP() {
 		Timeout:       80,
 		AdaptiveLimit: 100,
 	}
-	if err := bg.Monitor().ICMP().Update(fullPathname, item); err != nil {
+	if err := bg.Monitor().ICMP().Update(context.Background(), fullPathname, item); err != nil {
 		panic(err)
 	}
 }
@@ -356,7 +357,7 @@ func (bs *bigipTest) DeleteICMP() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/hello-icmp-m1"
 
-	if err := bg.Monitor().ICMP().Delete(name); err != nil {
+	if err := bg.Monitor().ICMP().Delete(context.Background(), name); err != nil {
 		log.Fatalf("delete  is failed %v", err)
 	}
 }
@@ -369,7 +370,7 @@ func (bs *bigipTest) ListProfileSSLClient() {
 
 func (bs *bigipTest) listPoolStats() {
 	bg := ltm.New(bs.bigIP)
-	ps, _ := bg.PoolStats().List()
+	ps, _ := bg.PoolStats().List(context.Background())
 	fmt.Println(ps)
 	//for key, va := range ps.Entries {
 	//	name := va.NestedPoolStats.Entries
@@ -384,7 +385,7 @@ func (bs *bigipTest) listPoolStats() {
 func (bs *bigipTest) getSinglePoolStats() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/hello-pool"
-	ps, err := bg.PoolStats().GetPoolStats(name)
+	ps, err := bg.PoolStats().GetPoolStats(context.Background(), name)
 	if err != nil {
 		panic(err)
 	}
@@ -393,7 +394,7 @@ func (bs *bigipTest) getSinglePoolStats() {
 
 func (bs *bigipTest) listVirtualServerStats() {
 	bg := ltm.New(bs.bigIP)
-	vs, err := bg.VirtualStats().List()
+	vs, err := bg.VirtualStats().List(context.Background())
 	if err != nil {
 		panic(err)
 	}
@@ -403,7 +404,7 @@ func (bs *bigipTest) listVirtualServerStats() {
 func (bs *bigipTest) getSingleVirtualServerStats() {
 	bg := ltm.New(bs.bigIP)
 	vsName := "/Common/10.100.131.91"
-	ps, err := bg.VirtualStats().Get(vsName)
+	ps, err := bg.VirtualStats().Get(context.Background(), vsName)
 	if err != nil {
 		panic(err)
 	}
@@ -414,7 +415,7 @@ func (bs *bigipTest) getSingleVirtualServerStats() {
 
 func (bs *bigipTest) listVirtualAddressStats() {
 	bg := ltm.New(bs.bigIP)
-	vs, err := bg.VirtualAddressStats().List()
+	vs, err := bg.VirtualAddressStats().List(context.Background())
 	if err != nil {
 		panic(err)
 	}
@@ -424,7 +425,7 @@ func (bs *bigipTest) listVirtualAddressStats() {
 func (bs *bigipTest) getSingleVirtualAddressStats() {
 	bg := ltm.New(bs.bigIP)
 	vsName := "/Common/10.100.131.91"
-	ps, err := bg.VirtualAddressStats().Get(vsName)
+	ps, err := bg.VirtualAddressStats().Get(context.Background(), vsName)
 	if err != nil {
 		panic(err)
 	}
@@ -437,7 +438,7 @@ func (bs *bigipTest) getSingleMemberStats() {
 	bg := ltm.New(bs.bigIP)
 	poolName := "/Common/hello-pool"
 	memberName := "/Common/142.10.3.2:4523"
-	ps, err := bg.PoolStats().GetMemberStats(poolName, memberName)
+	ps, err := bg.PoolStats().GetMemberStats(context.Background(), poolName, memberName)
 	if err != nil {
 		panic(err)
 	}
@@ -448,7 +449,7 @@ func (bs *bigipTest) getPoolAllMemberStats() {
 	bg := ltm.New(bs.bigIP)
 	poolName := "/Common/hello-pool"
 	//memberName := "/Common/142.10.3.2:4523"
-	ps, err := bg.PoolStats().GetPoolAllMemberStats(poolName)
+	ps, err := bg.PoolStats().GetPoolAllMemberStats(context.Background(), poolName)
 	if err != nil {
 		panic(err)
 	}
@@ -457,11 +458,11 @@ func (bs *bigipTest) getPoolAllMemberStats() {
 
 func (bs *bigipTest) virtualAddressList() {
 	bg := ltm.New(bs.bigIP)
-	val, _ := bg.VirtualAddress().List()
+	val, _ := bg.VirtualAddress().List(context.Background())
 	fmt.Println(val)
 	for _, va := range val.Items {
 		name := va.FullPath
-		va, err := bg.VirtualAddress().GetAddressByVirtualServerName(name)
+		va, err := bg.VirtualAddress().GetAddressByVirtualServerName(context.Background(), name)
 		if err != nil {
 			panic(err)
 		}
@@ -472,7 +473,7 @@ func (bs *bigipTest) virtualAddressList() {
 func (bs *bigipTest) getVersion() {
 
 	bga := cli.NewCli(bs.bigIP)
-	version, _ := bga.Version().Show()
+	version, _ := bga.Version().Show(context.Background())
 	//fmt.Println(version.NestedStats.EntriesMenu.Supported)
 
 	bt, _ := json.Marshal(version)
@@ -488,7 +489,7 @@ func (bs *bigipTest) useUtil() {
 		UtilCmdArgs: " -c  tmsh list ltm virtual  ",
 	}
 
-	bashr, _ := bga.Bash().Run(item)
+	bashr, _ := bga.Bash().Run(context.Background(), item)
 	fmt.Println(bashr)
 	//bt, err := json.Marshal(bashr)
 	//if err != nil {
@@ -499,7 +500,7 @@ func (bs *bigipTest) useUtil() {
 
 func (bs *bigipTest) listUser() {
 	bga := auth.NewAuth(bs.bigIP)
-	user, _ := bga.Users().List()
+	user, _ := bga.Users().List(context.Background())
 	fmt.Println(user)
 }
 func removePort(address string) string {
@@ -511,7 +512,7 @@ func removePort(address string) string {
 
 func (bs *bigipTest) listVirtualServerDetail() {
 	bg := ltm.New(bs.bigIP)
-	vs, _ := bg.Virtual().ListDetail()
+	vs, _ := bg.Virtual().ListDetail(context.Background())
 	fmt.Println(vs.Items)
 	//for _, va := range vs.Items {
 	//	name := va.FullPath
@@ -525,11 +526,11 @@ func (bs *bigipTest) listVirtualServerDetail() {
 
 func (bs *bigipTest) listSnatPool() {
 	bg := ltm.New(bs.bigIP)
-	spl, _ := bg.SnatPool().List()
+	spl, _ := bg.SnatPool().List(context.Background())
 	fmt.Println(spl.Items)
 	for _, sp := range spl.Items {
 		name := sp.FullPath
-		address, err := bg.SnatPool().Get(name)
+		address, err := bg.SnatPool().Get(context.Background(), name)
 		if err != nil {
 			panic(err)
 		}
@@ -539,12 +540,12 @@ func (bs *bigipTest) listSnatPool() {
 
 func (bs *bigipTest) listPool() {
 	bg := ltm.New(bs.bigIP)
-	pools, _ := bg.Pool().List()
+	pools, _ := bg.Pool().List(context.Background())
 	//fmt.Println(pools)
 	for _, pool := range pools.Items {
 		name := pool.FullPath
 		fmt.Println(name)
-		pl, err := bg.Pool().Get(name)
+		pl, err := bg.Pool().Get(context.Background(), name)
 		if err != nil {
 			panic(err)
 		}
@@ -561,7 +562,7 @@ func (bs *bigipTest) createPool() {
 		Monitor:           "http",
 	}
 
-	if err := bg.Pool().Create(item); err != nil {
+	if err := bg.Pool().Create(context.Background(), item); err != nil {
 		log.Fatalf("create pool is failed %v", err)
 	}
 }
@@ -573,7 +574,7 @@ func (bs *bigipTest) updatePool() {
 		LoadBalancingMode: "fastest-node",
 	}
 
-	if err := bg.Pool().Update(name, item); err != nil {
+	if err := bg.Pool().Update(context.Background(), name, item); err != nil {
 		log.Fatalf("update pool is failed %v", err)
 	}
 }
@@ -582,7 +583,7 @@ func (bs *bigipTest) deletePool() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/hello-pool"
 
-	if err := bg.Pool().Delete(name); err != nil {
+	if err := bg.Pool().Delete(context.Background(), name); err != nil {
 		log.Fatalf("delete pool is failed %v", err)
 	}
 }
@@ -599,7 +600,7 @@ func (bs *bigipTest) updateVirtualServer() {
 		ConnectionLimit:          1000,
 	}
 
-	if err := bg.Virtual().Update(name, item); err != nil {
+	if err := bg.Virtual().Update(context.Background(), name, item); err != nil {
 		log.Fatalf("update virtual server is failed %v", err)
 	}
 }
@@ -607,7 +608,7 @@ func (bs *bigipTest) updateVirtualServer() {
 func (bs *bigipTest) updateVSStateToDisable() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/hello-vs1"
-	if err := bg.Virtual().Disable(name); err != nil {
+	if err := bg.Virtual().Disable(context.Background(), name); err != nil {
 		log.Fatalf("disable virtual server is failed %v", err)
 	}
 }
@@ -615,7 +616,7 @@ func (bs *bigipTest) updateVSStateToDisable() {
 func (bs *bigipTest) updateVSStateToEnable() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/hello-vs1"
-	if err := bg.Virtual().Enable(name); err != nil {
+	if err := bg.Virtual().Enable(context.Background(), name); err != nil {
 		log.Fatalf("enabled virtual server is failed %v", err)
 	}
 
@@ -641,7 +642,7 @@ func (bs *bigipTest) createVirtualServer() {
 		//RateLimit:                "disabled",
 	}
 
-	if err := bg.Virtual().Create(item); err != nil {
+	if err := bg.Virtual().Create(context.Background(), item); err != nil {
 		log.Fatalf("create virtual server is failed %v", err)
 	}
 }
@@ -650,7 +651,7 @@ func (bs *bigipTest) getVirtualServer() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/go-test"
 
-	vs, err := bg.Virtual().Get(name)
+	vs, err := bg.Virtual().Get(context.Background(), name)
 	if err != nil {
 		log.Fatalf("get virtual server failed  %v", err)
 	}
@@ -663,21 +664,21 @@ func (bs *bigipTest) deleteVirtualServer() {
 	bg := ltm.New(bs.bigIP)
 	name := "/Common/go-test"
 
-	if err := bg.Virtual().Delete(name); err != nil {
+	if err := bg.Virtual().Delete(context.Background(), name); err != nil {
 		log.Fatalf("delete virtual server failed  %v", err)
 	}
 }
 
 func (bs *bigipTest) listRules() {
 	bg := ltm.New(bs.bigIP)
-	rulelist, err := bg.Rule().List()
+	rulelist, err := bg.Rule().List(context.Background())
 	if err != nil {
 		panic(err)
 	}
 	//fmt.Println(rulelist)
 	for _, rule := range rulelist.Items {
 		fullpath := rule.FullPath
-		rl, err := bg.Rule().Get(fullpath)
+		rl, err := bg.Rule().Get(context.Background(), fullpath)
 		if err != nil {
 			log.Fatalf("get rule failed  %v", err)
 		}
@@ -701,7 +702,7 @@ func (bs *bigipTest) createRule() {
 		ApiAnonymous: string(data),
 	}
 
-	err = bg.Rule().Create(rule)
+	err = bg.Rule().Create(context.Background(), rule)
 	if err != nil {
 		log.Fatalf("create rule failed %v\n", err)
 	}
@@ -720,7 +721,7 @@ func (bs *bigipTest) updateRule() {
 		ApiAnonymous: string(data),
 	}
 
-	err = bg.Rule().Update(name, rule)
+	err = bg.Rule().Update(context.Background(), name, rule)
 	if err != nil {
 		log.Fatalf("update rule failed %v\n", err)
 	}
@@ -730,7 +731,7 @@ func (bs *bigipTest) deleteRule() {
 	bg := ltm.New(bs.bigIP)
 	ruleName := "/Common/test_rule"
 
-	err := bg.Rule().Delete(ruleName)
+	err := bg.Rule().Delete(context.Background(), ruleName)
 	if err != nil {
 		log.Fatalf("delete rule failed %v\n", err)
 	}
@@ -739,12 +740,12 @@ func (bs *bigipTest) deleteRule() {
 func (bs *bigipTest) listPoolMembers() {
 	bg := ltm.New(bs.bigIP)
 	poolName := "/Project_abdc90059f18487d847d439167b01928/Project_ef79a1d0-a437-4f70-8f08-9a43d8998c25"
-	poolMembers, _ := bg.PoolMembers().List(poolName)
+	poolMembers, _ := bg.PoolMembers().List(context.Background(), poolName)
 	fmt.Println(poolMembers)
 	for _, pool := range poolMembers.Items {
 		memberName := pool.FullPath
 		//fmt.Println(name)
-		pl, err := bg.PoolMembers().Get(poolName, memberName)
+		pl, err := bg.PoolMembers().Get(context.Background(), poolName, memberName)
 		if err != nil {
 			panic(err)
 		}
@@ -759,7 +760,7 @@ func (bs *bigipTest) createPoolMembers() {
 		//Name: "142.10.3.2:4523",
 		Name: "142.10.3.3:4523",
 	}
-	if err := bg.PoolMembers().Create(poolName, item); err != nil {
+	if err := bg.PoolMembers().Create(context.Background(), poolName, item); err != nil {
 		log.Fatalf("create pool member is failed %v", err)
 	}
 }
@@ -779,7 +780,7 @@ func (bs *bigipTest) updatePoolMembers() {
 		//:         "enable",
 	}
 
-	if err := bg.PoolMembers().Update(poolName, memberName, item); err != nil {
+	if err := bg.PoolMembers().Update(context.Background(), poolName, memberName, item); err != nil {
 		log.Fatalf("update pool member is failed %v", err)
 	}
 }
@@ -789,7 +790,7 @@ func (bs *bigipTest) deletePoolMembers() {
 	poolName := "/Common/hello-pool"
 	memberName := "/Common/142.10.3.2:4523"
 
-	if err := bg.PoolMembers().Delete(poolName, memberName); err != nil {
+	if err := bg.PoolMembers().Delete(context.Background(), poolName, memberName); err != nil {
 		log.Fatalf("delete pool member is failed %v", err)
 	}
 }
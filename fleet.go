@@ -0,0 +1,335 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultFleetConcurrency is the number of devices a Fleet works on at the same time when none is set.
+const DefaultFleetConcurrency = 10
+
+// Defaults of NewCircuitBreaker.
+const (
+	DefaultCircuitThreshold = 3
+	DefaultCircuitCooldown  = time.Minute
+)
+
+var (
+	// ErrCircuitOpen is recorded for a device skipped because its circuit breaker is open.
+	ErrCircuitOpen = errors.New("circuit breaker open: device unreachable")
+	// ErrFleetSkipped is recorded for a device not started because another device failed in fail fast mode.
+	ErrFleetSkipped = errors.New("skipped after a failure on another device")
+)
+
+// Fleet runs the same operation on several BIG-IP devices, for example:
+//
+//	fleet := bigip.NewFleet(map[string]*bigip.BigIP{"dc1-a": a, "dc1-b": b},
+//		bigip.WithConcurrency(5),
+//		bigip.WithDeviceTimeout(30*time.Second))
+//	versions, err := bigip.RunFleet(ctx, fleet, func(ctx context.Context, name string, b *bigip.BigIP) (string, error) {
+//		...
+//	})
+type Fleet struct {
+	devices     map[string]*BigIP
+	concurrency int
+	timeout     time.Duration
+	failFast    bool
+	breaker     *CircuitBreaker
+}
+
+// FleetOption is a custom type that handles the options of a Fleet.
+type FleetOption func(f *Fleet)
+
+// NewFleet creates a Fleet of devices keyed by name.
+func NewFleet(devices map[string]*BigIP, opts ...FleetOption) *Fleet {
+	f := &Fleet{
+		devices:     make(map[string]*BigIP, len(devices)),
+		concurrency: DefaultFleetConcurrency,
+	}
+	for name, b := range devices {
+		f.devices[name] = b
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// WithConcurrency is a FleetOption type function used for limiting the number of devices worked on at the same time.
+func WithConcurrency(n int) FleetOption {
+	return func(f *Fleet) {
+		if n > 0 {
+			f.concurrency = n
+		}
+	}
+}
+
+// WithDeviceTimeout is a FleetOption type function used for bounding the time spent on every device.
+func WithDeviceTimeout(timeout time.Duration) FleetOption {
+	return func(f *Fleet) {
+		f.timeout = timeout
+	}
+}
+
+// WithFailFast is a FleetOption type function used for stopping at the first failure: the
+// operations in progress are cancelled and the devices not started yet get ErrFleetSkipped.
+func WithFailFast() FleetOption {
+	return func(f *Fleet) {
+		f.failFast = true
+	}
+}
+
+// WithCircuitBreaker is a FleetOption type function used for skipping the devices that were
+// unreachable in the previous runs. The breaker can be shared by several fleets.
+func WithCircuitBreaker(cb *CircuitBreaker) FleetOption {
+	return func(f *Fleet) {
+		f.breaker = cb
+	}
+}
+
+// Devices returns the names of the devices in alphabetical order.
+func (f *Fleet) Devices() []string {
+	names := make([]string, 0, len(f.devices))
+	for name := range f.devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Device returns the device with the given name, or nil.
+func (f *Fleet) Device(name string) *BigIP {
+	return f.devices[name]
+}
+
+// Do runs fn on every device and returns a *FleetError if it failed on any of them.
+func (f *Fleet) Do(ctx context.Context, fn func(ctx context.Context, name string, b *BigIP) error) error {
+	_, err := RunFleet(ctx, f, func(ctx context.Context, name string, b *BigIP) (struct{}, error) {
+		return struct{}{}, fn(ctx, name, b)
+	})
+	return err
+}
+
+// RunFleet runs fn on every device of the fleet and returns the values keyed by device.
+// The devices on which fn failed are missing from the values and reported in a *FleetError.
+func RunFleet[T any](ctx context.Context, f *Fleet, fn func(ctx context.Context, name string, b *BigIP) (T, error)) (map[string]T, error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		values = make(map[string]T, len(f.devices))
+		errs   = make(map[string]error)
+	)
+	record := func(name string, value T, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[name] = err
+			if f.failFast {
+				cancel()
+			}
+			return
+		}
+		values[name] = value
+	}
+	// skip records a device that is not started, without stopping the others.
+	skip := func(name string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs[name] = err
+	}
+	// skipped is the error recorded for a device not started because the run stopped.
+	skipped := func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return ErrFleetSkipped
+	}
+
+	slots := make(chan struct{}, f.concurrency)
+	for _, name := range f.Devices() {
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+			skip(name, skipped())
+			continue
+		}
+		if runCtx.Err() != nil {
+			<-slots
+			skip(name, skipped())
+			continue
+		}
+		if f.breaker != nil && !f.breaker.Allow(name) {
+			<-slots
+			skip(name, ErrCircuitOpen)
+			continue
+		}
+
+		wg.Add(1)
+		go func(name string, b *BigIP) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			deviceCtx := runCtx
+			if f.timeout > 0 {
+				var cancel context.CancelFunc
+				deviceCtx, cancel = context.WithTimeout(runCtx, f.timeout)
+				defer cancel()
+			}
+			value, err := fn(deviceCtx, name, b)
+			if f.breaker != nil {
+				f.breaker.Record(name, err)
+			}
+			record(name, value, err)
+		}(name, f.devices[name])
+	}
+	wg.Wait()
+
+	if len(errs) != 0 {
+		return values, &FleetError{Errors: errs}
+	}
+	return values, nil
+}
+
+// FleetError holds the errors of a fleet run keyed by device.
+type FleetError struct {
+	Errors map[string]error
+}
+
+// Error implements the errors.Error interface
+func (err *FleetError) Error() string {
+	names := make([]string, 0, len(err.Errors))
+	for name := range err.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	msg := fmt.Sprintf("failed on %d device(s)", len(names))
+	for _, name := range names {
+		msg += fmt.Sprintf("\n   %s: %v", name, err.Errors[name])
+	}
+	return msg
+}
+
+// Unwrap returns the errors of the devices, so that errors.Is and errors.As look into them.
+func (err *FleetError) Unwrap() []error {
+	errs := make([]error, 0, len(err.Errors))
+	for _, e := range err.Errors {
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// CircuitBreaker skips a device once it was unreachable threshold times in a row. After the
+// cooldown, a single attempt is let through: the circuit closes if it succeeds and opens again
+// otherwise. An attempt cancelled with its context does not change the circuit.
+// Only connection failures and timeouts count, errors returned by BIG-IP do not.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit holds the state of one device.
+type circuit struct {
+	failures int
+	openedAt time.Time
+	// probing is set while the attempt let through after the cooldown runs.
+	probing bool
+}
+
+// NewCircuitBreaker creates a CircuitBreaker. Zero values select DefaultCircuitThreshold and DefaultCircuitCooldown.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = DefaultCircuitThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCircuitCooldown
+	}
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		circuits:  make(map[string]*circuit),
+	}
+}
+
+// Allow reports whether the device can be tried. Once the cooldown of an open circuit is
+// over, it returns true for a single caller until the result of its attempt is recorded.
+func (cb *CircuitBreaker) Allow(name string) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	c := cb.circuits[name]
+	if c == nil || c.failures < cb.threshold {
+		return true
+	}
+	if c.probing || cb.now().Sub(c.openedAt) < cb.cooldown {
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+// Open reports whether the circuit of the device is open, that is whether Allow would
+// return false. Unlike Allow, it does not let an attempt through.
+func (cb *CircuitBreaker) Open(name string) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	c := cb.circuits[name]
+	if c == nil || c.failures < cb.threshold {
+		return false
+	}
+	return c.probing || cb.now().Sub(c.openedAt) < cb.cooldown
+}
+
+// Record updates the circuit of the device with the result of an attempt.
+func (cb *CircuitBreaker) Record(name string, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	c := cb.circuits[name]
+	if errors.Is(err, context.Canceled) {
+		// The attempt tells nothing about the device, another one may be let through.
+		if c != nil {
+			c.probing = false
+		}
+		return
+	}
+	if !unreachable(err) {
+		delete(cb.circuits, name)
+		return
+	}
+	if c == nil {
+		c = &circuit{}
+		cb.circuits[name] = c
+	}
+	c.failures++
+	c.probing = false
+	if c.failures >= cb.threshold {
+		c.openedAt = cb.now()
+	}
+}
+
+// Reset closes the circuit of the device.
+func (cb *CircuitBreaker) Reset(name string) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	delete(cb.circuits, name)
+}
+
+// unreachable reports whether err means the device could not be reached.
+func unreachable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
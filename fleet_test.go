@@ -0,0 +1,176 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newFleetDevice(t *testing.T, handler http.HandlerFunc) *BigIP {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestRunFleet(t *testing.T) {
+	devices := map[string]*BigIP{}
+	for i := 0; i < 6; i++ {
+		name := fmt.Sprintf("bigip-%d", i)
+		devices[name] = newFleetDevice(t, func(w http.ResponseWriter, r *http.Request) {
+			if name == "bigip-3" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(w, `{"items":[{"name":%q}]}`, name)
+		})
+	}
+
+	var running, peak int32
+	fleet := NewFleet(devices, WithConcurrency(2))
+	values, err := RunFleet(context.Background(), fleet, func(ctx context.Context, name string, b *BigIP) (string, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		pools, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(ctx)
+		if err != nil {
+			return "", err
+		}
+		return pools.Items[0].Name, nil
+	})
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 devices at the same time, got %d", peak)
+	}
+	if len(values) != 5 || values["bigip-0"] != "bigip-0" {
+		t.Errorf("Expected 5 values keyed by device, got %v", values)
+	}
+	var fleetErr *FleetError
+	if !errors.As(err, &fleetErr) || len(fleetErr.Errors) != 1 || fleetErr.Errors["bigip-3"] == nil {
+		t.Fatalf("Expected a FleetError for bigip-3, got %v", err)
+	}
+}
+
+func TestFleetFailFast(t *testing.T) {
+	devices := map[string]*BigIP{"a": nil, "b": nil, "c": nil, "d": nil}
+	fleet := NewFleet(devices, WithConcurrency(1), WithFailFast())
+
+	var calls []string
+	err := fleet.Do(context.Background(), func(ctx context.Context, name string, b *BigIP) error {
+		calls = append(calls, name)
+		if name == "b" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	if len(calls) != 2 {
+		t.Errorf("Expected the run to stop after b, got %v", calls)
+	}
+	var fleetErr *FleetError
+	if !errors.As(err, &fleetErr) {
+		t.Fatalf("Expected a FleetError, got %v", err)
+	}
+	if !errors.Is(fleetErr.Errors["c"], ErrFleetSkipped) || !errors.Is(fleetErr.Errors["d"], ErrFleetSkipped) {
+		t.Errorf("Expected c and d to be skipped, got %v", fleetErr)
+	}
+}
+
+func TestFleetDeviceTimeout(t *testing.T) {
+	slow := newFleetDevice(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	fleet := NewFleet(map[string]*BigIP{"slow": slow}, WithDeviceTimeout(20*time.Millisecond))
+	err := fleet.Do(context.Background(), func(ctx context.Context, name string, b *BigIP) error {
+		_, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(ctx)
+		return err
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the device to time out, got %v", err)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	// Nothing listens on port 1.
+	down, err := New("http://127.0.0.1:1", WithBasicAuth("admin", "admin"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	now := time.Now()
+	cb := NewCircuitBreaker(2, time.Minute)
+	cb.now = func() time.Time { return now }
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	fleet := NewFleet(map[string]*BigIP{"down": down, "up": nil}, WithCircuitBreaker(cb))
+	run := func() error {
+		return fleet.Do(context.Background(), func(ctx context.Context, name string, b *BigIP) error {
+			mu.Lock()
+			calls[name]++
+			mu.Unlock()
+			if name == "up" {
+				return nil
+			}
+			_, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(ctx)
+			return err
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		run()
+	}
+	if calls["down"] != 2 || calls["up"] != 3 {
+		t.Errorf("Expected the unreachable device to be skipped after 2 failures, got %v", calls)
+	}
+	if err := run(); !errors.Is(err, ErrCircuitOpen) || !cb.Open("down") {
+		t.Errorf("Expected ErrCircuitOpen, got %v", err)
+	}
+
+	// After the cooldown, one attempt is let through.
+	now = now.Add(time.Minute)
+	run()
+	if calls["down"] != 3 || !cb.Open("down") {
+		t.Errorf("Expected one more attempt that opens the circuit again, got %v", calls)
+	}
+
+	// Only one caller probes the device after the cooldown, and a cancelled probe is neutral.
+	now = now.Add(time.Minute)
+	if !cb.Allow("down") || cb.Allow("down") || !cb.Open("down") {
+		t.Error("Expected a single attempt to be let through after the cooldown")
+	}
+	cb.Record("down", context.Canceled)
+	if !cb.Allow("down") {
+		t.Error("Expected a cancelled attempt to let another one through")
+	}
+	cb.Record("down", fmt.Errorf("list pools: %w", context.Canceled))
+	if cb.Open("down") || cb.circuits["down"].failures != 3 {
+		t.Errorf("Expected a cancelled attempt not to change the circuit, got %+v", cb.circuits["down"])
+	}
+
+	cb.Reset("down")
+	if cb.Open("down") {
+		t.Error("Expected the circuit to be closed after Reset")
+	}
+	cb.Record("up", errors.New("bad request"))
+	if !cb.Allow("up") {
+		t.Error("Expected errors other than connection failures not to count")
+	}
+}
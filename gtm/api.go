@@ -0,0 +1,244 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package gtm
+
+import (
+	"context"
+	global_settings "github.com/lefeck/go-bigip/gtm/global-settings"
+	"github.com/lefeck/go-bigip/gtm/pool"
+	"github.com/lefeck/go-bigip/gtm/wideip"
+	"github.com/lefeck/go-bigip/ltm/monitor"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// DatacenterAPI is the interface of DatacenterResource, which mocks implement in tests.
+type DatacenterAPI interface {
+	// List retrieves all Datacenter details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DatacenterList, error)
+	// Get retrieves the details of a single Datacenter by node name.
+	Get(ctx context.Context, name string) (*Datacenter, error)
+	// Create creates a new Datacenter item.
+	Create(ctx context.Context, item Datacenter) error
+	// Update modifies the Datacenter item identified by the Datacenter name.
+	Update(ctx context.Context, name string, item Datacenter) error
+	// Patch updates only the given fields of the Datacenter identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Datacenter identified by the Datacenter name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ DatacenterAPI = &DatacenterResource{}
+
+// DistributedAppAPI is the interface of DistributedAppResource, which mocks implement in tests.
+type DistributedAppAPI interface {
+	// List retrieves all DistributedApp details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DistributedAppList, error)
+	// Get retrieves the details of a single DistributedApp by node name.
+	Get(ctx context.Context, name string) (*DistributedApp, error)
+	// Create creates a new DistributedApp item.
+	Create(ctx context.Context, item DistributedApp) error
+	// Update modifies the DistributedApp item identified by the DistributedApp name.
+	Update(ctx context.Context, name string, item DistributedApp) error
+	// Patch updates only the given fields of the DistributedApp identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single DistributedApp identified by the DistributedApp name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ DistributedAppAPI = &DistributedAppResource{}
+
+// GTMAPI is the interface of GTM, which mocks implement in tests.
+type GTMAPI interface {
+	// Datacenter returns a configured DatacenterResource.
+	SyncStatus() SyncStatusAPI
+	// Datacenter returns a configured DatacenterResource.
+	Datacenter() DatacenterAPI
+	// DistributedApp returns a configured DistributedAppResource.
+	DistributedApp() DistributedAppAPI
+	// GlobalSettings returns a configured GlobalSettingsResource.
+	GlobalSettingsGeneral() global_settings.GlobalSettingsAPI
+	// Persist returns a configured PersistResource.
+	Persist() PersistAPI
+	// ProberPool returns a configured ProberPoolResource.
+	ProberPool() ProberPoolAPI
+	// Region returns a configured RegionResource.
+	Region() RegionAPI
+	// Rule returns a configured RuleResource.
+	Rule() RuleAPI
+	// Server returns a configured ServerResource.
+	Server() ServerAPI
+	// Topology returns a configured TopologyResource.
+	Topology() TopologyAPI
+	// Wideip returns a configured WideipResource.
+	WideipA() wideip.WideipAPI
+	// Pool returns a configured PoolResource.
+	PoolA() pool.PoolAPI
+	// Monitor returns a configured MonitorResource.
+	Monitor() monitor.MonitorAPI
+}
+
+var _ GTMAPI = &GTM{}
+
+// LinkAPI is the interface of LinkResource, which mocks implement in tests.
+type LinkAPI interface {
+	// List retrieves all Link details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*LinkList, error)
+	// Get retrieves the details of a single Link by node name.
+	Get(ctx context.Context, name string) (*Link, error)
+	// Create creates a new Link item.
+	Create(ctx context.Context, item Link) error
+	// Update modifies the Link item identified by the Link name.
+	Update(ctx context.Context, name string, item Link) error
+	// Patch updates only the given fields of the Link identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Link identified by the Link name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ LinkAPI = &LinkResource{}
+
+// ListenerAPI is the interface of ListenerResource, which mocks implement in tests.
+type ListenerAPI interface {
+	// List retrieves all Listener details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ListenerList, error)
+	// Get retrieves the details of a single Listener by node name.
+	Get(ctx context.Context, name string) (*Listener, error)
+	// Create creates a new Listener item.
+	Create(ctx context.Context, item Listener) error
+	// Update modifies the Listener item identified by the Listener name.
+	Update(ctx context.Context, name string, item Listener) error
+	// Patch updates only the given fields of the Listener identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Listener identified by the Listener name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ListenerAPI = &ListenerResource{}
+
+// ListenerProfilesAPI is the interface of ListenerProfilesResource, which mocks implement in tests.
+type ListenerProfilesAPI interface {
+	// List retrieves all ListenerProfiles details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ListenerProfilesList, error)
+	// Get retrieves the details of a single ListenerProfiles by node name.
+	Get(ctx context.Context, name string) (*ListenerProfiles, error)
+	// Create creates a new ListenerProfiles item.
+	Create(ctx context.Context, item ListenerProfiles) error
+	// Update modifies the ListenerProfiles item identified by the ListenerProfiles name.
+	Update(ctx context.Context, name string, item ListenerProfiles) error
+	// Patch updates only the given fields of the ListenerProfiles identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single ListenerProfiles identified by the ListenerProfiles name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ListenerProfilesAPI = &ListenerProfilesResource{}
+
+// PersistAPI is the interface of PersistResource, which mocks implement in tests.
+type PersistAPI interface {
+	// List retrieves all Persist details.
+	List(ctx context.Context) (*PersistList, error)
+}
+
+var _ PersistAPI = &PersistResource{}
+
+// ProberPoolAPI is the interface of ProberPoolResource, which mocks implement in tests.
+type ProberPoolAPI interface {
+	// List retrieves all ProberPool details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ProberPoolList, error)
+	// Get retrieves the details of a single ProberPool by node name.
+	Get(ctx context.Context, name string) (*ProberPool, error)
+	// Create creates a new ProberPool item.
+	Create(ctx context.Context, item ProberPool) error
+	// Update modifies the ProberPool item identified by the ProberPool name.
+	Update(ctx context.Context, name string, item ProberPool) error
+	// Patch updates only the given fields of the ProberPool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single ProberPool identified by the ProberPool name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// GetMembers  lists all the ProberPoolMembers configurations.
+	GetMembers(ctx context.Context, name string) (*ProberPoolMembersList, error)
+}
+
+var _ ProberPoolAPI = &ProberPoolResource{}
+
+// RegionAPI is the interface of RegionResource, which mocks implement in tests.
+type RegionAPI interface {
+	// List retrieves all Region details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RegionList, error)
+	// Get retrieves the details of a single Region by node name.
+	Get(ctx context.Context, name string) (*Region, error)
+	// Create creates a new Region item.
+	Create(ctx context.Context, item Region) error
+	// Update modifies the Region item identified by the Region name.
+	Update(ctx context.Context, name string, item Region) error
+	// Patch updates only the given fields of the Region identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Region identified by the Region name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RegionAPI = &RegionResource{}
+
+// RuleAPI is the interface of RuleResource, which mocks implement in tests.
+type RuleAPI interface {
+	// List retrieves all Rule details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RuleList, error)
+	// Get retrieves the details of a single Rule by node name.
+	Get(ctx context.Context, name string) (*Rule, error)
+	// Create creates a new Rule item.
+	Create(ctx context.Context, item Rule) error
+	// Update modifies the Rule item identified by the Rule name.
+	Update(ctx context.Context, name string, item Rule) error
+	// Patch updates only the given fields of the Rule identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Rule identified by the Rule name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RuleAPI = &RuleResource{}
+
+// ServerAPI is the interface of ServerResource, which mocks implement in tests.
+type ServerAPI interface {
+	// ListAll  lists all the Server configurations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServerList, error)
+	// Get a single Server configuration identified by name.
+	Get(ctx context.Context, fullPathName string) (*Server, error)
+	// GetVirtualServers lists all the ServerVirtualServers configurations.
+	GetVirtualServers(ctx context.Context, fullPathName string) (*ServerVirtualServersList, error)
+	// Create a new Server configuration.
+	Create(ctx context.Context, item Server) error
+	// Edit a Server configuration identified by name.
+	Update(ctx context.Context, fullPathName string, item Server) error
+	// Patch updates only the given fields of the Server identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete a single Server configuration identified by name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ServerAPI = &ServerResource{}
+
+// SyncStatusAPI is the interface of SyncStatusResource, which mocks implement in tests.
+type SyncStatusAPI interface {
+	// List retrieves all SyncStatus details.
+	Show(ctx context.Context) (*SyncStatus, error)
+}
+
+var _ SyncStatusAPI = &SyncStatusResource{}
+
+// TopologyAPI is the interface of TopologyResource, which mocks implement in tests.
+type TopologyAPI interface {
+	// List retrieves all Topology details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TopologyList, error)
+	// Get retrieves the details of a single Topology by node name.
+	Get(ctx context.Context, name string) (*Topology, error)
+	// Create creates a new Topology item.
+	Create(ctx context.Context, item Topology) error
+	// Update modifies the Topology item identified by the Topology name.
+	Update(ctx context.Context, name string, item Topology) error
+	// Patch updates only the given fields of the Topology identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Topology identified by the Topology name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TopologyAPI = &TopologyResource{}
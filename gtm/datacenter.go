@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // DatacenterList holds a list of Datacenter configuration.
@@ -38,72 +36,37 @@ type DatacenterResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Datacenter details.
-func (r *DatacenterResource) List() (*DatacenterList, error) {
-	var items DatacenterList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DatacenterResource.
+func (r *DatacenterResource) collection() *bigip.Collection[Datacenter, DatacenterList] {
+	return bigip.NewCollection[Datacenter, DatacenterList](r.b, GTMManager, DatacenterEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Datacenter details.
+func (r *DatacenterResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DatacenterList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Datacenter by node name.
-func (r *DatacenterResource) Get(name string) (*Datacenter, error) {
-	var item Datacenter
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *DatacenterResource) Get(ctx context.Context, name string) (*Datacenter, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Datacenter item.
-func (r *DatacenterResource) Create(item Datacenter) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *DatacenterResource) Create(ctx context.Context, item Datacenter) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Datacenter item identified by the Datacenter name.
-func (r *DatacenterResource) Update(name string, item Datacenter) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *DatacenterResource) Update(ctx context.Context, name string, item Datacenter) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Datacenter identified by the Datacenter name. If it does not exist, return an error.
-func (r *DatacenterResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Datacenter identified by name, see bigip.PatchBody.
+func (r *DatacenterResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Datacenter identified by the Datacenter name. If it does not exist, return an error.
+func (r *DatacenterResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -1,7 +1,7 @@
 package gtm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 	"testing"
 )
 
@@ -12,7 +12,7 @@ func TestCRUDDatacenter(t *testing.T) {
 	deviceIP := "192.168.12.21"
 
 	// Connect to the device
-	bigIP, err := bigip.NewSession(deviceIP, user, pass)
+	bigIP, err := newTestSession(t, deviceIP, user, pass)
 	if err != nil {
 		t.Error(err)
 	}
@@ -30,13 +30,13 @@ func TestCRUDDatacenter(t *testing.T) {
 
 	gtm := New(bigIP)
 
-	err = gtm.Datacenter().Create(datacenter)
+	err = gtm.Datacenter().Create(context.Background(), datacenter)
 	if err != nil {
 		t.Error(err)
 	}
 
 	// List all Datacenters to ensure the Test_Datacenter is created.
-	datacenterList, err := gtm.Datacenter().List()
+	datacenterList, err := gtm.Datacenter().List(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -53,7 +53,7 @@ func TestCRUDDatacenter(t *testing.T) {
 	}
 
 	// Get Test_Datacenter details
-	createdDatacenter, err := gtm.Datacenter().Get("Test_Datacenter")
+	createdDatacenter, err := gtm.Datacenter().Get(context.Background(), "Test_Datacenter")
 	if err != nil {
 		t.Error(err)
 	}
@@ -63,13 +63,13 @@ func TestCRUDDatacenter(t *testing.T) {
 
 	// Update the Datacenter
 	datacenter.Contact = "test2@test.com"
-	err = gtm.Datacenter().Update(datacenter.Name, datacenter)
+	err = gtm.Datacenter().Update(context.Background(), datacenter.Name, datacenter)
 	if err != nil {
 		t.Error(err)
 	}
 
 	// Check if the update was successful
-	updatedDatacenter, err := gtm.Datacenter().Get("Test_Datacenter")
+	updatedDatacenter, err := gtm.Datacenter().Get(context.Background(), "Test_Datacenter")
 	if err != nil {
 		t.Error(err)
 	}
@@ -78,13 +78,13 @@ func TestCRUDDatacenter(t *testing.T) {
 	}
 
 	// Delete the Test_Datacenter
-	err = gtm.Datacenter().Delete(datacenter.Name)
+	err = gtm.Datacenter().Delete(context.Background(), datacenter.Name)
 	if err != nil {
 		t.Error(err)
 	}
 
 	// Check if the Test_Datacenter was deleted
-	deletedDatacenter, err := gtm.Datacenter().Get("Test_Datacenter")
+	deletedDatacenter, err := gtm.Datacenter().Get(context.Background(), "Test_Datacenter")
 	if err == nil {
 		t.Error("Test_Datacenter is expected to be deleted but still exists")
 	}
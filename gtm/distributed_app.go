@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // DistributedAppList contains a list of DistributedApp.
@@ -41,72 +39,37 @@ type DistributedAppResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all DistributedApp details.
-func (r *DistributedAppResource) List() (*DistributedAppList, error) {
-	var items DistributedAppList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DistributedAppResource.
+func (r *DistributedAppResource) collection() *bigip.Collection[DistributedApp, DistributedAppList] {
+	return bigip.NewCollection[DistributedApp, DistributedAppList](r.b, GTMManager, DistributedAppEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all DistributedApp details.
+func (r *DistributedAppResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DistributedAppList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single DistributedApp by node name.
-func (r *DistributedAppResource) Get(name string) (*DistributedApp, error) {
-	var item DistributedApp
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *DistributedAppResource) Get(ctx context.Context, name string) (*DistributedApp, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new DistributedApp item.
-func (r *DistributedAppResource) Create(item DistributedApp) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *DistributedAppResource) Create(ctx context.Context, item DistributedApp) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the DistributedApp item identified by the DistributedApp name.
-func (r *DistributedAppResource) Update(name string, item DistributedApp) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *DistributedAppResource) Update(ctx context.Context, name string, item DistributedApp) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single DistributedApp identified by the DistributedApp name. If it does not exist, return an error.
-func (r *DistributedAppResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DistributedAppEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the DistributedApp identified by name, see bigip.PatchBody.
+func (r *DistributedAppResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single DistributedApp identified by the DistributedApp name. If it does not exist, return an error.
+func (r *DistributedAppResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
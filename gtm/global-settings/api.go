@@ -0,0 +1,46 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package global_settings
+
+import (
+	"context"
+)
+
+// GeneralAPI is the interface of GeneralResource, which mocks implement in tests.
+type GeneralAPI interface {
+	// List  lists all the General configurations.
+	List(ctx context.Context) (*General, error)
+	// Update a General configuration.
+	Update(ctx context.Context, item General) error
+}
+
+var _ GeneralAPI = &GeneralResource{}
+
+// GlobalSettingsAPI is the interface of GlobalSettingsResource, which mocks implement in tests.
+type GlobalSettingsAPI interface {
+	General() GeneralAPI
+	LoadBalancing() LoadBalancingAPI
+	Metrics() MetricsAPI
+}
+
+var _ GlobalSettingsAPI = &GlobalSettingsResource{}
+
+// LoadBalancingAPI is the interface of LoadBalancingResource, which mocks implement in tests.
+type LoadBalancingAPI interface {
+	// List  lists all the LoadBalancing configurations.
+	List(ctx context.Context) (*LoadBalancing, error)
+	// Update a LoadBalancing configuration.
+	Update(ctx context.Context, item LoadBalancing) error
+}
+
+var _ LoadBalancingAPI = &LoadBalancingResource{}
+
+// MetricsAPI is the interface of MetricsResource, which mocks implement in tests.
+type MetricsAPI interface {
+	// List  lists all the Metrics configurations.
+	List(ctx context.Context) (*Metrics, error)
+	// Update a Metrics configuration.
+	Update(ctx context.Context, item Metrics) error
+}
+
+var _ MetricsAPI = &MetricsResource{}
@@ -1,5 +1,7 @@
 package global_settings
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // GlobalSettingsEndpoint represents the REST resource for managing GlobalSettings.
@@ -21,14 +23,14 @@ func NewGlobalSettings(b *bigip.BigIP) GlobalSettingsResource {
 	}
 }
 
-func (gs *GlobalSettingsResource) General() *GeneralResource {
+func (gs *GlobalSettingsResource) General() GeneralAPI {
 	return &gs.general
 }
 
-func (gs *GlobalSettingsResource) LoadBalancing() *LoadBalancingResource {
+func (gs *GlobalSettingsResource) LoadBalancing() LoadBalancingAPI {
 	return &gs.loadBalancing
 }
 
-func (gs *GlobalSettingsResource) Metrics() *MetricsResource {
+func (gs *GlobalSettingsResource) Metrics() MetricsAPI {
 	return &gs.metrics
 }
@@ -44,10 +44,10 @@ type GeneralResource struct {
 }
 
 // List  lists all the General configurations.
-func (r *GeneralResource) List() (*General, error) {
+func (r *GeneralResource) List(ctx context.Context) (*General, error) {
 	var item General
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,14 +59,14 @@ func (r *GeneralResource) List() (*General, error) {
 }
 
 // Update a General configuration.
-func (r *GeneralResource) Update(item General) error {
+func (r *GeneralResource) Update(ctx context.Context, item General) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(GeneralEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
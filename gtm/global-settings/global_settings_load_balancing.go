@@ -40,10 +40,10 @@ type LoadBalancingResource struct {
 }
 
 // List  lists all the LoadBalancing configurations.
-func (r *LoadBalancingResource) List() (*LoadBalancing, error) {
+func (r *LoadBalancingResource) List(ctx context.Context) (*LoadBalancing, error) {
 	var item LoadBalancing
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,14 +55,14 @@ func (r *LoadBalancingResource) List() (*LoadBalancing, error) {
 }
 
 // Update a LoadBalancing configuration.
-func (r *LoadBalancingResource) Update(item LoadBalancing) error {
+func (r *LoadBalancingResource) Update(ctx context.Context, item LoadBalancing) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(LoadBalancingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
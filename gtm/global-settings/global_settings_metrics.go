@@ -36,10 +36,10 @@ type MetricsResource struct {
 }
 
 // List  lists all the Metrics configurations.
-func (r *MetricsResource) List() (*Metrics, error) {
+func (r *MetricsResource) List(ctx context.Context) (*Metrics, error) {
 	var item Metrics
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -51,14 +51,14 @@ func (r *MetricsResource) List() (*Metrics, error) {
 }
 
 // Update a Metrics configuration.
-func (r *MetricsResource) Update(item Metrics) error {
+func (r *MetricsResource) Update(ctx context.Context, item Metrics) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).SubResource(MetricsEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
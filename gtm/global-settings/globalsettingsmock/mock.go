@@ -0,0 +1,136 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package globalsettingsmock provides mocks of the interfaces of package global_settings, which record their calls.
+package globalsettingsmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	global_settings "github.com/lefeck/go-bigip/gtm/global-settings"
+)
+
+// GeneralAPI is a mock of global_settings.GeneralAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GeneralAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context) (*global_settings.General, error)
+	UpdateFunc func(context.Context, global_settings.General) error
+}
+
+var _ global_settings.GeneralAPI = &GeneralAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *GeneralAPI) List(ctx context.Context) (r0 *global_settings.General, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *GeneralAPI) Update(ctx context.Context, item global_settings.General) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return
+}
+
+// GlobalSettingsAPI is a mock of global_settings.GlobalSettingsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GlobalSettingsAPI struct {
+	bigiptest.CallRecorder
+
+	GeneralFunc       func() global_settings.GeneralAPI
+	LoadBalancingFunc func() global_settings.LoadBalancingAPI
+	MetricsFunc       func() global_settings.MetricsAPI
+}
+
+var _ global_settings.GlobalSettingsAPI = &GlobalSettingsAPI{}
+
+// General records the call and calls GeneralFunc if it is set.
+func (m *GlobalSettingsAPI) General() (r0 global_settings.GeneralAPI) {
+	m.CallRecorder.Record("General")
+	if m.GeneralFunc != nil {
+		return m.GeneralFunc()
+	}
+	return
+}
+
+// LoadBalancing records the call and calls LoadBalancingFunc if it is set.
+func (m *GlobalSettingsAPI) LoadBalancing() (r0 global_settings.LoadBalancingAPI) {
+	m.CallRecorder.Record("LoadBalancing")
+	if m.LoadBalancingFunc != nil {
+		return m.LoadBalancingFunc()
+	}
+	return
+}
+
+// Metrics records the call and calls MetricsFunc if it is set.
+func (m *GlobalSettingsAPI) Metrics() (r0 global_settings.MetricsAPI) {
+	m.CallRecorder.Record("Metrics")
+	if m.MetricsFunc != nil {
+		return m.MetricsFunc()
+	}
+	return
+}
+
+// LoadBalancingAPI is a mock of global_settings.LoadBalancingAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LoadBalancingAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context) (*global_settings.LoadBalancing, error)
+	UpdateFunc func(context.Context, global_settings.LoadBalancing) error
+}
+
+var _ global_settings.LoadBalancingAPI = &LoadBalancingAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *LoadBalancingAPI) List(ctx context.Context) (r0 *global_settings.LoadBalancing, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *LoadBalancingAPI) Update(ctx context.Context, item global_settings.LoadBalancing) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return
+}
+
+// MetricsAPI is a mock of global_settings.MetricsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MetricsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context) (*global_settings.Metrics, error)
+	UpdateFunc func(context.Context, global_settings.Metrics) error
+}
+
+var _ global_settings.MetricsAPI = &MetricsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MetricsAPI) List(ctx context.Context) (r0 *global_settings.Metrics, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MetricsAPI) Update(ctx context.Context, item global_settings.Metrics) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return
+}
@@ -1,5 +1,7 @@
 package gtm
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen -types GTM
+
 import (
 	"github.com/lefeck/go-bigip"
 	global_settings "github.com/lefeck/go-bigip/gtm/global-settings"
@@ -53,66 +55,66 @@ func New(b *bigip.BigIP) GTM {
 }
 
 // Datacenter returns a configured DatacenterResource.
-func (gtm GTM) SyncStatus() *SyncStatusResource {
+func (gtm GTM) SyncStatus() SyncStatusAPI {
 	return &gtm.syncStatus
 }
 
 // Datacenter returns a configured DatacenterResource.
-func (gtm GTM) Datacenter() *DatacenterResource {
+func (gtm GTM) Datacenter() DatacenterAPI {
 	return &gtm.datacenter
 }
 
 // DistributedApp returns a configured DistributedAppResource.
-func (gtm GTM) DistributedApp() *DistributedAppResource {
+func (gtm GTM) DistributedApp() DistributedAppAPI {
 	return &gtm.distributedApp
 }
 
 // GlobalSettings returns a configured GlobalSettingsResource.
-func (gtm GTM) GlobalSettingsGeneral() *global_settings.GlobalSettingsResource {
+func (gtm GTM) GlobalSettingsGeneral() global_settings.GlobalSettingsAPI {
 	return &gtm.globalSettings
 }
 
 // Persist returns a configured PersistResource.
-func (gtm GTM) Persist() *PersistResource {
+func (gtm GTM) Persist() PersistAPI {
 	return &gtm.persist
 }
 
 // ProberPool returns a configured ProberPoolResource.
-func (gtm GTM) ProberPool() *ProberPoolResource {
+func (gtm GTM) ProberPool() ProberPoolAPI {
 	return &gtm.proberPool
 }
 
 // Region returns a configured RegionResource.
-func (gtm GTM) Region() *RegionResource {
+func (gtm GTM) Region() RegionAPI {
 	return &gtm.region
 }
 
 // Rule returns a configured RuleResource.
-func (gtm GTM) Rule() *RuleResource {
+func (gtm GTM) Rule() RuleAPI {
 	return &gtm.rule
 }
 
 // Server returns a configured ServerResource.
-func (gtm GTM) Server() *ServerResource {
+func (gtm GTM) Server() ServerAPI {
 	return &gtm.server
 }
 
 // Topology returns a configured TopologyResource.
-func (gtm GTM) Topology() *TopologyResource {
+func (gtm GTM) Topology() TopologyAPI {
 	return &gtm.topology
 }
 
 // Wideip returns a configured WideipResource.
-func (gtm GTM) WideipA() *wideip.WideipResource {
+func (gtm GTM) WideipA() wideip.WideipAPI {
 	return &gtm.wideip
 }
 
 // Pool returns a configured PoolResource.
-func (gtm GTM) PoolA() *pool.PoolResource {
+func (gtm GTM) PoolA() pool.PoolAPI {
 	return &gtm.pool
 }
 
 // Monitor returns a configured MonitorResource.
-func (gtm GTM) Monitor() *monitor.MonitorResource {
+func (gtm GTM) Monitor() monitor.MonitorAPI {
 	return &gtm.monitor
 }
@@ -0,0 +1,902 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package gtmmock provides mocks of the interfaces of package gtm, which record their calls.
+package gtmmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/gtm"
+	global_settings "github.com/lefeck/go-bigip/gtm/global-settings"
+	"github.com/lefeck/go-bigip/gtm/pool"
+	"github.com/lefeck/go-bigip/gtm/wideip"
+	"github.com/lefeck/go-bigip/ltm/monitor"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// DatacenterAPI is a mock of gtm.DatacenterAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DatacenterAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.DatacenterList, error)
+	GetFunc    func(context.Context, string) (*gtm.Datacenter, error)
+	CreateFunc func(context.Context, gtm.Datacenter) error
+	UpdateFunc func(context.Context, string, gtm.Datacenter) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.DatacenterAPI = &DatacenterAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DatacenterAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.DatacenterList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DatacenterAPI) Get(ctx context.Context, name string) (r0 *gtm.Datacenter, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DatacenterAPI) Create(ctx context.Context, item gtm.Datacenter) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DatacenterAPI) Update(ctx context.Context, name string, item gtm.Datacenter) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DatacenterAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DatacenterAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// DistributedAppAPI is a mock of gtm.DistributedAppAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DistributedAppAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.DistributedAppList, error)
+	GetFunc    func(context.Context, string) (*gtm.DistributedApp, error)
+	CreateFunc func(context.Context, gtm.DistributedApp) error
+	UpdateFunc func(context.Context, string, gtm.DistributedApp) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.DistributedAppAPI = &DistributedAppAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DistributedAppAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.DistributedAppList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DistributedAppAPI) Get(ctx context.Context, name string) (r0 *gtm.DistributedApp, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DistributedAppAPI) Create(ctx context.Context, item gtm.DistributedApp) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DistributedAppAPI) Update(ctx context.Context, name string, item gtm.DistributedApp) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DistributedAppAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DistributedAppAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// GTMAPI is a mock of gtm.GTMAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GTMAPI struct {
+	bigiptest.CallRecorder
+
+	SyncStatusFunc            func() gtm.SyncStatusAPI
+	DatacenterFunc            func() gtm.DatacenterAPI
+	DistributedAppFunc        func() gtm.DistributedAppAPI
+	GlobalSettingsGeneralFunc func() global_settings.GlobalSettingsAPI
+	PersistFunc               func() gtm.PersistAPI
+	ProberPoolFunc            func() gtm.ProberPoolAPI
+	RegionFunc                func() gtm.RegionAPI
+	RuleFunc                  func() gtm.RuleAPI
+	ServerFunc                func() gtm.ServerAPI
+	TopologyFunc              func() gtm.TopologyAPI
+	WideipAFunc               func() wideip.WideipAPI
+	PoolAFunc                 func() pool.PoolAPI
+	MonitorFunc               func() monitor.MonitorAPI
+}
+
+var _ gtm.GTMAPI = &GTMAPI{}
+
+// SyncStatus records the call and calls SyncStatusFunc if it is set.
+func (m *GTMAPI) SyncStatus() (r0 gtm.SyncStatusAPI) {
+	m.CallRecorder.Record("SyncStatus")
+	if m.SyncStatusFunc != nil {
+		return m.SyncStatusFunc()
+	}
+	return
+}
+
+// Datacenter records the call and calls DatacenterFunc if it is set.
+func (m *GTMAPI) Datacenter() (r0 gtm.DatacenterAPI) {
+	m.CallRecorder.Record("Datacenter")
+	if m.DatacenterFunc != nil {
+		return m.DatacenterFunc()
+	}
+	return
+}
+
+// DistributedApp records the call and calls DistributedAppFunc if it is set.
+func (m *GTMAPI) DistributedApp() (r0 gtm.DistributedAppAPI) {
+	m.CallRecorder.Record("DistributedApp")
+	if m.DistributedAppFunc != nil {
+		return m.DistributedAppFunc()
+	}
+	return
+}
+
+// GlobalSettingsGeneral records the call and calls GlobalSettingsGeneralFunc if it is set.
+func (m *GTMAPI) GlobalSettingsGeneral() (r0 global_settings.GlobalSettingsAPI) {
+	m.CallRecorder.Record("GlobalSettingsGeneral")
+	if m.GlobalSettingsGeneralFunc != nil {
+		return m.GlobalSettingsGeneralFunc()
+	}
+	return
+}
+
+// Persist records the call and calls PersistFunc if it is set.
+func (m *GTMAPI) Persist() (r0 gtm.PersistAPI) {
+	m.CallRecorder.Record("Persist")
+	if m.PersistFunc != nil {
+		return m.PersistFunc()
+	}
+	return
+}
+
+// ProberPool records the call and calls ProberPoolFunc if it is set.
+func (m *GTMAPI) ProberPool() (r0 gtm.ProberPoolAPI) {
+	m.CallRecorder.Record("ProberPool")
+	if m.ProberPoolFunc != nil {
+		return m.ProberPoolFunc()
+	}
+	return
+}
+
+// Region records the call and calls RegionFunc if it is set.
+func (m *GTMAPI) Region() (r0 gtm.RegionAPI) {
+	m.CallRecorder.Record("Region")
+	if m.RegionFunc != nil {
+		return m.RegionFunc()
+	}
+	return
+}
+
+// Rule records the call and calls RuleFunc if it is set.
+func (m *GTMAPI) Rule() (r0 gtm.RuleAPI) {
+	m.CallRecorder.Record("Rule")
+	if m.RuleFunc != nil {
+		return m.RuleFunc()
+	}
+	return
+}
+
+// Server records the call and calls ServerFunc if it is set.
+func (m *GTMAPI) Server() (r0 gtm.ServerAPI) {
+	m.CallRecorder.Record("Server")
+	if m.ServerFunc != nil {
+		return m.ServerFunc()
+	}
+	return
+}
+
+// Topology records the call and calls TopologyFunc if it is set.
+func (m *GTMAPI) Topology() (r0 gtm.TopologyAPI) {
+	m.CallRecorder.Record("Topology")
+	if m.TopologyFunc != nil {
+		return m.TopologyFunc()
+	}
+	return
+}
+
+// WideipA records the call and calls WideipAFunc if it is set.
+func (m *GTMAPI) WideipA() (r0 wideip.WideipAPI) {
+	m.CallRecorder.Record("WideipA")
+	if m.WideipAFunc != nil {
+		return m.WideipAFunc()
+	}
+	return
+}
+
+// PoolA records the call and calls PoolAFunc if it is set.
+func (m *GTMAPI) PoolA() (r0 pool.PoolAPI) {
+	m.CallRecorder.Record("PoolA")
+	if m.PoolAFunc != nil {
+		return m.PoolAFunc()
+	}
+	return
+}
+
+// Monitor records the call and calls MonitorFunc if it is set.
+func (m *GTMAPI) Monitor() (r0 monitor.MonitorAPI) {
+	m.CallRecorder.Record("Monitor")
+	if m.MonitorFunc != nil {
+		return m.MonitorFunc()
+	}
+	return
+}
+
+// LinkAPI is a mock of gtm.LinkAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LinkAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.LinkList, error)
+	GetFunc    func(context.Context, string) (*gtm.Link, error)
+	CreateFunc func(context.Context, gtm.Link) error
+	UpdateFunc func(context.Context, string, gtm.Link) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.LinkAPI = &LinkAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *LinkAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.LinkList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *LinkAPI) Get(ctx context.Context, name string) (r0 *gtm.Link, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *LinkAPI) Create(ctx context.Context, item gtm.Link) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *LinkAPI) Update(ctx context.Context, name string, item gtm.Link) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *LinkAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *LinkAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ListenerAPI is a mock of gtm.ListenerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ListenerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.ListenerList, error)
+	GetFunc    func(context.Context, string) (*gtm.Listener, error)
+	CreateFunc func(context.Context, gtm.Listener) error
+	UpdateFunc func(context.Context, string, gtm.Listener) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.ListenerAPI = &ListenerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ListenerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.ListenerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ListenerAPI) Get(ctx context.Context, name string) (r0 *gtm.Listener, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ListenerAPI) Create(ctx context.Context, item gtm.Listener) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ListenerAPI) Update(ctx context.Context, name string, item gtm.Listener) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ListenerAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ListenerAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ListenerProfilesAPI is a mock of gtm.ListenerProfilesAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ListenerProfilesAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.ListenerProfilesList, error)
+	GetFunc    func(context.Context, string) (*gtm.ListenerProfiles, error)
+	CreateFunc func(context.Context, gtm.ListenerProfiles) error
+	UpdateFunc func(context.Context, string, gtm.ListenerProfiles) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.ListenerProfilesAPI = &ListenerProfilesAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ListenerProfilesAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.ListenerProfilesList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ListenerProfilesAPI) Get(ctx context.Context, name string) (r0 *gtm.ListenerProfiles, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ListenerProfilesAPI) Create(ctx context.Context, item gtm.ListenerProfiles) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ListenerProfilesAPI) Update(ctx context.Context, name string, item gtm.ListenerProfiles) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ListenerProfilesAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ListenerProfilesAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// PersistAPI is a mock of gtm.PersistAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PersistAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*gtm.PersistList, error)
+}
+
+var _ gtm.PersistAPI = &PersistAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PersistAPI) List(ctx context.Context) (r0 *gtm.PersistList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// ProberPoolAPI is a mock of gtm.ProberPoolAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ProberPoolAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc       func(context.Context, ...*rest.ListOptions) (*gtm.ProberPoolList, error)
+	GetFunc        func(context.Context, string) (*gtm.ProberPool, error)
+	CreateFunc     func(context.Context, gtm.ProberPool) error
+	UpdateFunc     func(context.Context, string, gtm.ProberPool) error
+	PatchFunc      func(context.Context, string, interface{}) error
+	DeleteFunc     func(context.Context, string) error
+	GetMembersFunc func(context.Context, string) (*gtm.ProberPoolMembersList, error)
+}
+
+var _ gtm.ProberPoolAPI = &ProberPoolAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ProberPoolAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.ProberPoolList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ProberPoolAPI) Get(ctx context.Context, name string) (r0 *gtm.ProberPool, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ProberPoolAPI) Create(ctx context.Context, item gtm.ProberPool) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ProberPoolAPI) Update(ctx context.Context, name string, item gtm.ProberPool) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ProberPoolAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ProberPoolAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// GetMembers records the call and calls GetMembersFunc if it is set.
+func (m *ProberPoolAPI) GetMembers(ctx context.Context, name string) (r0 *gtm.ProberPoolMembersList, r1 error) {
+	m.CallRecorder.Record("GetMembers", ctx, name)
+	if m.GetMembersFunc != nil {
+		return m.GetMembersFunc(ctx, name)
+	}
+	return
+}
+
+// RegionAPI is a mock of gtm.RegionAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RegionAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.RegionList, error)
+	GetFunc    func(context.Context, string) (*gtm.Region, error)
+	CreateFunc func(context.Context, gtm.Region) error
+	UpdateFunc func(context.Context, string, gtm.Region) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.RegionAPI = &RegionAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RegionAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.RegionList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RegionAPI) Get(ctx context.Context, name string) (r0 *gtm.Region, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RegionAPI) Create(ctx context.Context, item gtm.Region) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RegionAPI) Update(ctx context.Context, name string, item gtm.Region) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RegionAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RegionAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RuleAPI is a mock of gtm.RuleAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RuleAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.RuleList, error)
+	GetFunc    func(context.Context, string) (*gtm.Rule, error)
+	CreateFunc func(context.Context, gtm.Rule) error
+	UpdateFunc func(context.Context, string, gtm.Rule) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.RuleAPI = &RuleAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RuleAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.RuleList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RuleAPI) Get(ctx context.Context, name string) (r0 *gtm.Rule, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RuleAPI) Create(ctx context.Context, item gtm.Rule) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RuleAPI) Update(ctx context.Context, name string, item gtm.Rule) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RuleAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RuleAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ServerAPI is a mock of gtm.ServerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc              func(context.Context, ...*rest.ListOptions) (*gtm.ServerList, error)
+	GetFunc               func(context.Context, string) (*gtm.Server, error)
+	GetVirtualServersFunc func(context.Context, string) (*gtm.ServerVirtualServersList, error)
+	CreateFunc            func(context.Context, gtm.Server) error
+	UpdateFunc            func(context.Context, string, gtm.Server) error
+	PatchFunc             func(context.Context, string, interface{}) error
+	DeleteFunc            func(context.Context, string) error
+}
+
+var _ gtm.ServerAPI = &ServerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.ServerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServerAPI) Get(ctx context.Context, fullPathName string) (r0 *gtm.Server, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// GetVirtualServers records the call and calls GetVirtualServersFunc if it is set.
+func (m *ServerAPI) GetVirtualServers(ctx context.Context, fullPathName string) (r0 *gtm.ServerVirtualServersList, r1 error) {
+	m.CallRecorder.Record("GetVirtualServers", ctx, fullPathName)
+	if m.GetVirtualServersFunc != nil {
+		return m.GetVirtualServersFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServerAPI) Create(ctx context.Context, item gtm.Server) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServerAPI) Update(ctx context.Context, fullPathName string, item gtm.Server) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServerAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServerAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SyncStatusAPI is a mock of gtm.SyncStatusAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SyncStatusAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc func(context.Context) (*gtm.SyncStatus, error)
+}
+
+var _ gtm.SyncStatusAPI = &SyncStatusAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *SyncStatusAPI) Show(ctx context.Context) (r0 *gtm.SyncStatus, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// TopologyAPI is a mock of gtm.TopologyAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TopologyAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*gtm.TopologyList, error)
+	GetFunc    func(context.Context, string) (*gtm.Topology, error)
+	CreateFunc func(context.Context, gtm.Topology) error
+	UpdateFunc func(context.Context, string, gtm.Topology) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ gtm.TopologyAPI = &TopologyAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TopologyAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *gtm.TopologyList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TopologyAPI) Get(ctx context.Context, name string) (r0 *gtm.Topology, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TopologyAPI) Create(ctx context.Context, item gtm.Topology) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TopologyAPI) Update(ctx context.Context, name string, item gtm.Topology) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TopologyAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TopologyAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // LinkList holds a list of Link configuration.
@@ -54,72 +52,37 @@ type LinkResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Link details.
-func (r *LinkResource) List() (*LinkList, error) {
-	var items LinkList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing LinkResource.
+func (r *LinkResource) collection() *bigip.Collection[Link, LinkList] {
+	return bigip.NewCollection[Link, LinkList](r.b, GTMManager, LinkEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Link details.
+func (r *LinkResource) List(ctx context.Context, opts ...*rest.ListOptions) (*LinkList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Link by node name.
-func (r *LinkResource) Get(name string) (*Link, error) {
-	var item Link
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *LinkResource) Get(ctx context.Context, name string) (*Link, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Link item.
-func (r *LinkResource) Create(item Link) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LinkResource) Create(ctx context.Context, item Link) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Link item identified by the Link name.
-func (r *LinkResource) Update(name string, item Link) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LinkResource) Update(ctx context.Context, name string, item Link) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Link identified by the Link name. If it does not exist, return an error.
-func (r *LinkResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(LinkEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Link identified by name, see bigip.PatchBody.
+func (r *LinkResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Link identified by the Link name. If it does not exist, return an error.
+func (r *LinkResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
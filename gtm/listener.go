@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ListenerList holds a list of Listener uration.
@@ -50,72 +48,37 @@ type ListenerResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Listener details.
-func (r *ListenerResource) List() (*ListenerList, error) {
-	var items ListenerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ListenerResource.
+func (r *ListenerResource) collection() *bigip.Collection[Listener, ListenerList] {
+	return bigip.NewCollection[Listener, ListenerList](r.b, GTMManager, ListenerEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Listener details.
+func (r *ListenerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ListenerList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Listener by node name.
-func (r *ListenerResource) Get(name string) (*Listener, error) {
-	var item Listener
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ListenerResource) Get(ctx context.Context, name string) (*Listener, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Listener item.
-func (r *ListenerResource) Create(item Listener) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ListenerResource) Create(ctx context.Context, item Listener) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Listener item identified by the Listener name.
-func (r *ListenerResource) Update(name string, item Listener) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ListenerResource) Update(ctx context.Context, name string, item Listener) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Listener identified by the Listener name. If it does not exist, return an error.
-func (r *ListenerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Listener identified by name, see bigip.PatchBody.
+func (r *ListenerResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Listener identified by the Listener name. If it does not exist, return an error.
+func (r *ListenerResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ListenerProfilesList holds a list of ListenerProfiles configurations.
@@ -34,72 +32,37 @@ type ListenerProfilesResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all ListenerProfiles details.
-func (r *ListenerProfilesResource) List() (*ListenerProfilesList, error) {
-	var items ListenerProfilesList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ListenerProfilesResource.
+func (r *ListenerProfilesResource) collection() *bigip.Collection[ListenerProfiles, ListenerProfilesList] {
+	return bigip.NewCollection[ListenerProfiles, ListenerProfilesList](r.b, GTMManager, ListenerProfilesEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all ListenerProfiles details.
+func (r *ListenerProfilesResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ListenerProfilesList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single ListenerProfiles by node name.
-func (r *ListenerProfilesResource) Get(name string) (*ListenerProfiles, error) {
-	var item ListenerProfiles
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ListenerProfilesResource) Get(ctx context.Context, name string) (*ListenerProfiles, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new ListenerProfiles item.
-func (r *ListenerProfilesResource) Create(item ListenerProfiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ListenerProfilesResource) Create(ctx context.Context, item ListenerProfiles) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the ListenerProfiles item identified by the ListenerProfiles name.
-func (r *ListenerProfilesResource) Update(name string, item ListenerProfiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ListenerProfilesResource) Update(ctx context.Context, name string, item ListenerProfiles) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single ListenerProfiles identified by the ListenerProfiles name. If it does not exist, return an error.
-func (r *ListenerProfilesResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ListenerProfilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the ListenerProfiles identified by name, see bigip.PatchBody.
+func (r *ListenerProfilesResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single ListenerProfiles identified by the ListenerProfiles name. If it does not exist, return an error.
+func (r *ListenerProfilesResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -0,0 +1,654 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package monitor
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// BigIPAPI is the interface of BigIPResource, which mocks implement in tests.
+type BigIPAPI interface {
+	// List returns a list of all BigIP resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*BigIPList, error)
+	// Get returns a specific BigIP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*BigIP, error)
+	// Create adds a new BigIP resource provided by the item
+	Create(ctx context.Context, item BigIP) error
+	// Update modifies an existing BigIP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item BigIP) error
+	// Patch updates only the given fields of the BigIP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a BigIP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ BigIPAPI = &BigIPResource{}
+
+// BigIPLinkAPI is the interface of BigIPLinkResource, which mocks implement in tests.
+type BigIPLinkAPI interface {
+	// List returns a list of all BigIPLinkList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*BigIPLinkList, error)
+	// Get returns a specific BigIPLink resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*BigIPLink, error)
+	// Create adds a new BigIPLink resource provided by the item
+	Create(ctx context.Context, item BigIPLink) error
+	// Update modifies an existing BigIPLink resource identified by name using the provided item
+	Update(ctx context.Context, name string, item BigIPLink) error
+	// Patch updates only the given fields of the BigIPLink identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a BigIPLink resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ BigIPLinkAPI = &BigIPLinkResource{}
+
+// ExternalAPI is the interface of ExternalResource, which mocks implement in tests.
+type ExternalAPI interface {
+	// List returns a list of all ExternalList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ExternalList, error)
+	// Get returns a specific External resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*External, error)
+	// Create adds a new External resource provided by the item
+	Create(ctx context.Context, item External) error
+	// Update modifies an existing External resource identified by name using the provided item
+	Update(ctx context.Context, name string, item External) error
+	// Patch updates only the given fields of the External identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a External resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ExternalAPI = &ExternalResource{}
+
+// FTPAPI is the interface of FTPResource, which mocks implement in tests.
+type FTPAPI interface {
+	// List returns a list of all FTPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FTPList, error)
+	// Get returns a specific FTP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*FTP, error)
+	// Create adds a new FTP resource provided by the item
+	Create(ctx context.Context, item FTP) error
+	// Update modifies an existing FTP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item FTP) error
+	// Patch updates only the given fields of the FTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a FTP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ FTPAPI = &FTPResource{}
+
+// FirepassAPI is the interface of FirepassResource, which mocks implement in tests.
+type FirepassAPI interface {
+	// List returns a list of all FirepassList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FirepassList, error)
+	// Get returns a specific Firepass resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*Firepass, error)
+	// Create adds a new Firepass resource provided by the item
+	Create(ctx context.Context, item Firepass) error
+	// Update modifies an existing Firepass resource identified by name using the provided item
+	Update(ctx context.Context, name string, item Firepass) error
+	// Patch updates only the given fields of the Firepass identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a Firepass resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ FirepassAPI = &FirepassResource{}
+
+// GTPAPI is the interface of GTPResource, which mocks implement in tests.
+type GTPAPI interface {
+	// List returns a list of all GTPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*GTPList, error)
+	// Get returns a specific GTP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*GTP, error)
+	// Create adds a new GTP resource provided by the item
+	Create(ctx context.Context, item GTP) error
+	// Update modifies an existing GTP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item GTP) error
+	// Patch updates only the given fields of the GTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a GTP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ GTPAPI = &GTPResource{}
+
+// HTTPAPI is the interface of HTTPResource, which mocks implement in tests.
+type HTTPAPI interface {
+	// List returns a list of all HTTPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPList, error)
+	// Get returns a specific HTTP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*HTTP, error)
+	// Create adds a new HTTP resource provided by the item
+	Create(ctx context.Context, item HTTP) error
+	// Update modifies an existing HTTP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item HTTP) error
+	// Patch updates only the given fields of the HTTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a HTTP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ HTTPAPI = &HTTPResource{}
+
+// HTTPSAPI is the interface of HTTPSResource, which mocks implement in tests.
+type HTTPSAPI interface {
+	// List returns a list of all HTTPSList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPSList, error)
+	// Get returns a specific HTTPS resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*HTTPS, error)
+	// Create adds a new HTTPS resource provided by the item
+	Create(ctx context.Context, item HTTPS) error
+	// Update modifies an existing HTTPS resource identified by name using the provided item
+	Update(ctx context.Context, name string, item HTTPS) error
+	// Patch updates only the given fields of the HTTPS identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a HTTPS resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ HTTPSAPI = &HTTPSResource{}
+
+// ICMPAPI is the interface of ICMPResource, which mocks implement in tests.
+type ICMPAPI interface {
+	// List returns a list of all ICMPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ICMPList, error)
+	// Get returns a specific ICMP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*ICMP, error)
+	// Create adds a new ICMP resource provided by the item
+	Create(ctx context.Context, item ICMP) error
+	// Update modifies an existing ICMP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item ICMP) error
+	// Patch updates only the given fields of the ICMP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a ICMP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ICMPAPI = &ICMPResource{}
+
+// IMAPAPI is the interface of IMAPResource, which mocks implement in tests.
+type IMAPAPI interface {
+	// List returns a list of all IMAPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*IMAPList, error)
+	// Get returns a specific IMAP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*IMAP, error)
+	// Create adds a new IMAP resource provided by the item
+	Create(ctx context.Context, item IMAP) error
+	// Update modifies an existing IMAP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item IMAP) error
+	// Patch updates only the given fields of the IMAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a IMAP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ IMAPAPI = &IMAPResource{}
+
+// LDAPAPI is the interface of LDAPResource, which mocks implement in tests.
+type LDAPAPI interface {
+	// List returns a list of all LDAPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*LDAPList, error)
+	// Get returns a specific LDAP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*LDAP, error)
+	// Create adds a new LDAP resource provided by the item
+	Create(ctx context.Context, item LDAP) error
+	// Update modifies an existing LDAP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item LDAP) error
+	// Patch updates only the given fields of the LDAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a LDAP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ LDAPAPI = &LDAPResource{}
+
+// MSSQLAPI is the interface of MSSQLResource, which mocks implement in tests.
+type MSSQLAPI interface {
+	// List returns a list of all MSSQLList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*MSSQLList, error)
+	// Get returns a specific MSSQL resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*MSSQL, error)
+	// Create adds a new MSSQL resource provided by the item
+	Create(ctx context.Context, item MSSQL) error
+	// Update modifies an existing MSSQL resource identified by name using the provided item
+	Update(ctx context.Context, name string, item MSSQL) error
+	// Patch updates only the given fields of the MSSQL identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a MSSQL resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ MSSQLAPI = &MSSQLResource{}
+
+// MonitorAPI is the interface of MonitorResource, which mocks implement in tests.
+type MonitorAPI interface {
+	// BIGIP returns a reference to the BigIPResource instance
+	BIGIP() BigIPAPI
+	// BigIPLink returns a reference to the BigIPLinkResource instance
+	BigIPLink() BigIPLinkAPI
+	// External returns a reference to the ExternalResource instance
+	External() ExternalAPI
+	// FTP returns a reference to the FTPResource instance
+	FTP() FTPAPI
+	// Firepass returns a reference to the FirepassResource instance
+	Firepass() FirepassAPI
+	// GTP returns a reference to the GTPResource instance
+	GTP() GTPAPI
+	// HTTP returns a reference to the HTTPResource instance
+	HTTP() HTTPAPI
+	// HTTPS returns a reference to the HTTPSResource instance
+	HTTPS() HTTPSAPI
+	// ICMP returns a reference to the ICMPResource instance
+	ICMP() ICMPAPI
+	// IMAP returns a reference to the IMAPResource instance
+	IMAP() IMAPAPI
+	// LDAP returns a reference to the LDAPResource instance
+	LDAP() LDAPAPI
+	// MSSQL returns a reference to the MSSQLResource instance
+	MSSQL() MSSQLAPI
+	// MySQL returns a reference to the MySQLResource instance
+	MySQL() MySQLAPI
+	// NNTP returns a reference to the NNTPResource instance
+	NNTP() NNTPAPI
+	// None returns a reference to the NoneResource instance
+	None() NoneAPI
+	// Oracle returns a reference to the OracleResource instance
+	Oracle() OracleAPI
+	// POP3 returns a reference to the POP3Resource instance
+	POP3() POP3API
+	// PostgreSQL returns a reference to the PostgreSQLResource instance
+	PostgreSQL() PostgreSQLAPI
+	// Radius returns a reference to the RadiusResource instance
+	Radius() RadiusAPI
+	// RadiusAccounting returns a reference to the RadiusAccountingResource instance
+	RadiusAccounting() RadiusAccountingAPI
+	// RealServer returns a reference to the RealServerResource instance
+	RealServer() RealServerAPI
+	// SIP returns a reference to the SIPResource instance
+	SIP() SIPAPI
+	// SMTP returns a reference to the SMTPResource instance
+	SMTP() SMTPAPI
+	// SNMP returns a reference to the SNMPResource instance
+	SNMP() SNMPAPI
+	// SNMPLink returns a reference to the SNMPLinkResource instance
+	SNMPLink() SNMPLinkAPI
+	// SOAP returns a reference to the SOAPResource instance
+	SOAP() SOAPAPI
+	// TCP returns a reference to the TCPResource instance
+	TCP() TCPAPI
+	// TCPHalf returns a reference to the TCPHalfResource instance
+	TCPHalf() TCPHalfAPI
+	// UDP returns a reference to the UDPResource instance
+	UDP() UDPAPI
+	// WAP returns a reference to the WAPResource instance
+	WAP() WAPAPI
+	// WMI returns a reference to the WMIResource instance
+	WMI() WMIAPI
+	// Scripted returns a reference to the ScriptedResource instance
+	Scripted() ScriptedAPI
+}
+
+var _ MonitorAPI = &MonitorResource{}
+
+// MySQLAPI is the interface of MySQLResource, which mocks implement in tests.
+type MySQLAPI interface {
+	// List returns a list of all MySQLList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*MySQLList, error)
+	// Get returns a specific MySQL resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*MySQL, error)
+	// Create adds a new MySQL resource provided by the item
+	Create(ctx context.Context, item MySQL) error
+	// Update modifies an existing MySQL resource identified by name using the provided item
+	Update(ctx context.Context, name string, item MySQL) error
+	// Patch updates only the given fields of the MySQL identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a MySQL resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ MySQLAPI = &MySQLResource{}
+
+// NNTPAPI is the interface of NNTPResource, which mocks implement in tests.
+type NNTPAPI interface {
+	// List returns a list of all NNTPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*NNTPList, error)
+	// Get returns a specific NNTP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*NNTP, error)
+	// Create adds a new NNTP resource provided by the item
+	Create(ctx context.Context, item NNTP) error
+	// Update modifies an existing NNTP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item NNTP) error
+	// Patch updates only the given fields of the NNTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a NNTP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ NNTPAPI = &NNTPResource{}
+
+// NoneAPI is the interface of NoneResource, which mocks implement in tests.
+type NoneAPI interface {
+	// List returns a list of all NoneList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*NoneList, error)
+	// Get returns a specific None resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*None, error)
+	// Create adds a new None resource provided by the item
+	Create(ctx context.Context, item None) error
+	// Update modifies an existing None resource identified by name using the provided item
+	Update(ctx context.Context, name string, item None) error
+	// Patch updates only the given fields of the None identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a None resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ NoneAPI = &NoneResource{}
+
+// OracleAPI is the interface of OracleResource, which mocks implement in tests.
+type OracleAPI interface {
+	// List returns a list of all OracleList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*OracleList, error)
+	// Get returns a specific Oracle resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*Oracle, error)
+	// Create adds a new Oracle resource provided by the item
+	Create(ctx context.Context, item Oracle) error
+	// Update modifies an existing Oracle resource identified by name using the provided item
+	Update(ctx context.Context, name string, item Oracle) error
+	// Patch updates only the given fields of the Oracle identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a Oracle resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ OracleAPI = &OracleResource{}
+
+// POP3API is the interface of POP3Resource, which mocks implement in tests.
+type POP3API interface {
+	// List returns a list of all POP3List resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*POP3List, error)
+	// Get returns a specific POP3 resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*POP3, error)
+	// Create adds a new POP3 resource provided by the item
+	Create(ctx context.Context, item POP3) error
+	// Update modifies an existing POP3 resource identified by name using the provided item
+	Update(ctx context.Context, name string, item POP3) error
+	// Patch updates only the given fields of the POP3 identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a POP3 resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ POP3API = &POP3Resource{}
+
+// PostgreSQLAPI is the interface of PostgreSQLResource, which mocks implement in tests.
+type PostgreSQLAPI interface {
+	// List returns a list of all PostgreSQLList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PostgreSQLList, error)
+	// Get returns a specific PostgreSQL resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*PostgreSQL, error)
+	// Create adds a new PostgreSQL resource provided by the item
+	Create(ctx context.Context, item PostgreSQL) error
+	// Update modifies an existing PostgreSQL resource identified by name using the provided item
+	Update(ctx context.Context, name string, item PostgreSQL) error
+	// Patch updates only the given fields of the PostgreSQL identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a PostgreSQL resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ PostgreSQLAPI = &PostgreSQLResource{}
+
+// RadiusAPI is the interface of RadiusResource, which mocks implement in tests.
+type RadiusAPI interface {
+	// List returns a list of all RadiusList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusList, error)
+	// Get returns a specific Radius resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*Radius, error)
+	// Create adds a new Radius resource provided by the item
+	Create(ctx context.Context, item Radius) error
+	// Update modifies an existing Radius resource identified by name using the provided item
+	Update(ctx context.Context, name string, item Radius) error
+	// Patch updates only the given fields of the Radius identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a Radius resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RadiusAPI = &RadiusResource{}
+
+// RadiusAccountingAPI is the interface of RadiusAccountingResource, which mocks implement in tests.
+type RadiusAccountingAPI interface {
+	// List returns a list of all RadiusAccountingList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusAccountingList, error)
+	// Get returns a specific RadiusAccounting resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*RadiusAccounting, error)
+	// Create adds a new RadiusAccounting resource provided by the item
+	Create(ctx context.Context, item RadiusAccounting) error
+	// Update modifies an existing RadiusAccounting resource identified by name using the provided item
+	Update(ctx context.Context, name string, item RadiusAccounting) error
+	// Patch updates only the given fields of the RadiusAccounting identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a RadiusAccounting resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RadiusAccountingAPI = &RadiusAccountingResource{}
+
+// RealServerAPI is the interface of RealServerResource, which mocks implement in tests.
+type RealServerAPI interface {
+	// List returns a list of all RealServerList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RealServerList, error)
+	// Get returns a specific RealServer resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*RealServer, error)
+	// Create adds a new RealServer resource provided by the item
+	Create(ctx context.Context, item RealServer) error
+	// Update modifies an existing RealServer resource identified by name using the provided item
+	Update(ctx context.Context, name string, item RealServer) error
+	// Patch updates only the given fields of the RealServer identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a RealServer resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RealServerAPI = &RealServerResource{}
+
+// SIPAPI is the interface of SIPResource, which mocks implement in tests.
+type SIPAPI interface {
+	// List returns a list of all SIPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SIPList, error)
+	// Get returns a specific SIP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*SIP, error)
+	// Create adds a new SIP resource provided by the item
+	Create(ctx context.Context, item SIP) error
+	// Update modifies an existing SIP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item SIP) error
+	// Patch updates only the given fields of the SIP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a SIP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SIPAPI = &SIPResource{}
+
+// SMTPAPI is the interface of SMTPResource, which mocks implement in tests.
+type SMTPAPI interface {
+	// List returns a list of all SMTPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPList, error)
+	// Get returns a specific SMTP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*SMTP, error)
+	// Create adds a new SMTP resource provided by the item
+	Create(ctx context.Context, item SMTP) error
+	// Update modifies an existing SMTP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item SMTP) error
+	// Patch updates only the given fields of the SMTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a SMTP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SMTPAPI = &SMTPResource{}
+
+// SNMPAPI is the interface of SNMPResource, which mocks implement in tests.
+type SNMPAPI interface {
+	// List returns a list of all SNMPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPList, error)
+	// Get returns a specific SNMP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*SNMP, error)
+	// Create adds a new SNMP resource provided by the item
+	Create(ctx context.Context, item SNMP) error
+	// Update modifies an existing SNMP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item SNMP) error
+	// Patch updates only the given fields of the SNMP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a SNMP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SNMPAPI = &SNMPResource{}
+
+// SNMPLinkAPI is the interface of SNMPLinkResource, which mocks implement in tests.
+type SNMPLinkAPI interface {
+	// List returns a list of all SNMPLinkList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPLinkList, error)
+	// Get returns a specific SNMPLink resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*SNMPLink, error)
+	// Create adds a new SNMPLink resource provided by the item
+	Create(ctx context.Context, item SNMPLink) error
+	// Update modifies an existing SNMPLink resource identified by name using the provided item
+	Update(ctx context.Context, name string, item SNMPLink) error
+	// Patch updates only the given fields of the SNMPLink identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a SNMPLink resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SNMPLinkAPI = &SNMPLinkResource{}
+
+// SOAPAPI is the interface of SOAPResource, which mocks implement in tests.
+type SOAPAPI interface {
+	// List returns a list of all SOAPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SOAPList, error)
+	// Get returns a specific SOAP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*SOAP, error)
+	// Create adds a new SOAP resource provided by the item
+	Create(ctx context.Context, item SOAP) error
+	// Update modifies an existing SOAP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item SOAP) error
+	// Patch updates only the given fields of the SOAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a SOAP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SOAPAPI = &SOAPResource{}
+
+// ScriptedAPI is the interface of ScriptedResource, which mocks implement in tests.
+type ScriptedAPI interface {
+	// List returns a list of all ScriptedList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ScriptedList, error)
+	// Get returns a specific Scripted resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*Scripted, error)
+	// Create adds a new Scripted resource provided by the item
+	Create(ctx context.Context, item Scripted) error
+	// Update modifies an existing Scripted resource identified by name using the provided item
+	Update(ctx context.Context, name string, item Scripted) error
+	// Patch updates only the given fields of the Scripted identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a Scripted resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ScriptedAPI = &ScriptedResource{}
+
+// TCPAPI is the interface of TCPResource, which mocks implement in tests.
+type TCPAPI interface {
+	// List returns a list of all TCPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPList, error)
+	// Get returns a specific TCP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*TCP, error)
+	// Create adds a new TCP resource provided by the item
+	Create(ctx context.Context, item TCP) error
+	// Update modifies an existing TCP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item TCP) error
+	// Patch updates only the given fields of the TCP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a TCP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TCPAPI = &TCPResource{}
+
+// TCPHalfAPI is the interface of TCPHalfResource, which mocks implement in tests.
+type TCPHalfAPI interface {
+	// List returns a list of all TCPHalfList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPHalfList, error)
+	// Get returns a specific TCPHalf resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*TCPHalf, error)
+	// Create adds a new TCPHalf resource provided by the item
+	Create(ctx context.Context, item TCPHalf) error
+	// Update modifies an existing TCPHalf resource identified by name using the provided item
+	Update(ctx context.Context, name string, item TCPHalf) error
+	// Patch updates only the given fields of the TCPHalf identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a TCPHalf resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TCPHalfAPI = &TCPHalfResource{}
+
+// UDPAPI is the interface of UDPResource, which mocks implement in tests.
+type UDPAPI interface {
+	// List returns a list of all UDPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*UDPList, error)
+	// Get returns a specific UDP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*UDP, error)
+	// Create adds a new UDP resource provided by the item
+	Create(ctx context.Context, item UDP) error
+	// Update modifies an existing UDP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item UDP) error
+	// Patch updates only the given fields of the UDP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a UDP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ UDPAPI = &UDPResource{}
+
+// WAPAPI is the interface of WAPResource, which mocks implement in tests.
+type WAPAPI interface {
+	// List returns a list of all WAPList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WAPList, error)
+	// Get returns a specific WAP resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*WAP, error)
+	// Create adds a new WAP resource provided by the item
+	Create(ctx context.Context, item WAP) error
+	// Update modifies an existing WAP resource identified by name using the provided item
+	Update(ctx context.Context, name string, item WAP) error
+	// Patch updates only the given fields of the WAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a WAP resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ WAPAPI = &WAPResource{}
+
+// WMIAPI is the interface of WMIResource, which mocks implement in tests.
+type WMIAPI interface {
+	// List returns a list of all WMIList resources
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WMIList, error)
+	// Get returns a specific WMI resource identified by its fullPathName
+	Get(ctx context.Context, fullPathName string) (*WMI, error)
+	// Create adds a new WMI resource provided by the item
+	Create(ctx context.Context, item WMI) error
+	// Update modifies an existing WMI resource identified by name using the provided item
+	Update(ctx context.Context, name string, item WMI) error
+	// Patch updates only the given fields of the WMI identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete removes a WMI resource identified by its name
+	Delete(ctx context.Context, name string) error
+}
+
+var _ WMIAPI = &WMIResource{}
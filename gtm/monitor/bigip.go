@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // BigIPList contains a list of BigIP uration.
@@ -38,77 +36,37 @@ type BigIPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all BigIP resources
-func (r *BigIPResource) List() (*BigIPList, error) {
-	var mdcl BigIPList // Defines a variable of type BigIPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing BigIPResource.
+func (r *BigIPResource) collection() *bigip.Collection[BigIP, BigIPList] {
+	return bigip.NewCollection[BigIP, BigIPList](r.b, GTMManager, MonitorEndpoint, BigIPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all BigIP resources
+func (r *BigIPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*BigIPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific BigIP resource identified by its fullPathName
-func (r *BigIPResource) Get(fullPathName string) (*BigIP, error) {
-	var mdc BigIP // Defines a variable of type BigIP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *BigIPResource) Get(ctx context.Context, fullPathName string) (*BigIP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new BigIP resource provided by the item
-func (r *BigIPResource) Create(item BigIP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BigIPResource) Create(ctx context.Context, item BigIP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing BigIP resource identified by name using the provided item
-func (r *BigIPResource) Update(name string, item BigIP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BigIPResource) Update(ctx context.Context, name string, item BigIP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the BigIP identified by name, see bigip.PatchBody.
+func (r *BigIPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a BigIP resource identified by its name
-func (r *BigIPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BigIPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // BigIPLinkList holds a list of BigIPLink uration.
@@ -37,77 +35,37 @@ type BigIPLinkResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all BigIPLinkList resources
-func (r *BigIPLinkResource) List() (*BigIPLinkList, error) {
-	var mdcl BigIPLinkList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing BigIPLinkResource.
+func (r *BigIPLinkResource) collection() *bigip.Collection[BigIPLink, BigIPLinkList] {
+	return bigip.NewCollection[BigIPLink, BigIPLinkList](r.b, GTMManager, MonitorEndpoint, BigIPLinkEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all BigIPLinkList resources
+func (r *BigIPLinkResource) List(ctx context.Context, opts ...*rest.ListOptions) (*BigIPLinkList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific BigIPLink resource identified by its fullPathName
-func (r *BigIPLinkResource) Get(fullPathName string) (*BigIPLink, error) {
-	var mdc BigIPLink
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *BigIPLinkResource) Get(ctx context.Context, fullPathName string) (*BigIPLink, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new BigIPLink resource provided by the item
-func (r *BigIPLinkResource) Create(item BigIPLink) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BigIPLinkResource) Create(ctx context.Context, item BigIPLink) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing BigIPLink resource identified by name using the provided item
-func (r *BigIPLinkResource) Update(name string, item BigIPLink) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BigIPLinkResource) Update(ctx context.Context, name string, item BigIPLink) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the BigIPLink identified by name, see bigip.PatchBody.
+func (r *BigIPLinkResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a BigIPLink resource identified by its name
-func (r *BigIPLinkResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(BigIPLinkEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BigIPLinkResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ExternalList holds a list of External uration.
@@ -38,77 +36,37 @@ type ExternalResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all ExternalList resources
-func (r *ExternalResource) List() (*ExternalList, error) {
-	var mdcl ExternalList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ExternalResource.
+func (r *ExternalResource) collection() *bigip.Collection[External, ExternalList] {
+	return bigip.NewCollection[External, ExternalList](r.b, GTMManager, MonitorEndpoint, ExternalEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all ExternalList resources
+func (r *ExternalResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ExternalList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific External resource identified by its fullPathName
-func (r *ExternalResource) Get(fullPathName string) (*External, error) {
-	var mdc External
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *ExternalResource) Get(ctx context.Context, fullPathName string) (*External, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new External resource provided by the item
-func (r *ExternalResource) Create(item External) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ExternalResource) Create(ctx context.Context, item External) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing External resource identified by name using the provided item
-func (r *ExternalResource) Update(name string, item External) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ExternalResource) Update(ctx context.Context, name string, item External) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the External identified by name, see bigip.PatchBody.
+func (r *ExternalResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a External resource identified by its name
-func (r *ExternalResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ExternalResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // FirepassList holds a list of Firepass uration.
@@ -42,77 +40,37 @@ type FirepassResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all FirepassList resources
-func (r *FirepassResource) List() (*FirepassList, error) {
-	var mdcl FirepassList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FirepassResource.
+func (r *FirepassResource) collection() *bigip.Collection[Firepass, FirepassList] {
+	return bigip.NewCollection[Firepass, FirepassList](r.b, GTMManager, MonitorEndpoint, FirepassEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all FirepassList resources
+func (r *FirepassResource) List(ctx context.Context, opts ...*rest.ListOptions) (*FirepassList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific Firepass resource identified by its fullPathName
-func (r *FirepassResource) Get(fullPathName string) (*Firepass, error) {
-	var mdc Firepass
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *FirepassResource) Get(ctx context.Context, fullPathName string) (*Firepass, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Firepass resource provided by the item
-func (r *FirepassResource) Create(item Firepass) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FirepassResource) Create(ctx context.Context, item Firepass) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing Firepass resource identified by name using the provided item
-func (r *FirepassResource) Update(name string, item Firepass) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FirepassResource) Update(ctx context.Context, name string, item Firepass) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Firepass identified by name, see bigip.PatchBody.
+func (r *FirepassResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a Firepass resource identified by its name
-func (r *FirepassResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FirepassResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // FTPList holds a list of FTP uration.
@@ -40,77 +38,37 @@ type FTPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all FTPList resources
-func (r *FTPResource) List() (*FTPList, error) {
-	var mdcl FTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FTPResource.
+func (r *FTPResource) collection() *bigip.Collection[FTP, FTPList] {
+	return bigip.NewCollection[FTP, FTPList](r.b, GTMManager, MonitorEndpoint, FTPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all FTPList resources
+func (r *FTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*FTPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific FTP resource identified by its fullPathName
-func (r *FTPResource) Get(fullPathName string) (*FTP, error) {
-	var mdc FTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *FTPResource) Get(ctx context.Context, fullPathName string) (*FTP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new FTP resource provided by the item
-func (r *FTPResource) Create(item FTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FTPResource) Create(ctx context.Context, item FTP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing FTP resource identified by name using the provided item
-func (r *FTPResource) Update(name string, item FTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FTPResource) Update(ctx context.Context, name string, item FTP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the FTP identified by name, see bigip.PatchBody.
+func (r *FTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a FTP resource identified by its name
-func (r *FTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FTPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
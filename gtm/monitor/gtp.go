@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // GTPList holds a list of GTP uration.
@@ -41,77 +39,37 @@ type GTPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all GTPList resources
-func (r *GTPResource) List() (*GTPList, error) {
-	var mdcl GTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(GTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing GTPResource.
+func (r *GTPResource) collection() *bigip.Collection[GTP, GTPList] {
+	return bigip.NewCollection[GTP, GTPList](r.b, GTMManager, MonitorEndpoint, GTPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all GTPList resources
+func (r *GTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*GTPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific GTP resource identified by its fullPathName
-func (r *GTPResource) Get(fullPathName string) (*GTP, error) {
-	var mdc GTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *GTPResource) Get(ctx context.Context, fullPathName string) (*GTP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new GTP resource provided by the item
-func (r *GTPResource) Create(item GTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(GTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *GTPResource) Create(ctx context.Context, item GTP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing GTP resource identified by name using the provided item
-func (r *GTPResource) Update(name string, item GTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(GTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *GTPResource) Update(ctx context.Context, name string, item GTP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the GTP identified by name, see bigip.PatchBody.
+func (r *GTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a GTP resource identified by its name
-func (r *GTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(GTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *GTPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
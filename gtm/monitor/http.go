@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // HTTPList holds a list of HTTP uration.
@@ -41,77 +39,37 @@ type HTTPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all HTTPList resources
-func (r *HTTPResource) List() (*HTTPList, error) {
-	var mdcl HTTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPResource.
+func (r *HTTPResource) collection() *bigip.Collection[HTTP, HTTPList] {
+	return bigip.NewCollection[HTTP, HTTPList](r.b, GTMManager, MonitorEndpoint, HTTPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all HTTPList resources
+func (r *HTTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific HTTP resource identified by its fullPathName
-func (r *HTTPResource) Get(fullPathName string) (*HTTP, error) {
-	var mdc HTTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *HTTPResource) Get(ctx context.Context, fullPathName string) (*HTTP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTP resource provided by the item
-func (r *HTTPResource) Create(item HTTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HTTPResource) Create(ctx context.Context, item HTTP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing HTTP resource identified by name using the provided item
-func (r *HTTPResource) Update(name string, item HTTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HTTPResource) Update(ctx context.Context, name string, item HTTP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the HTTP identified by name, see bigip.PatchBody.
+func (r *HTTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a HTTP resource identified by its name
-func (r *HTTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HTTPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // HTTPSList holds a list of HTTPS uration.
@@ -43,77 +41,37 @@ type HTTPSResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all HTTPSList resources
-func (r *HTTPSResource) List() (*HTTPSList, error) {
-	var mdcl HTTPSList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPSResource.
+func (r *HTTPSResource) collection() *bigip.Collection[HTTPS, HTTPSList] {
+	return bigip.NewCollection[HTTPS, HTTPSList](r.b, GTMManager, MonitorEndpoint, HTTPSEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all HTTPSList resources
+func (r *HTTPSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPSList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific HTTPS resource identified by its fullPathName
-func (r *HTTPSResource) Get(fullPathName string) (*HTTPS, error) {
-	var mdc HTTPS
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *HTTPSResource) Get(ctx context.Context, fullPathName string) (*HTTPS, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTPS resource provided by the item
-func (r *HTTPSResource) Create(item HTTPS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HTTPSResource) Create(ctx context.Context, item HTTPS) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing HTTPS resource identified by name using the provided item
-func (r *HTTPSResource) Update(name string, item HTTPS) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HTTPSResource) Update(ctx context.Context, name string, item HTTPS) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the HTTPS identified by name, see bigip.PatchBody.
+func (r *HTTPSResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a HTTPS resource identified by its name
-func (r *HTTPSResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HTTPSResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ICMPList holds a list of ICMP uration.
@@ -41,77 +39,37 @@ type ICMPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all ICMPList resources
-func (r *ICMPResource) List() (*ICMPList, error) {
-	var mdcl ICMPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ICMPResource.
+func (r *ICMPResource) collection() *bigip.Collection[ICMP, ICMPList] {
+	return bigip.NewCollection[ICMP, ICMPList](r.b, GTMManager, MonitorEndpoint, ICMPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all ICMPList resources
+func (r *ICMPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ICMPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific ICMP resource identified by its fullPathName
-func (r *ICMPResource) Get(fullPathName string) (*ICMP, error) {
-	var mdc ICMP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *ICMPResource) Get(ctx context.Context, fullPathName string) (*ICMP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new ICMP resource provided by the item
-func (r *ICMPResource) Create(item ICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ICMPResource) Create(ctx context.Context, item ICMP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing ICMP resource identified by name using the provided item
-func (r *ICMPResource) Update(name string, item ICMP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ICMPResource) Update(ctx context.Context, name string, item ICMP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the ICMP identified by name, see bigip.PatchBody.
+func (r *ICMPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a ICMP resource identified by its name
-func (r *ICMPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ICMPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // IMAPList holds a list of IMAP uration.
@@ -40,77 +38,37 @@ type IMAPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all IMAPList resources
-func (r *IMAPResource) List() (*IMAPList, error) {
-	var mdcl IMAPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing IMAPResource.
+func (r *IMAPResource) collection() *bigip.Collection[IMAP, IMAPList] {
+	return bigip.NewCollection[IMAP, IMAPList](r.b, GTMManager, MonitorEndpoint, IMAPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all IMAPList resources
+func (r *IMAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*IMAPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific IMAP resource identified by its fullPathName
-func (r *IMAPResource) Get(fullPathName string) (*IMAP, error) {
-	var mdc IMAP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *IMAPResource) Get(ctx context.Context, fullPathName string) (*IMAP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new IMAP resource provided by the item
-func (r *IMAPResource) Create(item IMAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *IMAPResource) Create(ctx context.Context, item IMAP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing IMAP resource identified by name using the provided item
-func (r *IMAPResource) Update(name string, item IMAP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *IMAPResource) Update(ctx context.Context, name string, item IMAP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the IMAP identified by name, see bigip.PatchBody.
+func (r *IMAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a IMAP resource identified by its name
-func (r *IMAPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *IMAPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
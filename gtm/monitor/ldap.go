@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // LDAPList holds a list of LDAP uration.
@@ -40,77 +38,37 @@ type LDAPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all LDAPList resources
-func (r *LDAPResource) List() (*LDAPList, error) {
-	var mdcl LDAPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing LDAPResource.
+func (r *LDAPResource) collection() *bigip.Collection[LDAP, LDAPList] {
+	return bigip.NewCollection[LDAP, LDAPList](r.b, GTMManager, MonitorEndpoint, LDAPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all LDAPList resources
+func (r *LDAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*LDAPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific LDAP resource identified by its fullPathName
-func (r *LDAPResource) Get(fullPathName string) (*LDAP, error) {
-	var mdc LDAP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *LDAPResource) Get(ctx context.Context, fullPathName string) (*LDAP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new LDAP resource provided by the item
-func (r *LDAPResource) Create(item LDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LDAPResource) Create(ctx context.Context, item LDAP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing LDAP resource identified by name using the provided item
-func (r *LDAPResource) Update(name string, item LDAP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LDAPResource) Update(ctx context.Context, name string, item LDAP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the LDAP identified by name, see bigip.PatchBody.
+func (r *LDAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a LDAP resource identified by its name
-func (r *LDAPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LDAPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
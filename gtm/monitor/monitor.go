@@ -1,5 +1,7 @@
 package monitor
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // MonitorEndpoint represents the REST resource for managing monitor.
@@ -81,163 +83,163 @@ func NewMonitor(b *bigip.BigIP) MonitorResource {
 }
 
 // BIGIP returns a reference to the BigIPResource instance
-func (m *MonitorResource) BIGIP() *BigIPResource {
+func (m *MonitorResource) BIGIP() BigIPAPI {
 	return &m.bigip
 }
 
 // Getter functions for other resource types
 
 // BigIPLink returns a reference to the BigIPLinkResource instance
-func (m *MonitorResource) BigIPLink() *BigIPLinkResource {
+func (m *MonitorResource) BigIPLink() BigIPLinkAPI {
 	return &m.bigIPLink
 }
 
 // External returns a reference to the ExternalResource instance
-func (m *MonitorResource) External() *ExternalResource {
+func (m *MonitorResource) External() ExternalAPI {
 	return &m.external
 }
 
 // FTP returns a reference to the FTPResource instance
-func (m *MonitorResource) FTP() *FTPResource {
+func (m *MonitorResource) FTP() FTPAPI {
 	return &m.ftp
 }
 
 // Firepass returns a reference to the FirepassResource instance
-func (m *MonitorResource) Firepass() *FirepassResource {
+func (m *MonitorResource) Firepass() FirepassAPI {
 	return &m.firepass
 }
 
 // GTP returns a reference to the GTPResource instance
-func (m *MonitorResource) GTP() *GTPResource {
+func (m *MonitorResource) GTP() GTPAPI {
 	return &m.gtp
 }
 
 // HTTP returns a reference to the HTTPResource instance
-func (m *MonitorResource) HTTP() *HTTPResource {
+func (m *MonitorResource) HTTP() HTTPAPI {
 	return &m.http
 }
 
 // HTTPS returns a reference to the HTTPSResource instance
-func (m *MonitorResource) HTTPS() *HTTPSResource {
+func (m *MonitorResource) HTTPS() HTTPSAPI {
 	return &m.https
 }
 
 // ICMP returns a reference to the ICMPResource instance
-func (m *MonitorResource) ICMP() *ICMPResource {
+func (m *MonitorResource) ICMP() ICMPAPI {
 	return &m.icmp
 }
 
 // IMAP returns a reference to the IMAPResource instance
-func (m *MonitorResource) IMAP() *IMAPResource {
+func (m *MonitorResource) IMAP() IMAPAPI {
 	return &m.imap
 }
 
 // LDAP returns a reference to the LDAPResource instance
-func (m *MonitorResource) LDAP() *LDAPResource {
+func (m *MonitorResource) LDAP() LDAPAPI {
 	return &m.ldap
 }
 
 // MSSQL returns a reference to the MSSQLResource instance
-func (m *MonitorResource) MSSQL() *MSSQLResource {
+func (m *MonitorResource) MSSQL() MSSQLAPI {
 	return &m.mssql
 }
 
 // MySQL returns a reference to the MySQLResource instance
-func (m *MonitorResource) MySQL() *MySQLResource {
+func (m *MonitorResource) MySQL() MySQLAPI {
 	return &m.mysql
 }
 
 // NNTP returns a reference to the NNTPResource instance
-func (m *MonitorResource) NNTP() *NNTPResource {
+func (m *MonitorResource) NNTP() NNTPAPI {
 	return &m.nntp
 }
 
 // None returns a reference to the NoneResource instance
-func (m *MonitorResource) None() *NoneResource {
+func (m *MonitorResource) None() NoneAPI {
 	return &m.none
 }
 
 // Oracle returns a reference to the OracleResource instance
-func (m *MonitorResource) Oracle() *OracleResource {
+func (m *MonitorResource) Oracle() OracleAPI {
 	return &m.oracle
 }
 
 // POP3 returns a reference to the POP3Resource instance
-func (m *MonitorResource) POP3() *POP3Resource {
+func (m *MonitorResource) POP3() POP3API {
 	return &m.pop3
 }
 
 // PostgreSQL returns a reference to the PostgreSQLResource instance
-func (m *MonitorResource) PostgreSQL() *PostgreSQLResource {
+func (m *MonitorResource) PostgreSQL() PostgreSQLAPI {
 	return &m.postgreSQL
 }
 
 // Radius returns a reference to the RadiusResource instance
-func (m *MonitorResource) Radius() *RadiusResource {
+func (m *MonitorResource) Radius() RadiusAPI {
 	return &m.radius
 }
 
 // RadiusAccounting returns a reference to the RadiusAccountingResource instance
-func (m *MonitorResource) RadiusAccounting() *RadiusAccountingResource {
+func (m *MonitorResource) RadiusAccounting() RadiusAccountingAPI {
 	return &m.radiusAccounting
 }
 
 // RealServer returns a reference to the RealServerResource instance
-func (m *MonitorResource) RealServer() *RealServerResource {
+func (m *MonitorResource) RealServer() RealServerAPI {
 	return &m.realServer
 }
 
 // SIP returns a reference to the SIPResource instance
-func (m *MonitorResource) SIP() *SIPResource {
+func (m *MonitorResource) SIP() SIPAPI {
 	return &m.sip
 }
 
 // SMTP returns a reference to the SMTPResource instance
-func (m *MonitorResource) SMTP() *SMTPResource {
+func (m *MonitorResource) SMTP() SMTPAPI {
 	return &m.smtp
 }
 
 // SNMP returns a reference to the SNMPResource instance
-func (m *MonitorResource) SNMP() *SNMPResource {
+func (m *MonitorResource) SNMP() SNMPAPI {
 	return &m.snmp
 }
 
 // SNMPLink returns a reference to the SNMPLinkResource instance
-func (m *MonitorResource) SNMPLink() *SNMPLinkResource {
+func (m *MonitorResource) SNMPLink() SNMPLinkAPI {
 	return &m.snmpLink
 }
 
 // SOAP returns a reference to the SOAPResource instance
-func (m *MonitorResource) SOAP() *SOAPResource {
+func (m *MonitorResource) SOAP() SOAPAPI {
 	return &m.soap
 }
 
 // TCP returns a reference to the TCPResource instance
-func (m *MonitorResource) TCP() *TCPResource {
+func (m *MonitorResource) TCP() TCPAPI {
 	return &m.tcp
 }
 
 // TCPHalf returns a reference to the TCPHalfResource instance
-func (m *MonitorResource) TCPHalf() *TCPHalfResource {
+func (m *MonitorResource) TCPHalf() TCPHalfAPI {
 	return &m.tcpHalf
 }
 
 // UDP returns a reference to the UDPResource instance
-func (m *MonitorResource) UDP() *UDPResource {
+func (m *MonitorResource) UDP() UDPAPI {
 	return &m.udp
 }
 
 // WAP returns a reference to the WAPResource instance
-func (m *MonitorResource) WAP() *WAPResource {
+func (m *MonitorResource) WAP() WAPAPI {
 	return &m.wap
 }
 
 // WMI returns a reference to the WMIResource instance
-func (m *MonitorResource) WMI() *WMIResource {
+func (m *MonitorResource) WMI() WMIAPI {
 	return &m.wmi
 }
 
 // Scripted returns a reference to the ScriptedResource instance
-func (m *MonitorResource) Scripted() *ScriptedResource {
+func (m *MonitorResource) Scripted() ScriptedAPI {
 	return &m.scripted
 }
@@ -0,0 +1,2548 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package monitormock provides mocks of the interfaces of package monitor, which record their calls.
+package monitormock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/gtm/monitor"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// BigIPAPI is a mock of monitor.BigIPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type BigIPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.BigIPList, error)
+	GetFunc    func(context.Context, string) (*monitor.BigIP, error)
+	CreateFunc func(context.Context, monitor.BigIP) error
+	UpdateFunc func(context.Context, string, monitor.BigIP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.BigIPAPI = &BigIPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *BigIPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.BigIPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *BigIPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.BigIP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *BigIPAPI) Create(ctx context.Context, item monitor.BigIP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *BigIPAPI) Update(ctx context.Context, name string, item monitor.BigIP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *BigIPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *BigIPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// BigIPLinkAPI is a mock of monitor.BigIPLinkAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type BigIPLinkAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.BigIPLinkList, error)
+	GetFunc    func(context.Context, string) (*monitor.BigIPLink, error)
+	CreateFunc func(context.Context, monitor.BigIPLink) error
+	UpdateFunc func(context.Context, string, monitor.BigIPLink) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.BigIPLinkAPI = &BigIPLinkAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *BigIPLinkAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.BigIPLinkList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *BigIPLinkAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.BigIPLink, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *BigIPLinkAPI) Create(ctx context.Context, item monitor.BigIPLink) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *BigIPLinkAPI) Update(ctx context.Context, name string, item monitor.BigIPLink) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *BigIPLinkAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *BigIPLinkAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ExternalAPI is a mock of monitor.ExternalAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ExternalAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ExternalList, error)
+	GetFunc    func(context.Context, string) (*monitor.External, error)
+	CreateFunc func(context.Context, monitor.External) error
+	UpdateFunc func(context.Context, string, monitor.External) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ExternalAPI = &ExternalAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ExternalAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ExternalList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ExternalAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.External, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ExternalAPI) Create(ctx context.Context, item monitor.External) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ExternalAPI) Update(ctx context.Context, name string, item monitor.External) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ExternalAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ExternalAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// FTPAPI is a mock of monitor.FTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.FTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.FTP, error)
+	CreateFunc func(context.Context, monitor.FTP) error
+	UpdateFunc func(context.Context, string, monitor.FTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.FTPAPI = &FTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.FTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.FTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FTPAPI) Create(ctx context.Context, item monitor.FTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FTPAPI) Update(ctx context.Context, name string, item monitor.FTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// FirepassAPI is a mock of monitor.FirepassAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FirepassAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.FirepassList, error)
+	GetFunc    func(context.Context, string) (*monitor.Firepass, error)
+	CreateFunc func(context.Context, monitor.Firepass) error
+	UpdateFunc func(context.Context, string, monitor.Firepass) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.FirepassAPI = &FirepassAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FirepassAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.FirepassList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FirepassAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Firepass, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FirepassAPI) Create(ctx context.Context, item monitor.Firepass) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FirepassAPI) Update(ctx context.Context, name string, item monitor.Firepass) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FirepassAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FirepassAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// GTPAPI is a mock of monitor.GTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.GTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.GTP, error)
+	CreateFunc func(context.Context, monitor.GTP) error
+	UpdateFunc func(context.Context, string, monitor.GTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.GTPAPI = &GTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *GTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.GTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *GTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.GTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *GTPAPI) Create(ctx context.Context, item monitor.GTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *GTPAPI) Update(ctx context.Context, name string, item monitor.GTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *GTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *GTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// HTTPAPI is a mock of monitor.HTTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.HTTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.HTTP, error)
+	CreateFunc func(context.Context, monitor.HTTP) error
+	UpdateFunc func(context.Context, string, monitor.HTTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.HTTPAPI = &HTTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.HTTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.HTTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPAPI) Create(ctx context.Context, item monitor.HTTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPAPI) Update(ctx context.Context, name string, item monitor.HTTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// HTTPSAPI is a mock of monitor.HTTPSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.HTTPSList, error)
+	GetFunc    func(context.Context, string) (*monitor.HTTPS, error)
+	CreateFunc func(context.Context, monitor.HTTPS) error
+	UpdateFunc func(context.Context, string, monitor.HTTPS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.HTTPSAPI = &HTTPSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.HTTPSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPSAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.HTTPS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPSAPI) Create(ctx context.Context, item monitor.HTTPS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPSAPI) Update(ctx context.Context, name string, item monitor.HTTPS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPSAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPSAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ICMPAPI is a mock of monitor.ICMPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ICMPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ICMPList, error)
+	GetFunc    func(context.Context, string) (*monitor.ICMP, error)
+	CreateFunc func(context.Context, monitor.ICMP) error
+	UpdateFunc func(context.Context, string, monitor.ICMP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ICMPAPI = &ICMPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ICMPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ICMPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ICMPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.ICMP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ICMPAPI) Create(ctx context.Context, item monitor.ICMP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ICMPAPI) Update(ctx context.Context, name string, item monitor.ICMP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ICMPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ICMPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// IMAPAPI is a mock of monitor.IMAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IMAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.IMAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.IMAP, error)
+	CreateFunc func(context.Context, monitor.IMAP) error
+	UpdateFunc func(context.Context, string, monitor.IMAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.IMAPAPI = &IMAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *IMAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.IMAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *IMAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.IMAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *IMAPAPI) Create(ctx context.Context, item monitor.IMAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *IMAPAPI) Update(ctx context.Context, name string, item monitor.IMAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *IMAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *IMAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// LDAPAPI is a mock of monitor.LDAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LDAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.LDAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.LDAP, error)
+	CreateFunc func(context.Context, monitor.LDAP) error
+	UpdateFunc func(context.Context, string, monitor.LDAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.LDAPAPI = &LDAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *LDAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.LDAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *LDAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.LDAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *LDAPAPI) Create(ctx context.Context, item monitor.LDAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *LDAPAPI) Update(ctx context.Context, name string, item monitor.LDAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *LDAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *LDAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// MSSQLAPI is a mock of monitor.MSSQLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MSSQLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.MSSQLList, error)
+	GetFunc    func(context.Context, string) (*monitor.MSSQL, error)
+	CreateFunc func(context.Context, monitor.MSSQL) error
+	UpdateFunc func(context.Context, string, monitor.MSSQL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.MSSQLAPI = &MSSQLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MSSQLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.MSSQLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *MSSQLAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.MSSQL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *MSSQLAPI) Create(ctx context.Context, item monitor.MSSQL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MSSQLAPI) Update(ctx context.Context, name string, item monitor.MSSQL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *MSSQLAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *MSSQLAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// MonitorAPI is a mock of monitor.MonitorAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MonitorAPI struct {
+	bigiptest.CallRecorder
+
+	BIGIPFunc            func() monitor.BigIPAPI
+	BigIPLinkFunc        func() monitor.BigIPLinkAPI
+	ExternalFunc         func() monitor.ExternalAPI
+	FTPFunc              func() monitor.FTPAPI
+	FirepassFunc         func() monitor.FirepassAPI
+	GTPFunc              func() monitor.GTPAPI
+	HTTPFunc             func() monitor.HTTPAPI
+	HTTPSFunc            func() monitor.HTTPSAPI
+	ICMPFunc             func() monitor.ICMPAPI
+	IMAPFunc             func() monitor.IMAPAPI
+	LDAPFunc             func() monitor.LDAPAPI
+	MSSQLFunc            func() monitor.MSSQLAPI
+	MySQLFunc            func() monitor.MySQLAPI
+	NNTPFunc             func() monitor.NNTPAPI
+	NoneFunc             func() monitor.NoneAPI
+	OracleFunc           func() monitor.OracleAPI
+	POP3Func             func() monitor.POP3API
+	PostgreSQLFunc       func() monitor.PostgreSQLAPI
+	RadiusFunc           func() monitor.RadiusAPI
+	RadiusAccountingFunc func() monitor.RadiusAccountingAPI
+	RealServerFunc       func() monitor.RealServerAPI
+	SIPFunc              func() monitor.SIPAPI
+	SMTPFunc             func() monitor.SMTPAPI
+	SNMPFunc             func() monitor.SNMPAPI
+	SNMPLinkFunc         func() monitor.SNMPLinkAPI
+	SOAPFunc             func() monitor.SOAPAPI
+	TCPFunc              func() monitor.TCPAPI
+	TCPHalfFunc          func() monitor.TCPHalfAPI
+	UDPFunc              func() monitor.UDPAPI
+	WAPFunc              func() monitor.WAPAPI
+	WMIFunc              func() monitor.WMIAPI
+	ScriptedFunc         func() monitor.ScriptedAPI
+}
+
+var _ monitor.MonitorAPI = &MonitorAPI{}
+
+// BIGIP records the call and calls BIGIPFunc if it is set.
+func (m *MonitorAPI) BIGIP() (r0 monitor.BigIPAPI) {
+	m.CallRecorder.Record("BIGIP")
+	if m.BIGIPFunc != nil {
+		return m.BIGIPFunc()
+	}
+	return
+}
+
+// BigIPLink records the call and calls BigIPLinkFunc if it is set.
+func (m *MonitorAPI) BigIPLink() (r0 monitor.BigIPLinkAPI) {
+	m.CallRecorder.Record("BigIPLink")
+	if m.BigIPLinkFunc != nil {
+		return m.BigIPLinkFunc()
+	}
+	return
+}
+
+// External records the call and calls ExternalFunc if it is set.
+func (m *MonitorAPI) External() (r0 monitor.ExternalAPI) {
+	m.CallRecorder.Record("External")
+	if m.ExternalFunc != nil {
+		return m.ExternalFunc()
+	}
+	return
+}
+
+// FTP records the call and calls FTPFunc if it is set.
+func (m *MonitorAPI) FTP() (r0 monitor.FTPAPI) {
+	m.CallRecorder.Record("FTP")
+	if m.FTPFunc != nil {
+		return m.FTPFunc()
+	}
+	return
+}
+
+// Firepass records the call and calls FirepassFunc if it is set.
+func (m *MonitorAPI) Firepass() (r0 monitor.FirepassAPI) {
+	m.CallRecorder.Record("Firepass")
+	if m.FirepassFunc != nil {
+		return m.FirepassFunc()
+	}
+	return
+}
+
+// GTP records the call and calls GTPFunc if it is set.
+func (m *MonitorAPI) GTP() (r0 monitor.GTPAPI) {
+	m.CallRecorder.Record("GTP")
+	if m.GTPFunc != nil {
+		return m.GTPFunc()
+	}
+	return
+}
+
+// HTTP records the call and calls HTTPFunc if it is set.
+func (m *MonitorAPI) HTTP() (r0 monitor.HTTPAPI) {
+	m.CallRecorder.Record("HTTP")
+	if m.HTTPFunc != nil {
+		return m.HTTPFunc()
+	}
+	return
+}
+
+// HTTPS records the call and calls HTTPSFunc if it is set.
+func (m *MonitorAPI) HTTPS() (r0 monitor.HTTPSAPI) {
+	m.CallRecorder.Record("HTTPS")
+	if m.HTTPSFunc != nil {
+		return m.HTTPSFunc()
+	}
+	return
+}
+
+// ICMP records the call and calls ICMPFunc if it is set.
+func (m *MonitorAPI) ICMP() (r0 monitor.ICMPAPI) {
+	m.CallRecorder.Record("ICMP")
+	if m.ICMPFunc != nil {
+		return m.ICMPFunc()
+	}
+	return
+}
+
+// IMAP records the call and calls IMAPFunc if it is set.
+func (m *MonitorAPI) IMAP() (r0 monitor.IMAPAPI) {
+	m.CallRecorder.Record("IMAP")
+	if m.IMAPFunc != nil {
+		return m.IMAPFunc()
+	}
+	return
+}
+
+// LDAP records the call and calls LDAPFunc if it is set.
+func (m *MonitorAPI) LDAP() (r0 monitor.LDAPAPI) {
+	m.CallRecorder.Record("LDAP")
+	if m.LDAPFunc != nil {
+		return m.LDAPFunc()
+	}
+	return
+}
+
+// MSSQL records the call and calls MSSQLFunc if it is set.
+func (m *MonitorAPI) MSSQL() (r0 monitor.MSSQLAPI) {
+	m.CallRecorder.Record("MSSQL")
+	if m.MSSQLFunc != nil {
+		return m.MSSQLFunc()
+	}
+	return
+}
+
+// MySQL records the call and calls MySQLFunc if it is set.
+func (m *MonitorAPI) MySQL() (r0 monitor.MySQLAPI) {
+	m.CallRecorder.Record("MySQL")
+	if m.MySQLFunc != nil {
+		return m.MySQLFunc()
+	}
+	return
+}
+
+// NNTP records the call and calls NNTPFunc if it is set.
+func (m *MonitorAPI) NNTP() (r0 monitor.NNTPAPI) {
+	m.CallRecorder.Record("NNTP")
+	if m.NNTPFunc != nil {
+		return m.NNTPFunc()
+	}
+	return
+}
+
+// None records the call and calls NoneFunc if it is set.
+func (m *MonitorAPI) None() (r0 monitor.NoneAPI) {
+	m.CallRecorder.Record("None")
+	if m.NoneFunc != nil {
+		return m.NoneFunc()
+	}
+	return
+}
+
+// Oracle records the call and calls OracleFunc if it is set.
+func (m *MonitorAPI) Oracle() (r0 monitor.OracleAPI) {
+	m.CallRecorder.Record("Oracle")
+	if m.OracleFunc != nil {
+		return m.OracleFunc()
+	}
+	return
+}
+
+// POP3 records the call and calls POP3Func if it is set.
+func (m *MonitorAPI) POP3() (r0 monitor.POP3API) {
+	m.CallRecorder.Record("POP3")
+	if m.POP3Func != nil {
+		return m.POP3Func()
+	}
+	return
+}
+
+// PostgreSQL records the call and calls PostgreSQLFunc if it is set.
+func (m *MonitorAPI) PostgreSQL() (r0 monitor.PostgreSQLAPI) {
+	m.CallRecorder.Record("PostgreSQL")
+	if m.PostgreSQLFunc != nil {
+		return m.PostgreSQLFunc()
+	}
+	return
+}
+
+// Radius records the call and calls RadiusFunc if it is set.
+func (m *MonitorAPI) Radius() (r0 monitor.RadiusAPI) {
+	m.CallRecorder.Record("Radius")
+	if m.RadiusFunc != nil {
+		return m.RadiusFunc()
+	}
+	return
+}
+
+// RadiusAccounting records the call and calls RadiusAccountingFunc if it is set.
+func (m *MonitorAPI) RadiusAccounting() (r0 monitor.RadiusAccountingAPI) {
+	m.CallRecorder.Record("RadiusAccounting")
+	if m.RadiusAccountingFunc != nil {
+		return m.RadiusAccountingFunc()
+	}
+	return
+}
+
+// RealServer records the call and calls RealServerFunc if it is set.
+func (m *MonitorAPI) RealServer() (r0 monitor.RealServerAPI) {
+	m.CallRecorder.Record("RealServer")
+	if m.RealServerFunc != nil {
+		return m.RealServerFunc()
+	}
+	return
+}
+
+// SIP records the call and calls SIPFunc if it is set.
+func (m *MonitorAPI) SIP() (r0 monitor.SIPAPI) {
+	m.CallRecorder.Record("SIP")
+	if m.SIPFunc != nil {
+		return m.SIPFunc()
+	}
+	return
+}
+
+// SMTP records the call and calls SMTPFunc if it is set.
+func (m *MonitorAPI) SMTP() (r0 monitor.SMTPAPI) {
+	m.CallRecorder.Record("SMTP")
+	if m.SMTPFunc != nil {
+		return m.SMTPFunc()
+	}
+	return
+}
+
+// SNMP records the call and calls SNMPFunc if it is set.
+func (m *MonitorAPI) SNMP() (r0 monitor.SNMPAPI) {
+	m.CallRecorder.Record("SNMP")
+	if m.SNMPFunc != nil {
+		return m.SNMPFunc()
+	}
+	return
+}
+
+// SNMPLink records the call and calls SNMPLinkFunc if it is set.
+func (m *MonitorAPI) SNMPLink() (r0 monitor.SNMPLinkAPI) {
+	m.CallRecorder.Record("SNMPLink")
+	if m.SNMPLinkFunc != nil {
+		return m.SNMPLinkFunc()
+	}
+	return
+}
+
+// SOAP records the call and calls SOAPFunc if it is set.
+func (m *MonitorAPI) SOAP() (r0 monitor.SOAPAPI) {
+	m.CallRecorder.Record("SOAP")
+	if m.SOAPFunc != nil {
+		return m.SOAPFunc()
+	}
+	return
+}
+
+// TCP records the call and calls TCPFunc if it is set.
+func (m *MonitorAPI) TCP() (r0 monitor.TCPAPI) {
+	m.CallRecorder.Record("TCP")
+	if m.TCPFunc != nil {
+		return m.TCPFunc()
+	}
+	return
+}
+
+// TCPHalf records the call and calls TCPHalfFunc if it is set.
+func (m *MonitorAPI) TCPHalf() (r0 monitor.TCPHalfAPI) {
+	m.CallRecorder.Record("TCPHalf")
+	if m.TCPHalfFunc != nil {
+		return m.TCPHalfFunc()
+	}
+	return
+}
+
+// UDP records the call and calls UDPFunc if it is set.
+func (m *MonitorAPI) UDP() (r0 monitor.UDPAPI) {
+	m.CallRecorder.Record("UDP")
+	if m.UDPFunc != nil {
+		return m.UDPFunc()
+	}
+	return
+}
+
+// WAP records the call and calls WAPFunc if it is set.
+func (m *MonitorAPI) WAP() (r0 monitor.WAPAPI) {
+	m.CallRecorder.Record("WAP")
+	if m.WAPFunc != nil {
+		return m.WAPFunc()
+	}
+	return
+}
+
+// WMI records the call and calls WMIFunc if it is set.
+func (m *MonitorAPI) WMI() (r0 monitor.WMIAPI) {
+	m.CallRecorder.Record("WMI")
+	if m.WMIFunc != nil {
+		return m.WMIFunc()
+	}
+	return
+}
+
+// Scripted records the call and calls ScriptedFunc if it is set.
+func (m *MonitorAPI) Scripted() (r0 monitor.ScriptedAPI) {
+	m.CallRecorder.Record("Scripted")
+	if m.ScriptedFunc != nil {
+		return m.ScriptedFunc()
+	}
+	return
+}
+
+// MySQLAPI is a mock of monitor.MySQLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MySQLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.MySQLList, error)
+	GetFunc    func(context.Context, string) (*monitor.MySQL, error)
+	CreateFunc func(context.Context, monitor.MySQL) error
+	UpdateFunc func(context.Context, string, monitor.MySQL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.MySQLAPI = &MySQLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MySQLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.MySQLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *MySQLAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.MySQL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *MySQLAPI) Create(ctx context.Context, item monitor.MySQL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MySQLAPI) Update(ctx context.Context, name string, item monitor.MySQL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *MySQLAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *MySQLAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// NNTPAPI is a mock of monitor.NNTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NNTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.NNTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.NNTP, error)
+	CreateFunc func(context.Context, monitor.NNTP) error
+	UpdateFunc func(context.Context, string, monitor.NNTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.NNTPAPI = &NNTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NNTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.NNTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NNTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.NNTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NNTPAPI) Create(ctx context.Context, item monitor.NNTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NNTPAPI) Update(ctx context.Context, name string, item monitor.NNTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NNTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NNTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// NoneAPI is a mock of monitor.NoneAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NoneAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.NoneList, error)
+	GetFunc    func(context.Context, string) (*monitor.None, error)
+	CreateFunc func(context.Context, monitor.None) error
+	UpdateFunc func(context.Context, string, monitor.None) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.NoneAPI = &NoneAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NoneAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.NoneList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NoneAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.None, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NoneAPI) Create(ctx context.Context, item monitor.None) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NoneAPI) Update(ctx context.Context, name string, item monitor.None) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NoneAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NoneAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// OracleAPI is a mock of monitor.OracleAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type OracleAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.OracleList, error)
+	GetFunc    func(context.Context, string) (*monitor.Oracle, error)
+	CreateFunc func(context.Context, monitor.Oracle) error
+	UpdateFunc func(context.Context, string, monitor.Oracle) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.OracleAPI = &OracleAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *OracleAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.OracleList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *OracleAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Oracle, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *OracleAPI) Create(ctx context.Context, item monitor.Oracle) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *OracleAPI) Update(ctx context.Context, name string, item monitor.Oracle) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *OracleAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *OracleAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// POP3API is a mock of monitor.POP3API. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type POP3API struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.POP3List, error)
+	GetFunc    func(context.Context, string) (*monitor.POP3, error)
+	CreateFunc func(context.Context, monitor.POP3) error
+	UpdateFunc func(context.Context, string, monitor.POP3) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.POP3API = &POP3API{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *POP3API) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.POP3List, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *POP3API) Get(ctx context.Context, fullPathName string) (r0 *monitor.POP3, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *POP3API) Create(ctx context.Context, item monitor.POP3) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *POP3API) Update(ctx context.Context, name string, item monitor.POP3) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *POP3API) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *POP3API) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// PostgreSQLAPI is a mock of monitor.PostgreSQLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PostgreSQLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.PostgreSQLList, error)
+	GetFunc    func(context.Context, string) (*monitor.PostgreSQL, error)
+	CreateFunc func(context.Context, monitor.PostgreSQL) error
+	UpdateFunc func(context.Context, string, monitor.PostgreSQL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.PostgreSQLAPI = &PostgreSQLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PostgreSQLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.PostgreSQLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *PostgreSQLAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.PostgreSQL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *PostgreSQLAPI) Create(ctx context.Context, item monitor.PostgreSQL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *PostgreSQLAPI) Update(ctx context.Context, name string, item monitor.PostgreSQL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *PostgreSQLAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *PostgreSQLAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RadiusAPI is a mock of monitor.RadiusAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RadiusAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RadiusList, error)
+	GetFunc    func(context.Context, string) (*monitor.Radius, error)
+	CreateFunc func(context.Context, monitor.Radius) error
+	UpdateFunc func(context.Context, string, monitor.Radius) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RadiusAPI = &RadiusAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RadiusAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RadiusList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RadiusAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Radius, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RadiusAPI) Create(ctx context.Context, item monitor.Radius) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RadiusAPI) Update(ctx context.Context, name string, item monitor.Radius) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RadiusAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RadiusAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RadiusAccountingAPI is a mock of monitor.RadiusAccountingAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RadiusAccountingAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RadiusAccountingList, error)
+	GetFunc    func(context.Context, string) (*monitor.RadiusAccounting, error)
+	CreateFunc func(context.Context, monitor.RadiusAccounting) error
+	UpdateFunc func(context.Context, string, monitor.RadiusAccounting) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RadiusAccountingAPI = &RadiusAccountingAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RadiusAccountingAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RadiusAccountingList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RadiusAccountingAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.RadiusAccounting, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RadiusAccountingAPI) Create(ctx context.Context, item monitor.RadiusAccounting) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RadiusAccountingAPI) Update(ctx context.Context, name string, item monitor.RadiusAccounting) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RadiusAccountingAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RadiusAccountingAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RealServerAPI is a mock of monitor.RealServerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RealServerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RealServerList, error)
+	GetFunc    func(context.Context, string) (*monitor.RealServer, error)
+	CreateFunc func(context.Context, monitor.RealServer) error
+	UpdateFunc func(context.Context, string, monitor.RealServer) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RealServerAPI = &RealServerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RealServerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RealServerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RealServerAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.RealServer, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RealServerAPI) Create(ctx context.Context, item monitor.RealServer) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RealServerAPI) Update(ctx context.Context, name string, item monitor.RealServer) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RealServerAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RealServerAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SIPAPI is a mock of monitor.SIPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SIPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SIPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SIP, error)
+	CreateFunc func(context.Context, monitor.SIP) error
+	UpdateFunc func(context.Context, string, monitor.SIP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SIPAPI = &SIPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SIPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SIPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SIPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SIP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SIPAPI) Create(ctx context.Context, item monitor.SIP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SIPAPI) Update(ctx context.Context, name string, item monitor.SIP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SIPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SIPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SMTPAPI is a mock of monitor.SMTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SMTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SMTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SMTP, error)
+	CreateFunc func(context.Context, monitor.SMTP) error
+	UpdateFunc func(context.Context, string, monitor.SMTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SMTPAPI = &SMTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SMTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SMTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SMTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SMTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SMTPAPI) Create(ctx context.Context, item monitor.SMTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SMTPAPI) Update(ctx context.Context, name string, item monitor.SMTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SMTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SMTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SNMPAPI is a mock of monitor.SNMPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SNMPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SNMPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SNMP, error)
+	CreateFunc func(context.Context, monitor.SNMP) error
+	UpdateFunc func(context.Context, string, monitor.SNMP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SNMPAPI = &SNMPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SNMPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SNMPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SNMPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SNMP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SNMPAPI) Create(ctx context.Context, item monitor.SNMP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SNMPAPI) Update(ctx context.Context, name string, item monitor.SNMP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SNMPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SNMPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SNMPLinkAPI is a mock of monitor.SNMPLinkAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SNMPLinkAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SNMPLinkList, error)
+	GetFunc    func(context.Context, string) (*monitor.SNMPLink, error)
+	CreateFunc func(context.Context, monitor.SNMPLink) error
+	UpdateFunc func(context.Context, string, monitor.SNMPLink) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SNMPLinkAPI = &SNMPLinkAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SNMPLinkAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SNMPLinkList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SNMPLinkAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SNMPLink, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SNMPLinkAPI) Create(ctx context.Context, item monitor.SNMPLink) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SNMPLinkAPI) Update(ctx context.Context, name string, item monitor.SNMPLink) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SNMPLinkAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SNMPLinkAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SOAPAPI is a mock of monitor.SOAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SOAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SOAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SOAP, error)
+	CreateFunc func(context.Context, monitor.SOAP) error
+	UpdateFunc func(context.Context, string, monitor.SOAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SOAPAPI = &SOAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SOAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SOAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SOAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SOAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SOAPAPI) Create(ctx context.Context, item monitor.SOAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SOAPAPI) Update(ctx context.Context, name string, item monitor.SOAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SOAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SOAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ScriptedAPI is a mock of monitor.ScriptedAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ScriptedAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ScriptedList, error)
+	GetFunc    func(context.Context, string) (*monitor.Scripted, error)
+	CreateFunc func(context.Context, monitor.Scripted) error
+	UpdateFunc func(context.Context, string, monitor.Scripted) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ScriptedAPI = &ScriptedAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ScriptedAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ScriptedList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ScriptedAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Scripted, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ScriptedAPI) Create(ctx context.Context, item monitor.Scripted) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ScriptedAPI) Update(ctx context.Context, name string, item monitor.Scripted) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ScriptedAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ScriptedAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TCPAPI is a mock of monitor.TCPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.TCPList, error)
+	GetFunc    func(context.Context, string) (*monitor.TCP, error)
+	CreateFunc func(context.Context, monitor.TCP) error
+	UpdateFunc func(context.Context, string, monitor.TCP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.TCPAPI = &TCPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.TCPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.TCP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPAPI) Create(ctx context.Context, item monitor.TCP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPAPI) Update(ctx context.Context, name string, item monitor.TCP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TCPHalfAPI is a mock of monitor.TCPHalfAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPHalfAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.TCPHalfList, error)
+	GetFunc    func(context.Context, string) (*monitor.TCPHalf, error)
+	CreateFunc func(context.Context, monitor.TCPHalf) error
+	UpdateFunc func(context.Context, string, monitor.TCPHalf) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.TCPHalfAPI = &TCPHalfAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPHalfAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.TCPHalfList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPHalfAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.TCPHalf, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPHalfAPI) Create(ctx context.Context, item monitor.TCPHalf) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPHalfAPI) Update(ctx context.Context, name string, item monitor.TCPHalf) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPHalfAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPHalfAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// UDPAPI is a mock of monitor.UDPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type UDPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.UDPList, error)
+	GetFunc    func(context.Context, string) (*monitor.UDP, error)
+	CreateFunc func(context.Context, monitor.UDP) error
+	UpdateFunc func(context.Context, string, monitor.UDP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.UDPAPI = &UDPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *UDPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.UDPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *UDPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.UDP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *UDPAPI) Create(ctx context.Context, item monitor.UDP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *UDPAPI) Update(ctx context.Context, name string, item monitor.UDP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *UDPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *UDPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// WAPAPI is a mock of monitor.WAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.WAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.WAP, error)
+	CreateFunc func(context.Context, monitor.WAP) error
+	UpdateFunc func(context.Context, string, monitor.WAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.WAPAPI = &WAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *WAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.WAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *WAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.WAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *WAPAPI) Create(ctx context.Context, item monitor.WAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *WAPAPI) Update(ctx context.Context, name string, item monitor.WAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *WAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *WAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// WMIAPI is a mock of monitor.WMIAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WMIAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.WMIList, error)
+	GetFunc    func(context.Context, string) (*monitor.WMI, error)
+	CreateFunc func(context.Context, monitor.WMI) error
+	UpdateFunc func(context.Context, string, monitor.WMI) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.WMIAPI = &WMIAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *WMIAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.WMIList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *WMIAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.WMI, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *WMIAPI) Create(ctx context.Context, item monitor.WMI) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *WMIAPI) Update(ctx context.Context, name string, item monitor.WMI) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *WMIAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *WMIAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
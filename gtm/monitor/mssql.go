@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // MSSQLList holds a list of MSSQL uration.
@@ -40,77 +38,37 @@ type MSSQLResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all MSSQLList resources
-func (r *MSSQLResource) List() (*MSSQLList, error) {
-	var mdcl MSSQLList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing MSSQLResource.
+func (r *MSSQLResource) collection() *bigip.Collection[MSSQL, MSSQLList] {
+	return bigip.NewCollection[MSSQL, MSSQLList](r.b, GTMManager, MonitorEndpoint, MSSQLEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all MSSQLList resources
+func (r *MSSQLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*MSSQLList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific MSSQL resource identified by its fullPathName
-func (r *MSSQLResource) Get(fullPathName string) (*MSSQL, error) {
-	var mdc MSSQL
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *MSSQLResource) Get(ctx context.Context, fullPathName string) (*MSSQL, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new MSSQL resource provided by the item
-func (r *MSSQLResource) Create(item MSSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MSSQLResource) Create(ctx context.Context, item MSSQL) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing MSSQL resource identified by name using the provided item
-func (r *MSSQLResource) Update(name string, item MSSQL) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MSSQLResource) Update(ctx context.Context, name string, item MSSQL) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the MSSQL identified by name, see bigip.PatchBody.
+func (r *MSSQLResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a MSSQL resource identified by its name
-func (r *MSSQLResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MSSQLResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
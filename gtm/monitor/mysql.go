@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // MySQLList holds a list of MySQL uration.
@@ -40,77 +38,37 @@ type MySQLResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all MySQLList resources
-func (r *MySQLResource) List() (*MySQLList, error) {
-	var mdcl MySQLList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing MySQLResource.
+func (r *MySQLResource) collection() *bigip.Collection[MySQL, MySQLList] {
+	return bigip.NewCollection[MySQL, MySQLList](r.b, GTMManager, MonitorEndpoint, MySQLEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all MySQLList resources
+func (r *MySQLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*MySQLList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific MySQL resource identified by its fullPathName
-func (r *MySQLResource) Get(fullPathName string) (*MySQL, error) {
-	var mdc MySQL
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *MySQLResource) Get(ctx context.Context, fullPathName string) (*MySQL, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new MySQL resource provided by the item
-func (r *MySQLResource) Create(item MySQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MySQLResource) Create(ctx context.Context, item MySQL) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing MySQL resource identified by name using the provided item
-func (r *MySQLResource) Update(name string, item MySQL) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MySQLResource) Update(ctx context.Context, name string, item MySQL) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the MySQL identified by name, see bigip.PatchBody.
+func (r *MySQLResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a MySQL resource identified by its name
-func (r *MySQLResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MySQLResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // NNTPList holds a list of NNTP uration.
@@ -39,77 +37,37 @@ type NNTPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all NNTPList resources
-func (r *NNTPResource) List() (*NNTPList, error) {
-	var mdcl NNTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing NNTPResource.
+func (r *NNTPResource) collection() *bigip.Collection[NNTP, NNTPList] {
+	return bigip.NewCollection[NNTP, NNTPList](r.b, GTMManager, MonitorEndpoint, NNTPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all NNTPList resources
+func (r *NNTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*NNTPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific NNTP resource identified by its fullPathName
-func (r *NNTPResource) Get(fullPathName string) (*NNTP, error) {
-	var mdc NNTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *NNTPResource) Get(ctx context.Context, fullPathName string) (*NNTP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new NNTP resource provided by the item
-func (r *NNTPResource) Create(item NNTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NNTPResource) Create(ctx context.Context, item NNTP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing NNTP resource identified by name using the provided item
-func (r *NNTPResource) Update(name string, item NNTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NNTPResource) Update(ctx context.Context, name string, item NNTP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the NNTP identified by name, see bigip.PatchBody.
+func (r *NNTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a NNTP resource identified by its name
-func (r *NNTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NNTPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
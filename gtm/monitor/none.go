@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // NoneList holds a list of None uration.
@@ -40,77 +38,37 @@ type NoneResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all NoneList resources
-func (r *NoneResource) List() (*NoneList, error) {
-	var mdcl NoneList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NoneEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing NoneResource.
+func (r *NoneResource) collection() *bigip.Collection[None, NoneList] {
+	return bigip.NewCollection[None, NoneList](r.b, GTMManager, MonitorEndpoint, NoneEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all NoneList resources
+func (r *NoneResource) List(ctx context.Context, opts ...*rest.ListOptions) (*NoneList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific None resource identified by its fullPathName
-func (r *NoneResource) Get(fullPathName string) (*None, error) {
-	var mdc None
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NoneEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *NoneResource) Get(ctx context.Context, fullPathName string) (*None, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new None resource provided by the item
-func (r *NoneResource) Create(item None) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NoneEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NoneResource) Create(ctx context.Context, item None) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing None resource identified by name using the provided item
-func (r *NoneResource) Update(name string, item None) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NoneEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NoneResource) Update(ctx context.Context, name string, item None) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the None identified by name, see bigip.PatchBody.
+func (r *NoneResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a None resource identified by its name
-func (r *NoneResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(NoneEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NoneResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
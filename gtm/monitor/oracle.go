@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // OracleList holds a list of Oracle configuration.
@@ -40,77 +38,37 @@ type OracleResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all OracleList resources
-func (r *OracleResource) List() (*OracleList, error) {
-	var mdcl OracleList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing OracleResource.
+func (r *OracleResource) collection() *bigip.Collection[Oracle, OracleList] {
+	return bigip.NewCollection[Oracle, OracleList](r.b, GTMManager, MonitorEndpoint, OracleEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all OracleList resources
+func (r *OracleResource) List(ctx context.Context, opts ...*rest.ListOptions) (*OracleList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific Oracle resource identified by its fullPathName
-func (r *OracleResource) Get(fullPathName string) (*Oracle, error) {
-	var mdc Oracle
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *OracleResource) Get(ctx context.Context, fullPathName string) (*Oracle, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Oracle resource provided by the item
-func (r *OracleResource) Create(item Oracle) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *OracleResource) Create(ctx context.Context, item Oracle) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing Oracle resource identified by name using the provided item
-func (r *OracleResource) Update(name string, item Oracle) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *OracleResource) Update(ctx context.Context, name string, item Oracle) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Oracle identified by name, see bigip.PatchBody.
+func (r *OracleResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a Oracle resource identified by its name
-func (r *OracleResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *OracleResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // POP3List holds a list of POP3 configuration.
@@ -39,77 +37,37 @@ type POP3Resource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all POP3List resources
-func (r *POP3Resource) List() (*POP3List, error) {
-	var mdcl POP3List
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing POP3Resource.
+func (r *POP3Resource) collection() *bigip.Collection[POP3, POP3List] {
+	return bigip.NewCollection[POP3, POP3List](r.b, GTMManager, MonitorEndpoint, POP3Endpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all POP3List resources
+func (r *POP3Resource) List(ctx context.Context, opts ...*rest.ListOptions) (*POP3List, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific POP3 resource identified by its fullPathName
-func (r *POP3Resource) Get(fullPathName string) (*POP3, error) {
-	var mdc POP3
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *POP3Resource) Get(ctx context.Context, fullPathName string) (*POP3, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new POP3 resource provided by the item
-func (r *POP3Resource) Create(item POP3) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *POP3Resource) Create(ctx context.Context, item POP3) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing POP3 resource identified by name using the provided item
-func (r *POP3Resource) Update(name string, item POP3) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *POP3Resource) Update(ctx context.Context, name string, item POP3) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the POP3 identified by name, see bigip.PatchBody.
+func (r *POP3Resource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a POP3 resource identified by its name
-func (r *POP3Resource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *POP3Resource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
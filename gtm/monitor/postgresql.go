@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // PostgreSQLList holds a list of PostgreSQL configuration.
@@ -40,77 +38,37 @@ type PostgreSQLResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all PostgreSQLList resources
-func (r *PostgreSQLResource) List() (*PostgreSQLList, error) {
-	var mdcl PostgreSQLList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing PostgreSQLResource.
+func (r *PostgreSQLResource) collection() *bigip.Collection[PostgreSQL, PostgreSQLList] {
+	return bigip.NewCollection[PostgreSQL, PostgreSQLList](r.b, GTMManager, MonitorEndpoint, PostgreSQLEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all PostgreSQLList resources
+func (r *PostgreSQLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PostgreSQLList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific PostgreSQL resource identified by its fullPathName
-func (r *PostgreSQLResource) Get(fullPathName string) (*PostgreSQL, error) {
-	var mdc PostgreSQL
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *PostgreSQLResource) Get(ctx context.Context, fullPathName string) (*PostgreSQL, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new PostgreSQL resource provided by the item
-func (r *PostgreSQLResource) Create(item PostgreSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *PostgreSQLResource) Create(ctx context.Context, item PostgreSQL) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing PostgreSQL resource identified by name using the provided item
-func (r *PostgreSQLResource) Update(name string, item PostgreSQL) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *PostgreSQLResource) Update(ctx context.Context, name string, item PostgreSQL) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the PostgreSQL identified by name, see bigip.PatchBody.
+func (r *PostgreSQLResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a PostgreSQL resource identified by its name
-func (r *PostgreSQLResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *PostgreSQLResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
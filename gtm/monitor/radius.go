@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // RadiusList holds a list of MonitorRadius configuration.
@@ -39,77 +37,37 @@ type RadiusResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all RadiusList resources
-func (r *RadiusResource) List() (*RadiusList, error) {
-	var mdcl RadiusList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RadiusResource.
+func (r *RadiusResource) collection() *bigip.Collection[Radius, RadiusList] {
+	return bigip.NewCollection[Radius, RadiusList](r.b, GTMManager, MonitorEndpoint, RadiusEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all RadiusList resources
+func (r *RadiusResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific Radius resource identified by its fullPathName
-func (r *RadiusResource) Get(fullPathName string) (*Radius, error) {
-	var mdc Radius
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *RadiusResource) Get(ctx context.Context, fullPathName string) (*Radius, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Radius resource provided by the item
-func (r *RadiusResource) Create(item Radius) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RadiusResource) Create(ctx context.Context, item Radius) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing Radius resource identified by name using the provided item
-func (r *RadiusResource) Update(name string, item Radius) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RadiusResource) Update(ctx context.Context, name string, item Radius) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Radius identified by name, see bigip.PatchBody.
+func (r *RadiusResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a Radius resource identified by its name
-func (r *RadiusResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RadiusResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // RadiusAccountingList holds a list of RadiusAccounting configuration.
@@ -39,77 +37,37 @@ type RadiusAccountingResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all RadiusAccountingList resources
-func (r *RadiusAccountingResource) List() (*RadiusAccountingList, error) {
-	var mdcl RadiusAccountingList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RadiusAccountingResource.
+func (r *RadiusAccountingResource) collection() *bigip.Collection[RadiusAccounting, RadiusAccountingList] {
+	return bigip.NewCollection[RadiusAccounting, RadiusAccountingList](r.b, GTMManager, MonitorEndpoint, RadiusAccountingEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all RadiusAccountingList resources
+func (r *RadiusAccountingResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusAccountingList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific RadiusAccounting resource identified by its fullPathName
-func (r *RadiusAccountingResource) Get(fullPathName string) (*RadiusAccounting, error) {
-	var mdc RadiusAccounting
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *RadiusAccountingResource) Get(ctx context.Context, fullPathName string) (*RadiusAccounting, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new RadiusAccounting resource provided by the item
-func (r *RadiusAccountingResource) Create(item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RadiusAccountingResource) Create(ctx context.Context, item RadiusAccounting) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing RadiusAccounting resource identified by name using the provided item
-func (r *RadiusAccountingResource) Update(name string, item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RadiusAccountingResource) Update(ctx context.Context, name string, item RadiusAccounting) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the RadiusAccounting identified by name, see bigip.PatchBody.
+func (r *RadiusAccountingResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a RadiusAccounting resource identified by its name
-func (r *RadiusAccountingResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RadiusAccountingResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // RealServerList holds a list of RealServer configuration.
@@ -41,77 +39,37 @@ type RealServerResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all RealServerList resources
-func (r *RealServerResource) List() (*RealServerList, error) {
-	var mdcl RealServerList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RealServerResource.
+func (r *RealServerResource) collection() *bigip.Collection[RealServer, RealServerList] {
+	return bigip.NewCollection[RealServer, RealServerList](r.b, GTMManager, MonitorEndpoint, RealServerEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all RealServerList resources
+func (r *RealServerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RealServerList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific RealServer resource identified by its fullPathName
-func (r *RealServerResource) Get(fullPathName string) (*RealServer, error) {
-	var mdc RealServer
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *RealServerResource) Get(ctx context.Context, fullPathName string) (*RealServer, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new RealServer resource provided by the item
-func (r *RealServerResource) Create(item RealServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RealServerResource) Create(ctx context.Context, item RealServer) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing RealServer resource identified by name using the provided item
-func (r *RealServerResource) Update(name string, item RealServer) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RealServerResource) Update(ctx context.Context, name string, item RealServer) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the RealServer identified by name, see bigip.PatchBody.
+func (r *RealServerResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a RealServer resource identified by its name
-func (r *RealServerResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RealServerResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
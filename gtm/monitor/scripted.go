@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ScriptedList holds a list of Scripted uration.
@@ -39,77 +37,37 @@ type ScriptedResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all ScriptedList resources
-func (r *ScriptedResource) List() (*ScriptedList, error) {
-	var mdcl ScriptedList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ScriptedResource.
+func (r *ScriptedResource) collection() *bigip.Collection[Scripted, ScriptedList] {
+	return bigip.NewCollection[Scripted, ScriptedList](r.b, GTMManager, MonitorEndpoint, ScriptedEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all ScriptedList resources
+func (r *ScriptedResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ScriptedList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific Scripted resource identified by its fullPathName
-func (r *ScriptedResource) Get(fullPathName string) (*Scripted, error) {
-	var mdc Scripted
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *ScriptedResource) Get(ctx context.Context, fullPathName string) (*Scripted, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Scripted resource provided by the item
-func (r *ScriptedResource) Create(item Scripted) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ScriptedResource) Create(ctx context.Context, item Scripted) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing Scripted resource identified by name using the provided item
-func (r *ScriptedResource) Update(name string, item Scripted) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ScriptedResource) Update(ctx context.Context, name string, item Scripted) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Scripted identified by name, see bigip.PatchBody.
+func (r *ScriptedResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a Scripted resource identified by its name
-func (r *ScriptedResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ScriptedResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
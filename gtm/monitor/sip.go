@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SIPList holds a list of SIP configuration.
@@ -41,77 +39,37 @@ type SIPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all SIPList resources
-func (r *SIPResource) List() (*SIPList, error) {
-	var mdcl SIPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SIPResource.
+func (r *SIPResource) collection() *bigip.Collection[SIP, SIPList] {
+	return bigip.NewCollection[SIP, SIPList](r.b, GTMManager, MonitorEndpoint, SIPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all SIPList resources
+func (r *SIPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SIPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific SIP resource identified by its fullPathName
-func (r *SIPResource) Get(fullPathName string) (*SIP, error) {
-	var mdc SIP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *SIPResource) Get(ctx context.Context, fullPathName string) (*SIP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SIP resource provided by the item
-func (r *SIPResource) Create(item SIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SIPResource) Create(ctx context.Context, item SIP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing SIP resource identified by name using the provided item
-func (r *SIPResource) Update(name string, item SIP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SIPResource) Update(ctx context.Context, name string, item SIP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the SIP identified by name, see bigip.PatchBody.
+func (r *SIPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a SIP resource identified by its name
-func (r *SIPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SIPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
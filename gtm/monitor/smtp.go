@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SMTPList holds a list of SMTP configuration.
@@ -39,77 +37,37 @@ type SMTPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all SMTPList resources
-func (r *SMTPResource) List() (*SMTPList, error) {
-	var mdcl SMTPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SMTPResource.
+func (r *SMTPResource) collection() *bigip.Collection[SMTP, SMTPList] {
+	return bigip.NewCollection[SMTP, SMTPList](r.b, GTMManager, MonitorEndpoint, SMTPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all SMTPList resources
+func (r *SMTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific SMTP resource identified by its fullPathName
-func (r *SMTPResource) Get(fullPathName string) (*SMTP, error) {
-	var mdc SMTP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *SMTPResource) Get(ctx context.Context, fullPathName string) (*SMTP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SMTP resource provided by the item
-func (r *SMTPResource) Create(item SMTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SMTPResource) Create(ctx context.Context, item SMTP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing SMTP resource identified by name using the provided item
-func (r *SMTPResource) Update(name string, item SMTP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SMTPResource) Update(ctx context.Context, name string, item SMTP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the SMTP identified by name, see bigip.PatchBody.
+func (r *SMTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a SMTP resource identified by its name
-func (r *SMTPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SMTPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
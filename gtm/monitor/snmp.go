@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SNMPList holds a list of SNMP configuration.
@@ -43,77 +41,37 @@ type SNMPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all SNMPList resources
-func (r *SNMPResource) List() (*SNMPList, error) {
-	var mdcl SNMPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SNMPResource.
+func (r *SNMPResource) collection() *bigip.Collection[SNMP, SNMPList] {
+	return bigip.NewCollection[SNMP, SNMPList](r.b, GTMManager, MonitorEndpoint, SNMPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all SNMPList resources
+func (r *SNMPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific SNMP resource identified by its fullPathName
-func (r *SNMPResource) Get(fullPathName string) (*SNMP, error) {
-	var mdc SNMP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *SNMPResource) Get(ctx context.Context, fullPathName string) (*SNMP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SNMP resource provided by the item
-func (r *SNMPResource) Create(item SNMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SNMPResource) Create(ctx context.Context, item SNMP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing SNMP resource identified by name using the provided item
-func (r *SNMPResource) Update(name string, item SNMP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SNMPResource) Update(ctx context.Context, name string, item SNMP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the SNMP identified by name, see bigip.PatchBody.
+func (r *SNMPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a SNMP resource identified by its name
-func (r *SNMPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SNMPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SNMPLinkList holds a list of SNMPLink configuration.
@@ -43,77 +41,37 @@ type SNMPLinkResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all SNMPLinkList resources
-func (r *SNMPLinkResource) List() (*SNMPLinkList, error) {
-	var mdcl SNMPLinkList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SNMPLinkResource.
+func (r *SNMPLinkResource) collection() *bigip.Collection[SNMPLink, SNMPLinkList] {
+	return bigip.NewCollection[SNMPLink, SNMPLinkList](r.b, GTMManager, MonitorEndpoint, SNMPLinkEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all SNMPLinkList resources
+func (r *SNMPLinkResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPLinkList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific SNMPLink resource identified by its fullPathName
-func (r *SNMPLinkResource) Get(fullPathName string) (*SNMPLink, error) {
-	var mdc SNMPLink
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *SNMPLinkResource) Get(ctx context.Context, fullPathName string) (*SNMPLink, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SNMPLink resource provided by the item
-func (r *SNMPLinkResource) Create(item SNMPLink) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SNMPLinkResource) Create(ctx context.Context, item SNMPLink) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing SNMPLink resource identified by name using the provided item
-func (r *SNMPLinkResource) Update(name string, item SNMPLink) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SNMPLinkResource) Update(ctx context.Context, name string, item SNMPLink) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the SNMPLink identified by name, see bigip.PatchBody.
+func (r *SNMPLinkResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a SNMPLink resource identified by its name
-func (r *SNMPLinkResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SNMPLinkEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SNMPLinkResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
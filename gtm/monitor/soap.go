@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SOAPList holds a list of SOAP configuration.
@@ -41,77 +39,37 @@ type SOAPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all SOAPList resources
-func (r *SOAPResource) List() (*SOAPList, error) {
-	var mdcl SOAPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SOAPResource.
+func (r *SOAPResource) collection() *bigip.Collection[SOAP, SOAPList] {
+	return bigip.NewCollection[SOAP, SOAPList](r.b, GTMManager, MonitorEndpoint, SOAPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all SOAPList resources
+func (r *SOAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SOAPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific SOAP resource identified by its fullPathName
-func (r *SOAPResource) Get(fullPathName string) (*SOAP, error) {
-	var mdc SOAP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *SOAPResource) Get(ctx context.Context, fullPathName string) (*SOAP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SOAP resource provided by the item
-func (r *SOAPResource) Create(item SOAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SOAPResource) Create(ctx context.Context, item SOAP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing SOAP resource identified by name using the provided item
-func (r *SOAPResource) Update(name string, item SOAP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SOAPResource) Update(ctx context.Context, name string, item SOAP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the SOAP identified by name, see bigip.PatchBody.
+func (r *SOAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a SOAP resource identified by its name
-func (r *SOAPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SOAPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // TCPList holds a list of TCP configuration.
@@ -40,77 +38,37 @@ type TCPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all TCPList resources
-func (r *TCPResource) List() (*TCPList, error) {
-	var mdcl TCPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPResource.
+func (r *TCPResource) collection() *bigip.Collection[TCP, TCPList] {
+	return bigip.NewCollection[TCP, TCPList](r.b, GTMManager, MonitorEndpoint, TCPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all TCPList resources
+func (r *TCPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific TCP resource identified by its fullPathName
-func (r *TCPResource) Get(fullPathName string) (*TCP, error) {
-	var mdc TCP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *TCPResource) Get(ctx context.Context, fullPathName string) (*TCP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new TCP resource provided by the item
-func (r *TCPResource) Create(item TCP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TCPResource) Create(ctx context.Context, item TCP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing TCP resource identified by name using the provided item
-func (r *TCPResource) Update(name string, item TCP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TCPResource) Update(ctx context.Context, name string, item TCP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the TCP identified by name, see bigip.PatchBody.
+func (r *TCPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a TCP resource identified by its name
-func (r *TCPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TCPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // TCPHalfList holds a list of TCPHalf configuration.
@@ -41,77 +39,37 @@ type TCPHalfResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all TCPHalfList resources
-func (r *TCPHalfResource) List() (*TCPHalfList, error) {
-	var mdcl TCPHalfList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPHalfResource.
+func (r *TCPHalfResource) collection() *bigip.Collection[TCPHalf, TCPHalfList] {
+	return bigip.NewCollection[TCPHalf, TCPHalfList](r.b, GTMManager, MonitorEndpoint, TCPHalfEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all TCPHalfList resources
+func (r *TCPHalfResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPHalfList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific TCPHalf resource identified by its fullPathName
-func (r *TCPHalfResource) Get(fullPathName string) (*TCPHalf, error) {
-	var mdc TCPHalf
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *TCPHalfResource) Get(ctx context.Context, fullPathName string) (*TCPHalf, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new TCPHalf resource provided by the item
-func (r *TCPHalfResource) Create(item TCPHalf) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TCPHalfResource) Create(ctx context.Context, item TCPHalf) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing TCPHalf resource identified by name using the provided item
-func (r *TCPHalfResource) Update(name string, item TCPHalf) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TCPHalfResource) Update(ctx context.Context, name string, item TCPHalf) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the TCPHalf identified by name, see bigip.PatchBody.
+func (r *TCPHalfResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a TCPHalf resource identified by its name
-func (r *TCPHalfResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TCPHalfResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
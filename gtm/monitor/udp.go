@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // UDPList holds a list of UDP configuration.
@@ -44,77 +42,37 @@ type UDPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all UDPList resources
-func (r *UDPResource) List() (*UDPList, error) {
-	var mdcl UDPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing UDPResource.
+func (r *UDPResource) collection() *bigip.Collection[UDP, UDPList] {
+	return bigip.NewCollection[UDP, UDPList](r.b, GTMManager, MonitorEndpoint, UDPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all UDPList resources
+func (r *UDPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*UDPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific UDP resource identified by its fullPathName
-func (r *UDPResource) Get(fullPathName string) (*UDP, error) {
-	var mdc UDP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *UDPResource) Get(ctx context.Context, fullPathName string) (*UDP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new UDP resource provided by the item
-func (r *UDPResource) Create(item UDP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UDPResource) Create(ctx context.Context, item UDP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing UDP resource identified by name using the provided item
-func (r *UDPResource) Update(name string, item UDP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UDPResource) Update(ctx context.Context, name string, item UDP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the UDP identified by name, see bigip.PatchBody.
+func (r *UDPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a UDP resource identified by its name
-func (r *UDPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UDPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // WAPList holds a list of WAP configuration.
@@ -39,77 +37,37 @@ type WAPResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all WAPList resources
-func (r *WAPResource) List() (*WAPList, error) {
-	var mdcl WAPList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing WAPResource.
+func (r *WAPResource) collection() *bigip.Collection[WAP, WAPList] {
+	return bigip.NewCollection[WAP, WAPList](r.b, GTMManager, MonitorEndpoint, WAPEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all WAPList resources
+func (r *WAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WAPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific WAP resource identified by its fullPathName
-func (r *WAPResource) Get(fullPathName string) (*WAP, error) {
-	var mdc WAP
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *WAPResource) Get(ctx context.Context, fullPathName string) (*WAP, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new WAP resource provided by the item
-func (r *WAPResource) Create(item WAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *WAPResource) Create(ctx context.Context, item WAP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing WAP resource identified by name using the provided item
-func (r *WAPResource) Update(name string, item WAP) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *WAPResource) Update(ctx context.Context, name string, item WAP) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the WAP identified by name, see bigip.PatchBody.
+func (r *WAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a WAP resource identified by its name
-func (r *WAPResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *WAPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
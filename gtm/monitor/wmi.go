@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // WMIList holds a list of WMI configuration.
@@ -44,77 +42,37 @@ type WMIResource struct {
 	b *bigip.BigIP
 }
 
-// List returns a list of all WMIList resources
-func (r *WMIResource) List() (*WMIList, error) {
-	var mdcl WMIList
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing WMIResource.
+func (r *WMIResource) collection() *bigip.Collection[WMI, WMIList] {
+	return bigip.NewCollection[WMI, WMIList](r.b, GTMManager, MonitorEndpoint, WMIEndpoint)
+}
 
-	// Decode the returned JSON data into the BigIPList type variable
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+// List returns a list of all WMIList resources
+func (r *WMIResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WMIList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get returns a specific WMI resource identified by its fullPathName
-func (r *WMIResource) Get(fullPathName string) (*WMI, error) {
-	var mdc WMI
-	// Makes a GET request from the REST client and parses the returned data
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (r *WMIResource) Get(ctx context.Context, fullPathName string) (*WMI, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new WMI resource provided by the item
-func (r *WMIResource) Create(item WMI) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a POST request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *WMIResource) Create(ctx context.Context, item WMI) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies an existing WMI resource identified by name using the provided item
-func (r *WMIResource) Update(name string, item WMI) error {
-	jsonData, err := json.Marshal(item) // Marshals the item into JSON data
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	// Makes a PUT request from the REST client, specifying the JSON string in the body
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *WMIResource) Update(ctx context.Context, name string, item WMI) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the WMI identified by name, see bigip.PatchBody.
+func (r *WMIResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete removes a WMI resource identified by its name
-func (r *WMIResource) Delete(name string) error {
-	// Makes a DELETE request from the REST client
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *WMIResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
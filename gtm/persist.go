@@ -32,10 +32,10 @@ type PersistResource struct {
 }
 
 // List retrieves all Persist details.
-func (r *PersistResource) List() (*PersistList, error) {
+func (r *PersistResource) List(ctx context.Context) (*PersistList, error) {
 	var items PersistList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PersistEndpoint).DoRaw(context.Background())
+		Resource(PersistEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
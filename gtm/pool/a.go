@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // PoolList holds a list of Pool configuration.
@@ -141,81 +141,50 @@ type AResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all A details.
-func (r *AResource) List() (*PoolList, error) {
-	var items PoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing AResource.
+func (r *AResource) collection() *bigip.Collection[Pool, PoolList] {
+	return bigip.NewCollection[Pool, PoolList](r.b, GTMManager, PoolEndpoint, AEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all A details.
+func (r *AResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single A by node name.
-func (r *AResource) Get(name string) (*Pool, error) {
-	var item Pool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *AResource) Get(ctx context.Context, name string) (*Pool, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new A item.
-func (r *AResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AResource) Create(ctx context.Context, item Pool) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the A item identified by the A name.
-func (r *AResource) Update(name string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AResource) Update(ctx context.Context, name string, item Pool) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single A identified by the A name. If it does not exist, return an error.
-func (r *AResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+func (r *AResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single A identified by the A name. If it does not exist, return an error.
+func (r *AResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
-func (r *AResource) ShowAStats(name string) (*PoolStatsList, error) {
+func (r *AResource) ShowAStats(ctx context.Context, name string) (*PoolStatsList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var item PoolStatsList
 
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubResource(AEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -225,15 +194,16 @@ func (r *AResource) ShowAStats(name string) (*PoolStatsList, error) {
 	return &item, nil
 }
 
-func (r *AResource) ShowAllAStats() (*PoolStatsList, error) {
+func (r *AResource) ShowAllAStats(ctx context.Context) (*PoolStatsList, error) {
 	var item PoolStatsList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubResource(AEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(res, &item); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(r.b, item.Entries)
 	return &item, nil
 }
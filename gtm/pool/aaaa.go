@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // AAAAEndpoint represents the REST resource for managing AAAA.
@@ -16,81 +16,50 @@ type AAAAResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all AAAA details.
-func (r *AAAAResource) List() (*PoolList, error) {
-	var items PoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing AAAAResource.
+func (r *AAAAResource) collection() *bigip.Collection[Pool, PoolList] {
+	return bigip.NewCollection[Pool, PoolList](r.b, GTMManager, PoolEndpoint, AAAAEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all AAAA details.
+func (r *AAAAResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single AAAA by node name.
-func (r *AAAAResource) Get(name string) (*Pool, error) {
-	var item Pool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *AAAAResource) Get(ctx context.Context, name string) (*Pool, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new AAAA item.
-func (r *AAAAResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AAAAResource) Create(ctx context.Context, item Pool) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the AAAA item identified by the AAAA name.
-func (r *AAAAResource) Update(name string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AAAAResource) Update(ctx context.Context, name string, item Pool) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single AAAA identified by the AAAA name. If it does not exist, return an error.
-func (r *AAAAResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+func (r *AAAAResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single AAAA identified by the AAAA name. If it does not exist, return an error.
+func (r *AAAAResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
-func (r *AAAAResource) ShowAAAAStats(name string) (*PoolStatsList, error) {
+func (r *AAAAResource) ShowAAAAStats(ctx context.Context, name string) (*PoolStatsList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var item PoolStatsList
 
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -100,15 +69,16 @@ func (r *AAAAResource) ShowAAAAStats(name string) (*PoolStatsList, error) {
 	return &item, nil
 }
 
-func (r *AAAAResource) ShowAllAAAAStats() (*PoolStatsList, error) {
+func (r *AAAAResource) ShowAllAAAAStats(ctx context.Context) (*PoolStatsList, error) {
 	var item PoolStatsList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubResource(AAAAEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(res, &item); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(r.b, item.Entries)
 	return &item, nil
 }
@@ -0,0 +1,146 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package pool
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// AAAAAPI is the interface of AAAAResource, which mocks implement in tests.
+type AAAAAPI interface {
+	// List retrieves all AAAA details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Get retrieves the details of a single AAAA by node name.
+	Get(ctx context.Context, name string) (*Pool, error)
+	// Create creates a new AAAA item.
+	Create(ctx context.Context, item Pool) error
+	// Update modifies the AAAA item identified by the AAAA name.
+	Update(ctx context.Context, name string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single AAAA identified by the AAAA name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	ShowAAAAStats(ctx context.Context, name string) (*PoolStatsList, error)
+	ShowAllAAAAStats(ctx context.Context) (*PoolStatsList, error)
+}
+
+var _ AAAAAPI = &AAAAResource{}
+
+// AAPI is the interface of AResource, which mocks implement in tests.
+type AAPI interface {
+	// List retrieves all A details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Get retrieves the details of a single A by node name.
+	Get(ctx context.Context, name string) (*Pool, error)
+	// Create creates a new A item.
+	Create(ctx context.Context, item Pool) error
+	// Update modifies the A item identified by the A name.
+	Update(ctx context.Context, name string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single A identified by the A name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	ShowAStats(ctx context.Context, name string) (*PoolStatsList, error)
+	ShowAllAStats(ctx context.Context) (*PoolStatsList, error)
+}
+
+var _ AAPI = &AResource{}
+
+// CNAMEAPI is the interface of CNAMEResource, which mocks implement in tests.
+type CNAMEAPI interface {
+	// List retrieves all CNAME details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Get retrieves the details of a single CNAME by node name.
+	Get(ctx context.Context, name string) (*Pool, error)
+	// Create creates a new CNAME item.
+	Create(ctx context.Context, item Pool) error
+	// Update modifies the CNAME item identified by the CNAME name.
+	Update(ctx context.Context, name string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single CNAME identified by the CNAME name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	ShowCNAMEStats(ctx context.Context, name string) (*PoolStatsList, error)
+	ShowAllCNAMEStats(ctx context.Context) (*PoolStatsList, error)
+}
+
+var _ CNAMEAPI = &CNAMEResource{}
+
+// MXAPI is the interface of MXResource, which mocks implement in tests.
+type MXAPI interface {
+	// List retrieves all MX details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Get retrieves the details of a single MX by node name.
+	Get(ctx context.Context, name string) (*Pool, error)
+	// Create creates a new MX item.
+	Create(ctx context.Context, item Pool) error
+	// Update modifies the MX item identified by the MX name.
+	Update(ctx context.Context, name string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single MX identified by the MX name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	ShowMXStats(ctx context.Context, name string) (*PoolStatsList, error)
+	ShowAllMXStats(ctx context.Context) (*PoolStatsList, error)
+}
+
+var _ MXAPI = &MXResource{}
+
+// NAPTRAPI is the interface of NAPTRResource, which mocks implement in tests.
+type NAPTRAPI interface {
+	// List retrieves all NAPTR details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Get retrieves the details of a single NAPTR by node name.
+	Get(ctx context.Context, name string) (*Pool, error)
+	// Create creates a new NAPTR item.
+	Create(ctx context.Context, item Pool) error
+	// Update modifies the NAPTR item identified by the NAPTR name.
+	Update(ctx context.Context, name string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single NAPTR identified by the NAPTR name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	ShowNAPTRStats(ctx context.Context, name string) (*PoolStatsList, error)
+	ShowAllNAPTRStats(ctx context.Context) (*PoolStatsList, error)
+}
+
+var _ NAPTRAPI = &NAPTRResource{}
+
+// PoolAPI is the interface of PoolResource, which mocks implement in tests.
+type PoolAPI interface {
+	// A returns a reference to the AResource instance
+	A() AAPI
+	// AAAA returns a reference to the AAAAResource instance
+	AAAA() AAAAAPI
+	// CNAME returns a reference to the CNAMEResource instance
+	CNAME() CNAMEAPI
+	// MX returns a reference to the MXResource instance
+	MX() MXAPI
+	// NAPTR returns a reference to the NAPTRResource instance
+	NAPTR() NAPTRAPI
+	// SRV returns a reference to the SRVResource instance
+	SRV() SRVAPI
+}
+
+var _ PoolAPI = &PoolResource{}
+
+// SRVAPI is the interface of SRVResource, which mocks implement in tests.
+type SRVAPI interface {
+	// List retrieves all SRV details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Get retrieves the details of a single SRV by node name.
+	Get(ctx context.Context, name string) (*Pool, error)
+	// Create creates a new SRV item.
+	Create(ctx context.Context, item Pool) error
+	// Update modifies the SRV item identified by the SRV name.
+	Update(ctx context.Context, name string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single SRV identified by the SRV name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	ShowSRVStats(ctx context.Context, name string) (*PoolStatsList, error)
+	ShowAllSRVStats(ctx context.Context) (*PoolStatsList, error)
+}
+
+var _ SRVAPI = &SRVResource{}
@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CNAMEEndpoint represents the REST resource for managing CNAME.
@@ -16,81 +16,50 @@ type CNAMEResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all CNAME details.
-func (r *CNAMEResource) List() (*PoolList, error) {
-	var items PoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CNAMEResource.
+func (r *CNAMEResource) collection() *bigip.Collection[Pool, PoolList] {
+	return bigip.NewCollection[Pool, PoolList](r.b, GTMManager, PoolEndpoint, CNAMEEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all CNAME details.
+func (r *CNAMEResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single CNAME by node name.
-func (r *CNAMEResource) Get(name string) (*Pool, error) {
-	var item Pool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CNAMEResource) Get(ctx context.Context, name string) (*Pool, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new CNAME item.
-func (r *CNAMEResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CNAMEResource) Create(ctx context.Context, item Pool) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the CNAME item identified by the CNAME name.
-func (r *CNAMEResource) Update(name string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CNAMEResource) Update(ctx context.Context, name string, item Pool) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single CNAME identified by the CNAME name. If it does not exist, return an error.
-func (r *CNAMEResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Pool identified by name, see bigip.PatchBody.
+func (r *CNAMEResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single CNAME identified by the CNAME name. If it does not exist, return an error.
+func (r *CNAMEResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
-func (r *CNAMEResource) ShowCNAMEStats(name string) (*PoolStatsList, error) {
+func (r *CNAMEResource) ShowCNAMEStats(ctx context.Context, name string) (*PoolStatsList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var item PoolStatsList
 
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -100,15 +69,16 @@ func (r *CNAMEResource) ShowCNAMEStats(name string) (*PoolStatsList, error) {
 	return &item, nil
 }
 
-func (r *CNAMEResource) ShowAllCNAMEStats() (*PoolStatsList, error) {
+func (r *CNAMEResource) ShowAllCNAMEStats(ctx context.Context) (*PoolStatsList, error) {
 	var item PoolStatsList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubResource(CNAMEEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(res, &item); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(r.b, item.Entries)
 	return &item, nil
 }
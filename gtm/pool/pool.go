@@ -1,5 +1,7 @@
 package pool
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // PoolEndpoint is the REST resource for managing pool in BigIP
@@ -29,31 +31,31 @@ func NewPoolResource(b *bigip.BigIP) PoolResource {
 }
 
 // A returns a reference to the AResource instance
-func (p *PoolResource) A() *AResource {
+func (p *PoolResource) A() AAPI {
 	return &p.a
 }
 
 // AAAA returns a reference to the AAAAResource instance
-func (p *PoolResource) AAAA() *AAAAResource {
+func (p *PoolResource) AAAA() AAAAAPI {
 	return &p.aaaa
 }
 
 // CNAME returns a reference to the CNAMEResource instance
-func (p *PoolResource) CNAME() *CNAMEResource {
+func (p *PoolResource) CNAME() CNAMEAPI {
 	return &p.cname
 }
 
 // MX returns a reference to the MXResource instance
-func (p *PoolResource) MX() *MXResource {
+func (p *PoolResource) MX() MXAPI {
 	return &p.mx
 }
 
 // NAPTR returns a reference to the NAPTRResource instance
-func (p *PoolResource) NAPTR() *NAPTRResource {
+func (p *PoolResource) NAPTR() NAPTRAPI {
 	return &p.naptr
 }
 
 // SRV returns a reference to the SRVResource instance
-func (p *PoolResource) SRV() *SRVResource {
+func (p *PoolResource) SRV() SRVAPI {
 	return &p.srv
 }
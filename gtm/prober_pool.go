@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ProberPoolList holds a list of ProberPool configuration.
@@ -61,81 +61,50 @@ type ProberPoolResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all ProberPool details.
-func (r *ProberPoolResource) List() (*ProberPoolList, error) {
-	var items ProberPoolList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ProberPoolResource.
+func (r *ProberPoolResource) collection() *bigip.Collection[ProberPool, ProberPoolList] {
+	return bigip.NewCollection[ProberPool, ProberPoolList](r.b, GTMManager, ProberPoolEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all ProberPool details.
+func (r *ProberPoolResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ProberPoolList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single ProberPool by node name.
-func (r *ProberPoolResource) Get(name string) (*ProberPool, error) {
-	var item ProberPool
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ProberPoolResource) Get(ctx context.Context, name string) (*ProberPool, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new ProberPool item.
-func (r *ProberPoolResource) Create(item ProberPool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ProberPoolResource) Create(ctx context.Context, item ProberPool) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the ProberPool item identified by the ProberPool name.
-func (r *ProberPoolResource) Update(name string, item ProberPool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ProberPoolResource) Update(ctx context.Context, name string, item ProberPool) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single ProberPool identified by the ProberPool name. If it does not exist, return an error.
-func (r *ProberPoolResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the ProberPool identified by name, see bigip.PatchBody.
+func (r *ProberPoolResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single ProberPool identified by the ProberPool name. If it does not exist, return an error.
+func (r *ProberPoolResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
 // GetMembers  lists all the ProberPoolMembers configurations.
-func (r *ProberPoolResource) GetMembers(name string) (*ProberPoolMembersList, error) {
+func (r *ProberPoolResource) GetMembers(ctx context.Context, name string) (*ProberPoolMembersList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var items ProberPoolMembersList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ProberPoolEndpoint).ResourceInstance(name).SubResourceInstance(ProberPoolMembersEndpoint).DoRaw(context.Background())
+		Resource(ProberPoolEndpoint).ResourceInstance(name).SubResourceInstance(ProberPoolMembersEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // RegionList holds a list of Region configuration.
@@ -36,72 +34,37 @@ type RegionResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Region details.
-func (r *RegionResource) List() (*RegionList, error) {
-	var items RegionList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RegionResource.
+func (r *RegionResource) collection() *bigip.Collection[Region, RegionList] {
+	return bigip.NewCollection[Region, RegionList](r.b, GTMManager, RegionEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Region details.
+func (r *RegionResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RegionList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Region by node name.
-func (r *RegionResource) Get(name string) (*Region, error) {
-	var item Region
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *RegionResource) Get(ctx context.Context, name string) (*Region, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Region item.
-func (r *RegionResource) Create(item Region) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RegionResource) Create(ctx context.Context, item Region) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Region item identified by the Region name.
-func (r *RegionResource) Update(name string, item Region) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RegionResource) Update(ctx context.Context, name string, item Region) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Region identified by the Region name. If it does not exist, return an error.
-func (r *RegionResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RegionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Region identified by name, see bigip.PatchBody.
+func (r *RegionResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Region identified by the Region name. If it does not exist, return an error.
+func (r *RegionResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
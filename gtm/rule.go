@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // RuleList holds a list of Rule configuration.
@@ -34,72 +32,37 @@ type RuleResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Rule details.
-func (r *RuleResource) List() (*RuleList, error) {
-	var items RuleList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RuleResource.
+func (r *RuleResource) collection() *bigip.Collection[Rule, RuleList] {
+	return bigip.NewCollection[Rule, RuleList](r.b, GTMManager, RuleEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Rule details.
+func (r *RuleResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RuleList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Rule by node name.
-func (r *RuleResource) Get(name string) (*Rule, error) {
-	var item Rule
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *RuleResource) Get(ctx context.Context, name string) (*Rule, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Rule item.
-func (r *RuleResource) Create(item Rule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RuleResource) Create(ctx context.Context, item Rule) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Rule item identified by the Rule name.
-func (r *RuleResource) Update(name string, item Rule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *RuleResource) Update(ctx context.Context, name string, item Rule) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Rule identified by the Rule name. If it does not exist, return an error.
-func (r *RuleResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(RuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Rule identified by name, see bigip.PatchBody.
+func (r *RuleResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Rule identified by the Rule name. If it does not exist, return an error.
+func (r *RuleResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
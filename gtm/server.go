@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ServerList holds a list of Server configuration.
@@ -101,41 +101,30 @@ type ServerResource struct {
 	b *bigip.BigIP
 }
 
-// ListAll  lists all the Server configurations.
-func (r *ServerResource) List() (*ServerList, error) {
-	var items ServerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ServerResource.
+func (r *ServerResource) collection() *bigip.Collection[Server, ServerList] {
+	return bigip.NewCollection[Server, ServerList](r.b, GTMManager, ServerEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// ListAll  lists all the Server configurations.
+func (r *ServerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ServerList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single Server configuration identified by name.
-func (r *ServerResource) Get(fullPathName string) (*Server, error) {
-	var item Server
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ServerResource) Get(ctx context.Context, fullPathName string) (*Server, error) {
+	return r.collection().Get(ctx, fullPathName)
 }
 
 // GetVirtualServers lists all the ServerVirtualServers configurations.
-func (r *ServerResource) GetVirtualServers(fullPathName string) (*ServerVirtualServersList, error) {
+func (r *ServerResource) GetVirtualServers(ctx context.Context, fullPathName string) (*ServerVirtualServersList, error) {
+	fullPathName, err := r.b.Qualify(fullPathName)
+	if err != nil {
+		return nil, err
+	}
 	var items ServerVirtualServersList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).ResourceInstance(fullPathName).SubStatsResource(ServerVirtualServersEndpoint).DoRaw(context.Background())
+		Resource(ServerEndpoint).ResourceInstance(fullPathName).SubStatsResource(ServerVirtualServersEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -147,41 +136,21 @@ func (r *ServerResource) GetVirtualServers(fullPathName string) (*ServerVirtualS
 }
 
 // Create a new Server configuration.
-func (r *ServerResource) Create(item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(ServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServerResource) Create(ctx context.Context, item Server) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Edit a Server configuration identified by name.
-func (r *ServerResource) Update(fullPathName string, item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServerResource) Update(ctx context.Context, fullPathName string, item Server) error {
+	return r.collection().Update(ctx, fullPathName, item)
+}
+
+// Patch updates only the given fields of the Server identified by fullPathName, see bigip.PatchBody.
+func (r *ServerResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return r.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete a single Server configuration identified by name.
-func (r *ServerResource) Delete(fullPathName string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(DatacenterEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServerResource) Delete(ctx context.Context, fullPathName string) error {
+	return r.collection().Delete(ctx, fullPathName)
 }
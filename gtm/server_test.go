@@ -1,13 +1,13 @@
 package gtm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 
 	"testing"
 )
 
 func TestServerResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -25,7 +25,7 @@ func TestServerResource(t *testing.T) {
 
 	gtm := New(bigIP)
 
-	err = gtm.Datacenter().Create(datacenter)
+	err = gtm.Datacenter().Create(context.Background(), datacenter)
 	if err != nil {
 		t.Error(err)
 	}
@@ -48,13 +48,13 @@ func TestServerResource(t *testing.T) {
 		},
 	}
 
-	err = serverResource.Create(newServer)
+	err = serverResource.Create(context.Background(), newServer)
 	if err != nil {
 		t.Fatalf("Error creating server: %v", err)
 	}
 
 	// Get the created Server
-	server, err := serverResource.Get("/Common/test-server")
+	server, err := serverResource.Get(context.Background(), "/Common/test-server")
 	if err != nil {
 		t.Fatalf("Error getting server: %v", err)
 	}
@@ -69,13 +69,13 @@ func TestServerResource(t *testing.T) {
 
 	// Update the Server
 	server.Enabled = false
-	err = serverResource.Update("test-server", *server)
+	err = serverResource.Update(context.Background(), "test-server", *server)
 	if err != nil {
 		t.Fatalf("Error updating server: %v", err)
 	}
 
 	// Validate updated properties
-	updatedServer, err := serverResource.Get("/Common/test-server")
+	updatedServer, err := serverResource.Get(context.Background(), "/Common/test-server")
 	if err != nil {
 		t.Fatalf("Error getting updated server: %v", err)
 	}
@@ -84,13 +84,13 @@ func TestServerResource(t *testing.T) {
 	}
 
 	// Delete the Server
-	err = serverResource.Delete("test-server")
+	err = serverResource.Delete(context.Background(), "test-server")
 	if err != nil {
 		t.Fatalf("Error deleting server: %v", err)
 	}
 
 	// Validate that the Server was deleted
-	if _, err := serverResource.Get("/Common/test-server"); err == nil {
+	if _, err := serverResource.Get(context.Background(), "/Common/test-server"); err == nil {
 		t.Error("Server still exists after attempting deletion")
 	}
 }
@@ -0,0 +1,34 @@
+package gtm
+
+import (
+	"errors"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/transport"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestSession returns a session whose responses are replayed from the cassette
+// testdata/<test name>.json, so that the test runs without a device. The test fails
+// if the cassette was not recorded yet. With BIGIP_RECORD=1, the requests are sent to the
+// device at host and the cassette is recorded again.
+func newTestSession(t *testing.T, host, username, password string) (*bigip.BigIP, error) {
+	t.Helper()
+	cassette := filepath.Join("testdata", t.Name()+".json")
+	mode := transport.RecorderModeFromEnv()
+	if _, err := os.Stat(cassette); mode == transport.ModeReplay && errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("%s is not recorded, set %s=1 to record it against %s", cassette, transport.EnvRecord, host)
+	}
+	rec, err := transport.NewRecorder(cassette, mode)
+	if err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() {
+		if err := rec.Stop(); err != nil {
+			t.Errorf("failed to save %s: %v", cassette, err)
+		}
+	})
+	return bigip.NewSession(host, username, password, bigip.WithWrapTransport(rec.Wrap))
+}
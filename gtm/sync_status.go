@@ -24,10 +24,10 @@ type SyncStatusResource struct {
 }
 
 // List retrieves all SyncStatus details.
-func (r *SyncStatusResource) Show() (*SyncStatus, error) {
+func (r *SyncStatusResource) Show(ctx context.Context) (*SyncStatus, error) {
 	var items SyncStatus
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(SyncStatusEndpoint).DoRaw(context.Background())
+		Resource(SyncStatusEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
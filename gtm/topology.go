@@ -2,10 +2,8 @@ package gtm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // TopologyList holds a list of Topology configuration.
@@ -34,72 +32,37 @@ type TopologyResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Topology details.
-func (r *TopologyResource) List() (*TopologyList, error) {
-	var items TopologyList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TopologyResource.
+func (r *TopologyResource) collection() *bigip.Collection[Topology, TopologyList] {
+	return bigip.NewCollection[Topology, TopologyList](r.b, GTMManager, TopologyEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Topology details.
+func (r *TopologyResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TopologyList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Topology by node name.
-func (r *TopologyResource) Get(name string) (*Topology, error) {
-	var item Topology
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *TopologyResource) Get(ctx context.Context, name string) (*Topology, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Topology item.
-func (r *TopologyResource) Create(item Topology) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TopologyResource) Create(ctx context.Context, item Topology) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Topology item identified by the Topology name.
-func (r *TopologyResource) Update(name string, item Topology) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TopologyResource) Update(ctx context.Context, name string, item Topology) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Topology identified by the Topology name. If it does not exist, return an error.
-func (r *TopologyResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(TopologyEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Topology identified by name, see bigip.PatchBody.
+func (r *TopologyResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Topology identified by the Topology name. If it does not exist, return an error.
+func (r *TopologyResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
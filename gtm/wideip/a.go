@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // WideipList holds a list of WideipA configuration.
@@ -56,73 +56,39 @@ type AResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all A record details.
-func (r *AResource) List() (*WideipList, error) {
-	var items WideipList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing AResource.
+func (r *AResource) collection() *bigip.Collection[Wideip, WideipList] {
+	return bigip.NewCollection[Wideip, WideipList](r.b, GTMManager, WideipEndpoint, AEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all A record details.
+func (r *AResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single A record by node name.
-func (r *AResource) Get(name string) (*Wideip, error) {
-	var item Wideip
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *AResource) Get(ctx context.Context, name string) (*Wideip, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new A record item.
-func (r *AResource) Create(item Wideip) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AResource) Create(ctx context.Context, item Wideip) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the A record item identified by the A record name.
-func (r *AResource) Update(name string, item Wideip) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AResource) Update(ctx context.Context, name string, item Wideip) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+func (r *AResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single A record identified by the A record name. If it does not exist, return an error.
-func (r *AResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *AResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
 // ShowAStats retrieves the statistics for a single A record with the given name.
@@ -132,11 +98,15 @@ func (r *AResource) Delete(name string) error {
 // Returns:
 //   - *WideipList: Pointer to a structure containing the list of wide IP A records and their statistic details.
 //   - error: If an error occurs during the operation, it will be returned.
-func (r *AResource) ShowAStats(name string) (*WideipList, error) {
+func (r *AResource) ShowAStats(ctx context.Context, name string) (*WideipList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var item WideipList
 
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).ResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(WideipEndpoint).SubResource(AEndpoint).ResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,10 +120,10 @@ func (r *AResource) ShowAStats(name string) (*WideipList, error) {
 // Returns:
 //   - *WideipList: Pointer to a structure containing the list of all wide IP A records and their statistic details.
 //   - error: If an error occurs during the operation, it will be returned.
-func (r *AResource) ShowAllAStats() (*WideipList, error) {
+func (r *AResource) ShowAllAStats(ctx context.Context) (*WideipList, error) {
 	var item WideipList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(AEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(WideipEndpoint).SubResource(AEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,161 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package wideip
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// AAAAAPI is the interface of AAAAResource, which mocks implement in tests.
+type AAAAAPI interface {
+	// List retrieves all AAAA details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error)
+	// Get retrieves the details of a single AAAA by node name.
+	Get(ctx context.Context, name string) (*Wideip, error)
+	// Create creates a new AAAA item.
+	Create(ctx context.Context, item Wideip) error
+	// Update modifies the AAAA item identified by the AAAA name.
+	Update(ctx context.Context, name string, item Wideip) error
+	// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single AAAA identified by the AAAA name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// ShowAAAAStats retrieves the statistics for a single AAAA record with the given name.
+	ShowAAAAStats(ctx context.Context, name string) (*WideipList, error)
+	// ShowAllAAAAStats retrieves the statistics for all AAAA records in the system.
+	ShowAllAAAAStats(ctx context.Context) (*WideipList, error)
+}
+
+var _ AAAAAPI = &AAAAResource{}
+
+// AAPI is the interface of AResource, which mocks implement in tests.
+type AAPI interface {
+	// List retrieves all A record details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error)
+	// Get retrieves the details of a single A record by node name.
+	Get(ctx context.Context, name string) (*Wideip, error)
+	// Create creates a new A record item.
+	Create(ctx context.Context, item Wideip) error
+	// Update modifies the A record item identified by the A record name.
+	Update(ctx context.Context, name string, item Wideip) error
+	// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single A record identified by the A record name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// ShowAStats retrieves the statistics for a single A record with the given name.
+	//
+	// 	name: The name of the A record for which you want to retrieve statistics.
+	//
+	// Returns:
+	//   - *WideipList: Pointer to a structure containing the list of wide IP A records and their statistic details.
+	//   - error: If an error occurs during the operation, it will be returned.
+	ShowAStats(ctx context.Context, name string) (*WideipList, error)
+	// ShowAllAStats retrieves the statistics for all A records in the system.
+	// Returns:
+	//   - *WideipList: Pointer to a structure containing the list of all wide IP A records and their statistic details.
+	//   - error: If an error occurs during the operation, it will be returned.
+	ShowAllAStats(ctx context.Context) (*WideipList, error)
+}
+
+var _ AAPI = &AResource{}
+
+// CNAMEAPI is the interface of CNAMEResource, which mocks implement in tests.
+type CNAMEAPI interface {
+	// List retrieves all CNAME details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error)
+	// Get retrieves the details of a single CNAME by node name.
+	Get(ctx context.Context, name string) (*Wideip, error)
+	// Create creates a new CNAME item.
+	Create(ctx context.Context, item Wideip) error
+	// Update modifies the CNAME item identified by the CNAME name.
+	Update(ctx context.Context, name string, item Wideip) error
+	// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single CNAME identified by the CNAME name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// ShowCNAMEStats retrieves the statistics for a single CNAME record with the given name.
+	ShowCNAMEStats(ctx context.Context, name string) (*WideipList, error)
+	// ShowAllCNAMEStats retrieves the statistics for all CNAME records in the system.
+	ShowAllCNAMEStats(ctx context.Context) (*WideipList, error)
+}
+
+var _ CNAMEAPI = &CNAMEResource{}
+
+// MXAPI is the interface of MXResource, which mocks implement in tests.
+type MXAPI interface {
+	// List retrieves all MX details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error)
+	// Get retrieves the details of a single MX by node name.
+	Get(ctx context.Context, name string) (*Wideip, error)
+	// Create creates a new MX item.
+	Create(ctx context.Context, item Wideip) error
+	// Update modifies the MX item identified by the MX name.
+	Update(ctx context.Context, name string, item Wideip) error
+	// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single MX identified by the MX name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// ShowMXStats retrieves the statistics for a single MX record with the given name.
+	ShowMXStats(ctx context.Context, name string) (*WideipList, error)
+	// ShowAllMXStats retrieves the statistics for all MX records in the system.
+	ShowAllMXStats(ctx context.Context) (*WideipList, error)
+}
+
+var _ MXAPI = &MXResource{}
+
+// NAPTRAPI is the interface of NAPTRResource, which mocks implement in tests.
+type NAPTRAPI interface {
+	// List retrieves all NAPTR details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error)
+	// Get retrieves the details of a single NAPTR by node name.
+	Get(ctx context.Context, name string) (*Wideip, error)
+	// Create creates a new NAPTR item.
+	Create(ctx context.Context, item Wideip) error
+	// Update modifies the NAPTR item identified by the NAPTR name.
+	Update(ctx context.Context, name string, item Wideip) error
+	// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single NAPTR identified by the NAPTR name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// ShowNAPTRStats retrieves the statistics for a single NAPTR record with the given name.
+	ShowNAPTRStats(ctx context.Context, name string) (*WideipList, error)
+	// ShowAllNAPTRAStats retrieves the statistics for all NAPTR records in the system.
+	ShowAllNAPTRStats(ctx context.Context) (*WideipList, error)
+}
+
+var _ NAPTRAPI = &NAPTRResource{}
+
+// SRVAPI is the interface of SRVResource, which mocks implement in tests.
+type SRVAPI interface {
+	// List retrieves all SRV details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error)
+	// Get retrieves the details of a single SRV by node name.
+	Get(ctx context.Context, name string) (*Wideip, error)
+	// Create creates a new SRV item.
+	Create(ctx context.Context, item Wideip) error
+	// Update modifies the SRV item identified by the SRV name.
+	Update(ctx context.Context, name string, item Wideip) error
+	// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single SRV identified by the SRV name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+	// ShowSRVStats retrieves the statistics for a single SRV record with the given name.
+	ShowSRVStats(ctx context.Context, name string) (*WideipList, error)
+	// ShowAllSRVStats retrieves the statistics for all SRV records in the system.
+	ShowAllSRVStats(ctx context.Context) (*WideipList, error)
+}
+
+var _ SRVAPI = &SRVResource{}
+
+// WideipAPI is the interface of WideipResource, which mocks implement in tests.
+type WideipAPI interface {
+	A() AAPI
+	AAAA() AAAAAPI
+	CNAME() CNAMEAPI
+	MX() MXAPI
+	NAPTR() NAPTRAPI
+	SRV() SRVAPI
+}
+
+var _ WideipAPI = &WideipResource{}
@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // MXEndpoint represents the REST resource for managing MX.
@@ -16,82 +16,51 @@ type MXResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all MX details.
-func (r *MXResource) List() (*WideipList, error) {
-	var items WideipList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing MXResource.
+func (r *MXResource) collection() *bigip.Collection[Wideip, WideipList] {
+	return bigip.NewCollection[Wideip, WideipList](r.b, GTMManager, WideipEndpoint, MXEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all MX details.
+func (r *MXResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single MX by node name.
-func (r *MXResource) Get(name string) (*Wideip, error) {
-	var item Wideip
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *MXResource) Get(ctx context.Context, name string) (*Wideip, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new MX item.
-func (r *MXResource) Create(item Wideip) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MXResource) Create(ctx context.Context, item Wideip) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the MX item identified by the MX name.
-func (r *MXResource) Update(name string, item Wideip) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *MXResource) Update(ctx context.Context, name string, item Wideip) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single MX identified by the MX name. If it does not exist, return an error.
-func (r *MXResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+func (r *MXResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single MX identified by the MX name. If it does not exist, return an error.
+func (r *MXResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
 // ShowMXStats retrieves the statistics for a single MX record with the given name.
-func (r *MXResource) ShowMXStats(name string) (*WideipList, error) {
+func (r *MXResource) ShowMXStats(ctx context.Context, name string) (*WideipList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var item WideipList
 
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).ResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(WideipEndpoint).SubResource(MXEndpoint).ResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +71,10 @@ func (r *MXResource) ShowMXStats(name string) (*WideipList, error) {
 }
 
 // ShowAllMXStats retrieves the statistics for all MX records in the system.
-func (r *MXResource) ShowAllMXStats() (*WideipList, error) {
+func (r *MXResource) ShowAllMXStats(ctx context.Context) (*WideipList, error) {
 	var item WideipList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(MXEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(WideipEndpoint).SubResource(MXEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // NAPTREndpoint represents the REST resource for managing NAPTR.
@@ -17,82 +17,51 @@ type NAPTRResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all NAPTR details.
-func (r *NAPTRResource) List() (*WideipList, error) {
-	var items WideipList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing NAPTRResource.
+func (r *NAPTRResource) collection() *bigip.Collection[Wideip, WideipList] {
+	return bigip.NewCollection[Wideip, WideipList](r.b, GTMManager, WideipEndpoint, NAPTREndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all NAPTR details.
+func (r *NAPTRResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WideipList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single NAPTR by node name.
-func (r *NAPTRResource) Get(name string) (*Wideip, error) {
-	var item Wideip
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *NAPTRResource) Get(ctx context.Context, name string) (*Wideip, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new NAPTR item.
-func (r *NAPTRResource) Create(item Wideip) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NAPTRResource) Create(ctx context.Context, item Wideip) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the NAPTR item identified by the NAPTR name.
-func (r *NAPTRResource) Update(name string, item Wideip) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *NAPTRResource) Update(ctx context.Context, name string, item Wideip) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single NAPTR identified by the NAPTR name. If it does not exist, return an error.
-func (r *NAPTRResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Wideip identified by name, see bigip.PatchBody.
+func (r *NAPTRResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single NAPTR identified by the NAPTR name. If it does not exist, return an error.
+func (r *NAPTRResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
 
 // ShowNAPTRStats retrieves the statistics for a single NAPTR record with the given name.
-func (r *NAPTRResource) ShowNAPTRStats(name string) (*WideipList, error) {
+func (r *NAPTRResource) ShowNAPTRStats(ctx context.Context, name string) (*WideipList, error) {
+	name, err := r.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var item WideipList
 
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).ResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(WideipEndpoint).SubResource(NAPTREndpoint).ResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -103,10 +72,10 @@ func (r *NAPTRResource) ShowNAPTRStats(name string) (*WideipList, error) {
 }
 
 // ShowAllNAPTRAStats retrieves the statistics for all NAPTR records in the system.
-func (r *NAPTRResource) ShowAllNAPTRStats() (*WideipList, error) {
+func (r *NAPTRResource) ShowAllNAPTRStats(ctx context.Context) (*WideipList, error) {
 	var item WideipList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(GTMManager).
-		Resource(WideipEndpoint).SubResource(NAPTREndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(WideipEndpoint).SubResource(NAPTREndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package wideip
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 const WideipEndpoint = "wideip"
@@ -25,26 +27,26 @@ func NewWideip(b *bigip.BigIP) WideipResource {
 	}
 }
 
-func (w WideipResource) A() *AResource {
+func (w WideipResource) A() AAPI {
 	return &w.a
 }
 
-func (w WideipResource) AAAA() *AAAAResource {
+func (w WideipResource) AAAA() AAAAAPI {
 	return &w.aaaa
 }
 
-func (w WideipResource) CNAME() *CNAMEResource {
+func (w WideipResource) CNAME() CNAMEAPI {
 	return &w.cname
 }
 
-func (w WideipResource) MX() *MXResource {
+func (w WideipResource) MX() MXAPI {
 	return &w.mx
 }
 
-func (w WideipResource) NAPTR() *NAPTRResource {
+func (w WideipResource) NAPTR() NAPTRAPI {
 	return &w.naptr
 }
 
-func (w WideipResource) SRV() *SRVResource {
+func (w WideipResource) SRV() SRVAPI {
 	return &w.srv
 }
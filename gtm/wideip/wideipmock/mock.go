@@ -0,0 +1,614 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package wideipmock provides mocks of the interfaces of package wideip, which record their calls.
+package wideipmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/gtm/wideip"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// AAAAAPI is a mock of wideip.AAAAAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type AAAAAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc             func(context.Context, ...*rest.ListOptions) (*wideip.WideipList, error)
+	GetFunc              func(context.Context, string) (*wideip.Wideip, error)
+	CreateFunc           func(context.Context, wideip.Wideip) error
+	UpdateFunc           func(context.Context, string, wideip.Wideip) error
+	PatchFunc            func(context.Context, string, interface{}) error
+	DeleteFunc           func(context.Context, string) error
+	ShowAAAAStatsFunc    func(context.Context, string) (*wideip.WideipList, error)
+	ShowAllAAAAStatsFunc func(context.Context) (*wideip.WideipList, error)
+}
+
+var _ wideip.AAAAAPI = &AAAAAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *AAAAAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *AAAAAPI) Get(ctx context.Context, name string) (r0 *wideip.Wideip, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *AAAAAPI) Create(ctx context.Context, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *AAAAAPI) Update(ctx context.Context, name string, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *AAAAAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *AAAAAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAAAAStats records the call and calls ShowAAAAStatsFunc if it is set.
+func (m *AAAAAPI) ShowAAAAStats(ctx context.Context, name string) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAAAAStats", ctx, name)
+	if m.ShowAAAAStatsFunc != nil {
+		return m.ShowAAAAStatsFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAllAAAAStats records the call and calls ShowAllAAAAStatsFunc if it is set.
+func (m *AAAAAPI) ShowAllAAAAStats(ctx context.Context) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAllAAAAStats", ctx)
+	if m.ShowAllAAAAStatsFunc != nil {
+		return m.ShowAllAAAAStatsFunc(ctx)
+	}
+	return
+}
+
+// AAPI is a mock of wideip.AAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type AAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc          func(context.Context, ...*rest.ListOptions) (*wideip.WideipList, error)
+	GetFunc           func(context.Context, string) (*wideip.Wideip, error)
+	CreateFunc        func(context.Context, wideip.Wideip) error
+	UpdateFunc        func(context.Context, string, wideip.Wideip) error
+	PatchFunc         func(context.Context, string, interface{}) error
+	DeleteFunc        func(context.Context, string) error
+	ShowAStatsFunc    func(context.Context, string) (*wideip.WideipList, error)
+	ShowAllAStatsFunc func(context.Context) (*wideip.WideipList, error)
+}
+
+var _ wideip.AAPI = &AAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *AAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *AAPI) Get(ctx context.Context, name string) (r0 *wideip.Wideip, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *AAPI) Create(ctx context.Context, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *AAPI) Update(ctx context.Context, name string, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *AAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *AAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAStats records the call and calls ShowAStatsFunc if it is set.
+func (m *AAPI) ShowAStats(ctx context.Context, name string) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAStats", ctx, name)
+	if m.ShowAStatsFunc != nil {
+		return m.ShowAStatsFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAllAStats records the call and calls ShowAllAStatsFunc if it is set.
+func (m *AAPI) ShowAllAStats(ctx context.Context) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAllAStats", ctx)
+	if m.ShowAllAStatsFunc != nil {
+		return m.ShowAllAStatsFunc(ctx)
+	}
+	return
+}
+
+// CNAMEAPI is a mock of wideip.CNAMEAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CNAMEAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc              func(context.Context, ...*rest.ListOptions) (*wideip.WideipList, error)
+	GetFunc               func(context.Context, string) (*wideip.Wideip, error)
+	CreateFunc            func(context.Context, wideip.Wideip) error
+	UpdateFunc            func(context.Context, string, wideip.Wideip) error
+	PatchFunc             func(context.Context, string, interface{}) error
+	DeleteFunc            func(context.Context, string) error
+	ShowCNAMEStatsFunc    func(context.Context, string) (*wideip.WideipList, error)
+	ShowAllCNAMEStatsFunc func(context.Context) (*wideip.WideipList, error)
+}
+
+var _ wideip.CNAMEAPI = &CNAMEAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CNAMEAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CNAMEAPI) Get(ctx context.Context, name string) (r0 *wideip.Wideip, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CNAMEAPI) Create(ctx context.Context, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CNAMEAPI) Update(ctx context.Context, name string, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CNAMEAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CNAMEAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ShowCNAMEStats records the call and calls ShowCNAMEStatsFunc if it is set.
+func (m *CNAMEAPI) ShowCNAMEStats(ctx context.Context, name string) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowCNAMEStats", ctx, name)
+	if m.ShowCNAMEStatsFunc != nil {
+		return m.ShowCNAMEStatsFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAllCNAMEStats records the call and calls ShowAllCNAMEStatsFunc if it is set.
+func (m *CNAMEAPI) ShowAllCNAMEStats(ctx context.Context) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAllCNAMEStats", ctx)
+	if m.ShowAllCNAMEStatsFunc != nil {
+		return m.ShowAllCNAMEStatsFunc(ctx)
+	}
+	return
+}
+
+// MXAPI is a mock of wideip.MXAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MXAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc           func(context.Context, ...*rest.ListOptions) (*wideip.WideipList, error)
+	GetFunc            func(context.Context, string) (*wideip.Wideip, error)
+	CreateFunc         func(context.Context, wideip.Wideip) error
+	UpdateFunc         func(context.Context, string, wideip.Wideip) error
+	PatchFunc          func(context.Context, string, interface{}) error
+	DeleteFunc         func(context.Context, string) error
+	ShowMXStatsFunc    func(context.Context, string) (*wideip.WideipList, error)
+	ShowAllMXStatsFunc func(context.Context) (*wideip.WideipList, error)
+}
+
+var _ wideip.MXAPI = &MXAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MXAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *MXAPI) Get(ctx context.Context, name string) (r0 *wideip.Wideip, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *MXAPI) Create(ctx context.Context, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MXAPI) Update(ctx context.Context, name string, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *MXAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *MXAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ShowMXStats records the call and calls ShowMXStatsFunc if it is set.
+func (m *MXAPI) ShowMXStats(ctx context.Context, name string) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowMXStats", ctx, name)
+	if m.ShowMXStatsFunc != nil {
+		return m.ShowMXStatsFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAllMXStats records the call and calls ShowAllMXStatsFunc if it is set.
+func (m *MXAPI) ShowAllMXStats(ctx context.Context) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAllMXStats", ctx)
+	if m.ShowAllMXStatsFunc != nil {
+		return m.ShowAllMXStatsFunc(ctx)
+	}
+	return
+}
+
+// NAPTRAPI is a mock of wideip.NAPTRAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NAPTRAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc              func(context.Context, ...*rest.ListOptions) (*wideip.WideipList, error)
+	GetFunc               func(context.Context, string) (*wideip.Wideip, error)
+	CreateFunc            func(context.Context, wideip.Wideip) error
+	UpdateFunc            func(context.Context, string, wideip.Wideip) error
+	PatchFunc             func(context.Context, string, interface{}) error
+	DeleteFunc            func(context.Context, string) error
+	ShowNAPTRStatsFunc    func(context.Context, string) (*wideip.WideipList, error)
+	ShowAllNAPTRStatsFunc func(context.Context) (*wideip.WideipList, error)
+}
+
+var _ wideip.NAPTRAPI = &NAPTRAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NAPTRAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NAPTRAPI) Get(ctx context.Context, name string) (r0 *wideip.Wideip, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NAPTRAPI) Create(ctx context.Context, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NAPTRAPI) Update(ctx context.Context, name string, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NAPTRAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NAPTRAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ShowNAPTRStats records the call and calls ShowNAPTRStatsFunc if it is set.
+func (m *NAPTRAPI) ShowNAPTRStats(ctx context.Context, name string) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowNAPTRStats", ctx, name)
+	if m.ShowNAPTRStatsFunc != nil {
+		return m.ShowNAPTRStatsFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAllNAPTRStats records the call and calls ShowAllNAPTRStatsFunc if it is set.
+func (m *NAPTRAPI) ShowAllNAPTRStats(ctx context.Context) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAllNAPTRStats", ctx)
+	if m.ShowAllNAPTRStatsFunc != nil {
+		return m.ShowAllNAPTRStatsFunc(ctx)
+	}
+	return
+}
+
+// SRVAPI is a mock of wideip.SRVAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SRVAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc            func(context.Context, ...*rest.ListOptions) (*wideip.WideipList, error)
+	GetFunc             func(context.Context, string) (*wideip.Wideip, error)
+	CreateFunc          func(context.Context, wideip.Wideip) error
+	UpdateFunc          func(context.Context, string, wideip.Wideip) error
+	PatchFunc           func(context.Context, string, interface{}) error
+	DeleteFunc          func(context.Context, string) error
+	ShowSRVStatsFunc    func(context.Context, string) (*wideip.WideipList, error)
+	ShowAllSRVStatsFunc func(context.Context) (*wideip.WideipList, error)
+}
+
+var _ wideip.SRVAPI = &SRVAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SRVAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SRVAPI) Get(ctx context.Context, name string) (r0 *wideip.Wideip, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SRVAPI) Create(ctx context.Context, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SRVAPI) Update(ctx context.Context, name string, item wideip.Wideip) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SRVAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SRVAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ShowSRVStats records the call and calls ShowSRVStatsFunc if it is set.
+func (m *SRVAPI) ShowSRVStats(ctx context.Context, name string) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowSRVStats", ctx, name)
+	if m.ShowSRVStatsFunc != nil {
+		return m.ShowSRVStatsFunc(ctx, name)
+	}
+	return
+}
+
+// ShowAllSRVStats records the call and calls ShowAllSRVStatsFunc if it is set.
+func (m *SRVAPI) ShowAllSRVStats(ctx context.Context) (r0 *wideip.WideipList, r1 error) {
+	m.CallRecorder.Record("ShowAllSRVStats", ctx)
+	if m.ShowAllSRVStatsFunc != nil {
+		return m.ShowAllSRVStatsFunc(ctx)
+	}
+	return
+}
+
+// WideipAPI is a mock of wideip.WideipAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WideipAPI struct {
+	bigiptest.CallRecorder
+
+	AFunc     func() wideip.AAPI
+	AAAAFunc  func() wideip.AAAAAPI
+	CNAMEFunc func() wideip.CNAMEAPI
+	MXFunc    func() wideip.MXAPI
+	NAPTRFunc func() wideip.NAPTRAPI
+	SRVFunc   func() wideip.SRVAPI
+}
+
+var _ wideip.WideipAPI = &WideipAPI{}
+
+// A records the call and calls AFunc if it is set.
+func (m *WideipAPI) A() (r0 wideip.AAPI) {
+	m.CallRecorder.Record("A")
+	if m.AFunc != nil {
+		return m.AFunc()
+	}
+	return
+}
+
+// AAAA records the call and calls AAAAFunc if it is set.
+func (m *WideipAPI) AAAA() (r0 wideip.AAAAAPI) {
+	m.CallRecorder.Record("AAAA")
+	if m.AAAAFunc != nil {
+		return m.AAAAFunc()
+	}
+	return
+}
+
+// CNAME records the call and calls CNAMEFunc if it is set.
+func (m *WideipAPI) CNAME() (r0 wideip.CNAMEAPI) {
+	m.CallRecorder.Record("CNAME")
+	if m.CNAMEFunc != nil {
+		return m.CNAMEFunc()
+	}
+	return
+}
+
+// MX records the call and calls MXFunc if it is set.
+func (m *WideipAPI) MX() (r0 wideip.MXAPI) {
+	m.CallRecorder.Record("MX")
+	if m.MXFunc != nil {
+		return m.MXFunc()
+	}
+	return
+}
+
+// NAPTR records the call and calls NAPTRFunc if it is set.
+func (m *WideipAPI) NAPTR() (r0 wideip.NAPTRAPI) {
+	m.CallRecorder.Record("NAPTR")
+	if m.NAPTRFunc != nil {
+		return m.NAPTRFunc()
+	}
+	return
+}
+
+// SRV records the call and calls SRVFunc if it is set.
+func (m *WideipAPI) SRV() (r0 wideip.SRVAPI) {
+	m.CallRecorder.Record("SRV")
+	if m.SRVFunc != nil {
+		return m.SRVFunc()
+	}
+	return
+}
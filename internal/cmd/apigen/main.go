@@ -0,0 +1,498 @@
+// Command apigen generates the interfaces of the resources of a package and their mocks.
+//
+// Run in the directory of a package, usually with go generate, it writes:
+//
+//   - api.go, which declares an interface XAPI with the exported methods of every type
+//     XResource of the package, and of the types given with -types, such as LTM;
+//   - <package>mock/mock.go, which declares a mock of every interface, recording its
+//     calls with bigiptest.CallRecorder.
+//
+// Usage:
+//
+//	//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen -types LTM
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// header marks the generated files, which are skipped when the package is parsed.
+const header = "// Code generated by apigen. DO NOT EDIT.\n\n"
+
+// predeclared lists the predeclared types, which are not qualified in mocks.
+var predeclared = map[string]bool{
+	"any": true, "bool": true, "byte": true, "comparable": true, "complex64": true, "complex128": true,
+	"error": true, "float32": true, "float64": true, "int": true, "int8": true, "int16": true,
+	"int32": true, "int64": true, "rune": true, "string": true, "uint": true, "uint8": true,
+	"uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+}
+
+// method is an exported method of a resource.
+type method struct {
+	name    string
+	doc     string
+	params  []field
+	results []field
+	// variadic is set if the last parameter is variadic.
+	variadic bool
+}
+
+// field is a parameter or a result, with its type printed for the package itself and for its mock.
+type field struct {
+	// name is the name of the field in the mock, and orig its name in the package, if any.
+	name      string
+	orig      string
+	typ       string
+	qualified string
+}
+
+// api is the interface of a type.
+type api struct {
+	typeName string
+	name     string
+	methods  []method
+}
+
+func main() {
+	types := flag.String("types", "", "comma separated list of other types to generate an interface for")
+	flag.Parse()
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	extra := map[string]bool{}
+	for _, name := range strings.Split(*types, ",") {
+		if name != "" {
+			extra[name] = true
+		}
+	}
+	files, err := generate(dir, extra)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for filename, src := range files {
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filename, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the generated files of the package in dir, by file name.
+func generate(dir string, extra map[string]bool) (map[string][]byte, error) {
+	importPath, err := packageImportPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	pkgName, apis, imports, err := parsePackage(dir, extra)
+	if err != nil {
+		return nil, err
+	}
+	if len(apis) == 0 {
+		return nil, fmt.Errorf("no resource found in %s", dir)
+	}
+
+	mockName := strings.NewReplacer("_", "", "-", "").Replace(pkgName) + "mock"
+	files := map[string][]byte{
+		filepath.Join(dir, "api.go"):            interfaces(pkgName, apis, imports),
+		filepath.Join(dir, mockName, "mock.go"): mocks(mockName, pkgName, importPath, apis, imports),
+	}
+	for filename, src := range files {
+		formatted, err := format.Source(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w", filename, err)
+		}
+		files[filename] = formatted
+	}
+	return files, nil
+}
+
+// packageImportPath returns the import path of the package in dir, from the go.mod of its module.
+func packageImportPath(dir string) (string, error) {
+	for root := dir; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if module := strings.TrimPrefix(line, "module "); module != line {
+					rel, err := filepath.Rel(root, dir)
+					if err != nil {
+						return "", err
+					}
+					return strings.TrimSpace(module) + "/" + filepath.ToSlash(rel), nil
+				}
+			}
+			return "", fmt.Errorf("no module in %s", filepath.Join(root, "go.mod"))
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("no go.mod found for %s", dir)
+		}
+	}
+}
+
+// parsePackage returns the name of the package in dir, the interfaces of its resources and
+// the import paths of the packages used in their methods, by name.
+func parsePackage(dir string, extra map[string]bool) (string, []*api, map[string]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if len(pkgs) != 1 {
+		return "", nil, nil, fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	var filenames []string
+	for filename := range pkg.Files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	// The resources are the struct types named XResource and the extra types.
+	apis := map[string]*api{}
+	for _, filename := range filenames {
+		file := pkg.Files[filename]
+		if generated(file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if _, ok := ts.Type.(*ast.StructType); !ok || ts.TypeParams != nil {
+					continue
+				}
+				name := ts.Name.Name
+				switch {
+				case extra[name]:
+					apis[name] = &api{typeName: name, name: name + "API"}
+				case strings.HasSuffix(name, "Resource") && ast.IsExported(name):
+					apis[name] = &api{typeName: name, name: strings.TrimSuffix(name, "Resource") + "API"}
+				}
+			}
+		}
+	}
+
+	imports := map[string]string{}
+	for _, filename := range filenames {
+		file := pkg.Files[filename]
+		if generated(file) {
+			continue
+		}
+		fileImports := map[string]string{}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := importName(path)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			fileImports[name] = path
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			ident, ok := recv.(*ast.Ident)
+			if !ok || apis[ident.Name] == nil {
+				continue
+			}
+			m := method{name: fn.Name.Name, doc: fn.Doc.Text()}
+			m.params, m.variadic = fields(fset, pkg.Name, fn.Type.Params, "p", fileImports, imports)
+			m.results, _ = fields(fset, pkg.Name, fn.Type.Results, "r", fileImports, imports)
+			apis[ident.Name].methods = append(apis[ident.Name].methods, m)
+		}
+	}
+
+	var sorted []*api
+	for _, a := range apis {
+		if len(a.methods) != 0 {
+			sorted = append(sorted, a)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	return pkg.Name, sorted, imports, nil
+}
+
+// generated reports whether file was written by apigen.
+func generated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() < file.Package && strings.HasPrefix(group.Text(), "Code generated by apigen.") {
+			return true
+		}
+	}
+	return false
+}
+
+// fields returns the parameters or results of a method, named with prefix and their
+// position, and adds the packages they use to imports.
+func fields(fset *token.FileSet, pkgName string, list *ast.FieldList, prefix string, fileImports, imports map[string]string) ([]field, bool) {
+	if list == nil {
+		return nil, false
+	}
+	var result []field
+	variadic := false
+	for _, f := range list.List {
+		typ := printNode(fset, f.Type)
+		_, variadic = f.Type.(*ast.Ellipsis)
+
+		// The mock is in another package, so the types of the package are qualified.
+		qualify(f.Type, pkgName, fileImports, imports)
+		qualified := printNode(fset, f.Type)
+
+		count := len(f.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			p := field{name: fmt.Sprintf("%s%d", prefix, len(result)), typ: typ, qualified: qualified}
+			if i < len(f.Names) {
+				p.orig = f.Names[i].Name
+				// The receiver of the mock is m.
+				if prefix == "p" && p.orig != "_" && p.orig != "m" {
+					p.name = p.orig
+				}
+			}
+			result = append(result, p)
+		}
+	}
+	return result, variadic
+}
+
+// qualify prefixes the types of the package used in expr with its name, and adds the
+// other packages used in expr to imports.
+func qualify(expr ast.Expr, pkgName string, fileImports, imports map[string]string) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			// The names of the parameters of a function type are not types.
+			qualify(n.Type, pkgName, fileImports, imports)
+			return false
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok && fileImports[x.Name] != "" {
+				imports[x.Name] = fileImports[x.Name]
+			}
+			return false
+		case *ast.Ident:
+			if !predeclared[n.Name] {
+				n.Name = pkgName + "." + n.Name
+			}
+		}
+		return true
+	})
+}
+
+func printNode(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	format.Node(&buf, fset, node)
+	return buf.String()
+}
+
+// interfaces returns the source of api.go.
+func interfaces(pkgName string, apis []*api, imports map[string]string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	writeImports(&buf, usedImports(apis, imports, false))
+	for _, a := range apis {
+		fmt.Fprintf(&buf, "// %s is the interface of %s, which mocks implement in tests.\n", a.name, a.typeName)
+		fmt.Fprintf(&buf, "type %s interface {\n", a.name)
+		for _, m := range a.methods {
+			writeDoc(&buf, m.doc)
+			fmt.Fprintf(&buf, "%s(%s) %s\n", m.name, signature(m.params, false, true), results(m.results, false))
+		}
+		fmt.Fprintf(&buf, "}\n\nvar _ %s = &%s{}\n\n", a.name, a.typeName)
+	}
+	return buf.Bytes()
+}
+
+// mocks returns the source of the mock package.
+func mocks(mockName, pkgName, importPath string, apis []*api, imports map[string]string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "// Package %s provides mocks of the interfaces of package %s, which record their calls.\n", mockName, pkgName)
+	fmt.Fprintf(&buf, "package %s\n\n", mockName)
+	used := usedImports(apis, imports, true)
+	used[pkgName] = importPath
+	used["bigiptest"] = "github.com/lefeck/go-bigip/bigiptest"
+	writeImports(&buf, used)
+	for _, a := range apis {
+		fmt.Fprintf(&buf, "// %s is a mock of %s.%s. Its methods record their calls and call the function\n", a.name, pkgName, a.name)
+		fmt.Fprintf(&buf, "// of the same name with a Func suffix if it is set, or return zero values.\n")
+		fmt.Fprintf(&buf, "type %s struct {\nbigiptest.CallRecorder\n\n", a.name)
+		for _, m := range a.methods {
+			fmt.Fprintf(&buf, "%sFunc func(%s) %s\n", m.name, signature(m.params, true, false), results(m.results, true))
+		}
+		fmt.Fprintf(&buf, "}\n\nvar _ %s.%s = &%s{}\n\n", pkgName, a.name, a.name)
+		for _, m := range a.methods {
+			var named []string
+			for _, r := range m.results {
+				named = append(named, r.name+" "+r.qualified)
+			}
+			var args []string
+			for _, p := range m.params {
+				args = append(args, p.name)
+			}
+			call := strings.Join(args, ", ")
+			if m.variadic {
+				call += "..."
+			}
+			fmt.Fprintf(&buf, "// %s records the call and calls %sFunc if it is set.\n", m.name, m.name)
+			fmt.Fprintf(&buf, "func (m *%s) %s(%s) (%s) {\n", a.name, m.name, signature(m.params, true, true), strings.Join(named, ", "))
+			fmt.Fprintf(&buf, "m.CallRecorder.Record(%q", m.name)
+			if len(args) != 0 {
+				fmt.Fprintf(&buf, ", %s", strings.Join(args, ", "))
+			}
+			fmt.Fprintf(&buf, ")\nif m.%sFunc != nil {\n", m.name)
+			if len(m.results) == 0 {
+				fmt.Fprintf(&buf, "m.%sFunc(%s)\n}\nreturn\n}\n\n", m.name, call)
+				continue
+			}
+			fmt.Fprintf(&buf, "return m.%sFunc(%s)\n}\nreturn\n}\n\n", m.name, call)
+		}
+	}
+	return buf.Bytes()
+}
+
+// signature returns a parameter list, with the parameter names if named is set.
+// The names and types are those of the mock if mock is set.
+func signature(params []field, mock, named bool) string {
+	var list []string
+	for _, p := range params {
+		typ, name := p.typ, p.orig
+		if mock {
+			typ, name = p.qualified, p.name
+		}
+		if named && name != "" {
+			typ = name + " " + typ
+		}
+		list = append(list, typ)
+	}
+	return strings.Join(list, ", ")
+}
+
+// results returns a result list without names.
+func results(list []field, qualified bool) string {
+	var types []string
+	for _, r := range list {
+		if qualified {
+			types = append(types, r.qualified)
+		} else {
+			types = append(types, r.typ)
+		}
+	}
+	if len(types) == 1 {
+		return types[0]
+	}
+	if len(types) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+// usedImports returns the imports used by the methods of the interfaces.
+func usedImports(apis []*api, imports map[string]string, qualified bool) map[string]string {
+	used := map[string]string{}
+	for _, a := range apis {
+		for _, m := range a.methods {
+			for _, f := range append(append([]field{}, m.params...), m.results...) {
+				typ := f.typ
+				if qualified {
+					typ = f.qualified
+				}
+				for name, path := range imports {
+					if path != "" && uses(typ, name) {
+						used[name] = path
+					}
+				}
+			}
+		}
+	}
+	return used
+}
+
+// uses reports whether the type typ refers to the package name.
+func uses(typ, name string) bool {
+	for i := strings.Index(typ, name+"."); i >= 0; {
+		if i == 0 || !isIdentChar(typ[i-1]) {
+			return true
+		}
+		next := strings.Index(typ[i+1:], name+".")
+		if next < 0 {
+			break
+		}
+		i += 1 + next
+	}
+	return false
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func writeImports(buf *bytes.Buffer, imports map[string]string) {
+	if len(imports) == 0 {
+		return
+	}
+	var paths []string
+	for name, path := range imports {
+		spec := strconv.Quote(path)
+		if name != importName(path) {
+			spec = name + " " + spec
+		}
+		paths = append(paths, spec)
+	}
+	sort.Slice(paths, func(i, j int) bool { return unquote(paths[i]) < unquote(paths[j]) })
+	buf.WriteString("import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(buf, "%s\n", path)
+	}
+	buf.WriteString(")\n\n")
+}
+
+// importName returns the default name of an imported package, which is the last element of
+// its path without a go- prefix, such as bigip for github.com/lefeck/go-bigip.
+func importName(path string) string {
+	return strings.TrimPrefix(path[strings.LastIndex(path, "/")+1:], "go-")
+}
+
+// unquote returns the path of an import spec.
+func unquote(spec string) string {
+	path, _ := strconv.Unquote(spec[strings.IndexByte(spec, '"'):])
+	return path
+}
+
+func writeDoc(buf *bytes.Buffer, doc string) {
+	for _, line := range strings.Split(strings.TrimSpace(doc), "\n") {
+		if doc != "" {
+			fmt.Fprintf(buf, "// %s\n", line)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// directive matches the go:generate directives running apigen.
+var directive = regexp.MustCompile(`(?m)^//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen(?: -types (\S+))?$`)
+
+// TestGeneratedFilesUpToDate checks that the interfaces and mocks were generated again
+// after the resources changed.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	var packages int
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		match := directive.FindSubmatch(data)
+		if match == nil {
+			return nil
+		}
+		packages++
+		extra := map[string]bool{}
+		for _, name := range strings.Split(string(match[1]), ",") {
+			if name != "" {
+				extra[name] = true
+			}
+		}
+		dir, err := filepath.Abs(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		files, err := generate(dir, extra)
+		if err != nil {
+			return err
+		}
+		for filename, src := range files {
+			current, err := os.ReadFile(filename)
+			if err != nil || !bytes.Equal(current, src) {
+				t.Errorf("%s is out of date, run go generate in %s", filename, dir)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if packages == 0 {
+		t.Fatal("Expected packages with a go:generate directive")
+	}
+}
@@ -0,0 +1,350 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package ltm
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/ltm/monitor"
+	"github.com/lefeck/go-bigip/ltm/profile"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// DataGroupInternalAPI is the interface of DataGroupInternalResource, which mocks implement in tests.
+type DataGroupInternalAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DataGroupInternalList, error)
+	Get(ctx context.Context, fullPathName string) (*DataGroupInternal, error)
+	Create(ctx context.Context, item DataGroupInternal) error
+	Update(ctx context.Context, fullPathName string, item DataGroupInternal) error
+	// Patch updates only the given fields of the DataGroupInternal identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// UpdateIfUnchanged updates the data group identified by fullPathName only if it was not modified since
+	// item was read, see bigip.Collection.UpdateIfUnchanged.
+	UpdateIfUnchanged(ctx context.Context, fullPathName string, item DataGroupInternal) error
+	// Modify reads the data group identified by fullPathName, changes it with mutate and updates it,
+	// starting over if it was modified concurrently, see bigip.Collection.Modify.
+	Modify(ctx context.Context, fullPathName string, mutate func(item *DataGroupInternal) error) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ DataGroupInternalAPI = &DataGroupInternalResource{}
+
+// IFileAPI is the interface of IFileResource, which mocks implement in tests.
+type IFileAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*IFileList, error)
+	Get(ctx context.Context, fullPathName string) (*IFile, error)
+	Create(ctx context.Context, name string, fileObject string) error
+	Edit(ctx context.Context, name string, fileObject string) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ IFileAPI = &IFileResource{}
+
+// LTMAPI is the interface of LTM, which mocks implement in tests.
+type LTMAPI interface {
+	// Virtual returns a VirtualResource ured to query tm/ltm/virtual API.
+	Virtual() VirtualAPI
+	VirtualAddress() VirtualAddressAPI
+	VirtualAddressStats() VirtualAddressStatsAPI
+	VirtualStats() VirtualStatsAPI
+	Pool() PoolAPI
+	SnatPool() SnatPoolAPI
+	Rule() RuleAPI
+	PoolMembers() PoolMembersAPI
+	PoolStats() PoolStatsAPI
+	// Node returns a NodeResource used to query /tm/ltm/node API.
+	Node() NodeAPI
+	NodeStats() NodeStatsAPI
+	TrafficMatchingCriteria() TrafficMatchingCriteriaAPI
+	// IFile returns an IFileResource ured to query /tm/ltm/ifile API.
+	IFile() IFileAPI
+	// DataGroupInternal returns a DataGroupInternalResource ured to query /tm/ltm/data-group/internal API.
+	DataGroupInternal() DataGroupInternalAPI
+	Monitor() monitor.MonitorAPI
+	Profile() profile.ProfileAPI
+}
+
+var _ LTMAPI = &LTM{}
+
+// NodeAPI is the interface of NodeResource, which mocks implement in tests.
+type NodeAPI interface {
+	// List all node details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*NodeList, error)
+	// Pages walks the node collection one page at a time, see bigip.Collection.Pages.
+	Pages(ctx context.Context, fn func(page *bigip.Page[Node]) error, opts ...*rest.ListOptions) error
+	// Each calls fn for every node without loading the whole collection in memory.
+	// Return bigip.ErrStopIteration from fn to stop early.
+	Each(ctx context.Context, fn func(item *Node) error, opts ...*rest.ListOptions) error
+	// Get a single node details by the node name
+	Get(ctx context.Context, name string) (*Node, error)
+	// Create a new node item
+	Create(ctx context.Context, item Node) error
+	// Update the node item identified by the node name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item Node) error
+	// Patch updates only the given fields of the Node identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// UpdateIfUnchanged updates the node identified by name only if it was not modified since
+	// item was read, see bigip.Collection.UpdateIfUnchanged.
+	UpdateIfUnchanged(ctx context.Context, name string, item Node) error
+	// Modify reads the node identified by name, changes it with mutate and updates it,
+	// starting over if it was modified concurrently, see bigip.Collection.Modify.
+	Modify(ctx context.Context, name string, mutate func(item *Node) error) error
+	// Enable a node identified by the node name.
+	Enable(ctx context.Context, name string) error
+	// Disable a node identified by the node name.
+	Disable(ctx context.Context, name string) error
+	// ForceOffline a node identified by the node name.
+	ForceOffline(ctx context.Context, name string) error
+	// Delete a single node identified by the node name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ NodeAPI = &NodeResource{}
+
+// NodeStatsAPI is the interface of NodeStatsResource, which mocks implement in tests.
+type NodeStatsAPI interface {
+	List(ctx context.Context) (*NodeStatsList, error)
+}
+
+var _ NodeStatsAPI = &NodeStatsResource{}
+
+// PoolAPI is the interface of PoolResource, which mocks implement in tests.
+type PoolAPI interface {
+	// lists all the pool instances.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// Pages walks the pool collection one page at a time, see bigip.Collection.Pages.
+	Pages(ctx context.Context, fn func(page *bigip.Page[Pool]) error, opts ...*rest.ListOptions) error
+	// Each calls fn for every pool without loading the whole collection in memory.
+	// Return bigip.ErrStopIteration from fn to stop early.
+	Each(ctx context.Context, fn func(item *Pool) error, opts ...*rest.ListOptions) error
+	// List all the details of the pool, including: profile, policy, etc.
+	ListDetail(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error)
+	// ListVirtualServerName get all virtual server names
+	ListPoolName(ctx context.Context) ([]string, error)
+	// Get a single pool identified by name.
+	Get(ctx context.Context, fullPathName string) (*Pool, error)
+	// Create a new pool instance. If you just create a new pool, for example:
+	//
+	// 	item := Pool{
+	// 		Name:              "pool-demo",
+	// 		LoadBalancingMode: "round-robin",
+	// 		:           "http",
+	//         .....
+	// 	}
+	//
+	// If you create a new pool and also add member， for example:
+	//
+	// 	item := Pool{
+	// 		Name:              "pool-demo",
+	// 		LoadBalancingMode: "round-robin",
+	// 		Members:           []string{"192.13.23.1:90", "128.3.2.53:90"},
+	// 		:           "http",
+	//         ......
+	// 	}
+	Create(ctx context.Context, item Pool) error
+	// update a pool instance identified by name.
+	Update(ctx context.Context, fullPathName string, item Pool) error
+	// Patch updates only the given fields of the Pool identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// UpdateIfUnchanged updates the pool identified by fullPathName only if it was not modified since
+	// item was read, see bigip.Collection.UpdateIfUnchanged.
+	UpdateIfUnchanged(ctx context.Context, fullPathName string, item Pool) error
+	// Modify reads the pool identified by fullPathName, changes it with mutate and updates it,
+	// starting over if it was modified concurrently, see bigip.Collection.Modify.
+	Modify(ctx context.Context, fullPathName string, mutate func(item *Pool) error) error
+	// Delete a single pool instance identified by name.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ PoolAPI = &PoolResource{}
+
+// PoolMembersAPI is the interface of PoolMembersResource, which mocks implement in tests.
+type PoolMembersAPI interface {
+	// lists all the pool members.
+	List(ctx context.Context, pool string) (*PoolMembersList, error)
+	// Get a single pool members identified by pool name and member name.
+	Get(ctx context.Context, poolName string, memberName string) (*PoolMembers, error)
+	// Create a new pool members.
+	Create(ctx context.Context, pool string, item PoolMembers) error
+	// Update a pool members indentified by pool name and member name.
+	Update(ctx context.Context, poolName string, memberName string, item PoolMembers) error
+	// Patch updates only the given fields of a pool member, for example to disable it:
+	//
+	// 	map[string]interface{}{"session": "user-disabled"}
+	//
+	// See bigip.PatchBody for the accepted types of fields.
+	Patch(ctx context.Context, poolName string, memberName string, fields interface{}) error
+	// Delete a single pool members identified by pool name and member name.
+	Delete(ctx context.Context, poolName string, memberName string) error
+}
+
+var _ PoolMembersAPI = &PoolMembersResource{}
+
+// PoolStatsAPI is the interface of PoolStatsResource, which mocks implement in tests.
+type PoolStatsAPI interface {
+	// Specify pool and member, get the specified member stats.
+	GetMemberStats(ctx context.Context, poolFullPathName string, memberFullPathName string) (*MemberStatsList, error)
+	// Get the stats of all members in a pool.
+	GetPoolAllMemberStats(ctx context.Context, poolFullPathName string) (*PoolAllMemberStatsList, error)
+	List(ctx context.Context) (*PoolStatsList, error)
+	// Gets only the stats for the specified pool itself, not include members of the pool.
+	GetPoolStats(ctx context.Context, pool string) (*PoolStatsList, error)
+}
+
+var _ PoolStatsAPI = &PoolStatsResource{}
+
+// RuleAPI is the interface of RuleResource, which mocks implement in tests.
+type RuleAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RuleList, error)
+	Get(ctx context.Context, name string) (*Rule, error)
+	Create(ctx context.Context, item Rule) error
+	Update(ctx context.Context, name string, item Rule) error
+	// Patch updates only the given fields of the Rule identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RuleAPI = &RuleResource{}
+
+// SnatPoolAPI is the interface of SnatPoolResource, which mocks implement in tests.
+type SnatPoolAPI interface {
+	// List all the snatpool instances.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SnatPoolList, error)
+	// Get a single snatpool identified by name.
+	Get(ctx context.Context, fullPathName string) (*SnatPool, error)
+	// Create a new snatpool instance.
+	Create(ctx context.Context, item SnatPool) error
+	// Update a snatpool instance identified by name.
+	Update(ctx context.Context, fullPathName string, item SnatPool) error
+	// Patch updates only the given fields of the SnatPool identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete a single pool instance identified by name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ SnatPoolAPI = &SnatPoolResource{}
+
+// SnatTranslationAPI is the interface of SnatTranslationResource, which mocks implement in tests.
+type SnatTranslationAPI interface {
+	// List all the SnatTranslationstate instances.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SnatTranslationList, error)
+	// Get a single SnatTranslation identified by name.
+	Get(ctx context.Context, fullPathName string) (*SnatTranslation, error)
+	// Create a new SnatTranslation instance.
+	Create(ctx context.Context, item SnatTranslation) error
+	// Update a SnatTranslation instance identified by name.
+	Update(ctx context.Context, fullPathName string, item SnatTranslation) error
+	// Patch updates only the given fields of the SnatTranslation identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete a single SnatTranslation instance identified by name.
+	Delete(ctx context.Context, fullPathName string) error
+	// Enabling a SnatTranslation item identified by the SnatTranslation name.
+	Enable(ctx context.Context, name string) error
+	// Disabling a SnatTranslation item identified by the SnatTranslationname.
+	Disable(ctx context.Context, name string) error
+}
+
+var _ SnatTranslationAPI = &SnatTranslationResource{}
+
+// TrafficMatchingCriteriaAPI is the interface of TrafficMatchingCriteriaResource, which mocks implement in tests.
+type TrafficMatchingCriteriaAPI interface {
+	// List all TrafficMatchingCriteria details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TrafficMatchingCriteriaList, error)
+	// ListName all TrafficMatchingCriteria fullpath name
+	ListName(ctx context.Context) ([]string, error)
+	// Get a single TrafficMatchingCriteria details by the TrafficMatchingCriteria name
+	Get(ctx context.Context, fullPathName string) (*TrafficMatchingCriteria, error)
+	// Create a new TrafficMatchingCriteria item
+	Create(ctx context.Context, item TrafficMatchingCriteria) error
+	// Update the TrafficMatchingCriteria item identified by the TrafficMatchingCriteria name, otherwise an error will be reported.
+	Update(ctx context.Context, fullPathName string, item TrafficMatchingCriteria) error
+	// Patch updates only the given fields of the TrafficMatchingCriteria identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete a single TrafficMatchingCriteria identified by the TrafficMatchingCriteria name. if it is not exist return error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ TrafficMatchingCriteriaAPI = &TrafficMatchingCriteriaResource{}
+
+// VirtualAPI is the interface of VirtualResource, which mocks implement in tests.
+type VirtualAPI interface {
+	// List all virtual server items
+	List(ctx context.Context, opts ...*rest.ListOptions) (*VirtualServerList, error)
+	// Pages walks the virtual server collection one page at a time, see bigip.Collection.Pages.
+	Pages(ctx context.Context, fn func(page *bigip.Page[VirtualServer]) error, opts ...*rest.ListOptions) error
+	// Each calls fn for every virtual server without loading the whole collection in memory.
+	// Return bigip.ErrStopIteration from fn to stop early.
+	Each(ctx context.Context, fn func(item *VirtualServer) error, opts ...*rest.ListOptions) error
+	// List all the details of the virtual server, including: profile, policy, etc.
+	ListDetail(ctx context.Context, opts ...*rest.ListOptions) (*VirtualServerList, error)
+	// ListVirtualServerName get all virtual server names
+	ListVirtualServerName(ctx context.Context) ([]string, error)
+	// Get a single virtual server identified by name.
+	Get(ctx context.Context, fullPathName string) (*VirtualServer, error)
+	// Create a new virtual server item
+	Create(ctx context.Context, item VirtualServer) error
+	// Update the virtual server identified by the virtual server name
+	Update(ctx context.Context, name string, item VirtualServer) error
+	// Patch updates only the given fields of the VirtualServer identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// UpdateIfUnchanged updates the virtual server identified by name only if it was not modified since
+	// item was read, see bigip.Collection.UpdateIfUnchanged.
+	UpdateIfUnchanged(ctx context.Context, name string, item VirtualServer) error
+	// Modify reads the virtual server identified by name, changes it with mutate and updates it,
+	// starting over if it was modified concurrently, see bigip.Collection.Modify.
+	Modify(ctx context.Context, name string, mutate func(item *VirtualServer) error) error
+	// Delete a single virtual server identified by the virtual server name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+	// Enabling a virtual server item identified by the virtual server name.
+	Enable(ctx context.Context, name string) error
+	// Disabling a virtual server item identified by the virtual server name.
+	Disable(ctx context.Context, name string) error
+	// removes a single iRule from the virtual server identified by virtual server name.
+	RemoveRuleForVirtualServer(ctx context.Context, vsName string, ruleName string) error
+	// gets the iRules for a virtual server identified by name.
+	GetRulesByVirtualServer(ctx context.Context, name string) ([]Rule, error)
+	// adds an iRule to the virtual server identified by name.
+	AddRuleForVirtualServer(ctx context.Context, vsName string, rule Rule) error
+}
+
+var _ VirtualAPI = &VirtualResource{}
+
+// VirtualAddressAPI is the interface of VirtualAddressResource, which mocks implement in tests.
+type VirtualAddressAPI interface {
+	// List retrieves the list of all virtual addresses configured on the BIG-IP.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*VirtualAddressList, error)
+	// Get retrieves a single virtual address configuration identified by fullPathName.
+	Get(ctx context.Context, fullPathName string) (*VirtualAddress, error)
+	// GetAddressByVirtualServerName retrieves the IP address for a given virtual server identified by fullPathName.
+	GetAddressByVirtualServerName(ctx context.Context, fullPathName string) (string, error)
+	// Create adds a virtual address, which BIG-IP otherwise creates with the first virtual server using it.
+	Create(ctx context.Context, item VirtualAddress) error
+	// Update modifies a virtual address configuration identified by name with the given VirtualAddress object.
+	Update(ctx context.Context, name string, item VirtualAddress) error
+	// Patch updates only the given fields of the VirtualAddress identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single virtual address identified by the virtual address name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+	// Enabling a virtual address item identified by the virtual address.
+	Enable(ctx context.Context, name string) error
+	// Disabling a virtual address item identified by the virtual address.
+	Disable(ctx context.Context, name string) error
+}
+
+var _ VirtualAddressAPI = &VirtualAddressResource{}
+
+// VirtualAddressStatsAPI is the interface of VirtualAddressStatsResource, which mocks implement in tests.
+type VirtualAddressStatsAPI interface {
+	List(ctx context.Context) (*VirtualAddressStatsList, error)
+	Get(ctx context.Context, name string) (*VirtualAddressStatsList, error)
+}
+
+var _ VirtualAddressStatsAPI = &VirtualAddressStatsResource{}
+
+// VirtualStatsAPI is the interface of VirtualStatsResource, which mocks implement in tests.
+type VirtualStatsAPI interface {
+	List(ctx context.Context) (*VirtualStatsList, error)
+	Get(ctx context.Context, name string) (*VirtualStatsList, error)
+}
+
+var _ VirtualStatsAPI = &VirtualStatsResource{}
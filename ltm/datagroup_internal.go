@@ -2,10 +2,8 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type DataGroupInternalList struct {
@@ -35,68 +33,44 @@ type DataGroupInternalResource struct {
 	b *bigip.BigIP
 }
 
-func (dgir *DataGroupInternalResource) List() (*DataGroupInternalList, error) {
-	var dgil DataGroupInternalList
+// collection returns the typed collection backing DataGroupInternalResource.
+func (dgir *DataGroupInternalResource) collection() *bigip.Collection[DataGroupInternal, DataGroupInternalList] {
+	return bigip.NewCollection[DataGroupInternal, DataGroupInternalList](dgir.b, LtmManager, DataGroupInternalEndpoint)
+}
 
-	res, err := dgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+func (dgir *DataGroupInternalResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DataGroupInternalList, error) {
+	return dgir.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &dgil); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &dgil, nil
+func (dgir *DataGroupInternalResource) Get(ctx context.Context, fullPathName string) (*DataGroupInternal, error) {
+	return dgir.collection().Get(ctx, fullPathName)
 }
 
-func (dgir *DataGroupInternalResource) Get(fullPathName string) (*DataGroupInternal, error) {
-	var item DataGroupInternal
-	res, err := dgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+func (dgir *DataGroupInternalResource) Create(ctx context.Context, item DataGroupInternal) error {
+	return dgir.collection().Create(ctx, item)
+}
+
+func (dgir *DataGroupInternalResource) Update(ctx context.Context, fullPathName string, item DataGroupInternal) error {
+	return dgir.collection().Update(ctx, fullPathName, item)
+}
 
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+// Patch updates only the given fields of the DataGroupInternal identified by fullPathName, see bigip.PatchBody.
+func (dgir *DataGroupInternalResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return dgir.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (dgir *DataGroupInternalResource) Create(item DataGroupInternal) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = dgir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// UpdateIfUnchanged updates the data group identified by fullPathName only if it was not modified since
+// item was read, see bigip.Collection.UpdateIfUnchanged.
+func (dgir *DataGroupInternalResource) UpdateIfUnchanged(ctx context.Context, fullPathName string, item DataGroupInternal) error {
+	return dgir.collection().UpdateIfUnchanged(ctx, fullPathName, item)
 }
 
-func (dgir *DataGroupInternalResource) Update(fullPathName string, item DataGroupInternal) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = dgir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Modify reads the data group identified by fullPathName, changes it with mutate and updates it,
+// starting over if it was modified concurrently, see bigip.Collection.Modify.
+func (dgir *DataGroupInternalResource) Modify(ctx context.Context, fullPathName string, mutate func(item *DataGroupInternal) error) error {
+	return dgir.collection().Modify(ctx, fullPathName, mutate)
 }
 
-func (dgir *DataGroupInternalResource) Delete(fullPathName string) error {
-	_, err := dgir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(DataGroupInternalEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (dgir *DataGroupInternalResource) Delete(ctx context.Context, fullPathName string) error {
+	return dgir.collection().Delete(ctx, fullPathName)
 }
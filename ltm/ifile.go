@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -29,37 +30,20 @@ type IFileResource struct {
 	b *bigip.BigIP
 }
 
-func (ifr *IFileResource) List() (*IFileList, error) {
-	var ifl IFileList
-
-	res, err := ifr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &ifl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &ifl, nil
+// collection returns the typed collection backing IFileResource.
+func (ifr *IFileResource) collection() *bigip.Collection[IFile, IFileList] {
+	return bigip.NewCollection[IFile, IFileList](ifr.b, LtmManager, IFileEndpoint)
 }
 
-func (ifr *IFileResource) Get(fullPathName string) (*IFile, error) {
-	var item IFile
-
-	res, err := ifr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+func (ifr *IFileResource) List(ctx context.Context, opts ...*rest.ListOptions) (*IFileList, error) {
+	return ifr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (ifr *IFileResource) Get(ctx context.Context, fullPathName string) (*IFile, error) {
+	return ifr.collection().Get(ctx, fullPathName)
 }
 
-func (ifr *IFileResource) Create(name, fileObject string) error {
+func (ifr *IFileResource) Create(ctx context.Context, name, fileObject string) error {
 	item := map[string]string{
 		"name":      name,
 		"file-name": fileObject,
@@ -68,16 +52,19 @@ func (ifr *IFileResource) Create(name, fileObject string) error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
+	if jsonData, err = ifr.b.RestrictBody(jsonData); err != nil {
+		return err
+	}
 	jsonString := string(jsonData)
 	_, err = ifr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(IFileEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (ifr *IFileResource) Edit(name, fileObject string) error {
+func (ifr *IFileResource) Edit(ctx context.Context, name, fileObject string) error {
 	item := map[string]string{
 		"name":      name,
 		"file-name": fileObject,
@@ -87,19 +74,18 @@ func (ifr *IFileResource) Edit(name, fileObject string) error {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
-	_, err = ifr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+	fullPathName, err := ifr.b.Qualify(name)
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-func (ifr *IFileResource) Delete(name string) error {
-	_, err := ifr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(IFileEndpoint).ResourceInstance(name).DoRaw(context.Background())
+	_, err = ifr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(IFileEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (ifr *IFileResource) Delete(ctx context.Context, name string) error {
+	return ifr.collection().Delete(ctx, name)
+}
@@ -1,13 +1,13 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 
 	"testing"
 )
 
 func TestIFileResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -17,13 +17,13 @@ func TestIFileResource(t *testing.T) {
 
 	// Create an iFile
 	fileObject := "./file.txt"
-	err = iFileResource.Create("test-ifile", fileObject)
+	err = iFileResource.Create(context.Background(), "test-ifile", fileObject)
 	if err != nil {
 		t.Fatalf("Error creating iFile: %v", err)
 	}
 
 	// Get the iFile
-	iFileCheck, err := iFileResource.Get("/Common/test-ifile")
+	iFileCheck, err := iFileResource.Get(context.Background(), "/Common/test-ifile")
 	if err != nil {
 		t.Fatalf("Error getting iFile: %v", err)
 	}
@@ -35,13 +35,13 @@ func TestIFileResource(t *testing.T) {
 
 	// Update properties of the iFile
 	fileObjectUpdated := "./updated-file.txt"
-	err = iFileResource.Edit("test-ifile", fileObjectUpdated)
+	err = iFileResource.Edit(context.Background(), "test-ifile", fileObjectUpdated)
 	if err != nil {
 		t.Fatalf("Error updating iFile: %v", err)
 	}
 
 	// Validate that properties were updated
-	updatedIFile, err := iFileResource.Get("/Common/test-ifile")
+	updatedIFile, err := iFileResource.Get(context.Background(), "/Common/test-ifile")
 	if err != nil {
 		t.Fatalf("Error getting updated iFile: %v", err)
 	}
@@ -50,13 +50,13 @@ func TestIFileResource(t *testing.T) {
 	}
 
 	// Delete the iFile
-	err = iFileResource.Delete("test-ifile")
+	err = iFileResource.Delete(context.Background(), "test-ifile")
 	if err != nil {
 		t.Fatalf("Error deleting iFile: %v", err)
 	}
 
 	// Validate that iFile was deleted
-	if _, err := iFileResource.Get("/Common/test-ifile"); err == nil {
+	if _, err := iFileResource.Get(context.Background(), "/Common/test-ifile"); err == nil {
 		t.Error("iFile still exists after attempting deletion")
 	}
 }
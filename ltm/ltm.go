@@ -1,6 +1,8 @@
 // Package ltm provides a REST client for the /tm/ltm F5 BigIP API.
 package ltm
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen -types LTM
+
 import (
 	"github.com/lefeck/go-bigip"
 	"github.com/lefeck/go-bigip/ltm/monitor"
@@ -64,64 +66,69 @@ func New(b *bigip.BigIP) LTM {
 }
 
 // Virtual returns a VirtualResource ured to query tm/ltm/virtual API.
-func (ltm LTM) Virtual() *VirtualResource {
+func (ltm LTM) Virtual() VirtualAPI {
 	return &ltm.virtual
 }
 
-func (ltm LTM) VirtualAddress() *VirtualAddressResource {
+func (ltm LTM) VirtualAddress() VirtualAddressAPI {
 	return &ltm.virtualAddress
 }
 
-func (ltm LTM) VirtualAddressStats() *VirtualAddressStatsResource {
+func (ltm LTM) VirtualAddressStats() VirtualAddressStatsAPI {
 	return &ltm.virtualAddressStats
 }
 
-func (ltm LTM) VirtualStats() *VirtualStatsResource {
+func (ltm LTM) VirtualStats() VirtualStatsAPI {
 	return &ltm.virtualStats
 }
 
-func (ltm LTM) Pool() *PoolResource {
+func (ltm LTM) Pool() PoolAPI {
 	return &ltm.pool
 }
 
-func (ltm LTM) SnatPool() *SnatPoolResource {
+func (ltm LTM) SnatPool() SnatPoolAPI {
 	return &ltm.snatPool
 }
 
-func (ltm LTM) Rule() *RuleResource {
+func (ltm LTM) Rule() RuleAPI {
 	return &ltm.rule
 }
 
-func (ltm LTM) PoolMembers() *PoolMembersResource {
+func (ltm LTM) PoolMembers() PoolMembersAPI {
 	return &ltm.poolMembers
 }
 
-func (ltm LTM) PoolStats() *PoolStatsResource {
+func (ltm LTM) PoolStats() PoolStatsAPI {
 	return &ltm.poolStats
 }
 
-func (ltm LTM) NodeStats() *NodeStatsResource {
+// Node returns a NodeResource used to query /tm/ltm/node API.
+func (ltm LTM) Node() NodeAPI {
+	return &ltm.node
+}
+
+func (ltm LTM) NodeStats() NodeStatsAPI {
 	return &ltm.nodeStats
 }
 
-func (ltm LTM) TrafficMatchingCriteria() *TrafficMatchingCriteriaResource {
+func (ltm LTM) TrafficMatchingCriteria() TrafficMatchingCriteriaAPI {
 	return &ltm.trafficMatchingCriteriaResource
 }
 
 // IFile returns an IFileResource ured to query /tm/ltm/ifile API.
-func (ltm LTM) IFile() *IFileResource {
+func (ltm LTM) IFile() IFileAPI {
 	return &ltm.iFile
 }
 
 // DataGroupInternal returns a DataGroupInternalResource ured to query /tm/ltm/data-group/internal API.
-func (ltm LTM) DataGroupInternal() *DataGroupInternalResource {
+func (ltm LTM) DataGroupInternal() DataGroupInternalAPI {
 	return &ltm.dataGroupInternal
 }
 
-func (ltm LTM) Monitor() *monitor.MonitorResource {
+func (ltm LTM) Monitor() monitor.MonitorAPI {
 	return &ltm.monitor
 }
 
-func (ltm LTM) Profile() *profile.ProfileResource {
+func (ltm LTM) Profile() profile.ProfileAPI {
 	return &ltm.profile
 }
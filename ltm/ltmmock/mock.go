@@ -0,0 +1,1378 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package ltmmock provides mocks of the interfaces of package ltm, which record their calls.
+package ltmmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/ltm"
+	"github.com/lefeck/go-bigip/ltm/monitor"
+	"github.com/lefeck/go-bigip/ltm/profile"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// DataGroupInternalAPI is a mock of ltm.DataGroupInternalAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DataGroupInternalAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc              func(context.Context, ...*rest.ListOptions) (*ltm.DataGroupInternalList, error)
+	GetFunc               func(context.Context, string) (*ltm.DataGroupInternal, error)
+	CreateFunc            func(context.Context, ltm.DataGroupInternal) error
+	UpdateFunc            func(context.Context, string, ltm.DataGroupInternal) error
+	PatchFunc             func(context.Context, string, interface{}) error
+	UpdateIfUnchangedFunc func(context.Context, string, ltm.DataGroupInternal) error
+	ModifyFunc            func(context.Context, string, func(item *ltm.DataGroupInternal) error) error
+	DeleteFunc            func(context.Context, string) error
+}
+
+var _ ltm.DataGroupInternalAPI = &DataGroupInternalAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DataGroupInternalAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.DataGroupInternalList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DataGroupInternalAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.DataGroupInternal, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DataGroupInternalAPI) Create(ctx context.Context, item ltm.DataGroupInternal) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DataGroupInternalAPI) Update(ctx context.Context, fullPathName string, item ltm.DataGroupInternal) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DataGroupInternalAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// UpdateIfUnchanged records the call and calls UpdateIfUnchangedFunc if it is set.
+func (m *DataGroupInternalAPI) UpdateIfUnchanged(ctx context.Context, fullPathName string, item ltm.DataGroupInternal) (r0 error) {
+	m.CallRecorder.Record("UpdateIfUnchanged", ctx, fullPathName, item)
+	if m.UpdateIfUnchangedFunc != nil {
+		return m.UpdateIfUnchangedFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Modify records the call and calls ModifyFunc if it is set.
+func (m *DataGroupInternalAPI) Modify(ctx context.Context, fullPathName string, mutate func(item *ltm.DataGroupInternal) error) (r0 error) {
+	m.CallRecorder.Record("Modify", ctx, fullPathName, mutate)
+	if m.ModifyFunc != nil {
+		return m.ModifyFunc(ctx, fullPathName, mutate)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DataGroupInternalAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// IFileAPI is a mock of ltm.IFileAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IFileAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*ltm.IFileList, error)
+	GetFunc    func(context.Context, string) (*ltm.IFile, error)
+	CreateFunc func(context.Context, string, string) error
+	EditFunc   func(context.Context, string, string) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ ltm.IFileAPI = &IFileAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *IFileAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.IFileList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *IFileAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.IFile, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *IFileAPI) Create(ctx context.Context, name string, fileObject string) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, name, fileObject)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, name, fileObject)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc if it is set.
+func (m *IFileAPI) Edit(ctx context.Context, name string, fileObject string) (r0 error) {
+	m.CallRecorder.Record("Edit", ctx, name, fileObject)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, name, fileObject)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *IFileAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// LTMAPI is a mock of ltm.LTMAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LTMAPI struct {
+	bigiptest.CallRecorder
+
+	VirtualFunc                 func() ltm.VirtualAPI
+	VirtualAddressFunc          func() ltm.VirtualAddressAPI
+	VirtualAddressStatsFunc     func() ltm.VirtualAddressStatsAPI
+	VirtualStatsFunc            func() ltm.VirtualStatsAPI
+	PoolFunc                    func() ltm.PoolAPI
+	SnatPoolFunc                func() ltm.SnatPoolAPI
+	RuleFunc                    func() ltm.RuleAPI
+	PoolMembersFunc             func() ltm.PoolMembersAPI
+	PoolStatsFunc               func() ltm.PoolStatsAPI
+	NodeFunc                    func() ltm.NodeAPI
+	NodeStatsFunc               func() ltm.NodeStatsAPI
+	TrafficMatchingCriteriaFunc func() ltm.TrafficMatchingCriteriaAPI
+	IFileFunc                   func() ltm.IFileAPI
+	DataGroupInternalFunc       func() ltm.DataGroupInternalAPI
+	MonitorFunc                 func() monitor.MonitorAPI
+	ProfileFunc                 func() profile.ProfileAPI
+}
+
+var _ ltm.LTMAPI = &LTMAPI{}
+
+// Virtual records the call and calls VirtualFunc if it is set.
+func (m *LTMAPI) Virtual() (r0 ltm.VirtualAPI) {
+	m.CallRecorder.Record("Virtual")
+	if m.VirtualFunc != nil {
+		return m.VirtualFunc()
+	}
+	return
+}
+
+// VirtualAddress records the call and calls VirtualAddressFunc if it is set.
+func (m *LTMAPI) VirtualAddress() (r0 ltm.VirtualAddressAPI) {
+	m.CallRecorder.Record("VirtualAddress")
+	if m.VirtualAddressFunc != nil {
+		return m.VirtualAddressFunc()
+	}
+	return
+}
+
+// VirtualAddressStats records the call and calls VirtualAddressStatsFunc if it is set.
+func (m *LTMAPI) VirtualAddressStats() (r0 ltm.VirtualAddressStatsAPI) {
+	m.CallRecorder.Record("VirtualAddressStats")
+	if m.VirtualAddressStatsFunc != nil {
+		return m.VirtualAddressStatsFunc()
+	}
+	return
+}
+
+// VirtualStats records the call and calls VirtualStatsFunc if it is set.
+func (m *LTMAPI) VirtualStats() (r0 ltm.VirtualStatsAPI) {
+	m.CallRecorder.Record("VirtualStats")
+	if m.VirtualStatsFunc != nil {
+		return m.VirtualStatsFunc()
+	}
+	return
+}
+
+// Pool records the call and calls PoolFunc if it is set.
+func (m *LTMAPI) Pool() (r0 ltm.PoolAPI) {
+	m.CallRecorder.Record("Pool")
+	if m.PoolFunc != nil {
+		return m.PoolFunc()
+	}
+	return
+}
+
+// SnatPool records the call and calls SnatPoolFunc if it is set.
+func (m *LTMAPI) SnatPool() (r0 ltm.SnatPoolAPI) {
+	m.CallRecorder.Record("SnatPool")
+	if m.SnatPoolFunc != nil {
+		return m.SnatPoolFunc()
+	}
+	return
+}
+
+// Rule records the call and calls RuleFunc if it is set.
+func (m *LTMAPI) Rule() (r0 ltm.RuleAPI) {
+	m.CallRecorder.Record("Rule")
+	if m.RuleFunc != nil {
+		return m.RuleFunc()
+	}
+	return
+}
+
+// PoolMembers records the call and calls PoolMembersFunc if it is set.
+func (m *LTMAPI) PoolMembers() (r0 ltm.PoolMembersAPI) {
+	m.CallRecorder.Record("PoolMembers")
+	if m.PoolMembersFunc != nil {
+		return m.PoolMembersFunc()
+	}
+	return
+}
+
+// PoolStats records the call and calls PoolStatsFunc if it is set.
+func (m *LTMAPI) PoolStats() (r0 ltm.PoolStatsAPI) {
+	m.CallRecorder.Record("PoolStats")
+	if m.PoolStatsFunc != nil {
+		return m.PoolStatsFunc()
+	}
+	return
+}
+
+// Node records the call and calls NodeFunc if it is set.
+func (m *LTMAPI) Node() (r0 ltm.NodeAPI) {
+	m.CallRecorder.Record("Node")
+	if m.NodeFunc != nil {
+		return m.NodeFunc()
+	}
+	return
+}
+
+// NodeStats records the call and calls NodeStatsFunc if it is set.
+func (m *LTMAPI) NodeStats() (r0 ltm.NodeStatsAPI) {
+	m.CallRecorder.Record("NodeStats")
+	if m.NodeStatsFunc != nil {
+		return m.NodeStatsFunc()
+	}
+	return
+}
+
+// TrafficMatchingCriteria records the call and calls TrafficMatchingCriteriaFunc if it is set.
+func (m *LTMAPI) TrafficMatchingCriteria() (r0 ltm.TrafficMatchingCriteriaAPI) {
+	m.CallRecorder.Record("TrafficMatchingCriteria")
+	if m.TrafficMatchingCriteriaFunc != nil {
+		return m.TrafficMatchingCriteriaFunc()
+	}
+	return
+}
+
+// IFile records the call and calls IFileFunc if it is set.
+func (m *LTMAPI) IFile() (r0 ltm.IFileAPI) {
+	m.CallRecorder.Record("IFile")
+	if m.IFileFunc != nil {
+		return m.IFileFunc()
+	}
+	return
+}
+
+// DataGroupInternal records the call and calls DataGroupInternalFunc if it is set.
+func (m *LTMAPI) DataGroupInternal() (r0 ltm.DataGroupInternalAPI) {
+	m.CallRecorder.Record("DataGroupInternal")
+	if m.DataGroupInternalFunc != nil {
+		return m.DataGroupInternalFunc()
+	}
+	return
+}
+
+// Monitor records the call and calls MonitorFunc if it is set.
+func (m *LTMAPI) Monitor() (r0 monitor.MonitorAPI) {
+	m.CallRecorder.Record("Monitor")
+	if m.MonitorFunc != nil {
+		return m.MonitorFunc()
+	}
+	return
+}
+
+// Profile records the call and calls ProfileFunc if it is set.
+func (m *LTMAPI) Profile() (r0 profile.ProfileAPI) {
+	m.CallRecorder.Record("Profile")
+	if m.ProfileFunc != nil {
+		return m.ProfileFunc()
+	}
+	return
+}
+
+// NodeAPI is a mock of ltm.NodeAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NodeAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc              func(context.Context, ...*rest.ListOptions) (*ltm.NodeList, error)
+	PagesFunc             func(context.Context, func(page *bigip.Page[ltm.Node]) error, ...*rest.ListOptions) error
+	EachFunc              func(context.Context, func(item *ltm.Node) error, ...*rest.ListOptions) error
+	GetFunc               func(context.Context, string) (*ltm.Node, error)
+	CreateFunc            func(context.Context, ltm.Node) error
+	UpdateFunc            func(context.Context, string, ltm.Node) error
+	PatchFunc             func(context.Context, string, interface{}) error
+	UpdateIfUnchangedFunc func(context.Context, string, ltm.Node) error
+	ModifyFunc            func(context.Context, string, func(item *ltm.Node) error) error
+	EnableFunc            func(context.Context, string) error
+	DisableFunc           func(context.Context, string) error
+	ForceOfflineFunc      func(context.Context, string) error
+	DeleteFunc            func(context.Context, string) error
+}
+
+var _ ltm.NodeAPI = &NodeAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NodeAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.NodeList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Pages records the call and calls PagesFunc if it is set.
+func (m *NodeAPI) Pages(ctx context.Context, fn func(page *bigip.Page[ltm.Node]) error, opts ...*rest.ListOptions) (r0 error) {
+	m.CallRecorder.Record("Pages", ctx, fn, opts)
+	if m.PagesFunc != nil {
+		return m.PagesFunc(ctx, fn, opts...)
+	}
+	return
+}
+
+// Each records the call and calls EachFunc if it is set.
+func (m *NodeAPI) Each(ctx context.Context, fn func(item *ltm.Node) error, opts ...*rest.ListOptions) (r0 error) {
+	m.CallRecorder.Record("Each", ctx, fn, opts)
+	if m.EachFunc != nil {
+		return m.EachFunc(ctx, fn, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NodeAPI) Get(ctx context.Context, name string) (r0 *ltm.Node, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NodeAPI) Create(ctx context.Context, item ltm.Node) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NodeAPI) Update(ctx context.Context, name string, item ltm.Node) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NodeAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// UpdateIfUnchanged records the call and calls UpdateIfUnchangedFunc if it is set.
+func (m *NodeAPI) UpdateIfUnchanged(ctx context.Context, name string, item ltm.Node) (r0 error) {
+	m.CallRecorder.Record("UpdateIfUnchanged", ctx, name, item)
+	if m.UpdateIfUnchangedFunc != nil {
+		return m.UpdateIfUnchangedFunc(ctx, name, item)
+	}
+	return
+}
+
+// Modify records the call and calls ModifyFunc if it is set.
+func (m *NodeAPI) Modify(ctx context.Context, name string, mutate func(item *ltm.Node) error) (r0 error) {
+	m.CallRecorder.Record("Modify", ctx, name, mutate)
+	if m.ModifyFunc != nil {
+		return m.ModifyFunc(ctx, name, mutate)
+	}
+	return
+}
+
+// Enable records the call and calls EnableFunc if it is set.
+func (m *NodeAPI) Enable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Enable", ctx, name)
+	if m.EnableFunc != nil {
+		return m.EnableFunc(ctx, name)
+	}
+	return
+}
+
+// Disable records the call and calls DisableFunc if it is set.
+func (m *NodeAPI) Disable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Disable", ctx, name)
+	if m.DisableFunc != nil {
+		return m.DisableFunc(ctx, name)
+	}
+	return
+}
+
+// ForceOffline records the call and calls ForceOfflineFunc if it is set.
+func (m *NodeAPI) ForceOffline(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("ForceOffline", ctx, name)
+	if m.ForceOfflineFunc != nil {
+		return m.ForceOfflineFunc(ctx, name)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NodeAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// NodeStatsAPI is a mock of ltm.NodeStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NodeStatsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*ltm.NodeStatsList, error)
+}
+
+var _ ltm.NodeStatsAPI = &NodeStatsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NodeStatsAPI) List(ctx context.Context) (r0 *ltm.NodeStatsList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// PoolAPI is a mock of ltm.PoolAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PoolAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc              func(context.Context, ...*rest.ListOptions) (*ltm.PoolList, error)
+	PagesFunc             func(context.Context, func(page *bigip.Page[ltm.Pool]) error, ...*rest.ListOptions) error
+	EachFunc              func(context.Context, func(item *ltm.Pool) error, ...*rest.ListOptions) error
+	ListDetailFunc        func(context.Context, ...*rest.ListOptions) (*ltm.PoolList, error)
+	ListPoolNameFunc      func(context.Context) ([]string, error)
+	GetFunc               func(context.Context, string) (*ltm.Pool, error)
+	CreateFunc            func(context.Context, ltm.Pool) error
+	UpdateFunc            func(context.Context, string, ltm.Pool) error
+	PatchFunc             func(context.Context, string, interface{}) error
+	UpdateIfUnchangedFunc func(context.Context, string, ltm.Pool) error
+	ModifyFunc            func(context.Context, string, func(item *ltm.Pool) error) error
+	DeleteFunc            func(context.Context, string) error
+}
+
+var _ ltm.PoolAPI = &PoolAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PoolAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.PoolList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Pages records the call and calls PagesFunc if it is set.
+func (m *PoolAPI) Pages(ctx context.Context, fn func(page *bigip.Page[ltm.Pool]) error, opts ...*rest.ListOptions) (r0 error) {
+	m.CallRecorder.Record("Pages", ctx, fn, opts)
+	if m.PagesFunc != nil {
+		return m.PagesFunc(ctx, fn, opts...)
+	}
+	return
+}
+
+// Each records the call and calls EachFunc if it is set.
+func (m *PoolAPI) Each(ctx context.Context, fn func(item *ltm.Pool) error, opts ...*rest.ListOptions) (r0 error) {
+	m.CallRecorder.Record("Each", ctx, fn, opts)
+	if m.EachFunc != nil {
+		return m.EachFunc(ctx, fn, opts...)
+	}
+	return
+}
+
+// ListDetail records the call and calls ListDetailFunc if it is set.
+func (m *PoolAPI) ListDetail(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.PoolList, r1 error) {
+	m.CallRecorder.Record("ListDetail", ctx, opts)
+	if m.ListDetailFunc != nil {
+		return m.ListDetailFunc(ctx, opts...)
+	}
+	return
+}
+
+// ListPoolName records the call and calls ListPoolNameFunc if it is set.
+func (m *PoolAPI) ListPoolName(ctx context.Context) (r0 []string, r1 error) {
+	m.CallRecorder.Record("ListPoolName", ctx)
+	if m.ListPoolNameFunc != nil {
+		return m.ListPoolNameFunc(ctx)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *PoolAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.Pool, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *PoolAPI) Create(ctx context.Context, item ltm.Pool) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *PoolAPI) Update(ctx context.Context, fullPathName string, item ltm.Pool) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *PoolAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// UpdateIfUnchanged records the call and calls UpdateIfUnchangedFunc if it is set.
+func (m *PoolAPI) UpdateIfUnchanged(ctx context.Context, fullPathName string, item ltm.Pool) (r0 error) {
+	m.CallRecorder.Record("UpdateIfUnchanged", ctx, fullPathName, item)
+	if m.UpdateIfUnchangedFunc != nil {
+		return m.UpdateIfUnchangedFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Modify records the call and calls ModifyFunc if it is set.
+func (m *PoolAPI) Modify(ctx context.Context, fullPathName string, mutate func(item *ltm.Pool) error) (r0 error) {
+	m.CallRecorder.Record("Modify", ctx, fullPathName, mutate)
+	if m.ModifyFunc != nil {
+		return m.ModifyFunc(ctx, fullPathName, mutate)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *PoolAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// PoolMembersAPI is a mock of ltm.PoolMembersAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PoolMembersAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, string) (*ltm.PoolMembersList, error)
+	GetFunc    func(context.Context, string, string) (*ltm.PoolMembers, error)
+	CreateFunc func(context.Context, string, ltm.PoolMembers) error
+	UpdateFunc func(context.Context, string, string, ltm.PoolMembers) error
+	PatchFunc  func(context.Context, string, string, interface{}) error
+	DeleteFunc func(context.Context, string, string) error
+}
+
+var _ ltm.PoolMembersAPI = &PoolMembersAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PoolMembersAPI) List(ctx context.Context, pool string) (r0 *ltm.PoolMembersList, r1 error) {
+	m.CallRecorder.Record("List", ctx, pool)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, pool)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *PoolMembersAPI) Get(ctx context.Context, poolName string, memberName string) (r0 *ltm.PoolMembers, r1 error) {
+	m.CallRecorder.Record("Get", ctx, poolName, memberName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, poolName, memberName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *PoolMembersAPI) Create(ctx context.Context, pool string, item ltm.PoolMembers) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, pool, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, pool, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *PoolMembersAPI) Update(ctx context.Context, poolName string, memberName string, item ltm.PoolMembers) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, poolName, memberName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, poolName, memberName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *PoolMembersAPI) Patch(ctx context.Context, poolName string, memberName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, poolName, memberName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, poolName, memberName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *PoolMembersAPI) Delete(ctx context.Context, poolName string, memberName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, poolName, memberName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, poolName, memberName)
+	}
+	return
+}
+
+// PoolStatsAPI is a mock of ltm.PoolStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PoolStatsAPI struct {
+	bigiptest.CallRecorder
+
+	GetMemberStatsFunc        func(context.Context, string, string) (*ltm.MemberStatsList, error)
+	GetPoolAllMemberStatsFunc func(context.Context, string) (*ltm.PoolAllMemberStatsList, error)
+	ListFunc                  func(context.Context) (*ltm.PoolStatsList, error)
+	GetPoolStatsFunc          func(context.Context, string) (*ltm.PoolStatsList, error)
+}
+
+var _ ltm.PoolStatsAPI = &PoolStatsAPI{}
+
+// GetMemberStats records the call and calls GetMemberStatsFunc if it is set.
+func (m *PoolStatsAPI) GetMemberStats(ctx context.Context, poolFullPathName string, memberFullPathName string) (r0 *ltm.MemberStatsList, r1 error) {
+	m.CallRecorder.Record("GetMemberStats", ctx, poolFullPathName, memberFullPathName)
+	if m.GetMemberStatsFunc != nil {
+		return m.GetMemberStatsFunc(ctx, poolFullPathName, memberFullPathName)
+	}
+	return
+}
+
+// GetPoolAllMemberStats records the call and calls GetPoolAllMemberStatsFunc if it is set.
+func (m *PoolStatsAPI) GetPoolAllMemberStats(ctx context.Context, poolFullPathName string) (r0 *ltm.PoolAllMemberStatsList, r1 error) {
+	m.CallRecorder.Record("GetPoolAllMemberStats", ctx, poolFullPathName)
+	if m.GetPoolAllMemberStatsFunc != nil {
+		return m.GetPoolAllMemberStatsFunc(ctx, poolFullPathName)
+	}
+	return
+}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PoolStatsAPI) List(ctx context.Context) (r0 *ltm.PoolStatsList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// GetPoolStats records the call and calls GetPoolStatsFunc if it is set.
+func (m *PoolStatsAPI) GetPoolStats(ctx context.Context, pool string) (r0 *ltm.PoolStatsList, r1 error) {
+	m.CallRecorder.Record("GetPoolStats", ctx, pool)
+	if m.GetPoolStatsFunc != nil {
+		return m.GetPoolStatsFunc(ctx, pool)
+	}
+	return
+}
+
+// RuleAPI is a mock of ltm.RuleAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RuleAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*ltm.RuleList, error)
+	GetFunc    func(context.Context, string) (*ltm.Rule, error)
+	CreateFunc func(context.Context, ltm.Rule) error
+	UpdateFunc func(context.Context, string, ltm.Rule) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ ltm.RuleAPI = &RuleAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RuleAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.RuleList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RuleAPI) Get(ctx context.Context, name string) (r0 *ltm.Rule, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RuleAPI) Create(ctx context.Context, item ltm.Rule) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RuleAPI) Update(ctx context.Context, name string, item ltm.Rule) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RuleAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RuleAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SnatPoolAPI is a mock of ltm.SnatPoolAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SnatPoolAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*ltm.SnatPoolList, error)
+	GetFunc    func(context.Context, string) (*ltm.SnatPool, error)
+	CreateFunc func(context.Context, ltm.SnatPool) error
+	UpdateFunc func(context.Context, string, ltm.SnatPool) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ ltm.SnatPoolAPI = &SnatPoolAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SnatPoolAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.SnatPoolList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SnatPoolAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.SnatPool, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SnatPoolAPI) Create(ctx context.Context, item ltm.SnatPool) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SnatPoolAPI) Update(ctx context.Context, fullPathName string, item ltm.SnatPool) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SnatPoolAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SnatPoolAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SnatTranslationAPI is a mock of ltm.SnatTranslationAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SnatTranslationAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc    func(context.Context, ...*rest.ListOptions) (*ltm.SnatTranslationList, error)
+	GetFunc     func(context.Context, string) (*ltm.SnatTranslation, error)
+	CreateFunc  func(context.Context, ltm.SnatTranslation) error
+	UpdateFunc  func(context.Context, string, ltm.SnatTranslation) error
+	PatchFunc   func(context.Context, string, interface{}) error
+	DeleteFunc  func(context.Context, string) error
+	EnableFunc  func(context.Context, string) error
+	DisableFunc func(context.Context, string) error
+}
+
+var _ ltm.SnatTranslationAPI = &SnatTranslationAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SnatTranslationAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.SnatTranslationList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SnatTranslationAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.SnatTranslation, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SnatTranslationAPI) Create(ctx context.Context, item ltm.SnatTranslation) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SnatTranslationAPI) Update(ctx context.Context, fullPathName string, item ltm.SnatTranslation) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SnatTranslationAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SnatTranslationAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Enable records the call and calls EnableFunc if it is set.
+func (m *SnatTranslationAPI) Enable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Enable", ctx, name)
+	if m.EnableFunc != nil {
+		return m.EnableFunc(ctx, name)
+	}
+	return
+}
+
+// Disable records the call and calls DisableFunc if it is set.
+func (m *SnatTranslationAPI) Disable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Disable", ctx, name)
+	if m.DisableFunc != nil {
+		return m.DisableFunc(ctx, name)
+	}
+	return
+}
+
+// TrafficMatchingCriteriaAPI is a mock of ltm.TrafficMatchingCriteriaAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TrafficMatchingCriteriaAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc     func(context.Context, ...*rest.ListOptions) (*ltm.TrafficMatchingCriteriaList, error)
+	ListNameFunc func(context.Context) ([]string, error)
+	GetFunc      func(context.Context, string) (*ltm.TrafficMatchingCriteria, error)
+	CreateFunc   func(context.Context, ltm.TrafficMatchingCriteria) error
+	UpdateFunc   func(context.Context, string, ltm.TrafficMatchingCriteria) error
+	PatchFunc    func(context.Context, string, interface{}) error
+	DeleteFunc   func(context.Context, string) error
+}
+
+var _ ltm.TrafficMatchingCriteriaAPI = &TrafficMatchingCriteriaAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.TrafficMatchingCriteriaList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// ListName records the call and calls ListNameFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) ListName(ctx context.Context) (r0 []string, r1 error) {
+	m.CallRecorder.Record("ListName", ctx)
+	if m.ListNameFunc != nil {
+		return m.ListNameFunc(ctx)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.TrafficMatchingCriteria, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) Create(ctx context.Context, item ltm.TrafficMatchingCriteria) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) Update(ctx context.Context, fullPathName string, item ltm.TrafficMatchingCriteria) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TrafficMatchingCriteriaAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// VirtualAPI is a mock of ltm.VirtualAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VirtualAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc                       func(context.Context, ...*rest.ListOptions) (*ltm.VirtualServerList, error)
+	PagesFunc                      func(context.Context, func(page *bigip.Page[ltm.VirtualServer]) error, ...*rest.ListOptions) error
+	EachFunc                       func(context.Context, func(item *ltm.VirtualServer) error, ...*rest.ListOptions) error
+	ListDetailFunc                 func(context.Context, ...*rest.ListOptions) (*ltm.VirtualServerList, error)
+	ListVirtualServerNameFunc      func(context.Context) ([]string, error)
+	GetFunc                        func(context.Context, string) (*ltm.VirtualServer, error)
+	CreateFunc                     func(context.Context, ltm.VirtualServer) error
+	UpdateFunc                     func(context.Context, string, ltm.VirtualServer) error
+	PatchFunc                      func(context.Context, string, interface{}) error
+	UpdateIfUnchangedFunc          func(context.Context, string, ltm.VirtualServer) error
+	ModifyFunc                     func(context.Context, string, func(item *ltm.VirtualServer) error) error
+	DeleteFunc                     func(context.Context, string) error
+	EnableFunc                     func(context.Context, string) error
+	DisableFunc                    func(context.Context, string) error
+	RemoveRuleForVirtualServerFunc func(context.Context, string, string) error
+	GetRulesByVirtualServerFunc    func(context.Context, string) ([]ltm.Rule, error)
+	AddRuleForVirtualServerFunc    func(context.Context, string, ltm.Rule) error
+}
+
+var _ ltm.VirtualAPI = &VirtualAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VirtualAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.VirtualServerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Pages records the call and calls PagesFunc if it is set.
+func (m *VirtualAPI) Pages(ctx context.Context, fn func(page *bigip.Page[ltm.VirtualServer]) error, opts ...*rest.ListOptions) (r0 error) {
+	m.CallRecorder.Record("Pages", ctx, fn, opts)
+	if m.PagesFunc != nil {
+		return m.PagesFunc(ctx, fn, opts...)
+	}
+	return
+}
+
+// Each records the call and calls EachFunc if it is set.
+func (m *VirtualAPI) Each(ctx context.Context, fn func(item *ltm.VirtualServer) error, opts ...*rest.ListOptions) (r0 error) {
+	m.CallRecorder.Record("Each", ctx, fn, opts)
+	if m.EachFunc != nil {
+		return m.EachFunc(ctx, fn, opts...)
+	}
+	return
+}
+
+// ListDetail records the call and calls ListDetailFunc if it is set.
+func (m *VirtualAPI) ListDetail(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.VirtualServerList, r1 error) {
+	m.CallRecorder.Record("ListDetail", ctx, opts)
+	if m.ListDetailFunc != nil {
+		return m.ListDetailFunc(ctx, opts...)
+	}
+	return
+}
+
+// ListVirtualServerName records the call and calls ListVirtualServerNameFunc if it is set.
+func (m *VirtualAPI) ListVirtualServerName(ctx context.Context) (r0 []string, r1 error) {
+	m.CallRecorder.Record("ListVirtualServerName", ctx)
+	if m.ListVirtualServerNameFunc != nil {
+		return m.ListVirtualServerNameFunc(ctx)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VirtualAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.VirtualServer, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *VirtualAPI) Create(ctx context.Context, item ltm.VirtualServer) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *VirtualAPI) Update(ctx context.Context, name string, item ltm.VirtualServer) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *VirtualAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// UpdateIfUnchanged records the call and calls UpdateIfUnchangedFunc if it is set.
+func (m *VirtualAPI) UpdateIfUnchanged(ctx context.Context, name string, item ltm.VirtualServer) (r0 error) {
+	m.CallRecorder.Record("UpdateIfUnchanged", ctx, name, item)
+	if m.UpdateIfUnchangedFunc != nil {
+		return m.UpdateIfUnchangedFunc(ctx, name, item)
+	}
+	return
+}
+
+// Modify records the call and calls ModifyFunc if it is set.
+func (m *VirtualAPI) Modify(ctx context.Context, name string, mutate func(item *ltm.VirtualServer) error) (r0 error) {
+	m.CallRecorder.Record("Modify", ctx, name, mutate)
+	if m.ModifyFunc != nil {
+		return m.ModifyFunc(ctx, name, mutate)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *VirtualAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// Enable records the call and calls EnableFunc if it is set.
+func (m *VirtualAPI) Enable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Enable", ctx, name)
+	if m.EnableFunc != nil {
+		return m.EnableFunc(ctx, name)
+	}
+	return
+}
+
+// Disable records the call and calls DisableFunc if it is set.
+func (m *VirtualAPI) Disable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Disable", ctx, name)
+	if m.DisableFunc != nil {
+		return m.DisableFunc(ctx, name)
+	}
+	return
+}
+
+// RemoveRuleForVirtualServer records the call and calls RemoveRuleForVirtualServerFunc if it is set.
+func (m *VirtualAPI) RemoveRuleForVirtualServer(ctx context.Context, vsName string, ruleName string) (r0 error) {
+	m.CallRecorder.Record("RemoveRuleForVirtualServer", ctx, vsName, ruleName)
+	if m.RemoveRuleForVirtualServerFunc != nil {
+		return m.RemoveRuleForVirtualServerFunc(ctx, vsName, ruleName)
+	}
+	return
+}
+
+// GetRulesByVirtualServer records the call and calls GetRulesByVirtualServerFunc if it is set.
+func (m *VirtualAPI) GetRulesByVirtualServer(ctx context.Context, name string) (r0 []ltm.Rule, r1 error) {
+	m.CallRecorder.Record("GetRulesByVirtualServer", ctx, name)
+	if m.GetRulesByVirtualServerFunc != nil {
+		return m.GetRulesByVirtualServerFunc(ctx, name)
+	}
+	return
+}
+
+// AddRuleForVirtualServer records the call and calls AddRuleForVirtualServerFunc if it is set.
+func (m *VirtualAPI) AddRuleForVirtualServer(ctx context.Context, vsName string, rule ltm.Rule) (r0 error) {
+	m.CallRecorder.Record("AddRuleForVirtualServer", ctx, vsName, rule)
+	if m.AddRuleForVirtualServerFunc != nil {
+		return m.AddRuleForVirtualServerFunc(ctx, vsName, rule)
+	}
+	return
+}
+
+// VirtualAddressAPI is a mock of ltm.VirtualAddressAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VirtualAddressAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc                          func(context.Context, ...*rest.ListOptions) (*ltm.VirtualAddressList, error)
+	GetFunc                           func(context.Context, string) (*ltm.VirtualAddress, error)
+	GetAddressByVirtualServerNameFunc func(context.Context, string) (string, error)
+	CreateFunc                        func(context.Context, ltm.VirtualAddress) error
+	UpdateFunc                        func(context.Context, string, ltm.VirtualAddress) error
+	PatchFunc                         func(context.Context, string, interface{}) error
+	DeleteFunc                        func(context.Context, string) error
+	EnableFunc                        func(context.Context, string) error
+	DisableFunc                       func(context.Context, string) error
+}
+
+var _ ltm.VirtualAddressAPI = &VirtualAddressAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VirtualAddressAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ltm.VirtualAddressList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VirtualAddressAPI) Get(ctx context.Context, fullPathName string) (r0 *ltm.VirtualAddress, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// GetAddressByVirtualServerName records the call and calls GetAddressByVirtualServerNameFunc if it is set.
+func (m *VirtualAddressAPI) GetAddressByVirtualServerName(ctx context.Context, fullPathName string) (r0 string, r1 error) {
+	m.CallRecorder.Record("GetAddressByVirtualServerName", ctx, fullPathName)
+	if m.GetAddressByVirtualServerNameFunc != nil {
+		return m.GetAddressByVirtualServerNameFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *VirtualAddressAPI) Create(ctx context.Context, item ltm.VirtualAddress) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *VirtualAddressAPI) Update(ctx context.Context, name string, item ltm.VirtualAddress) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *VirtualAddressAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *VirtualAddressAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// Enable records the call and calls EnableFunc if it is set.
+func (m *VirtualAddressAPI) Enable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Enable", ctx, name)
+	if m.EnableFunc != nil {
+		return m.EnableFunc(ctx, name)
+	}
+	return
+}
+
+// Disable records the call and calls DisableFunc if it is set.
+func (m *VirtualAddressAPI) Disable(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Disable", ctx, name)
+	if m.DisableFunc != nil {
+		return m.DisableFunc(ctx, name)
+	}
+	return
+}
+
+// VirtualAddressStatsAPI is a mock of ltm.VirtualAddressStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VirtualAddressStatsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*ltm.VirtualAddressStatsList, error)
+	GetFunc  func(context.Context, string) (*ltm.VirtualAddressStatsList, error)
+}
+
+var _ ltm.VirtualAddressStatsAPI = &VirtualAddressStatsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VirtualAddressStatsAPI) List(ctx context.Context) (r0 *ltm.VirtualAddressStatsList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VirtualAddressStatsAPI) Get(ctx context.Context, name string) (r0 *ltm.VirtualAddressStatsList, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// VirtualStatsAPI is a mock of ltm.VirtualStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VirtualStatsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*ltm.VirtualStatsList, error)
+	GetFunc  func(context.Context, string) (*ltm.VirtualStatsList, error)
+}
+
+var _ ltm.VirtualStatsAPI = &VirtualStatsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VirtualStatsAPI) List(ctx context.Context) (r0 *ltm.VirtualStatsList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VirtualStatsAPI) Get(ctx context.Context, name string) (r0 *ltm.VirtualStatsList, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
@@ -98,11 +98,19 @@ type MemberStats struct {
 }
 
 // Specify pool and member, get the specified member stats.
-func (psr *PoolStatsResource) GetMemberStats(poolFullPathName, memberFullPathName string) (*MemberStatsList, error) {
+func (psr *PoolStatsResource) GetMemberStats(ctx context.Context, poolFullPathName, memberFullPathName string) (*MemberStatsList, error) {
+	poolFullPathName, err := psr.b.Qualify(poolFullPathName)
+	if err != nil {
+		return nil, err
+	}
+	memberFullPathName, err = psr.b.Qualify(memberFullPathName)
+	if err != nil {
+		return nil, err
+	}
 	var msl MemberStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
 		Resource(PoolEndpoint).ResourceInstance(poolFullPathName).SubResource(poolMembersEndpoint).
-		SubResourceInstance(memberFullPathName).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		SubResourceInstance(memberFullPathName).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -124,11 +132,15 @@ type PoolAllMemberStatsEntries struct {
 }
 
 // Get the stats of all members in a pool.
-func (psr *PoolStatsResource) GetPoolAllMemberStats(poolFullPathName string) (*PoolAllMemberStatsList, error) {
+func (psr *PoolStatsResource) GetPoolAllMemberStats(ctx context.Context, poolFullPathName string) (*PoolAllMemberStatsList, error) {
+	poolFullPathName, err := psr.b.Qualify(poolFullPathName)
+	if err != nil {
+		return nil, err
+	}
 	var pams PoolAllMemberStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
 		Resource(PoolEndpoint).ResourceInstance(poolFullPathName).SubResource(poolMembersEndpoint).
-		SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
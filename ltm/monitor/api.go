@@ -0,0 +1,546 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package monitor
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// DNSAPI is the interface of DNSResource, which mocks implement in tests.
+type DNSAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DNSList, error)
+	Get(ctx context.Context, fullPathName string) (*DNS, error)
+	Create(ctx context.Context, item DNS) error
+	Update(ctx context.Context, name string, item DNS) error
+	// Patch updates only the given fields of the DNS identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ DNSAPI = &DNSResource{}
+
+// DiameterAPI is the interface of DiameterResource, which mocks implement in tests.
+type DiameterAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DiameterList, error)
+	Get(ctx context.Context, fullPathName string) (*Diameter, error)
+	Create(ctx context.Context, item Diameter) error
+	Update(ctx context.Context, fullPathName string, item Diameter) error
+	// Patch updates only the given fields of the Diameter identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ DiameterAPI = &DiameterResource{}
+
+// ExternalAPI is the interface of ExternalResource, which mocks implement in tests.
+type ExternalAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ExternalList, error)
+	Get(ctx context.Context, fullPathName string) (*External, error)
+	Create(ctx context.Context, item External) error
+	Update(ctx context.Context, name string, item External) error
+	// Patch updates only the given fields of the External identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ExternalAPI = &ExternalResource{}
+
+// FTPAPI is the interface of FTPResource, which mocks implement in tests.
+type FTPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FTPList, error)
+	Get(ctx context.Context, fullPathName string) (*FTP, error)
+	Create(ctx context.Context, item FTP) error
+	Update(ctx context.Context, name string, item FTP) error
+	// Patch updates only the given fields of the FTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ FTPAPI = &FTPResource{}
+
+// FirepassAPI is the interface of FirepassResource, which mocks implement in tests.
+type FirepassAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FirepassList, error)
+	Get(ctx context.Context, fullPathName string) (*Firepass, error)
+	Create(ctx context.Context, item Firepass) error
+	Update(ctx context.Context, name string, item Firepass) error
+	// Patch updates only the given fields of the Firepass identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ FirepassAPI = &FirepassResource{}
+
+// GatewayICMPAPI is the interface of GatewayICMPResource, which mocks implement in tests.
+type GatewayICMPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*GatewayICMPList, error)
+	Get(ctx context.Context, fullPathName string) (*GatewayICMP, error)
+	Create(ctx context.Context, item GatewayICMP) error
+	Update(ctx context.Context, name string, item GatewayICMP) error
+	// Patch updates only the given fields of the GatewayICMP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ GatewayICMPAPI = &GatewayICMPResource{}
+
+// HTTPAPI is the interface of HTTPResource, which mocks implement in tests.
+type HTTPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPList, error)
+	Get(ctx context.Context, fullPathName string) (*HTTP, error)
+	Create(ctx context.Context, item HTTP) error
+	Update(ctx context.Context, name string, item HTTP) error
+	// Patch updates only the given fields of the HTTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ HTTPAPI = &HTTPResource{}
+
+// HTTPSAPI is the interface of HTTPSResource, which mocks implement in tests.
+type HTTPSAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPSList, error)
+	Get(ctx context.Context, fullPathName string) (*HTTPS, error)
+	Create(ctx context.Context, item HTTPS) error
+	Update(ctx context.Context, name string, item HTTPS) error
+	// Patch updates only the given fields of the HTTPS identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ HTTPSAPI = &HTTPSResource{}
+
+// ICMPAPI is the interface of ICMPResource, which mocks implement in tests.
+type ICMPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ICMPList, error)
+	Get(ctx context.Context, fullPathName string) (*ICMP, error)
+	Create(ctx context.Context, item ICMP) error
+	Update(ctx context.Context, fullPathName string, item ICMP) error
+	// Patch updates only the given fields of the ICMP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ICMPAPI = &ICMPResource{}
+
+// IMAPAPI is the interface of IMAPResource, which mocks implement in tests.
+type IMAPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*IMAPList, error)
+	Get(ctx context.Context, fullPathName string) (*IMAP, error)
+	Create(ctx context.Context, item IMAP) error
+	Update(ctx context.Context, name string, item IMAP) error
+	// Patch updates only the given fields of the IMAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ IMAPAPI = &IMAPResource{}
+
+// InbandAPI is the interface of InbandResource, which mocks implement in tests.
+type InbandAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*InbandList, error)
+	Get(ctx context.Context, fullPathName string) (*Inband, error)
+	Create(ctx context.Context, item Inband) error
+	Update(ctx context.Context, name string, item Inband) error
+	// Patch updates only the given fields of the Inband identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ InbandAPI = &InbandResource{}
+
+// LDAPAPI is the interface of LDAPResource, which mocks implement in tests.
+type LDAPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*LDAPList, error)
+	Get(ctx context.Context, fullPathName string) (*LDAP, error)
+	Create(ctx context.Context, item LDAP) error
+	Update(ctx context.Context, name string, item LDAP) error
+	// Patch updates only the given fields of the LDAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ LDAPAPI = &LDAPResource{}
+
+// MSSQLAPI is the interface of MSSQLResource, which mocks implement in tests.
+type MSSQLAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*MSSQLList, error)
+	Get(ctx context.Context, fullPathName string) (*MSSQL, error)
+	Create(ctx context.Context, item MSSQL) error
+	Update(ctx context.Context, name string, item MSSQL) error
+	// Patch updates only the given fields of the MSSQL identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ MSSQLAPI = &MSSQLResource{}
+
+// ModuleScoreAPI is the interface of ModuleScoreResource, which mocks implement in tests.
+type ModuleScoreAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ModuleScoreList, error)
+	Get(ctx context.Context, fullPathName string) (*ModuleScore, error)
+	Create(ctx context.Context, item ModuleScore) error
+	Update(ctx context.Context, name string, item ModuleScore) error
+	// Patch updates only the given fields of the ModuleScore identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ModuleScoreAPI = &ModuleScoreResource{}
+
+// MonitorAPI is the interface of MonitorResource, which mocks implement in tests.
+type MonitorAPI interface {
+	Diameter() DiameterAPI
+	DNS() DNSAPI
+	External() ExternalAPI
+	Firepass() FirepassAPI
+	FTP() FTPAPI
+	GatewayICMP() GatewayICMPAPI
+	HTTP() HTTPAPI
+	HTTPS() HTTPSAPI
+	ICMP() ICMPAPI
+	IMAP() IMAPAPI
+	Inband() InbandAPI
+	LDAP() LDAPAPI
+	ModuleScore() ModuleScoreAPI
+	MSSQL() MSSQLAPI
+	MySQL() MySQLAPI
+	NNTP() NNTPAPI
+	Oracle() OracleAPI
+	POP3() POP3API
+	PostgreSQL() PostgreSQLAPI
+	RadiusAccounting() RadiusAccountingAPI
+	Radius() RadiusAPI
+	RealServer() RealServerAPI
+	RPC() RPCAPI
+	SASP() SASPAPI
+	Scripted() ScriptedAPI
+	SIP() SIPAPI
+	SMB() SMBAPI
+	SMTP() SMTPAPI
+	SNMPDCABase() SNMPDCABaseAPI
+	SNMPDCA() SNMPDCAAPI
+	SOAP() SOAPAPI
+	TCPEcho() TCPEchoAPI
+	TCP() TCPAPI
+	TCPHalfOpen() TCPHalfOpenAPI
+	UDP() UDPAPI
+	VirtualLocation() VirtualLocationAPI
+	WAP() WAPAPI
+	WMI() WMIAPI
+}
+
+var _ MonitorAPI = &MonitorResource{}
+
+// MySQLAPI is the interface of MySQLResource, which mocks implement in tests.
+type MySQLAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*MySQLList, error)
+	Get(ctx context.Context, fullPathName string) (*MySQL, error)
+	Create(ctx context.Context, item MySQL) error
+	Update(ctx context.Context, name string, item MySQL) error
+	// Patch updates only the given fields of the MySQL identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ MySQLAPI = &MySQLResource{}
+
+// NNTPAPI is the interface of NNTPResource, which mocks implement in tests.
+type NNTPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*NNTPList, error)
+	Get(ctx context.Context, fullPathName string) (*NNTP, error)
+	Create(ctx context.Context, item NNTP) error
+	Update(ctx context.Context, name string, item NNTP) error
+	// Patch updates only the given fields of the NNTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ NNTPAPI = &NNTPResource{}
+
+// OracleAPI is the interface of OracleResource, which mocks implement in tests.
+type OracleAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*OracleList, error)
+	Get(ctx context.Context, fullPathName string) (*Oracle, error)
+	Create(ctx context.Context, item Oracle) error
+	Update(ctx context.Context, name string, item Oracle) error
+	// Patch updates only the given fields of the Oracle identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ OracleAPI = &OracleResource{}
+
+// POP3API is the interface of POP3Resource, which mocks implement in tests.
+type POP3API interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*POP3List, error)
+	Get(ctx context.Context, fullPathName string) (*POP3, error)
+	Create(ctx context.Context, item POP3) error
+	Update(ctx context.Context, name string, item POP3) error
+	// Patch updates only the given fields of the POP3 identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ POP3API = &POP3Resource{}
+
+// PostgreSQLAPI is the interface of PostgreSQLResource, which mocks implement in tests.
+type PostgreSQLAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PostgreSQLList, error)
+	Get(ctx context.Context, fullPathName string) (*PostgreSQL, error)
+	Create(ctx context.Context, item PostgreSQL) error
+	Update(ctx context.Context, name string, item PostgreSQL) error
+	// Patch updates only the given fields of the PostgreSQL identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ PostgreSQLAPI = &PostgreSQLResource{}
+
+// RPCAPI is the interface of RPCResource, which mocks implement in tests.
+type RPCAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RPCList, error)
+	Get(ctx context.Context, fullPathName string) (*RPC, error)
+	Create(ctx context.Context, item RPC) error
+	Update(ctx context.Context, name string, item RPC) error
+	// Patch updates only the given fields of the RPC identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RPCAPI = &RPCResource{}
+
+// RadiusAPI is the interface of RadiusResource, which mocks implement in tests.
+type RadiusAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusList, error)
+	Get(ctx context.Context, fullPathName string) (*Radius, error)
+	Create(ctx context.Context, item Radius) error
+	Update(ctx context.Context, name string, item Radius) error
+	// Patch updates only the given fields of the Radius identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RadiusAPI = &RadiusResource{}
+
+// RadiusAccountingAPI is the interface of RadiusAccountingResource, which mocks implement in tests.
+type RadiusAccountingAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusAccountingList, error)
+	Get(ctx context.Context, fullPathName string) (*RadiusAccounting, error)
+	Create(ctx context.Context, item RadiusAccounting) error
+	Update(ctx context.Context, name string, item RadiusAccounting) error
+	// Patch updates only the given fields of the RadiusAccounting identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RadiusAccountingAPI = &RadiusAccountingResource{}
+
+// RealServerAPI is the interface of RealServerResource, which mocks implement in tests.
+type RealServerAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RealServerList, error)
+	Get(ctx context.Context, fullPathName string) (*RealServer, error)
+	Create(ctx context.Context, item RealServer) error
+	Update(ctx context.Context, name string, item RealServer) error
+	// Patch updates only the given fields of the RealServer identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RealServerAPI = &RealServerResource{}
+
+// SASPAPI is the interface of SASPResource, which mocks implement in tests.
+type SASPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SASPList, error)
+	Get(ctx context.Context, fullPathName string) (*SASP, error)
+	Create(ctx context.Context, item SASP) error
+	Update(ctx context.Context, name string, item SASP) error
+	// Patch updates only the given fields of the SASP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SASPAPI = &SASPResource{}
+
+// SIPAPI is the interface of SIPResource, which mocks implement in tests.
+type SIPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SIPList, error)
+	Get(ctx context.Context, fullPathName string) (*SIP, error)
+	Create(ctx context.Context, item SIP) error
+	Update(ctx context.Context, name string, item SIP) error
+	// Patch updates only the given fields of the SIP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SIPAPI = &SIPResource{}
+
+// SMBAPI is the interface of SMBResource, which mocks implement in tests.
+type SMBAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SMBList, error)
+	Get(ctx context.Context, fullPathName string) (*SMB, error)
+	Create(ctx context.Context, item SMB) error
+	Update(ctx context.Context, name string, item SMB) error
+	// Patch updates only the given fields of the SMB identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SMBAPI = &SMBResource{}
+
+// SMTPAPI is the interface of SMTPResource, which mocks implement in tests.
+type SMTPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPList, error)
+	Get(ctx context.Context, fullPathName string) (*SMTP, error)
+	Create(ctx context.Context, item SMTP) error
+	Update(ctx context.Context, name string, item SMTP) error
+	// Patch updates only the given fields of the SMTP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SMTPAPI = &SMTPResource{}
+
+// SNMPDCAAPI is the interface of SNMPDCAResource, which mocks implement in tests.
+type SNMPDCAAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPDCAList, error)
+	Get(ctx context.Context, fullPathName string) (*SNMPDCA, error)
+	Create(ctx context.Context, item SNMPDCA) error
+	Update(ctx context.Context, name string, item SNMPDCA) error
+	// Patch updates only the given fields of the SNMPDCA identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SNMPDCAAPI = &SNMPDCAResource{}
+
+// SNMPDCABaseAPI is the interface of SNMPDCABaseResource, which mocks implement in tests.
+type SNMPDCABaseAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPDCABaseList, error)
+	Get(ctx context.Context, fullPathName string) (*SNMPDCABase, error)
+	Create(ctx context.Context, item SNMPDCABase) error
+	Update(ctx context.Context, name string, item SNMPDCABase) error
+	// Patch updates only the given fields of the SNMPDCABase identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SNMPDCABaseAPI = &SNMPDCABaseResource{}
+
+// SOAPAPI is the interface of SOAPResource, which mocks implement in tests.
+type SOAPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SOAPList, error)
+	Get(ctx context.Context, fullPathName string) (*SOAP, error)
+	Create(ctx context.Context, item SOAP) error
+	Update(ctx context.Context, name string, item SOAP) error
+	// Patch updates only the given fields of the SOAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SOAPAPI = &SOAPResource{}
+
+// ScriptedAPI is the interface of ScriptedResource, which mocks implement in tests.
+type ScriptedAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ScriptedList, error)
+	Get(ctx context.Context, fullPathName string) (*Scripted, error)
+	Create(ctx context.Context, item Scripted) error
+	Update(ctx context.Context, name string, item Scripted) error
+	// Patch updates only the given fields of the Scripted identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ScriptedAPI = &ScriptedResource{}
+
+// TCPAPI is the interface of TCPResource, which mocks implement in tests.
+type TCPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPList, error)
+	Get(ctx context.Context, fullPathName string) (*TCP, error)
+	Create(ctx context.Context, item TCP) error
+	Update(ctx context.Context, name string, item TCP) error
+	// Patch updates only the given fields of the TCP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TCPAPI = &TCPResource{}
+
+// TCPEchoAPI is the interface of TCPEchoResource, which mocks implement in tests.
+type TCPEchoAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPEchoList, error)
+	Get(ctx context.Context, fullPathName string) (*TCPEcho, error)
+	Create(ctx context.Context, item TCPEcho) error
+	Update(ctx context.Context, name string, item TCPEcho) error
+	// Patch updates only the given fields of the TCPEcho identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TCPEchoAPI = &TCPEchoResource{}
+
+// TCPHalfOpenAPI is the interface of TCPHalfOpenResource, which mocks implement in tests.
+type TCPHalfOpenAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPHalfOpenList, error)
+	Get(ctx context.Context, fullPathName string) (*TCPHalfOpen, error)
+	Create(ctx context.Context, item TCPHalfOpen) error
+	Update(ctx context.Context, name string, item TCPHalfOpen) error
+	// Patch updates only the given fields of the TCPHalfOpen identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TCPHalfOpenAPI = &TCPHalfOpenResource{}
+
+// UDPAPI is the interface of UDPResource, which mocks implement in tests.
+type UDPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*UDPList, error)
+	Get(ctx context.Context, fullPathName string) (*UDP, error)
+	Create(ctx context.Context, item UDP) error
+	Update(ctx context.Context, name string, item UDP) error
+	// Patch updates only the given fields of the UDP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ UDPAPI = &UDPResource{}
+
+// VirtualLocationAPI is the interface of VirtualLocationResource, which mocks implement in tests.
+type VirtualLocationAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*VirtualLocationList, error)
+	Get(ctx context.Context, fullPathName string) (*VirtualLocation, error)
+	Create(ctx context.Context, item VirtualLocation) error
+	Update(ctx context.Context, name string, item VirtualLocation) error
+	// Patch updates only the given fields of the VirtualLocation identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ VirtualLocationAPI = &VirtualLocationResource{}
+
+// WAPAPI is the interface of WAPResource, which mocks implement in tests.
+type WAPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WAPList, error)
+	Get(ctx context.Context, fullPathName string) (*WAP, error)
+	Create(ctx context.Context, item WAP) error
+	Update(ctx context.Context, name string, item WAP) error
+	// Patch updates only the given fields of the WAP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ WAPAPI = &WAPResource{}
+
+// WMIAPI is the interface of WMIResource, which mocks implement in tests.
+type WMIAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WMIList, error)
+	Get(ctx context.Context, fullPathName string) (*WMI, error)
+	Create(ctx context.Context, item WMI) error
+	Update(ctx context.Context, name string, item WMI) error
+	// Patch updates only the given fields of the WMI identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ WMIAPI = &WMIResource{}
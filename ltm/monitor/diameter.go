@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type DiameterList struct {
@@ -48,66 +46,32 @@ type DiameterResource struct {
 	b *bigip.BigIP
 }
 
-func (mdr *DiameterResource) List() (*DiameterList, error) {
-	var mdcl DiameterList
-	res, err := mdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DiameterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DiameterResource.
+func (mdr *DiameterResource) collection() *bigip.Collection[Diameter, DiameterList] {
+	return bigip.NewCollection[Diameter, DiameterList](mdr.b, LtmManager, MonitorEndpoint, DiameterEndpoint)
+}
+
+func (mdr *DiameterResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DiameterList, error) {
+	return mdr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+func (mdr *DiameterResource) Get(ctx context.Context, fullPathName string) (*Diameter, error) {
+	return mdr.collection().Get(ctx, fullPathName)
 }
 
-func (mdr *DiameterResource) Get(fullPathName string) (*Diameter, error) {
-	var mdc Diameter
-	res, err := mdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DiameterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (mdr *DiameterResource) Create(ctx context.Context, item Diameter) error {
+	return mdr.collection().Create(ctx, item)
 }
 
-func (mdr *DiameterResource) Create(item Diameter) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DiameterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mdr *DiameterResource) Update(ctx context.Context, fullPathName string, item Diameter) error {
+	return mdr.collection().Update(ctx, fullPathName, item)
 }
 
-func (mdr *DiameterResource) Update(fullPathName string, item Diameter) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DiameterEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Diameter identified by fullPathName, see bigip.PatchBody.
+func (mdr *DiameterResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return mdr.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (mdr *DiameterResource) Delete(fullPathName string) error {
-	_, err := mdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DiameterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mdr *DiameterResource) Delete(ctx context.Context, fullPathName string) error {
+	return mdr.collection().Delete(ctx, fullPathName)
 }
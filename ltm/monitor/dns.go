@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type DNSList struct {
@@ -49,66 +47,32 @@ type DNSResource struct {
 	b *bigip.BigIP
 }
 
-func (mdr *DNSResource) List() (*DNSList, error) {
-	var mdcl DNSList
-	res, err := mdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DNSResource.
+func (mdr *DNSResource) collection() *bigip.Collection[DNS, DNSList] {
+	return bigip.NewCollection[DNS, DNSList](mdr.b, LtmManager, MonitorEndpoint, DNSEndpoint)
+}
+
+func (mdr *DNSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DNSList, error) {
+	return mdr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdcl, nil
+func (mdr *DNSResource) Get(ctx context.Context, fullPathName string) (*DNS, error) {
+	return mdr.collection().Get(ctx, fullPathName)
 }
 
-func (mdr *DNSResource) Get(fullPathName string) (*DNS, error) {
-	var mdc DNS
-	res, err := mdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mdc, nil
+func (mdr *DNSResource) Create(ctx context.Context, item DNS) error {
+	return mdr.collection().Create(ctx, item)
 }
 
-func (mdr *DNSResource) Create(item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mdr *DNSResource) Update(ctx context.Context, name string, item DNS) error {
+	return mdr.collection().Update(ctx, name, item)
 }
 
-func (mdr *DNSResource) Update(name string, item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the DNS identified by name, see bigip.PatchBody.
+func (mdr *DNSResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mdr.collection().Patch(ctx, name, fields)
 }
 
-func (mdr *DNSResource) Delete(name string) error {
-	_, err := mdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(DNSEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mdr *DNSResource) Delete(ctx context.Context, name string) error {
+	return mdr.collection().Delete(ctx, name)
 }
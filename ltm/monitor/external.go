@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ExternalList struct {
@@ -41,66 +39,32 @@ type ExternalResource struct {
 	b *bigip.BigIP
 }
 
-func (mer *ExternalResource) List() (*ExternalList, error) {
-	var mecl ExternalList
-	res, err := mer.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ExternalResource.
+func (mer *ExternalResource) collection() *bigip.Collection[External, ExternalList] {
+	return bigip.NewCollection[External, ExternalList](mer.b, LtmManager, MonitorEndpoint, ExternalEndpoint)
+}
+
+func (mer *ExternalResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ExternalList, error) {
+	return mer.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mecl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mecl, nil
+func (mer *ExternalResource) Get(ctx context.Context, fullPathName string) (*External, error) {
+	return mer.collection().Get(ctx, fullPathName)
 }
 
-func (mer *ExternalResource) Get(fullPathName string) (*External, error) {
-	var mec External
-	res, err := mer.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mec); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mec, nil
+func (mer *ExternalResource) Create(ctx context.Context, item External) error {
+	return mer.collection().Create(ctx, item)
 }
 
-func (mer *ExternalResource) Create(item External) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mer.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mer *ExternalResource) Update(ctx context.Context, name string, item External) error {
+	return mer.collection().Update(ctx, name, item)
 }
 
-func (mer *ExternalResource) Update(name string, item External) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mer.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the External identified by name, see bigip.PatchBody.
+func (mer *ExternalResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mer.collection().Patch(ctx, name, fields)
 }
 
-func (mer *ExternalResource) Delete(name string) error {
-	_, err := mer.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ExternalEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mer *ExternalResource) Delete(ctx context.Context, name string) error {
+	return mer.collection().Delete(ctx, name)
 }
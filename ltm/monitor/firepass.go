@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type FirepassList struct {
@@ -41,66 +39,32 @@ type FirepassResource struct {
 	b *bigip.BigIP
 }
 
-func (mfr *FirepassResource) List() (*FirepassList, error) {
-	var mfcl FirepassList
-	res, err := mfr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FirepassResource.
+func (mfr *FirepassResource) collection() *bigip.Collection[Firepass, FirepassList] {
+	return bigip.NewCollection[Firepass, FirepassList](mfr.b, LtmManager, MonitorEndpoint, FirepassEndpoint)
+}
+
+func (mfr *FirepassResource) List(ctx context.Context, opts ...*rest.ListOptions) (*FirepassList, error) {
+	return mfr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mfcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mfcl, nil
+func (mfr *FirepassResource) Get(ctx context.Context, fullPathName string) (*Firepass, error) {
+	return mfr.collection().Get(ctx, fullPathName)
 }
 
-func (mfr *FirepassResource) Get(fullPathName string) (*Firepass, error) {
-	var mfc Firepass
-	res, err := mfr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mfc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mfc, nil
+func (mfr *FirepassResource) Create(ctx context.Context, item Firepass) error {
+	return mfr.collection().Create(ctx, item)
 }
 
-func (mfr *FirepassResource) Create(item Firepass) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mfr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mfr *FirepassResource) Update(ctx context.Context, name string, item Firepass) error {
+	return mfr.collection().Update(ctx, name, item)
 }
 
-func (mfr *FirepassResource) Update(name string, item Firepass) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mfr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Firepass identified by name, see bigip.PatchBody.
+func (mfr *FirepassResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mfr.collection().Patch(ctx, name, fields)
 }
 
-func (mfr *FirepassResource) Delete(name string) error {
-	_, err := mfr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FirepassEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mfr *FirepassResource) Delete(ctx context.Context, name string) error {
+	return mfr.collection().Delete(ctx, name)
 }
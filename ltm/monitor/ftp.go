@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type FTPList struct {
@@ -45,66 +43,32 @@ type FTPResource struct {
 	b *bigip.BigIP
 }
 
-func (mfr *FTPResource) List() (*FTPList, error) {
-	var mfcl FTPList
-	res, err := mfr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FTPResource.
+func (mfr *FTPResource) collection() *bigip.Collection[FTP, FTPList] {
+	return bigip.NewCollection[FTP, FTPList](mfr.b, LtmManager, MonitorEndpoint, FTPEndpoint)
+}
+
+func (mfr *FTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*FTPList, error) {
+	return mfr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mfcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mfcl, nil
+func (mfr *FTPResource) Get(ctx context.Context, fullPathName string) (*FTP, error) {
+	return mfr.collection().Get(ctx, fullPathName)
 }
 
-func (mfr *FTPResource) Get(fullPathName string) (*FTP, error) {
-	var mfc FTP
-	res, err := mfr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mfc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mfc, nil
+func (mfr *FTPResource) Create(ctx context.Context, item FTP) error {
+	return mfr.collection().Create(ctx, item)
 }
 
-func (mfr *FTPResource) Create(item FTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mfr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mfr *FTPResource) Update(ctx context.Context, name string, item FTP) error {
+	return mfr.collection().Update(ctx, name, item)
 }
 
-func (mfr *FTPResource) Update(name string, item FTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mfr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the FTP identified by name, see bigip.PatchBody.
+func (mfr *FTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mfr.collection().Patch(ctx, name, fields)
 }
 
-func (mfr *FTPResource) Delete(name string) error {
-	_, err := mfr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(FTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mfr *FTPResource) Delete(ctx context.Context, name string) error {
+	return mfr.collection().Delete(ctx, name)
 }
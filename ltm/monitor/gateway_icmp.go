@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type GatewayICMPList struct {
@@ -43,66 +41,32 @@ type GatewayICMPResource struct {
 	b *bigip.BigIP
 }
 
-func (mgir *GatewayICMPResource) List() (*GatewayICMPList, error) {
-	var mgicl GatewayICMPList
-	res, err := mgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing GatewayICMPResource.
+func (mgir *GatewayICMPResource) collection() *bigip.Collection[GatewayICMP, GatewayICMPList] {
+	return bigip.NewCollection[GatewayICMP, GatewayICMPList](mgir.b, LtmManager, MonitorEndpoint, GatewayICMPEndpoint)
+}
+
+func (mgir *GatewayICMPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*GatewayICMPList, error) {
+	return mgir.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mgicl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mgicl, nil
+func (mgir *GatewayICMPResource) Get(ctx context.Context, fullPathName string) (*GatewayICMP, error) {
+	return mgir.collection().Get(ctx, fullPathName)
 }
 
-func (mgir *GatewayICMPResource) Get(fullPathName string) (*GatewayICMP, error) {
-	var mgic GatewayICMP
-	res, err := mgir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mgic); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mgic, nil
+func (mgir *GatewayICMPResource) Create(ctx context.Context, item GatewayICMP) error {
+	return mgir.collection().Create(ctx, item)
 }
 
-func (mgir *GatewayICMPResource) Create(item GatewayICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mgir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mgir *GatewayICMPResource) Update(ctx context.Context, name string, item GatewayICMP) error {
+	return mgir.collection().Update(ctx, name, item)
 }
 
-func (mgir *GatewayICMPResource) Update(name string, item GatewayICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mgir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the GatewayICMP identified by name, see bigip.PatchBody.
+func (mgir *GatewayICMPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mgir.collection().Patch(ctx, name, fields)
 }
 
-func (mgir *GatewayICMPResource) Delete(name string) error {
-	_, err := mgir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(GatewayICMPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mgir *GatewayICMPResource) Delete(ctx context.Context, name string) error {
+	return mgir.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTPList struct {
@@ -48,66 +46,32 @@ type HTTPResource struct {
 	b *bigip.BigIP
 }
 
-func (mhr *HTTPResource) List() (*HTTPList, error) {
-	var mhcl HTTPList
-	res, err := mhr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPResource.
+func (mhr *HTTPResource) collection() *bigip.Collection[HTTP, HTTPList] {
+	return bigip.NewCollection[HTTP, HTTPList](mhr.b, LtmManager, MonitorEndpoint, HTTPEndpoint)
+}
+
+func (mhr *HTTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPList, error) {
+	return mhr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mhcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mhcl, nil
+func (mhr *HTTPResource) Get(ctx context.Context, fullPathName string) (*HTTP, error) {
+	return mhr.collection().Get(ctx, fullPathName)
 }
 
-func (mhr *HTTPResource) Get(fullPathName string) (*HTTP, error) {
-	var mhc HTTP
-	res, err := mhr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mhc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mhc, nil
+func (mhr *HTTPResource) Create(ctx context.Context, item HTTP) error {
+	return mhr.collection().Create(ctx, item)
 }
 
-func (mhr *HTTPResource) Create(item HTTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mhr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mhr *HTTPResource) Update(ctx context.Context, name string, item HTTP) error {
+	return mhr.collection().Update(ctx, name, item)
 }
 
-func (mhr *HTTPResource) Update(name string, item HTTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mhr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTP identified by name, see bigip.PatchBody.
+func (mhr *HTTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mhr.collection().Patch(ctx, name, fields)
 }
 
-func (mhr *HTTPResource) Delete(name string) error {
-	_, err := mhr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mhr *HTTPResource) Delete(ctx context.Context, name string) error {
+	return mhr.collection().Delete(ctx, name)
 }
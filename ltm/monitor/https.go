@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTPSList struct {
@@ -50,66 +48,32 @@ type HTTPSResource struct {
 	b *bigip.BigIP
 }
 
-func (mhr *HTTPSResource) List() (*HTTPSList, error) {
-	var mhcl HTTPSList
-	res, err := mhr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPSResource.
+func (mhr *HTTPSResource) collection() *bigip.Collection[HTTPS, HTTPSList] {
+	return bigip.NewCollection[HTTPS, HTTPSList](mhr.b, LtmManager, MonitorEndpoint, HTTPSEndpoint)
+}
+
+func (mhr *HTTPSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPSList, error) {
+	return mhr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mhcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mhcl, nil
+func (mhr *HTTPSResource) Get(ctx context.Context, fullPathName string) (*HTTPS, error) {
+	return mhr.collection().Get(ctx, fullPathName)
 }
 
-func (mhr *HTTPSResource) Get(fullPathName string) (*HTTPS, error) {
-	var mhc HTTPS
-	res, err := mhr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mhc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mhc, nil
+func (mhr *HTTPSResource) Create(ctx context.Context, item HTTPS) error {
+	return mhr.collection().Create(ctx, item)
 }
 
-func (mhr *HTTPSResource) Create(item HTTPS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mhr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mhr *HTTPSResource) Update(ctx context.Context, name string, item HTTPS) error {
+	return mhr.collection().Update(ctx, name, item)
 }
 
-func (mhr *HTTPSResource) Update(name string, item HTTPS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mhr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTPS identified by name, see bigip.PatchBody.
+func (mhr *HTTPSResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mhr.collection().Patch(ctx, name, fields)
 }
 
-func (mhr *HTTPSResource) Delete(name string) error {
-	_, err := mhr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(HTTPSEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mhr *HTTPSResource) Delete(ctx context.Context, name string) error {
+	return mhr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ICMPList struct {
@@ -44,66 +42,32 @@ type ICMPResource struct {
 	b *bigip.BigIP
 }
 
-func (mir *ICMPResource) List() (*ICMPList, error) {
-	var micl ICMPList
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ICMPResource.
+func (mir *ICMPResource) collection() *bigip.Collection[ICMP, ICMPList] {
+	return bigip.NewCollection[ICMP, ICMPList](mir.b, LtmManager, MonitorEndpoint, ICMPEndpoint)
+}
+
+func (mir *ICMPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ICMPList, error) {
+	return mir.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &micl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &micl, nil
+func (mir *ICMPResource) Get(ctx context.Context, fullPathName string) (*ICMP, error) {
+	return mir.collection().Get(ctx, fullPathName)
 }
 
-func (mir *ICMPResource) Get(fullPathName string) (*ICMP, error) {
-	var mic ICMP
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mic); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mic, nil
+func (mir *ICMPResource) Create(ctx context.Context, item ICMP) error {
+	return mir.collection().Create(ctx, item)
 }
 
-func (mir *ICMPResource) Create(item ICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *ICMPResource) Update(ctx context.Context, fullPathName string, item ICMP) error {
+	return mir.collection().Update(ctx, fullPathName, item)
 }
 
-func (mir *ICMPResource) Update(fullPathName string, item ICMP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ICMP identified by fullPathName, see bigip.PatchBody.
+func (mir *ICMPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return mir.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (mir *ICMPResource) Delete(fullPathName string) error {
-	_, err := mir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ICMPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *ICMPResource) Delete(ctx context.Context, fullPathName string) error {
+	return mir.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type IMAPList struct {
@@ -39,66 +37,32 @@ type IMAPResource struct {
 	b *bigip.BigIP
 }
 
-func (mir *IMAPResource) List() (*IMAPList, error) {
-	var micl IMAPList
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing IMAPResource.
+func (mir *IMAPResource) collection() *bigip.Collection[IMAP, IMAPList] {
+	return bigip.NewCollection[IMAP, IMAPList](mir.b, LtmManager, MonitorEndpoint, IMAPEndpoint)
+}
+
+func (mir *IMAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*IMAPList, error) {
+	return mir.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &micl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &micl, nil
+func (mir *IMAPResource) Get(ctx context.Context, fullPathName string) (*IMAP, error) {
+	return mir.collection().Get(ctx, fullPathName)
 }
 
-func (mir *IMAPResource) Get(fullPathName string) (*IMAP, error) {
-	var mic IMAP
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mic); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mic, nil
+func (mir *IMAPResource) Create(ctx context.Context, item IMAP) error {
+	return mir.collection().Create(ctx, item)
 }
 
-func (mir *IMAPResource) Create(item IMAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *IMAPResource) Update(ctx context.Context, name string, item IMAP) error {
+	return mir.collection().Update(ctx, name, item)
 }
 
-func (mir *IMAPResource) Update(name string, item IMAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the IMAP identified by name, see bigip.PatchBody.
+func (mir *IMAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mir.collection().Patch(ctx, name, fields)
 }
 
-func (mir *IMAPResource) Delete(name string) error {
-	_, err := mir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *IMAPResource) Delete(ctx context.Context, name string) error {
+	return mir.collection().Delete(ctx, name)
 }
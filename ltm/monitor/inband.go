@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type InbandList struct {
@@ -36,66 +34,32 @@ type InbandResource struct {
 	b *bigip.BigIP
 }
 
-func (mir *InbandResource) List() (*InbandList, error) {
-	var micl InbandList
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(InbandEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing InbandResource.
+func (mir *InbandResource) collection() *bigip.Collection[Inband, InbandList] {
+	return bigip.NewCollection[Inband, InbandList](mir.b, LtmManager, MonitorEndpoint, InbandEndpoint)
+}
+
+func (mir *InbandResource) List(ctx context.Context, opts ...*rest.ListOptions) (*InbandList, error) {
+	return mir.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &micl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &micl, nil
+func (mir *InbandResource) Get(ctx context.Context, fullPathName string) (*Inband, error) {
+	return mir.collection().Get(ctx, fullPathName)
 }
 
-func (mir *InbandResource) Get(fullPathName string) (*Inband, error) {
-	var mic Inband
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(InbandEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mic); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mic, nil
+func (mir *InbandResource) Create(ctx context.Context, item Inband) error {
+	return mir.collection().Create(ctx, item)
 }
 
-func (mir *InbandResource) Create(item Inband) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(InbandEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *InbandResource) Update(ctx context.Context, name string, item Inband) error {
+	return mir.collection().Update(ctx, name, item)
 }
 
-func (mir *InbandResource) Update(name string, item Inband) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(InbandEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Inband identified by name, see bigip.PatchBody.
+func (mir *InbandResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mir.collection().Patch(ctx, name, fields)
 }
 
-func (mir *InbandResource) Delete(name string) error {
-	_, err := mir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(InbandEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *InbandResource) Delete(ctx context.Context, name string) error {
+	return mir.collection().Delete(ctx, name)
 }
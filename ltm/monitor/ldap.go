@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type LDAPList struct {
@@ -43,66 +41,32 @@ type LDAPResource struct {
 	b *bigip.BigIP
 }
 
-func (mlr *LDAPResource) List() (*LDAPList, error) {
-	var mlcl LDAPList
-	res, err := mlr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing LDAPResource.
+func (mlr *LDAPResource) collection() *bigip.Collection[LDAP, LDAPList] {
+	return bigip.NewCollection[LDAP, LDAPList](mlr.b, LtmManager, MonitorEndpoint, LDAPEndpoint)
+}
+
+func (mlr *LDAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*LDAPList, error) {
+	return mlr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mlcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mlcl, nil
+func (mlr *LDAPResource) Get(ctx context.Context, fullPathName string) (*LDAP, error) {
+	return mlr.collection().Get(ctx, fullPathName)
 }
 
-func (mlr *LDAPResource) Get(fullPathName string) (*LDAP, error) {
-	var mlc LDAP
-	res, err := mlr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mlc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mlc, nil
+func (mlr *LDAPResource) Create(ctx context.Context, item LDAP) error {
+	return mlr.collection().Create(ctx, item)
 }
 
-func (mlr *LDAPResource) Create(item LDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mlr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mlr *LDAPResource) Update(ctx context.Context, name string, item LDAP) error {
+	return mlr.collection().Update(ctx, name, item)
 }
 
-func (mlr *LDAPResource) Update(name string, item LDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mlr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the LDAP identified by name, see bigip.PatchBody.
+func (mlr *LDAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mlr.collection().Patch(ctx, name, fields)
 }
 
-func (mlr *LDAPResource) Delete(name string) error {
-	_, err := mlr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(LDAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mlr *LDAPResource) Delete(ctx context.Context, name string) error {
+	return mlr.collection().Delete(ctx, name)
 }
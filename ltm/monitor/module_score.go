@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ModuleScoreList struct {
@@ -42,66 +40,32 @@ type ModuleScoreResource struct {
 	b *bigip.BigIP
 }
 
-func (mmsr *ModuleScoreResource) List() (*ModuleScoreList, error) {
-	var mmscl ModuleScoreList
-	res, err := mmsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ModuleScoreEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ModuleScoreResource.
+func (mmsr *ModuleScoreResource) collection() *bigip.Collection[ModuleScore, ModuleScoreList] {
+	return bigip.NewCollection[ModuleScore, ModuleScoreList](mmsr.b, LtmManager, MonitorEndpoint, ModuleScoreEndpoint)
+}
+
+func (mmsr *ModuleScoreResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ModuleScoreList, error) {
+	return mmsr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mmscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mmscl, nil
+func (mmsr *ModuleScoreResource) Get(ctx context.Context, fullPathName string) (*ModuleScore, error) {
+	return mmsr.collection().Get(ctx, fullPathName)
 }
 
-func (mmsr *ModuleScoreResource) Get(fullPathName string) (*ModuleScore, error) {
-	var mmsc ModuleScore
-	res, err := mmsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ModuleScoreEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mmsc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mmsc, nil
+func (mmsr *ModuleScoreResource) Create(ctx context.Context, item ModuleScore) error {
+	return mmsr.collection().Create(ctx, item)
 }
 
-func (mmsr *ModuleScoreResource) Create(item ModuleScore) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mmsr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ModuleScoreEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mmsr *ModuleScoreResource) Update(ctx context.Context, name string, item ModuleScore) error {
+	return mmsr.collection().Update(ctx, name, item)
 }
 
-func (mmsr *ModuleScoreResource) Update(name string, item ModuleScore) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mmsr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ModuleScoreEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ModuleScore identified by name, see bigip.PatchBody.
+func (mmsr *ModuleScoreResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mmsr.collection().Patch(ctx, name, fields)
 }
 
-func (mmsr *ModuleScoreResource) Delete(name string) error {
-	_, err := mmsr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ModuleScoreEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mmsr *ModuleScoreResource) Delete(ctx context.Context, name string) error {
+	return mmsr.collection().Delete(ctx, name)
 }
@@ -1,5 +1,7 @@
 package monitor
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 const LtmManager = "ltm"
@@ -91,197 +93,157 @@ func NewMonitor(b *bigip.BigIP) MonitorResource {
 	}
 }
 
-type Monitor interface {
-	Diameter() *DiameterResource
-	DNS() *DNSResource
-	External() *ExternalResource
-	Firepass() *FirepassResource
-	FTP() *FTPResource
-	GatewayICMP() *GatewayICMPResource
-	HTTP() *HTTPResource
-	HTTPS() *HTTPSResource
-	ICMP() *ICMPResource
-	IMAP() *IMAPResource
-	Inband() *InbandResource
-	LDAP() *LDAPResource
-	ModuleScore() *ModuleScoreResource
-	MSSQL() *MSSQLResource
-	MySQL() *MySQLResource
-	NNTP() *NNTPResource
-	Oracle() *OracleResource
-	POP3() *POP3Resource
-	PostgreSQL() *PostgreSQLResource
-	RadiusAccounting() *RadiusAccountingResource
-	Radius() *RadiusResource
-	RealServer() *RealServerResource
-	RPC() *RPCResource
-	SASP() *SASPResource
-	Scripted() *ScriptedResource
-	SIP() *SIPResource
-	SMB() *SMBResource
-	SMTP() *SMTPResource
-	SNMPDCABase() *SNMPDCABaseResource
-	SNMPDCA() *SNMPDCAResource
-	SOAP() *SOAPResource
-	TCPEcho() *TCPEchoResource
-	TCP() *TCPResource
-	TCPHalfOpen() *TCPHalfOpenResource
-	UDP() *UDPResource
-	VirtualLocation() *VirtualLocationResource
-	WAP() *WAPResource
-	WMI() *WMIResource
-}
-
-var _ Monitor = MonitorResource{}
-
-func (m MonitorResource) Diameter() *DiameterResource {
+// Monitor is the former name of MonitorAPI.
+type Monitor = MonitorAPI
+
+func (m MonitorResource) Diameter() DiameterAPI {
 	return &m.diameter
 }
 
-func (m MonitorResource) DNS() *DNSResource {
+func (m MonitorResource) DNS() DNSAPI {
 	return &m.dns
 }
 
-func (m MonitorResource) External() *ExternalResource {
+func (m MonitorResource) External() ExternalAPI {
 	return &m.external
 }
 
-func (m MonitorResource) Firepass() *FirepassResource {
+func (m MonitorResource) Firepass() FirepassAPI {
 	return &m.firepass
 }
 
-func (m MonitorResource) FTP() *FTPResource {
+func (m MonitorResource) FTP() FTPAPI {
 	return &m.ftp
 }
 
-func (m MonitorResource) GatewayICMP() *GatewayICMPResource {
+func (m MonitorResource) GatewayICMP() GatewayICMPAPI {
 	return &m.gatewayicmp
 }
 
-func (m MonitorResource) HTTP() *HTTPResource {
+func (m MonitorResource) HTTP() HTTPAPI {
 	return &m.http
 }
 
-func (m MonitorResource) HTTPS() *HTTPSResource {
+func (m MonitorResource) HTTPS() HTTPSAPI {
 	return &m.https
 }
 
-func (m MonitorResource) ICMP() *ICMPResource {
+func (m MonitorResource) ICMP() ICMPAPI {
 	return &m.icmp
 }
 
-func (m MonitorResource) IMAP() *IMAPResource {
+func (m MonitorResource) IMAP() IMAPAPI {
 	return &m.imap
 }
 
-func (m MonitorResource) Inband() *InbandResource {
+func (m MonitorResource) Inband() InbandAPI {
 	return &m.inband
 }
 
-func (m MonitorResource) LDAP() *LDAPResource {
+func (m MonitorResource) LDAP() LDAPAPI {
 	return &m.ldap
 }
 
-func (m MonitorResource) ModuleScore() *ModuleScoreResource {
+func (m MonitorResource) ModuleScore() ModuleScoreAPI {
 	return &m.modulescore
 }
 
-func (m MonitorResource) MSSQL() *MSSQLResource {
+func (m MonitorResource) MSSQL() MSSQLAPI {
 	return &m.mssql
 }
 
-func (m MonitorResource) MySQL() *MySQLResource {
+func (m MonitorResource) MySQL() MySQLAPI {
 	return &m.mysql
 }
 
-func (m MonitorResource) NNTP() *NNTPResource {
+func (m MonitorResource) NNTP() NNTPAPI {
 	return &m.nntp
 }
 
-func (m MonitorResource) Oracle() *OracleResource {
+func (m MonitorResource) Oracle() OracleAPI {
 	return &m.oracle
 }
 
-func (m MonitorResource) POP3() *POP3Resource {
+func (m MonitorResource) POP3() POP3API {
 	return &m.pop3
 }
 
-func (m MonitorResource) PostgreSQL() *PostgreSQLResource {
+func (m MonitorResource) PostgreSQL() PostgreSQLAPI {
 	return &m.postgresql
 }
 
-func (m MonitorResource) RadiusAccounting() *RadiusAccountingResource {
+func (m MonitorResource) RadiusAccounting() RadiusAccountingAPI {
 	return &m.radiusaccounting
 }
 
-func (m MonitorResource) Radius() *RadiusResource {
+func (m MonitorResource) Radius() RadiusAPI {
 	return &m.radius
 }
 
-func (m MonitorResource) RealServer() *RealServerResource {
+func (m MonitorResource) RealServer() RealServerAPI {
 	return &m.realserver
 }
 
-func (m MonitorResource) RPC() *RPCResource {
+func (m MonitorResource) RPC() RPCAPI {
 	return &m.rpc
 }
 
-func (m MonitorResource) SASP() *SASPResource {
+func (m MonitorResource) SASP() SASPAPI {
 	return &m.sasp
 }
 
-func (m MonitorResource) Scripted() *ScriptedResource {
+func (m MonitorResource) Scripted() ScriptedAPI {
 	return &m.scripted
 }
 
-func (m MonitorResource) SIP() *SIPResource {
+func (m MonitorResource) SIP() SIPAPI {
 	return &m.sip
 }
 
-func (m MonitorResource) SMB() *SMBResource {
+func (m MonitorResource) SMB() SMBAPI {
 	return &m.smb
 }
 
-func (m MonitorResource) SMTP() *SMTPResource {
+func (m MonitorResource) SMTP() SMTPAPI {
 	return &m.smtp
 }
 
-func (m MonitorResource) SNMPDCABase() *SNMPDCABaseResource {
+func (m MonitorResource) SNMPDCABase() SNMPDCABaseAPI {
 	return &m.snmpdcabase
 }
 
-func (m MonitorResource) SNMPDCA() *SNMPDCAResource {
+func (m MonitorResource) SNMPDCA() SNMPDCAAPI {
 	return &m.snmpdca
 }
 
-func (m MonitorResource) SOAP() *SOAPResource {
+func (m MonitorResource) SOAP() SOAPAPI {
 	return &m.soap
 }
 
-func (m MonitorResource) TCPEcho() *TCPEchoResource {
+func (m MonitorResource) TCPEcho() TCPEchoAPI {
 	return &m.tcpecho
 }
 
-func (m MonitorResource) TCP() *TCPResource {
+func (m MonitorResource) TCP() TCPAPI {
 	return &m.tcp
 }
 
-func (m MonitorResource) TCPHalfOpen() *TCPHalfOpenResource {
+func (m MonitorResource) TCPHalfOpen() TCPHalfOpenAPI {
 	return &m.tcphalfopen
 }
 
-func (m MonitorResource) UDP() *UDPResource {
+func (m MonitorResource) UDP() UDPAPI {
 	return &m.udp
 }
 
-func (m MonitorResource) VirtualLocation() *VirtualLocationResource {
+func (m MonitorResource) VirtualLocation() VirtualLocationAPI {
 	return &m.virtuallocation
 }
 
-func (m MonitorResource) WAP() *WAPResource {
+func (m MonitorResource) WAP() WAPAPI {
 	return &m.wap
 }
 
-func (m MonitorResource) WMI() *WMIResource {
+func (m MonitorResource) WMI() WMIAPI {
 	return &m.wmi
 }
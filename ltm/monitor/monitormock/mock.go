@@ -0,0 +1,3022 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package monitormock provides mocks of the interfaces of package monitor, which record their calls.
+package monitormock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/ltm/monitor"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// DNSAPI is a mock of monitor.DNSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DNSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.DNSList, error)
+	GetFunc    func(context.Context, string) (*monitor.DNS, error)
+	CreateFunc func(context.Context, monitor.DNS) error
+	UpdateFunc func(context.Context, string, monitor.DNS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.DNSAPI = &DNSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DNSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.DNSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DNSAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.DNS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DNSAPI) Create(ctx context.Context, item monitor.DNS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DNSAPI) Update(ctx context.Context, name string, item monitor.DNS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DNSAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DNSAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// DiameterAPI is a mock of monitor.DiameterAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DiameterAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.DiameterList, error)
+	GetFunc    func(context.Context, string) (*monitor.Diameter, error)
+	CreateFunc func(context.Context, monitor.Diameter) error
+	UpdateFunc func(context.Context, string, monitor.Diameter) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.DiameterAPI = &DiameterAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DiameterAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.DiameterList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DiameterAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Diameter, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DiameterAPI) Create(ctx context.Context, item monitor.Diameter) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DiameterAPI) Update(ctx context.Context, fullPathName string, item monitor.Diameter) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DiameterAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DiameterAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ExternalAPI is a mock of monitor.ExternalAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ExternalAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ExternalList, error)
+	GetFunc    func(context.Context, string) (*monitor.External, error)
+	CreateFunc func(context.Context, monitor.External) error
+	UpdateFunc func(context.Context, string, monitor.External) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ExternalAPI = &ExternalAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ExternalAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ExternalList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ExternalAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.External, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ExternalAPI) Create(ctx context.Context, item monitor.External) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ExternalAPI) Update(ctx context.Context, name string, item monitor.External) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ExternalAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ExternalAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// FTPAPI is a mock of monitor.FTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.FTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.FTP, error)
+	CreateFunc func(context.Context, monitor.FTP) error
+	UpdateFunc func(context.Context, string, monitor.FTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.FTPAPI = &FTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.FTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.FTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FTPAPI) Create(ctx context.Context, item monitor.FTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FTPAPI) Update(ctx context.Context, name string, item monitor.FTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// FirepassAPI is a mock of monitor.FirepassAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FirepassAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.FirepassList, error)
+	GetFunc    func(context.Context, string) (*monitor.Firepass, error)
+	CreateFunc func(context.Context, monitor.Firepass) error
+	UpdateFunc func(context.Context, string, monitor.Firepass) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.FirepassAPI = &FirepassAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FirepassAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.FirepassList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FirepassAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Firepass, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FirepassAPI) Create(ctx context.Context, item monitor.Firepass) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FirepassAPI) Update(ctx context.Context, name string, item monitor.Firepass) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FirepassAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FirepassAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// GatewayICMPAPI is a mock of monitor.GatewayICMPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GatewayICMPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.GatewayICMPList, error)
+	GetFunc    func(context.Context, string) (*monitor.GatewayICMP, error)
+	CreateFunc func(context.Context, monitor.GatewayICMP) error
+	UpdateFunc func(context.Context, string, monitor.GatewayICMP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.GatewayICMPAPI = &GatewayICMPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *GatewayICMPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.GatewayICMPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *GatewayICMPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.GatewayICMP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *GatewayICMPAPI) Create(ctx context.Context, item monitor.GatewayICMP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *GatewayICMPAPI) Update(ctx context.Context, name string, item monitor.GatewayICMP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *GatewayICMPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *GatewayICMPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// HTTPAPI is a mock of monitor.HTTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.HTTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.HTTP, error)
+	CreateFunc func(context.Context, monitor.HTTP) error
+	UpdateFunc func(context.Context, string, monitor.HTTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.HTTPAPI = &HTTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.HTTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.HTTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPAPI) Create(ctx context.Context, item monitor.HTTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPAPI) Update(ctx context.Context, name string, item monitor.HTTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// HTTPSAPI is a mock of monitor.HTTPSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.HTTPSList, error)
+	GetFunc    func(context.Context, string) (*monitor.HTTPS, error)
+	CreateFunc func(context.Context, monitor.HTTPS) error
+	UpdateFunc func(context.Context, string, monitor.HTTPS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.HTTPSAPI = &HTTPSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.HTTPSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPSAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.HTTPS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPSAPI) Create(ctx context.Context, item monitor.HTTPS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPSAPI) Update(ctx context.Context, name string, item monitor.HTTPS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPSAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPSAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ICMPAPI is a mock of monitor.ICMPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ICMPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ICMPList, error)
+	GetFunc    func(context.Context, string) (*monitor.ICMP, error)
+	CreateFunc func(context.Context, monitor.ICMP) error
+	UpdateFunc func(context.Context, string, monitor.ICMP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ICMPAPI = &ICMPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ICMPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ICMPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ICMPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.ICMP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ICMPAPI) Create(ctx context.Context, item monitor.ICMP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ICMPAPI) Update(ctx context.Context, fullPathName string, item monitor.ICMP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ICMPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ICMPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// IMAPAPI is a mock of monitor.IMAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IMAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.IMAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.IMAP, error)
+	CreateFunc func(context.Context, monitor.IMAP) error
+	UpdateFunc func(context.Context, string, monitor.IMAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.IMAPAPI = &IMAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *IMAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.IMAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *IMAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.IMAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *IMAPAPI) Create(ctx context.Context, item monitor.IMAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *IMAPAPI) Update(ctx context.Context, name string, item monitor.IMAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *IMAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *IMAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// InbandAPI is a mock of monitor.InbandAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type InbandAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.InbandList, error)
+	GetFunc    func(context.Context, string) (*monitor.Inband, error)
+	CreateFunc func(context.Context, monitor.Inband) error
+	UpdateFunc func(context.Context, string, monitor.Inband) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.InbandAPI = &InbandAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *InbandAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.InbandList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *InbandAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Inband, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *InbandAPI) Create(ctx context.Context, item monitor.Inband) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *InbandAPI) Update(ctx context.Context, name string, item monitor.Inband) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *InbandAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *InbandAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// LDAPAPI is a mock of monitor.LDAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LDAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.LDAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.LDAP, error)
+	CreateFunc func(context.Context, monitor.LDAP) error
+	UpdateFunc func(context.Context, string, monitor.LDAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.LDAPAPI = &LDAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *LDAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.LDAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *LDAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.LDAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *LDAPAPI) Create(ctx context.Context, item monitor.LDAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *LDAPAPI) Update(ctx context.Context, name string, item monitor.LDAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *LDAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *LDAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// MSSQLAPI is a mock of monitor.MSSQLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MSSQLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.MSSQLList, error)
+	GetFunc    func(context.Context, string) (*monitor.MSSQL, error)
+	CreateFunc func(context.Context, monitor.MSSQL) error
+	UpdateFunc func(context.Context, string, monitor.MSSQL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.MSSQLAPI = &MSSQLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MSSQLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.MSSQLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *MSSQLAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.MSSQL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *MSSQLAPI) Create(ctx context.Context, item monitor.MSSQL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MSSQLAPI) Update(ctx context.Context, name string, item monitor.MSSQL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *MSSQLAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *MSSQLAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ModuleScoreAPI is a mock of monitor.ModuleScoreAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ModuleScoreAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ModuleScoreList, error)
+	GetFunc    func(context.Context, string) (*monitor.ModuleScore, error)
+	CreateFunc func(context.Context, monitor.ModuleScore) error
+	UpdateFunc func(context.Context, string, monitor.ModuleScore) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ModuleScoreAPI = &ModuleScoreAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ModuleScoreAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ModuleScoreList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ModuleScoreAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.ModuleScore, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ModuleScoreAPI) Create(ctx context.Context, item monitor.ModuleScore) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ModuleScoreAPI) Update(ctx context.Context, name string, item monitor.ModuleScore) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ModuleScoreAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ModuleScoreAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// MonitorAPI is a mock of monitor.MonitorAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MonitorAPI struct {
+	bigiptest.CallRecorder
+
+	DiameterFunc         func() monitor.DiameterAPI
+	DNSFunc              func() monitor.DNSAPI
+	ExternalFunc         func() monitor.ExternalAPI
+	FirepassFunc         func() monitor.FirepassAPI
+	FTPFunc              func() monitor.FTPAPI
+	GatewayICMPFunc      func() monitor.GatewayICMPAPI
+	HTTPFunc             func() monitor.HTTPAPI
+	HTTPSFunc            func() monitor.HTTPSAPI
+	ICMPFunc             func() monitor.ICMPAPI
+	IMAPFunc             func() monitor.IMAPAPI
+	InbandFunc           func() monitor.InbandAPI
+	LDAPFunc             func() monitor.LDAPAPI
+	ModuleScoreFunc      func() monitor.ModuleScoreAPI
+	MSSQLFunc            func() monitor.MSSQLAPI
+	MySQLFunc            func() monitor.MySQLAPI
+	NNTPFunc             func() monitor.NNTPAPI
+	OracleFunc           func() monitor.OracleAPI
+	POP3Func             func() monitor.POP3API
+	PostgreSQLFunc       func() monitor.PostgreSQLAPI
+	RadiusAccountingFunc func() monitor.RadiusAccountingAPI
+	RadiusFunc           func() monitor.RadiusAPI
+	RealServerFunc       func() monitor.RealServerAPI
+	RPCFunc              func() monitor.RPCAPI
+	SASPFunc             func() monitor.SASPAPI
+	ScriptedFunc         func() monitor.ScriptedAPI
+	SIPFunc              func() monitor.SIPAPI
+	SMBFunc              func() monitor.SMBAPI
+	SMTPFunc             func() monitor.SMTPAPI
+	SNMPDCABaseFunc      func() monitor.SNMPDCABaseAPI
+	SNMPDCAFunc          func() monitor.SNMPDCAAPI
+	SOAPFunc             func() monitor.SOAPAPI
+	TCPEchoFunc          func() monitor.TCPEchoAPI
+	TCPFunc              func() monitor.TCPAPI
+	TCPHalfOpenFunc      func() monitor.TCPHalfOpenAPI
+	UDPFunc              func() monitor.UDPAPI
+	VirtualLocationFunc  func() monitor.VirtualLocationAPI
+	WAPFunc              func() monitor.WAPAPI
+	WMIFunc              func() monitor.WMIAPI
+}
+
+var _ monitor.MonitorAPI = &MonitorAPI{}
+
+// Diameter records the call and calls DiameterFunc if it is set.
+func (m *MonitorAPI) Diameter() (r0 monitor.DiameterAPI) {
+	m.CallRecorder.Record("Diameter")
+	if m.DiameterFunc != nil {
+		return m.DiameterFunc()
+	}
+	return
+}
+
+// DNS records the call and calls DNSFunc if it is set.
+func (m *MonitorAPI) DNS() (r0 monitor.DNSAPI) {
+	m.CallRecorder.Record("DNS")
+	if m.DNSFunc != nil {
+		return m.DNSFunc()
+	}
+	return
+}
+
+// External records the call and calls ExternalFunc if it is set.
+func (m *MonitorAPI) External() (r0 monitor.ExternalAPI) {
+	m.CallRecorder.Record("External")
+	if m.ExternalFunc != nil {
+		return m.ExternalFunc()
+	}
+	return
+}
+
+// Firepass records the call and calls FirepassFunc if it is set.
+func (m *MonitorAPI) Firepass() (r0 monitor.FirepassAPI) {
+	m.CallRecorder.Record("Firepass")
+	if m.FirepassFunc != nil {
+		return m.FirepassFunc()
+	}
+	return
+}
+
+// FTP records the call and calls FTPFunc if it is set.
+func (m *MonitorAPI) FTP() (r0 monitor.FTPAPI) {
+	m.CallRecorder.Record("FTP")
+	if m.FTPFunc != nil {
+		return m.FTPFunc()
+	}
+	return
+}
+
+// GatewayICMP records the call and calls GatewayICMPFunc if it is set.
+func (m *MonitorAPI) GatewayICMP() (r0 monitor.GatewayICMPAPI) {
+	m.CallRecorder.Record("GatewayICMP")
+	if m.GatewayICMPFunc != nil {
+		return m.GatewayICMPFunc()
+	}
+	return
+}
+
+// HTTP records the call and calls HTTPFunc if it is set.
+func (m *MonitorAPI) HTTP() (r0 monitor.HTTPAPI) {
+	m.CallRecorder.Record("HTTP")
+	if m.HTTPFunc != nil {
+		return m.HTTPFunc()
+	}
+	return
+}
+
+// HTTPS records the call and calls HTTPSFunc if it is set.
+func (m *MonitorAPI) HTTPS() (r0 monitor.HTTPSAPI) {
+	m.CallRecorder.Record("HTTPS")
+	if m.HTTPSFunc != nil {
+		return m.HTTPSFunc()
+	}
+	return
+}
+
+// ICMP records the call and calls ICMPFunc if it is set.
+func (m *MonitorAPI) ICMP() (r0 monitor.ICMPAPI) {
+	m.CallRecorder.Record("ICMP")
+	if m.ICMPFunc != nil {
+		return m.ICMPFunc()
+	}
+	return
+}
+
+// IMAP records the call and calls IMAPFunc if it is set.
+func (m *MonitorAPI) IMAP() (r0 monitor.IMAPAPI) {
+	m.CallRecorder.Record("IMAP")
+	if m.IMAPFunc != nil {
+		return m.IMAPFunc()
+	}
+	return
+}
+
+// Inband records the call and calls InbandFunc if it is set.
+func (m *MonitorAPI) Inband() (r0 monitor.InbandAPI) {
+	m.CallRecorder.Record("Inband")
+	if m.InbandFunc != nil {
+		return m.InbandFunc()
+	}
+	return
+}
+
+// LDAP records the call and calls LDAPFunc if it is set.
+func (m *MonitorAPI) LDAP() (r0 monitor.LDAPAPI) {
+	m.CallRecorder.Record("LDAP")
+	if m.LDAPFunc != nil {
+		return m.LDAPFunc()
+	}
+	return
+}
+
+// ModuleScore records the call and calls ModuleScoreFunc if it is set.
+func (m *MonitorAPI) ModuleScore() (r0 monitor.ModuleScoreAPI) {
+	m.CallRecorder.Record("ModuleScore")
+	if m.ModuleScoreFunc != nil {
+		return m.ModuleScoreFunc()
+	}
+	return
+}
+
+// MSSQL records the call and calls MSSQLFunc if it is set.
+func (m *MonitorAPI) MSSQL() (r0 monitor.MSSQLAPI) {
+	m.CallRecorder.Record("MSSQL")
+	if m.MSSQLFunc != nil {
+		return m.MSSQLFunc()
+	}
+	return
+}
+
+// MySQL records the call and calls MySQLFunc if it is set.
+func (m *MonitorAPI) MySQL() (r0 monitor.MySQLAPI) {
+	m.CallRecorder.Record("MySQL")
+	if m.MySQLFunc != nil {
+		return m.MySQLFunc()
+	}
+	return
+}
+
+// NNTP records the call and calls NNTPFunc if it is set.
+func (m *MonitorAPI) NNTP() (r0 monitor.NNTPAPI) {
+	m.CallRecorder.Record("NNTP")
+	if m.NNTPFunc != nil {
+		return m.NNTPFunc()
+	}
+	return
+}
+
+// Oracle records the call and calls OracleFunc if it is set.
+func (m *MonitorAPI) Oracle() (r0 monitor.OracleAPI) {
+	m.CallRecorder.Record("Oracle")
+	if m.OracleFunc != nil {
+		return m.OracleFunc()
+	}
+	return
+}
+
+// POP3 records the call and calls POP3Func if it is set.
+func (m *MonitorAPI) POP3() (r0 monitor.POP3API) {
+	m.CallRecorder.Record("POP3")
+	if m.POP3Func != nil {
+		return m.POP3Func()
+	}
+	return
+}
+
+// PostgreSQL records the call and calls PostgreSQLFunc if it is set.
+func (m *MonitorAPI) PostgreSQL() (r0 monitor.PostgreSQLAPI) {
+	m.CallRecorder.Record("PostgreSQL")
+	if m.PostgreSQLFunc != nil {
+		return m.PostgreSQLFunc()
+	}
+	return
+}
+
+// RadiusAccounting records the call and calls RadiusAccountingFunc if it is set.
+func (m *MonitorAPI) RadiusAccounting() (r0 monitor.RadiusAccountingAPI) {
+	m.CallRecorder.Record("RadiusAccounting")
+	if m.RadiusAccountingFunc != nil {
+		return m.RadiusAccountingFunc()
+	}
+	return
+}
+
+// Radius records the call and calls RadiusFunc if it is set.
+func (m *MonitorAPI) Radius() (r0 monitor.RadiusAPI) {
+	m.CallRecorder.Record("Radius")
+	if m.RadiusFunc != nil {
+		return m.RadiusFunc()
+	}
+	return
+}
+
+// RealServer records the call and calls RealServerFunc if it is set.
+func (m *MonitorAPI) RealServer() (r0 monitor.RealServerAPI) {
+	m.CallRecorder.Record("RealServer")
+	if m.RealServerFunc != nil {
+		return m.RealServerFunc()
+	}
+	return
+}
+
+// RPC records the call and calls RPCFunc if it is set.
+func (m *MonitorAPI) RPC() (r0 monitor.RPCAPI) {
+	m.CallRecorder.Record("RPC")
+	if m.RPCFunc != nil {
+		return m.RPCFunc()
+	}
+	return
+}
+
+// SASP records the call and calls SASPFunc if it is set.
+func (m *MonitorAPI) SASP() (r0 monitor.SASPAPI) {
+	m.CallRecorder.Record("SASP")
+	if m.SASPFunc != nil {
+		return m.SASPFunc()
+	}
+	return
+}
+
+// Scripted records the call and calls ScriptedFunc if it is set.
+func (m *MonitorAPI) Scripted() (r0 monitor.ScriptedAPI) {
+	m.CallRecorder.Record("Scripted")
+	if m.ScriptedFunc != nil {
+		return m.ScriptedFunc()
+	}
+	return
+}
+
+// SIP records the call and calls SIPFunc if it is set.
+func (m *MonitorAPI) SIP() (r0 monitor.SIPAPI) {
+	m.CallRecorder.Record("SIP")
+	if m.SIPFunc != nil {
+		return m.SIPFunc()
+	}
+	return
+}
+
+// SMB records the call and calls SMBFunc if it is set.
+func (m *MonitorAPI) SMB() (r0 monitor.SMBAPI) {
+	m.CallRecorder.Record("SMB")
+	if m.SMBFunc != nil {
+		return m.SMBFunc()
+	}
+	return
+}
+
+// SMTP records the call and calls SMTPFunc if it is set.
+func (m *MonitorAPI) SMTP() (r0 monitor.SMTPAPI) {
+	m.CallRecorder.Record("SMTP")
+	if m.SMTPFunc != nil {
+		return m.SMTPFunc()
+	}
+	return
+}
+
+// SNMPDCABase records the call and calls SNMPDCABaseFunc if it is set.
+func (m *MonitorAPI) SNMPDCABase() (r0 monitor.SNMPDCABaseAPI) {
+	m.CallRecorder.Record("SNMPDCABase")
+	if m.SNMPDCABaseFunc != nil {
+		return m.SNMPDCABaseFunc()
+	}
+	return
+}
+
+// SNMPDCA records the call and calls SNMPDCAFunc if it is set.
+func (m *MonitorAPI) SNMPDCA() (r0 monitor.SNMPDCAAPI) {
+	m.CallRecorder.Record("SNMPDCA")
+	if m.SNMPDCAFunc != nil {
+		return m.SNMPDCAFunc()
+	}
+	return
+}
+
+// SOAP records the call and calls SOAPFunc if it is set.
+func (m *MonitorAPI) SOAP() (r0 monitor.SOAPAPI) {
+	m.CallRecorder.Record("SOAP")
+	if m.SOAPFunc != nil {
+		return m.SOAPFunc()
+	}
+	return
+}
+
+// TCPEcho records the call and calls TCPEchoFunc if it is set.
+func (m *MonitorAPI) TCPEcho() (r0 monitor.TCPEchoAPI) {
+	m.CallRecorder.Record("TCPEcho")
+	if m.TCPEchoFunc != nil {
+		return m.TCPEchoFunc()
+	}
+	return
+}
+
+// TCP records the call and calls TCPFunc if it is set.
+func (m *MonitorAPI) TCP() (r0 monitor.TCPAPI) {
+	m.CallRecorder.Record("TCP")
+	if m.TCPFunc != nil {
+		return m.TCPFunc()
+	}
+	return
+}
+
+// TCPHalfOpen records the call and calls TCPHalfOpenFunc if it is set.
+func (m *MonitorAPI) TCPHalfOpen() (r0 monitor.TCPHalfOpenAPI) {
+	m.CallRecorder.Record("TCPHalfOpen")
+	if m.TCPHalfOpenFunc != nil {
+		return m.TCPHalfOpenFunc()
+	}
+	return
+}
+
+// UDP records the call and calls UDPFunc if it is set.
+func (m *MonitorAPI) UDP() (r0 monitor.UDPAPI) {
+	m.CallRecorder.Record("UDP")
+	if m.UDPFunc != nil {
+		return m.UDPFunc()
+	}
+	return
+}
+
+// VirtualLocation records the call and calls VirtualLocationFunc if it is set.
+func (m *MonitorAPI) VirtualLocation() (r0 monitor.VirtualLocationAPI) {
+	m.CallRecorder.Record("VirtualLocation")
+	if m.VirtualLocationFunc != nil {
+		return m.VirtualLocationFunc()
+	}
+	return
+}
+
+// WAP records the call and calls WAPFunc if it is set.
+func (m *MonitorAPI) WAP() (r0 monitor.WAPAPI) {
+	m.CallRecorder.Record("WAP")
+	if m.WAPFunc != nil {
+		return m.WAPFunc()
+	}
+	return
+}
+
+// WMI records the call and calls WMIFunc if it is set.
+func (m *MonitorAPI) WMI() (r0 monitor.WMIAPI) {
+	m.CallRecorder.Record("WMI")
+	if m.WMIFunc != nil {
+		return m.WMIFunc()
+	}
+	return
+}
+
+// MySQLAPI is a mock of monitor.MySQLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MySQLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.MySQLList, error)
+	GetFunc    func(context.Context, string) (*monitor.MySQL, error)
+	CreateFunc func(context.Context, monitor.MySQL) error
+	UpdateFunc func(context.Context, string, monitor.MySQL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.MySQLAPI = &MySQLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MySQLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.MySQLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *MySQLAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.MySQL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *MySQLAPI) Create(ctx context.Context, item monitor.MySQL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MySQLAPI) Update(ctx context.Context, name string, item monitor.MySQL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *MySQLAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *MySQLAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// NNTPAPI is a mock of monitor.NNTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NNTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.NNTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.NNTP, error)
+	CreateFunc func(context.Context, monitor.NNTP) error
+	UpdateFunc func(context.Context, string, monitor.NNTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.NNTPAPI = &NNTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NNTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.NNTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NNTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.NNTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NNTPAPI) Create(ctx context.Context, item monitor.NNTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NNTPAPI) Update(ctx context.Context, name string, item monitor.NNTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NNTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NNTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// OracleAPI is a mock of monitor.OracleAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type OracleAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.OracleList, error)
+	GetFunc    func(context.Context, string) (*monitor.Oracle, error)
+	CreateFunc func(context.Context, monitor.Oracle) error
+	UpdateFunc func(context.Context, string, monitor.Oracle) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.OracleAPI = &OracleAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *OracleAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.OracleList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *OracleAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Oracle, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *OracleAPI) Create(ctx context.Context, item monitor.Oracle) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *OracleAPI) Update(ctx context.Context, name string, item monitor.Oracle) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *OracleAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *OracleAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// POP3API is a mock of monitor.POP3API. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type POP3API struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.POP3List, error)
+	GetFunc    func(context.Context, string) (*monitor.POP3, error)
+	CreateFunc func(context.Context, monitor.POP3) error
+	UpdateFunc func(context.Context, string, monitor.POP3) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.POP3API = &POP3API{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *POP3API) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.POP3List, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *POP3API) Get(ctx context.Context, fullPathName string) (r0 *monitor.POP3, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *POP3API) Create(ctx context.Context, item monitor.POP3) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *POP3API) Update(ctx context.Context, name string, item monitor.POP3) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *POP3API) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *POP3API) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// PostgreSQLAPI is a mock of monitor.PostgreSQLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PostgreSQLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.PostgreSQLList, error)
+	GetFunc    func(context.Context, string) (*monitor.PostgreSQL, error)
+	CreateFunc func(context.Context, monitor.PostgreSQL) error
+	UpdateFunc func(context.Context, string, monitor.PostgreSQL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.PostgreSQLAPI = &PostgreSQLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PostgreSQLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.PostgreSQLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *PostgreSQLAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.PostgreSQL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *PostgreSQLAPI) Create(ctx context.Context, item monitor.PostgreSQL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *PostgreSQLAPI) Update(ctx context.Context, name string, item monitor.PostgreSQL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *PostgreSQLAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *PostgreSQLAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RPCAPI is a mock of monitor.RPCAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RPCAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RPCList, error)
+	GetFunc    func(context.Context, string) (*monitor.RPC, error)
+	CreateFunc func(context.Context, monitor.RPC) error
+	UpdateFunc func(context.Context, string, monitor.RPC) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RPCAPI = &RPCAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RPCAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RPCList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RPCAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.RPC, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RPCAPI) Create(ctx context.Context, item monitor.RPC) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RPCAPI) Update(ctx context.Context, name string, item monitor.RPC) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RPCAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RPCAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RadiusAPI is a mock of monitor.RadiusAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RadiusAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RadiusList, error)
+	GetFunc    func(context.Context, string) (*monitor.Radius, error)
+	CreateFunc func(context.Context, monitor.Radius) error
+	UpdateFunc func(context.Context, string, monitor.Radius) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RadiusAPI = &RadiusAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RadiusAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RadiusList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RadiusAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Radius, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RadiusAPI) Create(ctx context.Context, item monitor.Radius) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RadiusAPI) Update(ctx context.Context, name string, item monitor.Radius) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RadiusAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RadiusAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RadiusAccountingAPI is a mock of monitor.RadiusAccountingAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RadiusAccountingAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RadiusAccountingList, error)
+	GetFunc    func(context.Context, string) (*monitor.RadiusAccounting, error)
+	CreateFunc func(context.Context, monitor.RadiusAccounting) error
+	UpdateFunc func(context.Context, string, monitor.RadiusAccounting) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RadiusAccountingAPI = &RadiusAccountingAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RadiusAccountingAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RadiusAccountingList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RadiusAccountingAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.RadiusAccounting, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RadiusAccountingAPI) Create(ctx context.Context, item monitor.RadiusAccounting) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RadiusAccountingAPI) Update(ctx context.Context, name string, item monitor.RadiusAccounting) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RadiusAccountingAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RadiusAccountingAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RealServerAPI is a mock of monitor.RealServerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RealServerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.RealServerList, error)
+	GetFunc    func(context.Context, string) (*monitor.RealServer, error)
+	CreateFunc func(context.Context, monitor.RealServer) error
+	UpdateFunc func(context.Context, string, monitor.RealServer) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.RealServerAPI = &RealServerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RealServerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.RealServerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RealServerAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.RealServer, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RealServerAPI) Create(ctx context.Context, item monitor.RealServer) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RealServerAPI) Update(ctx context.Context, name string, item monitor.RealServer) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RealServerAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RealServerAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SASPAPI is a mock of monitor.SASPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SASPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SASPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SASP, error)
+	CreateFunc func(context.Context, monitor.SASP) error
+	UpdateFunc func(context.Context, string, monitor.SASP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SASPAPI = &SASPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SASPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SASPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SASPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SASP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SASPAPI) Create(ctx context.Context, item monitor.SASP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SASPAPI) Update(ctx context.Context, name string, item monitor.SASP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SASPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SASPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SIPAPI is a mock of monitor.SIPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SIPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SIPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SIP, error)
+	CreateFunc func(context.Context, monitor.SIP) error
+	UpdateFunc func(context.Context, string, monitor.SIP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SIPAPI = &SIPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SIPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SIPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SIPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SIP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SIPAPI) Create(ctx context.Context, item monitor.SIP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SIPAPI) Update(ctx context.Context, name string, item monitor.SIP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SIPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SIPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SMBAPI is a mock of monitor.SMBAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SMBAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SMBList, error)
+	GetFunc    func(context.Context, string) (*monitor.SMB, error)
+	CreateFunc func(context.Context, monitor.SMB) error
+	UpdateFunc func(context.Context, string, monitor.SMB) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SMBAPI = &SMBAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SMBAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SMBList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SMBAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SMB, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SMBAPI) Create(ctx context.Context, item monitor.SMB) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SMBAPI) Update(ctx context.Context, name string, item monitor.SMB) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SMBAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SMBAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SMTPAPI is a mock of monitor.SMTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SMTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SMTPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SMTP, error)
+	CreateFunc func(context.Context, monitor.SMTP) error
+	UpdateFunc func(context.Context, string, monitor.SMTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SMTPAPI = &SMTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SMTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SMTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SMTPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SMTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SMTPAPI) Create(ctx context.Context, item monitor.SMTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SMTPAPI) Update(ctx context.Context, name string, item monitor.SMTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SMTPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SMTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SNMPDCAAPI is a mock of monitor.SNMPDCAAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SNMPDCAAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SNMPDCAList, error)
+	GetFunc    func(context.Context, string) (*monitor.SNMPDCA, error)
+	CreateFunc func(context.Context, monitor.SNMPDCA) error
+	UpdateFunc func(context.Context, string, monitor.SNMPDCA) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SNMPDCAAPI = &SNMPDCAAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SNMPDCAAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SNMPDCAList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SNMPDCAAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SNMPDCA, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SNMPDCAAPI) Create(ctx context.Context, item monitor.SNMPDCA) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SNMPDCAAPI) Update(ctx context.Context, name string, item monitor.SNMPDCA) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SNMPDCAAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SNMPDCAAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SNMPDCABaseAPI is a mock of monitor.SNMPDCABaseAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SNMPDCABaseAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SNMPDCABaseList, error)
+	GetFunc    func(context.Context, string) (*monitor.SNMPDCABase, error)
+	CreateFunc func(context.Context, monitor.SNMPDCABase) error
+	UpdateFunc func(context.Context, string, monitor.SNMPDCABase) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SNMPDCABaseAPI = &SNMPDCABaseAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SNMPDCABaseAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SNMPDCABaseList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SNMPDCABaseAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SNMPDCABase, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SNMPDCABaseAPI) Create(ctx context.Context, item monitor.SNMPDCABase) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SNMPDCABaseAPI) Update(ctx context.Context, name string, item monitor.SNMPDCABase) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SNMPDCABaseAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SNMPDCABaseAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SOAPAPI is a mock of monitor.SOAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SOAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.SOAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.SOAP, error)
+	CreateFunc func(context.Context, monitor.SOAP) error
+	UpdateFunc func(context.Context, string, monitor.SOAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.SOAPAPI = &SOAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SOAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.SOAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SOAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.SOAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SOAPAPI) Create(ctx context.Context, item monitor.SOAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SOAPAPI) Update(ctx context.Context, name string, item monitor.SOAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SOAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SOAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ScriptedAPI is a mock of monitor.ScriptedAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ScriptedAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.ScriptedList, error)
+	GetFunc    func(context.Context, string) (*monitor.Scripted, error)
+	CreateFunc func(context.Context, monitor.Scripted) error
+	UpdateFunc func(context.Context, string, monitor.Scripted) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.ScriptedAPI = &ScriptedAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ScriptedAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.ScriptedList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ScriptedAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.Scripted, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ScriptedAPI) Create(ctx context.Context, item monitor.Scripted) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ScriptedAPI) Update(ctx context.Context, name string, item monitor.Scripted) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ScriptedAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ScriptedAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TCPAPI is a mock of monitor.TCPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.TCPList, error)
+	GetFunc    func(context.Context, string) (*monitor.TCP, error)
+	CreateFunc func(context.Context, monitor.TCP) error
+	UpdateFunc func(context.Context, string, monitor.TCP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.TCPAPI = &TCPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.TCPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.TCP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPAPI) Create(ctx context.Context, item monitor.TCP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPAPI) Update(ctx context.Context, name string, item monitor.TCP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TCPEchoAPI is a mock of monitor.TCPEchoAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPEchoAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.TCPEchoList, error)
+	GetFunc    func(context.Context, string) (*monitor.TCPEcho, error)
+	CreateFunc func(context.Context, monitor.TCPEcho) error
+	UpdateFunc func(context.Context, string, monitor.TCPEcho) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.TCPEchoAPI = &TCPEchoAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPEchoAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.TCPEchoList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPEchoAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.TCPEcho, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPEchoAPI) Create(ctx context.Context, item monitor.TCPEcho) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPEchoAPI) Update(ctx context.Context, name string, item monitor.TCPEcho) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPEchoAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPEchoAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TCPHalfOpenAPI is a mock of monitor.TCPHalfOpenAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPHalfOpenAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.TCPHalfOpenList, error)
+	GetFunc    func(context.Context, string) (*monitor.TCPHalfOpen, error)
+	CreateFunc func(context.Context, monitor.TCPHalfOpen) error
+	UpdateFunc func(context.Context, string, monitor.TCPHalfOpen) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.TCPHalfOpenAPI = &TCPHalfOpenAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPHalfOpenAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.TCPHalfOpenList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPHalfOpenAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.TCPHalfOpen, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPHalfOpenAPI) Create(ctx context.Context, item monitor.TCPHalfOpen) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPHalfOpenAPI) Update(ctx context.Context, name string, item monitor.TCPHalfOpen) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPHalfOpenAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPHalfOpenAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// UDPAPI is a mock of monitor.UDPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type UDPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.UDPList, error)
+	GetFunc    func(context.Context, string) (*monitor.UDP, error)
+	CreateFunc func(context.Context, monitor.UDP) error
+	UpdateFunc func(context.Context, string, monitor.UDP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.UDPAPI = &UDPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *UDPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.UDPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *UDPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.UDP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *UDPAPI) Create(ctx context.Context, item monitor.UDP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *UDPAPI) Update(ctx context.Context, name string, item monitor.UDP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *UDPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *UDPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// VirtualLocationAPI is a mock of monitor.VirtualLocationAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VirtualLocationAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.VirtualLocationList, error)
+	GetFunc    func(context.Context, string) (*monitor.VirtualLocation, error)
+	CreateFunc func(context.Context, monitor.VirtualLocation) error
+	UpdateFunc func(context.Context, string, monitor.VirtualLocation) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.VirtualLocationAPI = &VirtualLocationAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VirtualLocationAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.VirtualLocationList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VirtualLocationAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.VirtualLocation, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *VirtualLocationAPI) Create(ctx context.Context, item monitor.VirtualLocation) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *VirtualLocationAPI) Update(ctx context.Context, name string, item monitor.VirtualLocation) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *VirtualLocationAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *VirtualLocationAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// WAPAPI is a mock of monitor.WAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.WAPList, error)
+	GetFunc    func(context.Context, string) (*monitor.WAP, error)
+	CreateFunc func(context.Context, monitor.WAP) error
+	UpdateFunc func(context.Context, string, monitor.WAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.WAPAPI = &WAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *WAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.WAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *WAPAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.WAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *WAPAPI) Create(ctx context.Context, item monitor.WAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *WAPAPI) Update(ctx context.Context, name string, item monitor.WAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *WAPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *WAPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// WMIAPI is a mock of monitor.WMIAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WMIAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*monitor.WMIList, error)
+	GetFunc    func(context.Context, string) (*monitor.WMI, error)
+	CreateFunc func(context.Context, monitor.WMI) error
+	UpdateFunc func(context.Context, string, monitor.WMI) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ monitor.WMIAPI = &WMIAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *WMIAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *monitor.WMIList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *WMIAPI) Get(ctx context.Context, fullPathName string) (r0 *monitor.WMI, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *WMIAPI) Create(ctx context.Context, item monitor.WMI) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *WMIAPI) Update(ctx context.Context, name string, item monitor.WMI) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *WMIAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *WMIAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
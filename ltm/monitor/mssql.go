@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type MSSQLList struct {
@@ -45,66 +43,32 @@ type MSSQLResource struct {
 	b *bigip.BigIP
 }
 
-func (mmr *MSSQLResource) List() (*MSSQLList, error) {
-	var mmcl MSSQLList
-	res, err := mmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing MSSQLResource.
+func (mmr *MSSQLResource) collection() *bigip.Collection[MSSQL, MSSQLList] {
+	return bigip.NewCollection[MSSQL, MSSQLList](mmr.b, LtmManager, MonitorEndpoint, MSSQLEndpoint)
+}
+
+func (mmr *MSSQLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*MSSQLList, error) {
+	return mmr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mmcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mmcl, nil
+func (mmr *MSSQLResource) Get(ctx context.Context, fullPathName string) (*MSSQL, error) {
+	return mmr.collection().Get(ctx, fullPathName)
 }
 
-func (mmr *MSSQLResource) Get(fullPathName string) (*MSSQL, error) {
-	var mmc MSSQL
-	res, err := mmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mmc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mmc, nil
+func (mmr *MSSQLResource) Create(ctx context.Context, item MSSQL) error {
+	return mmr.collection().Create(ctx, item)
 }
 
-func (mmr *MSSQLResource) Create(item MSSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mmr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mmr *MSSQLResource) Update(ctx context.Context, name string, item MSSQL) error {
+	return mmr.collection().Update(ctx, name, item)
 }
 
-func (mmr *MSSQLResource) Update(name string, item MSSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mmr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the MSSQL identified by name, see bigip.PatchBody.
+func (mmr *MSSQLResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mmr.collection().Patch(ctx, name, fields)
 }
 
-func (mmr *MSSQLResource) Delete(name string) error {
-	_, err := mmr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MSSQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mmr *MSSQLResource) Delete(ctx context.Context, name string) error {
+	return mmr.collection().Delete(ctx, name)
 }
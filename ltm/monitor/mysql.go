@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type MySQLList struct {
@@ -44,66 +42,32 @@ type MySQLResource struct {
 	b *bigip.BigIP
 }
 
-func (mmr *MySQLResource) List() (*MySQLList, error) {
-	var mmcl MySQLList
-	res, err := mmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing MySQLResource.
+func (mmr *MySQLResource) collection() *bigip.Collection[MySQL, MySQLList] {
+	return bigip.NewCollection[MySQL, MySQLList](mmr.b, LtmManager, MonitorEndpoint, MySQLEndpoint)
+}
+
+func (mmr *MySQLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*MySQLList, error) {
+	return mmr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mmcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mmcl, nil
+func (mmr *MySQLResource) Get(ctx context.Context, fullPathName string) (*MySQL, error) {
+	return mmr.collection().Get(ctx, fullPathName)
 }
 
-func (mmr *MySQLResource) Get(fullPathName string) (*MySQL, error) {
-	var mmc MySQL
-	res, err := mmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mmc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mmc, nil
+func (mmr *MySQLResource) Create(ctx context.Context, item MySQL) error {
+	return mmr.collection().Create(ctx, item)
 }
 
-func (mmr *MySQLResource) Create(item MySQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mmr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mmr *MySQLResource) Update(ctx context.Context, name string, item MySQL) error {
+	return mmr.collection().Update(ctx, name, item)
 }
 
-func (mmr *MySQLResource) Update(name string, item MySQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mmr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the MySQL identified by name, see bigip.PatchBody.
+func (mmr *MySQLResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mmr.collection().Patch(ctx, name, fields)
 }
 
-func (mmr *MySQLResource) Delete(name string) error {
-	_, err := mmr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(MySQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mmr *MySQLResource) Delete(ctx context.Context, name string) error {
+	return mmr.collection().Delete(ctx, name)
 }
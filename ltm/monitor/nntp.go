@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type NNTPList struct {
@@ -40,66 +38,32 @@ type NNTPResource struct {
 	b *bigip.BigIP
 }
 
-func (mnr *NNTPResource) List() (*NNTPList, error) {
-	var mncl NNTPList
-	res, err := mnr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing NNTPResource.
+func (mnr *NNTPResource) collection() *bigip.Collection[NNTP, NNTPList] {
+	return bigip.NewCollection[NNTP, NNTPList](mnr.b, LtmManager, MonitorEndpoint, NNTPEndpoint)
+}
+
+func (mnr *NNTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*NNTPList, error) {
+	return mnr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mncl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mncl, nil
+func (mnr *NNTPResource) Get(ctx context.Context, fullPathName string) (*NNTP, error) {
+	return mnr.collection().Get(ctx, fullPathName)
 }
 
-func (mnr *NNTPResource) Get(fullPathName string) (*NNTP, error) {
-	var mnc NNTP
-	res, err := mnr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mnc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mnc, nil
+func (mnr *NNTPResource) Create(ctx context.Context, item NNTP) error {
+	return mnr.collection().Create(ctx, item)
 }
 
-func (mnr *NNTPResource) Create(item NNTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mnr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mnr *NNTPResource) Update(ctx context.Context, name string, item NNTP) error {
+	return mnr.collection().Update(ctx, name, item)
 }
 
-func (mnr *NNTPResource) Update(name string, item NNTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mnr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the NNTP identified by name, see bigip.PatchBody.
+func (mnr *NNTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mnr.collection().Patch(ctx, name, fields)
 }
 
-func (mnr *NNTPResource) Delete(name string) error {
-	_, err := mnr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(NNTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mnr *NNTPResource) Delete(ctx context.Context, name string) error {
+	return mnr.collection().Delete(ctx, name)
 }
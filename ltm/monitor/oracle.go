@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type OracleList struct {
@@ -44,66 +42,32 @@ type OracleResource struct {
 	b *bigip.BigIP
 }
 
-func (mor *OracleResource) List() (*OracleList, error) {
-	var mocl OracleList
-	res, err := mor.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing OracleResource.
+func (mor *OracleResource) collection() *bigip.Collection[Oracle, OracleList] {
+	return bigip.NewCollection[Oracle, OracleList](mor.b, LtmManager, MonitorEndpoint, OracleEndpoint)
+}
+
+func (mor *OracleResource) List(ctx context.Context, opts ...*rest.ListOptions) (*OracleList, error) {
+	return mor.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mocl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mocl, nil
+func (mor *OracleResource) Get(ctx context.Context, fullPathName string) (*Oracle, error) {
+	return mor.collection().Get(ctx, fullPathName)
 }
 
-func (mor *OracleResource) Get(fullPathName string) (*Oracle, error) {
-	var moc Oracle
-	res, err := mor.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &moc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &moc, nil
+func (mor *OracleResource) Create(ctx context.Context, item Oracle) error {
+	return mor.collection().Create(ctx, item)
 }
 
-func (mor *OracleResource) Create(item Oracle) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mor.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mor *OracleResource) Update(ctx context.Context, name string, item Oracle) error {
+	return mor.collection().Update(ctx, name, item)
 }
 
-func (mor *OracleResource) Update(name string, item Oracle) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mor.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Oracle identified by name, see bigip.PatchBody.
+func (mor *OracleResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mor.collection().Patch(ctx, name, fields)
 }
 
-func (mor *OracleResource) Delete(name string) error {
-	_, err := mor.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(OracleEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mor *OracleResource) Delete(ctx context.Context, name string) error {
+	return mor.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type POP3List struct {
@@ -39,66 +37,32 @@ type POP3Resource struct {
 	b *bigip.BigIP
 }
 
-func (mpr *POP3Resource) List() (*POP3List, error) {
-	var mpcl POP3List
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing POP3Resource.
+func (mpr *POP3Resource) collection() *bigip.Collection[POP3, POP3List] {
+	return bigip.NewCollection[POP3, POP3List](mpr.b, LtmManager, MonitorEndpoint, POP3Endpoint)
+}
+
+func (mpr *POP3Resource) List(ctx context.Context, opts ...*rest.ListOptions) (*POP3List, error) {
+	return mpr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mpcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpcl, nil
+func (mpr *POP3Resource) Get(ctx context.Context, fullPathName string) (*POP3, error) {
+	return mpr.collection().Get(ctx, fullPathName)
 }
 
-func (mpr *POP3Resource) Get(fullPathName string) (*POP3, error) {
-	var mpc POP3
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mpc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpc, nil
+func (mpr *POP3Resource) Create(ctx context.Context, item POP3) error {
+	return mpr.collection().Create(ctx, item)
 }
 
-func (mpr *POP3Resource) Create(item POP3) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mpr *POP3Resource) Update(ctx context.Context, name string, item POP3) error {
+	return mpr.collection().Update(ctx, name, item)
 }
 
-func (mpr *POP3Resource) Update(name string, item POP3) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the POP3 identified by name, see bigip.PatchBody.
+func (mpr *POP3Resource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mpr.collection().Patch(ctx, name, fields)
 }
 
-func (mpr *POP3Resource) Delete(name string) error {
-	_, err := mpr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(POP3Endpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mpr *POP3Resource) Delete(ctx context.Context, name string) error {
+	return mpr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type PostgreSQLList struct {
@@ -44,66 +42,32 @@ type PostgreSQLResource struct {
 	b *bigip.BigIP
 }
 
-func (mpr *PostgreSQLResource) List() (*PostgreSQLList, error) {
-	var mpcl PostgreSQLList
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing PostgreSQLResource.
+func (mpr *PostgreSQLResource) collection() *bigip.Collection[PostgreSQL, PostgreSQLList] {
+	return bigip.NewCollection[PostgreSQL, PostgreSQLList](mpr.b, LtmManager, MonitorEndpoint, PostgreSQLEndpoint)
+}
+
+func (mpr *PostgreSQLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PostgreSQLList, error) {
+	return mpr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mpcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpcl, nil
+func (mpr *PostgreSQLResource) Get(ctx context.Context, fullPathName string) (*PostgreSQL, error) {
+	return mpr.collection().Get(ctx, fullPathName)
 }
 
-func (mpr *PostgreSQLResource) Get(fullPathName string) (*PostgreSQL, error) {
-	var mpc PostgreSQL
-	res, err := mpr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mpc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mpc, nil
+func (mpr *PostgreSQLResource) Create(ctx context.Context, item PostgreSQL) error {
+	return mpr.collection().Create(ctx, item)
 }
 
-func (mpr *PostgreSQLResource) Create(item PostgreSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mpr *PostgreSQLResource) Update(ctx context.Context, name string, item PostgreSQL) error {
+	return mpr.collection().Update(ctx, name, item)
 }
 
-func (mpr *PostgreSQLResource) Update(name string, item PostgreSQL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mpr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the PostgreSQL identified by name, see bigip.PatchBody.
+func (mpr *PostgreSQLResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mpr.collection().Patch(ctx, name, fields)
 }
 
-func (mpr *PostgreSQLResource) Delete(name string) error {
-	_, err := mpr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(PostgreSQLEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mpr *PostgreSQLResource) Delete(ctx context.Context, name string) error {
+	return mpr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type RadiusList struct {
@@ -39,66 +37,32 @@ type RadiusResource struct {
 	b *bigip.BigIP
 }
 
-func (mrr *RadiusResource) List() (*RadiusList, error) {
-	var mrcl RadiusList
-	res, err := mrr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RadiusResource.
+func (mrr *RadiusResource) collection() *bigip.Collection[Radius, RadiusList] {
+	return bigip.NewCollection[Radius, RadiusList](mrr.b, LtmManager, MonitorEndpoint, RadiusEndpoint)
+}
+
+func (mrr *RadiusResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusList, error) {
+	return mrr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mrcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrcl, nil
+func (mrr *RadiusResource) Get(ctx context.Context, fullPathName string) (*Radius, error) {
+	return mrr.collection().Get(ctx, fullPathName)
 }
 
-func (mrr *RadiusResource) Get(fullPathName string) (*Radius, error) {
-	var mrc Radius
-	res, err := mrr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mrc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrc, nil
+func (mrr *RadiusResource) Create(ctx context.Context, item Radius) error {
+	return mrr.collection().Create(ctx, item)
 }
 
-func (mrr *RadiusResource) Create(item Radius) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrr *RadiusResource) Update(ctx context.Context, name string, item Radius) error {
+	return mrr.collection().Update(ctx, name, item)
 }
 
-func (mrr *RadiusResource) Update(name string, item Radius) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Radius identified by name, see bigip.PatchBody.
+func (mrr *RadiusResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mrr.collection().Patch(ctx, name, fields)
 }
 
-func (mrr *RadiusResource) Delete(name string) error {
-	_, err := mrr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrr *RadiusResource) Delete(ctx context.Context, name string) error {
+	return mrr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type RadiusAccountingList struct {
@@ -39,66 +37,32 @@ type RadiusAccountingResource struct {
 	b *bigip.BigIP
 }
 
-func (mrar *RadiusAccountingResource) List() (*RadiusAccountingList, error) {
-	var mracl RadiusAccountingList
-	res, err := mrar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RadiusAccountingResource.
+func (mrar *RadiusAccountingResource) collection() *bigip.Collection[RadiusAccounting, RadiusAccountingList] {
+	return bigip.NewCollection[RadiusAccounting, RadiusAccountingList](mrar.b, LtmManager, MonitorEndpoint, RadiusAccountingEndpoint)
+}
+
+func (mrar *RadiusAccountingResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RadiusAccountingList, error) {
+	return mrar.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mracl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mracl, nil
+func (mrar *RadiusAccountingResource) Get(ctx context.Context, fullPathName string) (*RadiusAccounting, error) {
+	return mrar.collection().Get(ctx, fullPathName)
 }
 
-func (mrar *RadiusAccountingResource) Get(fullPathName string) (*RadiusAccounting, error) {
-	var mrac RadiusAccounting
-	res, err := mrar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mrac); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrac, nil
+func (mrar *RadiusAccountingResource) Create(ctx context.Context, item RadiusAccounting) error {
+	return mrar.collection().Create(ctx, item)
 }
 
-func (mrar *RadiusAccountingResource) Create(item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrar.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrar *RadiusAccountingResource) Update(ctx context.Context, name string, item RadiusAccounting) error {
+	return mrar.collection().Update(ctx, name, item)
 }
 
-func (mrar *RadiusAccountingResource) Update(name string, item RadiusAccounting) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrar.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the RadiusAccounting identified by name, see bigip.PatchBody.
+func (mrar *RadiusAccountingResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mrar.collection().Patch(ctx, name, fields)
 }
 
-func (mrar *RadiusAccountingResource) Delete(name string) error {
-	_, err := mrar.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RadiusAccountingEndpoint).SubStatsResource(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrar *RadiusAccountingResource) Delete(ctx context.Context, name string) error {
+	return mrar.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type RealServerList struct {
@@ -39,66 +37,32 @@ type RealServerResource struct {
 	b *bigip.BigIP
 }
 
-func (mrsr *RealServerResource) List() (*RealServerList, error) {
-	var mrscl RealServerList
-	res, err := mrsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RealServerResource.
+func (mrsr *RealServerResource) collection() *bigip.Collection[RealServer, RealServerList] {
+	return bigip.NewCollection[RealServer, RealServerList](mrsr.b, LtmManager, MonitorEndpoint, RealServerEndpoint)
+}
+
+func (mrsr *RealServerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RealServerList, error) {
+	return mrsr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mrscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrscl, nil
+func (mrsr *RealServerResource) Get(ctx context.Context, fullPathName string) (*RealServer, error) {
+	return mrsr.collection().Get(ctx, fullPathName)
 }
 
-func (mrsr *RealServerResource) Get(fullPathName string) (*RealServer, error) {
-	var mrsc RealServer
-	res, err := mrsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mrsc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrsc, nil
+func (mrsr *RealServerResource) Create(ctx context.Context, item RealServer) error {
+	return mrsr.collection().Create(ctx, item)
 }
 
-func (mrsr *RealServerResource) Create(item RealServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrsr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrsr *RealServerResource) Update(ctx context.Context, name string, item RealServer) error {
+	return mrsr.collection().Update(ctx, name, item)
 }
 
-func (mrsr *RealServerResource) Update(name string, item RealServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrsr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the RealServer identified by name, see bigip.PatchBody.
+func (mrsr *RealServerResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mrsr.collection().Patch(ctx, name, fields)
 }
 
-func (mrsr *RealServerResource) Delete(name string) error {
-	_, err := mrsr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RealServerEndpoint).SubStatsResource(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrsr *RealServerResource) Delete(ctx context.Context, name string) error {
+	return mrsr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type RPCList struct {
@@ -42,66 +40,32 @@ type RPCResource struct {
 	b *bigip.BigIP
 }
 
-func (mrr *RPCResource) List() (*RPCList, error) {
-	var mrrcl RPCList
-	res, err := mrr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RPCEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RPCResource.
+func (mrr *RPCResource) collection() *bigip.Collection[RPC, RPCList] {
+	return bigip.NewCollection[RPC, RPCList](mrr.b, LtmManager, MonitorEndpoint, RPCEndpoint)
+}
+
+func (mrr *RPCResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RPCList, error) {
+	return mrr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mrrcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrrcl, nil
+func (mrr *RPCResource) Get(ctx context.Context, fullPathName string) (*RPC, error) {
+	return mrr.collection().Get(ctx, fullPathName)
 }
 
-func (mrr *RPCResource) Get(fullPathName string) (*RPC, error) {
-	var mrrc RPC
-	res, err := mrr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RPCEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mrrc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mrrc, nil
+func (mrr *RPCResource) Create(ctx context.Context, item RPC) error {
+	return mrr.collection().Create(ctx, item)
 }
 
-func (mrr *RPCResource) Create(item RPC) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RPCEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrr *RPCResource) Update(ctx context.Context, name string, item RPC) error {
+	return mrr.collection().Update(ctx, name, item)
 }
 
-func (mrr *RPCResource) Update(name string, item RPC) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mrr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RPCEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the RPC identified by name, see bigip.PatchBody.
+func (mrr *RPCResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mrr.collection().Patch(ctx, name, fields)
 }
 
-func (mrr *RPCResource) Delete(name string) error {
-	_, err := mrr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(RPCEndpoint).SubStatsResource(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mrr *RPCResource) Delete(ctx context.Context, name string) error {
+	return mrr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SASPList struct {
@@ -41,66 +39,32 @@ type SASPResource struct {
 	b *bigip.BigIP
 }
 
-func (msr *SASPResource) List() (*SASPList, error) {
-	var mscl SASPList
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SASPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SASPResource.
+func (msr *SASPResource) collection() *bigip.Collection[SASP, SASPList] {
+	return bigip.NewCollection[SASP, SASPList](msr.b, LtmManager, MonitorEndpoint, SASPEndpoint)
+}
+
+func (msr *SASPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SASPList, error) {
+	return msr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mscl, nil
+func (msr *SASPResource) Get(ctx context.Context, fullPathName string) (*SASP, error) {
+	return msr.collection().Get(ctx, fullPathName)
 }
 
-func (msr *SASPResource) Get(fullPathName string) (*SASP, error) {
-	var msc SASP
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SASPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msc, nil
+func (msr *SASPResource) Create(ctx context.Context, item SASP) error {
+	return msr.collection().Create(ctx, item)
 }
 
-func (msr *SASPResource) Create(item SASP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SASPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SASPResource) Update(ctx context.Context, name string, item SASP) error {
+	return msr.collection().Update(ctx, name, item)
 }
 
-func (msr *SASPResource) Update(name string, item SASP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SASPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SASP identified by name, see bigip.PatchBody.
+func (msr *SASPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msr.collection().Patch(ctx, name, fields)
 }
 
-func (msr *SASPResource) Delete(name string) error {
-	_, err := msr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SASPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SASPResource) Delete(ctx context.Context, name string) error {
+	return msr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ScriptedList struct {
@@ -40,66 +38,32 @@ type ScriptedResource struct {
 	b *bigip.BigIP
 }
 
-func (msr *ScriptedResource) List() (*ScriptedList, error) {
-	var mscl ScriptedList
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ScriptedResource.
+func (msr *ScriptedResource) collection() *bigip.Collection[Scripted, ScriptedList] {
+	return bigip.NewCollection[Scripted, ScriptedList](msr.b, LtmManager, MonitorEndpoint, ScriptedEndpoint)
+}
+
+func (msr *ScriptedResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ScriptedList, error) {
+	return msr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mscl, nil
+func (msr *ScriptedResource) Get(ctx context.Context, fullPathName string) (*Scripted, error) {
+	return msr.collection().Get(ctx, fullPathName)
 }
 
-func (msr *ScriptedResource) Get(fullPathName string) (*Scripted, error) {
-	var msc Scripted
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msc, nil
+func (msr *ScriptedResource) Create(ctx context.Context, item Scripted) error {
+	return msr.collection().Create(ctx, item)
 }
 
-func (msr *ScriptedResource) Create(item Scripted) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *ScriptedResource) Update(ctx context.Context, name string, item Scripted) error {
+	return msr.collection().Update(ctx, name, item)
 }
 
-func (msr *ScriptedResource) Update(name string, item Scripted) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Scripted identified by name, see bigip.PatchBody.
+func (msr *ScriptedResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msr.collection().Patch(ctx, name, fields)
 }
 
-func (msr *ScriptedResource) Delete(name string) error {
-	_, err := msr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(ScriptedEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *ScriptedResource) Delete(ctx context.Context, name string) error {
+	return msr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SIPList struct {
@@ -47,66 +45,32 @@ type SIPResource struct {
 	b *bigip.BigIP
 }
 
-func (msr *SIPResource) List() (*SIPList, error) {
-	var mscl SIPList
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SIPResource.
+func (msr *SIPResource) collection() *bigip.Collection[SIP, SIPList] {
+	return bigip.NewCollection[SIP, SIPList](msr.b, LtmManager, MonitorEndpoint, SIPEndpoint)
+}
+
+func (msr *SIPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SIPList, error) {
+	return msr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mscl, nil
+func (msr *SIPResource) Get(ctx context.Context, fullPathName string) (*SIP, error) {
+	return msr.collection().Get(ctx, fullPathName)
 }
 
-func (msr *SIPResource) Get(fullPathName string) (*SIP, error) {
-	var msc SIP
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msc, nil
+func (msr *SIPResource) Create(ctx context.Context, item SIP) error {
+	return msr.collection().Create(ctx, item)
 }
 
-func (msr *SIPResource) Create(item SIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SIPResource) Update(ctx context.Context, name string, item SIP) error {
+	return msr.collection().Update(ctx, name, item)
 }
 
-func (msr *SIPResource) Update(name string, item SIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SIP identified by name, see bigip.PatchBody.
+func (msr *SIPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msr.collection().Patch(ctx, name, fields)
 }
 
-func (msr *SIPResource) Delete(name string) error {
-	_, err := msr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SIPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SIPResource) Delete(ctx context.Context, name string) error {
+	return msr.collection().Delete(ctx, name)
 }
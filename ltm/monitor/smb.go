@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SMBList struct {
@@ -41,65 +39,31 @@ type SMBResource struct {
 	b *bigip.BigIP
 }
 
-func (msr *SMBResource) List() (*SMBList, error) {
-	var mscl SMBList
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMBEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SMBResource.
+func (msr *SMBResource) collection() *bigip.Collection[SMB, SMBList] {
+	return bigip.NewCollection[SMB, SMBList](msr.b, LtmManager, MonitorEndpoint, SMBEndpoint)
+}
 
-	if err := json.Unmarshal(res, &mscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mscl, nil
+func (msr *SMBResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SMBList, error) {
+	return msr.collection().List(ctx, opts...)
 }
 
-func (msr *SMBResource) Get(fullPathName string) (*SMB, error) {
-	var msc SMB
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMBEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msc, nil
+func (msr *SMBResource) Get(ctx context.Context, fullPathName string) (*SMB, error) {
+	return msr.collection().Get(ctx, fullPathName)
 }
 
-func (msr *SMBResource) Create(item SMB) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMBEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SMBResource) Create(ctx context.Context, item SMB) error {
+	return msr.collection().Create(ctx, item)
+}
+func (msr *SMBResource) Update(ctx context.Context, name string, item SMB) error {
+	return msr.collection().Update(ctx, name, item)
 }
-func (msr *SMBResource) Update(name string, item SMB) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMBEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+
+// Patch updates only the given fields of the SMB identified by name, see bigip.PatchBody.
+func (msr *SMBResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msr.collection().Patch(ctx, name, fields)
 }
 
-func (msr *SMBResource) Delete(name string) error {
-	_, err := msr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMBEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SMBResource) Delete(ctx context.Context, name string) error {
+	return msr.collection().Delete(ctx, name)
 }
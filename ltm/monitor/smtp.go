@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SMTPList struct {
@@ -40,66 +38,32 @@ type SMTPResource struct {
 	b *bigip.BigIP
 }
 
-func (msr *SMTPResource) List() (*SMTPList, error) {
-	var mscl SMTPList
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SMTPResource.
+func (msr *SMTPResource) collection() *bigip.Collection[SMTP, SMTPList] {
+	return bigip.NewCollection[SMTP, SMTPList](msr.b, LtmManager, MonitorEndpoint, SMTPEndpoint)
+}
+
+func (msr *SMTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPList, error) {
+	return msr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mscl, nil
+func (msr *SMTPResource) Get(ctx context.Context, fullPathName string) (*SMTP, error) {
+	return msr.collection().Get(ctx, fullPathName)
 }
 
-func (msr *SMTPResource) Get(fullPathName string) (*SMTP, error) {
-	var msc SMTP
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msc, nil
+func (msr *SMTPResource) Create(ctx context.Context, item SMTP) error {
+	return msr.collection().Create(ctx, item)
 }
 
-func (msr *SMTPResource) Create(item SMTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SMTPResource) Update(ctx context.Context, name string, item SMTP) error {
+	return msr.collection().Update(ctx, name, item)
 }
 
-func (msr *SMTPResource) Update(name string, item SMTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SMTP identified by name, see bigip.PatchBody.
+func (msr *SMTPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msr.collection().Patch(ctx, name, fields)
 }
 
-func (msr *SMTPResource) Delete(name string) error {
-	_, err := msr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SMTPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SMTPResource) Delete(ctx context.Context, name string) error {
+	return msr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SNMPDCAList struct {
@@ -46,66 +44,32 @@ type SNMPDCAResource struct {
 	b *bigip.BigIP
 }
 
-func (msdr *SNMPDCAResource) List() (*SNMPDCAList, error) {
-	var msdcl SNMPDCAList
-	res, err := msdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SNMPDCAResource.
+func (msdr *SNMPDCAResource) collection() *bigip.Collection[SNMPDCA, SNMPDCAList] {
+	return bigip.NewCollection[SNMPDCA, SNMPDCAList](msdr.b, LtmManager, MonitorEndpoint, SNMPDCAEndpoint)
+}
+
+func (msdr *SNMPDCAResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPDCAList, error) {
+	return msdr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &msdcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdcl, nil
+func (msdr *SNMPDCAResource) Get(ctx context.Context, fullPathName string) (*SNMPDCA, error) {
+	return msdr.collection().Get(ctx, fullPathName)
 }
 
-func (msdr *SNMPDCAResource) Get(fullPathName string) (*SNMPDCA, error) {
-	var msdc SNMPDCA
-	res, err := msdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msdc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdc, nil
+func (msdr *SNMPDCAResource) Create(ctx context.Context, item SNMPDCA) error {
+	return msdr.collection().Create(ctx, item)
 }
 
-func (msdr *SNMPDCAResource) Create(item SNMPDCA) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msdr *SNMPDCAResource) Update(ctx context.Context, name string, item SNMPDCA) error {
+	return msdr.collection().Update(ctx, name, item)
 }
 
-func (msdr *SNMPDCAResource) Update(name string, item SNMPDCA) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SNMPDCA identified by name, see bigip.PatchBody.
+func (msdr *SNMPDCAResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msdr.collection().Patch(ctx, name, fields)
 }
 
-func (msdr *SNMPDCAResource) Delete(name string) error {
-	_, err := msdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCAEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msdr *SNMPDCAResource) Delete(ctx context.Context, name string) error {
+	return msdr.collection().Delete(ctx, name)
 }
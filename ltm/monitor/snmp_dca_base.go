@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SNMPDCABaseList struct {
@@ -39,65 +37,31 @@ type SNMPDCABaseResource struct {
 	b *bigip.BigIP
 }
 
-func (msdbr *SNMPDCABaseResource) List() (*SNMPDCABaseList, error) {
-	var msdbcl SNMPDCABaseList
-	res, err := msdbr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SNMPDCABaseResource.
+func (msdbr *SNMPDCABaseResource) collection() *bigip.Collection[SNMPDCABase, SNMPDCABaseList] {
+	return bigip.NewCollection[SNMPDCABase, SNMPDCABaseList](msdbr.b, LtmManager, MonitorEndpoint, SNMPDCABaseEndpoint)
+}
 
-	if err := json.Unmarshal(res, &msdbcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdbcl, nil
+func (msdbr *SNMPDCABaseResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SNMPDCABaseList, error) {
+	return msdbr.collection().List(ctx, opts...)
 }
 
-func (msdbr *SNMPDCABaseResource) Get(fullPathName string) (*SNMPDCABase, error) {
-	var msdbc SNMPDCABase
-	res, err := msdbr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msdbc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msdbc, nil
+func (msdbr *SNMPDCABaseResource) Get(ctx context.Context, fullPathName string) (*SNMPDCABase, error) {
+	return msdbr.collection().Get(ctx, fullPathName)
+}
+func (msdbr *SNMPDCABaseResource) Create(ctx context.Context, item SNMPDCABase) error {
+	return msdbr.collection().Create(ctx, item)
 }
-func (msdbr *SNMPDCABaseResource) Create(item SNMPDCABase) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdbr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+
+func (msdbr *SNMPDCABaseResource) Update(ctx context.Context, name string, item SNMPDCABase) error {
+	return msdbr.collection().Update(ctx, name, item)
 }
 
-func (msdbr *SNMPDCABaseResource) Update(name string, item SNMPDCABase) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msdbr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SNMPDCABase identified by name, see bigip.PatchBody.
+func (msdbr *SNMPDCABaseResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msdbr.collection().Patch(ctx, name, fields)
 }
 
-func (msdbr *SNMPDCABaseResource) Delete(name string) error {
-	_, err := msdbr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SNMPDCABaseEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msdbr *SNMPDCABaseResource) Delete(ctx context.Context, name string) error {
+	return msdbr.collection().Delete(ctx, name)
 }
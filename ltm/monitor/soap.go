@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SOAPList struct {
@@ -49,65 +47,31 @@ type SOAPResource struct {
 	b *bigip.BigIP
 }
 
-func (msr *SOAPResource) List() (*SOAPList, error) {
-	var mscl SOAPList
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SOAPResource.
+func (msr *SOAPResource) collection() *bigip.Collection[SOAP, SOAPList] {
+	return bigip.NewCollection[SOAP, SOAPList](msr.b, LtmManager, MonitorEndpoint, SOAPEndpoint)
+}
 
-	if err := json.Unmarshal(res, &mscl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mscl, nil
+func (msr *SOAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SOAPList, error) {
+	return msr.collection().List(ctx, opts...)
+}
+func (msr *SOAPResource) Get(ctx context.Context, fullPathName string) (*SOAP, error) {
+	return msr.collection().Get(ctx, fullPathName)
 }
-func (msr *SOAPResource) Get(fullPathName string) (*SOAP, error) {
-	var msc SOAP
-	res, err := msr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &msc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &msc, nil
+
+func (msr *SOAPResource) Create(ctx context.Context, item SOAP) error {
+	return msr.collection().Create(ctx, item)
 }
 
-func (msr *SOAPResource) Create(item SOAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SOAPResource) Update(ctx context.Context, name string, item SOAP) error {
+	return msr.collection().Update(ctx, name, item)
 }
 
-func (msr *SOAPResource) Update(name string, item SOAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = msr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SOAP identified by name, see bigip.PatchBody.
+func (msr *SOAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return msr.collection().Patch(ctx, name, fields)
 }
 
-func (msr *SOAPResource) Delete(name string) error {
-	_, err := msr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(SOAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (msr *SOAPResource) Delete(ctx context.Context, name string) error {
+	return msr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TCPList struct {
@@ -49,66 +47,32 @@ type TCPResource struct {
 	b *bigip.BigIP
 }
 
-func (mtr *TCPResource) List() (*TCPList, error) {
-	var mtcl TCPList
-	res, err := mtr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPResource.
+func (mtr *TCPResource) collection() *bigip.Collection[TCP, TCPList] {
+	return bigip.NewCollection[TCP, TCPList](mtr.b, LtmManager, MonitorEndpoint, TCPEndpoint)
+}
+
+func (mtr *TCPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPList, error) {
+	return mtr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mtcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mtcl, nil
+func (mtr *TCPResource) Get(ctx context.Context, fullPathName string) (*TCP, error) {
+	return mtr.collection().Get(ctx, fullPathName)
 }
 
-func (mtr *TCPResource) Get(fullPathName string) (*TCP, error) {
-	var mtc TCP
-	res, err := mtr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mtc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mtc, nil
+func (mtr *TCPResource) Create(ctx context.Context, item TCP) error {
+	return mtr.collection().Create(ctx, item)
 }
 
-func (mtr *TCPResource) Create(item TCP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mtr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mtr *TCPResource) Update(ctx context.Context, name string, item TCP) error {
+	return mtr.collection().Update(ctx, name, item)
 }
 
-func (mtr *TCPResource) Update(name string, item TCP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mtr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TCP identified by name, see bigip.PatchBody.
+func (mtr *TCPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mtr.collection().Patch(ctx, name, fields)
 }
 
-func (mtr *TCPResource) Delete(name string) error {
-	_, err := mtr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mtr *TCPResource) Delete(ctx context.Context, name string) error {
+	return mtr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TCPEchoList struct {
@@ -44,66 +42,32 @@ type TCPEchoResource struct {
 	b *bigip.BigIP
 }
 
-func (mter *TCPEchoResource) List() (*TCPEchoList, error) {
-	var mtecl TCPEchoList
-	res, err := mter.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEchoEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPEchoResource.
+func (mter *TCPEchoResource) collection() *bigip.Collection[TCPEcho, TCPEchoList] {
+	return bigip.NewCollection[TCPEcho, TCPEchoList](mter.b, LtmManager, MonitorEndpoint, TCPEchoEndpoint)
+}
+
+func (mter *TCPEchoResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPEchoList, error) {
+	return mter.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mtecl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mtecl, nil
+func (mter *TCPEchoResource) Get(ctx context.Context, fullPathName string) (*TCPEcho, error) {
+	return mter.collection().Get(ctx, fullPathName)
 }
 
-func (mter *TCPEchoResource) Get(fullPathName string) (*TCPEcho, error) {
-	var mtec TCPEcho
-	res, err := mter.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEchoEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mtec); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mtec, nil
+func (mter *TCPEchoResource) Create(ctx context.Context, item TCPEcho) error {
+	return mter.collection().Create(ctx, item)
 }
 
-func (mter *TCPEchoResource) Create(item TCPEcho) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mter.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEchoEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mter *TCPEchoResource) Update(ctx context.Context, name string, item TCPEcho) error {
+	return mter.collection().Update(ctx, name, item)
 }
 
-func (mter *TCPEchoResource) Update(name string, item TCPEcho) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mter.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEchoEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TCPEcho identified by name, see bigip.PatchBody.
+func (mter *TCPEchoResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mter.collection().Patch(ctx, name, fields)
 }
 
-func (mter *TCPEchoResource) Delete(name string) error {
-	_, err := mter.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPEchoEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mter *TCPEchoResource) Delete(ctx context.Context, name string) error {
+	return mter.collection().Delete(ctx, name)
 }
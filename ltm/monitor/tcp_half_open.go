@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TCPHalfOpenList struct {
@@ -38,66 +36,32 @@ type TCPHalfOpenResource struct {
 	b *bigip.BigIP
 }
 
-func (mthor *TCPHalfOpenResource) List() (*TCPHalfOpenList, error) {
-	var mthocl TCPHalfOpenList
-	res, err := mthor.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPHalfOpenResource.
+func (mthor *TCPHalfOpenResource) collection() *bigip.Collection[TCPHalfOpen, TCPHalfOpenList] {
+	return bigip.NewCollection[TCPHalfOpen, TCPHalfOpenList](mthor.b, LtmManager, MonitorEndpoint, TCPHalfOpenEndpoint)
+}
+
+func (mthor *TCPHalfOpenResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPHalfOpenList, error) {
+	return mthor.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mthocl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mthocl, nil
+func (mthor *TCPHalfOpenResource) Get(ctx context.Context, fullPathName string) (*TCPHalfOpen, error) {
+	return mthor.collection().Get(ctx, fullPathName)
 }
 
-func (mthor *TCPHalfOpenResource) Get(fullPathName string) (*TCPHalfOpen, error) {
-	var mthoc TCPHalfOpen
-	res, err := mthor.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mthoc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mthoc, nil
+func (mthor *TCPHalfOpenResource) Create(ctx context.Context, item TCPHalfOpen) error {
+	return mthor.collection().Create(ctx, item)
 }
 
-func (mthor *TCPHalfOpenResource) Create(item TCPHalfOpen) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mthor.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mthor *TCPHalfOpenResource) Update(ctx context.Context, name string, item TCPHalfOpen) error {
+	return mthor.collection().Update(ctx, name, item)
 }
 
-func (mthor *TCPHalfOpenResource) Update(name string, item TCPHalfOpen) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mthor.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TCPHalfOpen identified by name, see bigip.PatchBody.
+func (mthor *TCPHalfOpenResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mthor.collection().Patch(ctx, name, fields)
 }
 
-func (mthor *TCPHalfOpenResource) Delete(name string) error {
-	_, err := mthor.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(TCPHalfOpenEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mthor *TCPHalfOpenResource) Delete(ctx context.Context, name string) error {
+	return mthor.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type UDPList struct {
@@ -49,66 +47,32 @@ type UDPResource struct {
 	b *bigip.BigIP
 }
 
-func (mur *UDPResource) List() (*UDPList, error) {
-	var mucl UDPList
-	res, err := mur.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing UDPResource.
+func (mur *UDPResource) collection() *bigip.Collection[UDP, UDPList] {
+	return bigip.NewCollection[UDP, UDPList](mur.b, LtmManager, MonitorEndpoint, UDPEndpoint)
+}
+
+func (mur *UDPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*UDPList, error) {
+	return mur.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mucl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mucl, nil
+func (mur *UDPResource) Get(ctx context.Context, fullPathName string) (*UDP, error) {
+	return mur.collection().Get(ctx, fullPathName)
 }
 
-func (mur *UDPResource) Get(fullPathName string) (*UDP, error) {
-	var muc UDP
-	res, err := mur.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &muc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &muc, nil
+func (mur *UDPResource) Create(ctx context.Context, item UDP) error {
+	return mur.collection().Create(ctx, item)
 }
 
-func (mur *UDPResource) Create(item UDP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mur.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mur *UDPResource) Update(ctx context.Context, name string, item UDP) error {
+	return mur.collection().Update(ctx, name, item)
 }
 
-func (mur *UDPResource) Update(name string, item UDP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mur.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the UDP identified by name, see bigip.PatchBody.
+func (mur *UDPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mur.collection().Patch(ctx, name, fields)
 }
 
-func (mur *UDPResource) Delete(name string) error {
-	_, err := mur.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(UDPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mur *UDPResource) Delete(ctx context.Context, name string) error {
+	return mur.collection().Delete(ctx, name)
 }
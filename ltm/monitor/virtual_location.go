@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type VirtualLocationList struct {
@@ -38,66 +36,32 @@ type VirtualLocationResource struct {
 	b *bigip.BigIP
 }
 
-func (mvlr *VirtualLocationResource) List() (*VirtualLocationList, error) {
-	var mvlcl VirtualLocationList
-	res, err := mvlr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(VirtualLocationEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing VirtualLocationResource.
+func (mvlr *VirtualLocationResource) collection() *bigip.Collection[VirtualLocation, VirtualLocationList] {
+	return bigip.NewCollection[VirtualLocation, VirtualLocationList](mvlr.b, LtmManager, MonitorEndpoint, VirtualLocationEndpoint)
+}
+
+func (mvlr *VirtualLocationResource) List(ctx context.Context, opts ...*rest.ListOptions) (*VirtualLocationList, error) {
+	return mvlr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mvlcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mvlcl, nil
+func (mvlr *VirtualLocationResource) Get(ctx context.Context, fullPathName string) (*VirtualLocation, error) {
+	return mvlr.collection().Get(ctx, fullPathName)
 }
 
-func (mvlr *VirtualLocationResource) Get(fullPathName string) (*VirtualLocation, error) {
-	var mvlc VirtualLocation
-	res, err := mvlr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(VirtualLocationEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mvlc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mvlc, nil
+func (mvlr *VirtualLocationResource) Create(ctx context.Context, item VirtualLocation) error {
+	return mvlr.collection().Create(ctx, item)
 }
 
-func (mvlr *VirtualLocationResource) Create(item VirtualLocation) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mvlr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(VirtualLocationEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mvlr *VirtualLocationResource) Update(ctx context.Context, name string, item VirtualLocation) error {
+	return mvlr.collection().Update(ctx, name, item)
 }
 
-func (mvlr *VirtualLocationResource) Update(name string, item VirtualLocation) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mvlr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(VirtualLocationEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the VirtualLocation identified by name, see bigip.PatchBody.
+func (mvlr *VirtualLocationResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mvlr.collection().Patch(ctx, name, fields)
 }
 
-func (mvlr *VirtualLocationResource) Delete(name string) error {
-	_, err := mvlr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(VirtualLocationEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mvlr *VirtualLocationResource) Delete(ctx context.Context, name string) error {
+	return mvlr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type WAPList struct {
@@ -47,66 +45,32 @@ type WAPResource struct {
 	b *bigip.BigIP
 }
 
-func (mwr *WAPResource) List() (*WAPList, error) {
-	var mwcl WAPList
-	res, err := mwr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing WAPResource.
+func (mwr *WAPResource) collection() *bigip.Collection[WAP, WAPList] {
+	return bigip.NewCollection[WAP, WAPList](mwr.b, LtmManager, MonitorEndpoint, WAPEndpoint)
+}
+
+func (mwr *WAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WAPList, error) {
+	return mwr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mwcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mwcl, nil
+func (mwr *WAPResource) Get(ctx context.Context, fullPathName string) (*WAP, error) {
+	return mwr.collection().Get(ctx, fullPathName)
 }
 
-func (mwr *WAPResource) Get(fullPathName string) (*WAP, error) {
-	var mwc WAP
-	res, err := mwr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mwc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mwc, nil
+func (mwr *WAPResource) Create(ctx context.Context, item WAP) error {
+	return mwr.collection().Create(ctx, item)
 }
 
-func (mwr *WAPResource) Create(item WAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mwr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mwr *WAPResource) Update(ctx context.Context, name string, item WAP) error {
+	return mwr.collection().Update(ctx, name, item)
 }
 
-func (mwr *WAPResource) Update(name string, item WAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mwr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the WAP identified by name, see bigip.PatchBody.
+func (mwr *WAPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mwr.collection().Patch(ctx, name, fields)
 }
 
-func (mwr *WAPResource) Delete(name string) error {
-	_, err := mwr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WAPEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mwr *WAPResource) Delete(ctx context.Context, name string) error {
+	return mwr.collection().Delete(ctx, name)
 }
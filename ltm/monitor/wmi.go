@@ -2,10 +2,8 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type WMIList struct {
@@ -41,66 +39,32 @@ type WMIResource struct {
 	b *bigip.BigIP
 }
 
-func (mwr *WMIResource) List() (*WMIList, error) {
-	var mwcl WMIList
-	res, err := mwr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing WMIResource.
+func (mwr *WMIResource) collection() *bigip.Collection[WMI, WMIList] {
+	return bigip.NewCollection[WMI, WMIList](mwr.b, LtmManager, MonitorEndpoint, WMIEndpoint)
+}
+
+func (mwr *WMIResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WMIList, error) {
+	return mwr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &mwcl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mwcl, nil
+func (mwr *WMIResource) Get(ctx context.Context, fullPathName string) (*WMI, error) {
+	return mwr.collection().Get(ctx, fullPathName)
 }
 
-func (mwr *WMIResource) Get(fullPathName string) (*WMI, error) {
-	var mwc WMI
-	res, err := mwr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubStatsResource(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &mwc); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &mwc, nil
+func (mwr *WMIResource) Create(ctx context.Context, item WMI) error {
+	return mwr.collection().Create(ctx, item)
 }
 
-func (mwr *WMIResource) Create(item WMI) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mwr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mwr *WMIResource) Update(ctx context.Context, name string, item WMI) error {
+	return mwr.collection().Update(ctx, name, item)
 }
 
-func (mwr *WMIResource) Update(name string, item WMI) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mwr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubStatsResource(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the WMI identified by name, see bigip.PatchBody.
+func (mwr *WMIResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return mwr.collection().Patch(ctx, name, fields)
 }
 
-func (mwr *WMIResource) Delete(name string) error {
-	_, err := mwr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(MonitorEndpoint).SubResource(WMIEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mwr *WMIResource) Delete(ctx context.Context, name string) error {
+	return mwr.collection().Delete(ctx, name)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -51,67 +52,65 @@ type NodeResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing NodeResource.
+func (nr *NodeResource) collection() *bigip.Collection[Node, NodeList] {
+	return bigip.NewCollection[Node, NodeList](nr.b, LtmManager, NodeEndpoint)
+}
+
 // List all node details
-func (nr *NodeResource) List() (*NodeList, error) {
-	var nl NodeList
-	res, err := nr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+func (nr *NodeResource) List(ctx context.Context, opts ...*rest.ListOptions) (*NodeList, error) {
+	return nr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &nl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &nl, nil
+// Pages walks the node collection one page at a time, see bigip.Collection.Pages.
+func (nr *NodeResource) Pages(ctx context.Context, fn func(page *bigip.Page[Node]) error, opts ...*rest.ListOptions) error {
+	return nr.collection().Pages(ctx, fn, opts...)
+}
+
+// Each calls fn for every node without loading the whole collection in memory.
+// Return bigip.ErrStopIteration from fn to stop early.
+func (nr *NodeResource) Each(ctx context.Context, fn func(item *Node) error, opts ...*rest.ListOptions) error {
+	return nr.collection().Each(ctx, fn, opts...)
 }
 
 // Get a single node details by the node name
-func (nr *NodeResource) Get(name string) (*Node, error) {
-	var node Node
-	res, err := nr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &node); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &node, nil
+func (nr *NodeResource) Get(ctx context.Context, name string) (*Node, error) {
+	return nr.collection().Get(ctx, name)
 }
 
 // Create a new node item
-func (nr *NodeResource) Create(item Node) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = nr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (nr *NodeResource) Create(ctx context.Context, item Node) error {
+	return nr.collection().Create(ctx, item)
 }
 
 // Update the node item identified by the node name, otherwise an error will be reported.
-func (nr *NodeResource) Update(name string, item Node) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (nr *NodeResource) Update(ctx context.Context, name string, item Node) error {
+	return nr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Node identified by name, see bigip.PatchBody.
+func (nr *NodeResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return nr.collection().Patch(ctx, name, fields)
+}
+
+// UpdateIfUnchanged updates the node identified by name only if it was not modified since
+// item was read, see bigip.Collection.UpdateIfUnchanged.
+func (nr *NodeResource) UpdateIfUnchanged(ctx context.Context, name string, item Node) error {
+	return nr.collection().UpdateIfUnchanged(ctx, name, item)
+}
+
+// Modify reads the node identified by name, changes it with mutate and updates it,
+// starting over if it was modified concurrently, see bigip.Collection.Modify.
+func (nr *NodeResource) Modify(ctx context.Context, name string, mutate func(item *Node) error) error {
+	return nr.collection().Modify(ctx, name, mutate)
 }
 
 // Enable a node identified by the node name.
-func (nr *NodeResource) Enable(name string) error {
+func (nr *NodeResource) Enable(ctx context.Context, name string) error {
+	name, err := nr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := Node{Session: "user-enabled", State: "user-up"}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -119,7 +118,7 @@ func (nr *NodeResource) Enable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -127,7 +126,11 @@ func (nr *NodeResource) Enable(name string) error {
 }
 
 // Disable a node identified by the node name.
-func (nr *NodeResource) Disable(name string) error {
+func (nr *NodeResource) Disable(ctx context.Context, name string) error {
+	name, err := nr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := Node{Session: "user-disabled", State: "user-up"}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -135,7 +138,7 @@ func (nr *NodeResource) Disable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -143,7 +146,11 @@ func (nr *NodeResource) Disable(name string) error {
 }
 
 // ForceOffline a node identified by the node name.
-func (nr *NodeResource) ForceOffline(name string) error {
+func (nr *NodeResource) ForceOffline(ctx context.Context, name string) error {
+	name, err := nr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := Node{Session: "user-disabled", State: "user-down"}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -151,7 +158,7 @@ func (nr *NodeResource) ForceOffline(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NodeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -159,12 +166,6 @@ func (nr *NodeResource) ForceOffline(name string) error {
 }
 
 // Delete a single node identified by the node name. if it is not exist return error
-func (nr *NodeResource) Delete(name string) error {
-	_, err := nr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-
-	return nil
+func (nr *NodeResource) Delete(ctx context.Context, name string) error {
+	return nr.collection().Delete(ctx, name)
 }
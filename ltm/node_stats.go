@@ -48,10 +48,10 @@ type NodeStatsResource struct {
 	b *bigip.BigIP
 }
 
-func (nsr *NodeStatsResource) List() (*NodeStatsList, error) {
+func (nsr *NodeStatsResource) List(ctx context.Context) (*NodeStatsList, error) {
 	var nsl NodeStatsList
 	res, err := nsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(NodeEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(NodeEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,5 +59,6 @@ func (nsr *NodeStatsResource) List() (*NodeStatsList, error) {
 	if err := json.Unmarshal(res, &nsl); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(nsr.b, nsl.Entries)
 	return &nsl, nil
 }
@@ -1,13 +1,12 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
-
+	"context"
 	"testing"
 )
 
 func TestNodeResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -23,12 +22,12 @@ func TestNodeResource(t *testing.T) {
 		Partition: partition,
 		Address:   "152.16.11.11",
 	}
-	if err := nodeResource.Create(node); err != nil {
+	if err := nodeResource.Create(context.Background(), node); err != nil {
 		t.Fatalf("Error creating Node: %v", err)
 	}
 
 	// Get the Node
-	nodeCheck, err := nodeResource.Get(fullPathName)
+	nodeCheck, err := nodeResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Fatalf("Error getting Node: %v", err)
 	}
@@ -54,27 +53,27 @@ func TestNodeResource(t *testing.T) {
 	//}
 
 	// Force Node offline
-	if err := nodeResource.ForceOffline(nodeCheck.Name); err != nil {
+	if err := nodeResource.ForceOffline(context.Background(), nodeCheck.Name); err != nil {
 		t.Fatalf("Error forcing Node offline: %v", err)
 	}
 
 	// Enable the Node
-	if err := nodeResource.Enable(nodeCheck.Name); err != nil {
+	if err := nodeResource.Enable(context.Background(), nodeCheck.Name); err != nil {
 		t.Fatalf("Error enabling Node: %v", err)
 	}
 
 	// Disable the Node
-	if err := nodeResource.Disable(nodeCheck.Name); err != nil {
+	if err := nodeResource.Disable(context.Background(), nodeCheck.Name); err != nil {
 		t.Fatalf("Error disabling Node: %v", err)
 	}
 
 	// Delete the Node
-	if err := nodeResource.Delete(nodeCheck.Name); err != nil {
+	if err := nodeResource.Delete(context.Background(), nodeCheck.Name); err != nil {
 		t.Fatalf("Error deleting Node: %v", err)
 	}
 
 	// Validate that Node was deleted
-	if _, err := nodeResource.Get(fullPathName); err == nil {
+	if _, err := nodeResource.Get(context.Background(), fullPathName); err == nil {
 		t.Error("Node still exists after attempting deletion")
 	}
 }
@@ -0,0 +1,86 @@
+package ltm
+
+import (
+	"context"
+	"errors"
+	"github.com/lefeck/go-bigip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInPartition(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/tm/ltm/pool/stats" {
+			w.Write([]byte(`{"entries":{
+				"https://localhost/mgmt/tm/ltm/pool/~Tenant_A~web/stats":{},
+				"https://localhost/mgmt/tm/ltm/pool/~Common~web/stats":{}}}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	b, err := bigip.NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	tenant := New(b.InPartition("Tenant_A"))
+	ctx := context.Background()
+
+	if err := tenant.IFile().Create(ctx, "blocklist", "/Tenant_A/blocklist.txt"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := tenant.IFile().Edit(ctx, "blocklist", "/Tenant_A/blocklist.txt"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := tenant.PoolMembers().Get(ctx, "web", "10.1.1.1:80"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	stats, err := tenant.PoolStats().List(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(stats.Entries) != 1 {
+		t.Errorf("Expected only the stats of Tenant_A, got %v", stats.Entries)
+	}
+
+	var partitionErr *bigip.PartitionError
+	for name, err := range map[string]error{
+		"ifile edit":    tenant.IFile().Edit(ctx, "/Common/blocklist", "/Common/blocklist.txt"),
+		"member":        func() error { _, err := tenant.PoolMembers().Get(ctx, "web", "/Common/10.1.1.1:80"); return err }(),
+		"member delete": tenant.PoolMembers().Delete(ctx, "web", "~Common~10.1.1.1:80"),
+		"member create": tenant.PoolMembers().Create(ctx, "web", PoolMembers{Name: "/Common/10.1.1.2:80"}),
+		"virtual stats": func() error { _, err := tenant.VirtualStats().Get(ctx, "/Common/vs"); return err }(),
+		"member stats": func() error {
+			_, err := tenant.PoolStats().GetMemberStats(ctx, "web", "/Common/10.1.1.1:80")
+			return err
+		}(),
+		"ifile create":   tenant.IFile().Create(ctx, "/Common/blocklist", "/Common/blocklist.txt"),
+		"pool all stats": func() error { _, err := tenant.PoolStats().GetPoolAllMemberStats(ctx, "/Common/web"); return err }(),
+	} {
+		if !errors.As(err, &partitionErr) {
+			t.Errorf("%s: expected a PartitionError, got %v", name, err)
+		}
+	}
+
+	expected := []string{
+		`POST /mgmt/tm/ltm/ifile {"file-name":"/Tenant_A/blocklist.txt","name":"blocklist","partition":"Tenant_A"}`,
+		`PUT /mgmt/tm/ltm/ifile/~Tenant_A~blocklist {"file-name":"/Tenant_A/blocklist.txt","name":"blocklist"}`,
+		"GET /mgmt/tm/ltm/pool/~Tenant_A~web/members/~Tenant_A~10.1.1.1:80 ",
+		"GET /mgmt/tm/ltm/pool/stats ",
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected %d calls, got %d: %q", len(expected), len(calls), calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Expected call %q, got %q", expected[i], calls[i])
+		}
+	}
+}
@@ -2,10 +2,8 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // PoolList is a list contains multiple Pool objects.
@@ -59,39 +57,36 @@ type PoolResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing PoolResource.
+func (pr *PoolResource) collection() *bigip.Collection[Pool, PoolList] {
+	return bigip.NewCollection[Pool, PoolList](pr.b, LtmManager, PoolEndpoint)
+}
+
 // lists all the pool instances.
-func (pr *PoolResource) List() (*PoolList, error) {
-	var pl PoolList
-	res, err := pr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+func (pr *PoolResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error) {
+	return pr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &pl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &pl, nil
+// Pages walks the pool collection one page at a time, see bigip.Collection.Pages.
+func (pr *PoolResource) Pages(ctx context.Context, fn func(page *bigip.Page[Pool]) error, opts ...*rest.ListOptions) error {
+	return pr.collection().Pages(ctx, fn, opts...)
 }
 
-// List all the details of the pool, including: profile, policy, etc.
-func (vr *PoolResource) ListDetail() (*PoolList, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).SetParams("expandSubcollections", "true").DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// Each calls fn for every pool without loading the whole collection in memory.
+// Return bigip.ErrStopIteration from fn to stop early.
+func (pr *PoolResource) Each(ctx context.Context, fn func(item *Pool) error, opts ...*rest.ListOptions) error {
+	return pr.collection().Each(ctx, fn, opts...)
+}
 
-	var pl PoolList
-	if err := json.Unmarshal(res, &pl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &pl, nil
+// List all the details of the pool, including: profile, policy, etc.
+func (vr *PoolResource) ListDetail(ctx context.Context, opts ...*rest.ListOptions) (*PoolList, error) {
+	opts = append(opts, &rest.ListOptions{ExpandSubcollections: true})
+	return vr.collection().List(ctx, opts...)
 }
 
 // ListVirtualServerName get all virtual server names
-func (vr *PoolResource) ListPoolName() ([]string, error) {
-	pl, err := vr.List()
+func (vr *PoolResource) ListPoolName(ctx context.Context) ([]string, error) {
+	pl, err := vr.List(ctx, &rest.ListOptions{Select: []string{"fullPath"}})
 	if err != nil {
 		return nil, err
 	}
@@ -104,18 +99,8 @@ func (vr *PoolResource) ListPoolName() ([]string, error) {
 }
 
 // Get a single pool identified by name.
-func (pr *PoolResource) Get(fullPathName string) (*Pool, error) {
-	var pool Pool
-	res, err := pr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &pool); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &pool, nil
+func (pr *PoolResource) Get(ctx context.Context, fullPathName string) (*Pool, error) {
+	return pr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new pool instance. If you just create a new pool, for example:
@@ -137,43 +122,35 @@ func (pr *PoolResource) Get(fullPathName string) (*Pool, error) {
         ......
 	}
 */
-func (pr *PoolResource) Create(item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = pr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (pr *PoolResource) Create(ctx context.Context, item Pool) error {
+	return pr.collection().Create(ctx, item)
 }
 
 // update a pool instance identified by name.
-func (pr *PoolResource) Update(fullPathName string, item Pool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = pr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (pr *PoolResource) Update(ctx context.Context, fullPathName string, item Pool) error {
+	return pr.collection().Update(ctx, fullPathName, item)
+}
+
+// Patch updates only the given fields of the Pool identified by fullPathName, see bigip.PatchBody.
+func (pr *PoolResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return pr.collection().Patch(ctx, fullPathName, fields)
+}
+
+// UpdateIfUnchanged updates the pool identified by fullPathName only if it was not modified since
+// item was read, see bigip.Collection.UpdateIfUnchanged.
+func (pr *PoolResource) UpdateIfUnchanged(ctx context.Context, fullPathName string, item Pool) error {
+	return pr.collection().UpdateIfUnchanged(ctx, fullPathName, item)
+}
+
+// Modify reads the pool identified by fullPathName, changes it with mutate and updates it,
+// starting over if it was modified concurrently, see bigip.Collection.Modify.
+func (pr *PoolResource) Modify(ctx context.Context, fullPathName string, mutate func(item *Pool) error) error {
+	return pr.collection().Modify(ctx, fullPathName, mutate)
 }
 
 // Delete a single pool instance identified by name.
-func (pr *PoolResource) Delete(name string) error {
-	_, err := pr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (pr *PoolResource) Delete(ctx context.Context, name string) error {
+	return pr.collection().Delete(ctx, name)
 }
 
 // // GetMembers gets all the members associated to the pool identified by id.
@@ -49,10 +49,14 @@ type PoolMembersResource struct {
 }
 
 // lists all the pool members.
-func (pmr *PoolMembersResource) List(pool string) (*PoolMembersList, error) {
+func (pmr *PoolMembersResource) List(ctx context.Context, pool string) (*PoolMembersList, error) {
+	pool, err := pmr.b.Qualify(pool)
+	if err != nil {
+		return nil, err
+	}
 	var pml PoolMembersList
 	res, err := pmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -64,10 +68,17 @@ func (pmr *PoolMembersResource) List(pool string) (*PoolMembersList, error) {
 }
 
 // Get a single pool members identified by pool name and member name.
-func (pmr *PoolMembersResource) Get(poolName string, memberName string) (*PoolMembers, error) {
+func (pmr *PoolMembersResource) Get(ctx context.Context, poolName string, memberName string) (*PoolMembers, error) {
+	poolName, err := pmr.b.Qualify(poolName)
+	if err != nil {
+		return nil, err
+	}
+	if memberName, err = pmr.b.Qualify(memberName); err != nil {
+		return nil, err
+	}
 	var pm PoolMembers
 	res, err := pmr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -79,14 +90,21 @@ func (pmr *PoolMembersResource) Get(poolName string, memberName string) (*PoolMe
 }
 
 // Create a new pool members.
-func (pmr *PoolMembersResource) Create(pool string, item PoolMembers) error {
+func (pmr *PoolMembersResource) Create(ctx context.Context, pool string, item PoolMembers) error {
+	pool, err := pmr.b.Qualify(pool)
+	if err != nil {
+		return err
+	}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
+	if jsonData, err = pmr.b.RestrictBody(jsonData); err != nil {
+		return err
+	}
 	jsonString := string(jsonData)
 	_, err = pmr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(pool).SubResource(poolMembersEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -94,14 +112,46 @@ func (pmr *PoolMembersResource) Create(pool string, item PoolMembers) error {
 }
 
 // Update a pool members indentified by pool name and member name.
-func (pmr *PoolMembersResource) Update(poolName string, memberName string, item PoolMembers) error {
+func (pmr *PoolMembersResource) Update(ctx context.Context, poolName string, memberName string, item PoolMembers) error {
+	poolName, err := pmr.b.Qualify(poolName)
+	if err != nil {
+		return err
+	}
+	if memberName, err = pmr.b.Qualify(memberName); err != nil {
+		return err
+	}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = pmr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).Body(strings.NewReader(jsonString)).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Patch updates only the given fields of a pool member, for example to disable it:
+//
+//	map[string]interface{}{"session": "user-disabled"}
+//
+// See bigip.PatchBody for the accepted types of fields.
+func (pmr *PoolMembersResource) Patch(ctx context.Context, poolName string, memberName string, fields interface{}) error {
+	poolName, err := pmr.b.Qualify(poolName)
+	if err != nil {
+		return err
+	}
+	if memberName, err = pmr.b.Qualify(memberName); err != nil {
+		return err
+	}
+	data, err := bigip.PatchBody(fields)
+	if err != nil {
+		return err
+	}
+	_, err = pmr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -109,9 +159,16 @@ func (pmr *PoolMembersResource) Update(poolName string, memberName string, item
 }
 
 // Delete a single pool members identified by pool name and member name.
-func (pmr *PoolMembersResource) Delete(poolName string, memberName string) error {
-	_, err := pmr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(context.Background())
+func (pmr *PoolMembersResource) Delete(ctx context.Context, poolName string, memberName string) error {
+	poolName, err := pmr.b.Qualify(poolName)
+	if err != nil {
+		return err
+	}
+	if memberName, err = pmr.b.Qualify(memberName); err != nil {
+		return err
+	}
+	_, err = pmr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
+		Resource(PoolEndpoint).ResourceInstance(poolName).SubResource(poolMembersEndpoint).SubResourceInstance(memberName).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,13 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 
 	"testing"
 )
 
 func TestPoolMembersResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -21,7 +21,7 @@ func TestPoolMembersResource(t *testing.T) {
 		LoadBalancingMode: "round-robin",
 	}
 
-	err = poolResource.Create(pool)
+	err = poolResource.Create(context.Background(), pool)
 	if err != nil {
 		t.Fatalf("Error creating Pool: %v", err)
 	}
@@ -36,13 +36,13 @@ func TestPoolMembersResource(t *testing.T) {
 		Address: "192.168.1.1",
 	}
 
-	err = poolMemberResource.Create("test-pool-members", poolMember)
+	err = poolMemberResource.Create(context.Background(), "test-pool-members", poolMember)
 	if err != nil {
 		t.Fatalf("Error creating Pool Member: %v", err)
 	}
 
 	// Get the Pool Member
-	poolMemberCheck, err := poolMemberResource.Get("test-pool-members", poolMember.Name)
+	poolMemberCheck, err := poolMemberResource.Get(context.Background(), "test-pool-members", poolMember.Name)
 	if err != nil {
 		t.Fatalf("Error getting Pool Member: %v", err)
 	}
@@ -54,13 +54,13 @@ func TestPoolMembersResource(t *testing.T) {
 
 	// Update properties of the Pool Member
 	poolMemberCheck.ConnectionLimit = 100
-	err = poolMemberResource.Update("test-pool-members", poolMemberCheck.Name, *poolMemberCheck)
+	err = poolMemberResource.Update(context.Background(), "test-pool-members", poolMemberCheck.Name, *poolMemberCheck)
 	if err != nil {
 		t.Fatalf("Error updating Pool Member: %v", err)
 	}
 
 	// Validate that properties were updated
-	updatedPoolMember, err := poolMemberResource.Get("test-pool-members", poolMemberCheck.Name)
+	updatedPoolMember, err := poolMemberResource.Get(context.Background(), "test-pool-members", poolMemberCheck.Name)
 	if err != nil {
 		t.Fatalf("Error getting updated Pool Member: %v", err)
 	}
@@ -69,13 +69,13 @@ func TestPoolMembersResource(t *testing.T) {
 	}
 
 	// Delete the Pool Member
-	err = poolMemberResource.Delete("test-pool-members", poolMember.Name)
+	err = poolMemberResource.Delete(context.Background(), "test-pool-members", poolMember.Name)
 	if err != nil {
 		t.Fatalf("Error deleting Pool Member: %v", err)
 	}
 
 	// Validate that Pool Member was deleted
-	if _, err := poolMemberResource.Get("test-pool-members", poolMemberCheck.Name); err == nil {
+	if _, err := poolMemberResource.Get(context.Background(), "test-pool-members", poolMemberCheck.Name); err == nil {
 		t.Error("Pool Member still exists after attempting deletion")
 	}
 }
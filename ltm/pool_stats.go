@@ -123,10 +123,10 @@ type PoolStatsResource struct {
 
 // https://192.168.13.91/mgmt/tm/ltm/pool/stats?expandSubcollections=true
 
-func (psr *PoolStatsResource) List() (*PoolStatsList, error) {
+func (psr *PoolStatsResource) List(ctx context.Context) (*PoolStatsList, error) {
 	var psl PoolStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -134,14 +134,19 @@ func (psr *PoolStatsResource) List() (*PoolStatsList, error) {
 	if err := json.Unmarshal(res, &psl); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(psr.b, psl.Entries)
 	return &psl, nil
 }
 
 // Gets only the stats for the specified pool itself, not include members of the pool.
-func (psr *PoolStatsResource) GetPoolStats(pool string) (*PoolStatsList, error) {
+func (psr *PoolStatsResource) GetPoolStats(ctx context.Context, pool string) (*PoolStatsList, error) {
+	pool, err := psr.b.Qualify(pool)
+	if err != nil {
+		return nil, err
+	}
 	var psl PoolStatsList
 	res, err := psr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(PoolEndpoint).ResourceInstance(pool).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(PoolEndpoint).ResourceInstance(pool).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,12 +1,12 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 	"testing"
 )
 
 func TestPoolResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -20,12 +20,12 @@ func TestPoolResource(t *testing.T) {
 		Partition:         "Common",
 		LoadBalancingMode: "round-robin",
 	}
-	if err := poolResource.Create(pool); err != nil {
+	if err := poolResource.Create(context.Background(), pool); err != nil {
 		t.Fatalf("Error creating Pool: %v", err)
 	}
 
 	// Get the Pool
-	poolCheck, err := poolResource.Get("/Common/test-pool")
+	poolCheck, err := poolResource.Get(context.Background(), "/Common/test-pool")
 	if err != nil {
 		t.Fatalf("Error getting Pool: %v", err)
 	}
@@ -38,12 +38,12 @@ func TestPoolResource(t *testing.T) {
 	// Update properties of the Pool
 	poolCheck.AllowNat = "yes"
 	poolCheck.AllowSnat = "yes"
-	if err := poolResource.Update(poolCheck.FullPath, *poolCheck); err != nil {
+	if err := poolResource.Update(context.Background(), poolCheck.FullPath, *poolCheck); err != nil {
 		t.Fatalf("Error updating Pool: %v", err)
 	}
 
 	// Validate that properties were updated
-	poolUpdated, err := poolResource.Get("/Common/test-pool")
+	poolUpdated, err := poolResource.Get(context.Background(), "/Common/test-pool")
 	if err != nil {
 		t.Fatalf("Error getting updated Pool: %v", err)
 	}
@@ -52,12 +52,12 @@ func TestPoolResource(t *testing.T) {
 	}
 
 	// Delete the Pool
-	if err := poolResource.Delete(poolCheck.FullPath); err != nil {
+	if err := poolResource.Delete(context.Background(), poolCheck.FullPath); err != nil {
 		t.Fatalf("Error deleting Pool: %v", err)
 	}
 
 	// Validate that Pool was deleted
-	if _, err := poolResource.Get("/Common/test-pool"); err == nil {
+	if _, err := poolResource.Get(context.Background(), "/Common/test-pool"); err == nil {
 		t.Error("Pool still exists after attempting deletion")
 	}
 }
@@ -0,0 +1,905 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package profile
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// CertificateAuthorityAPI is the interface of CertificateAuthorityResource, which mocks implement in tests.
+type CertificateAuthorityAPI interface {
+	// List retrieves a list of CertificateAuthority resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CertificateAuthorityList, error)
+	// Get retrieves a CertificateAuthority resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*CertificateAuthority, error)
+	// Create adds a new CertificateAuthority resource using the provided CertificateAuthority item.
+	Create(ctx context.Context, item CertificateAuthority) error
+	// Update modifies a CertificateAuthority resource identified by its full path name using the provided CertificateAuthority item.
+	Update(ctx context.Context, fullPathName string, item CertificateAuthority) error
+	// Patch updates only the given fields of the CertificateAuthority identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a CertificateAuthority resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ CertificateAuthorityAPI = &CertificateAuthorityResource{}
+
+// ClientLDAPAPI is the interface of ClientLDAPResource, which mocks implement in tests.
+type ClientLDAPAPI interface {
+	// List retrieves a list of ClientLDAP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ClientLDAPList, error)
+	// Get retrieves a ClientLDAP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*ClientLDAP, error)
+	// Create adds a new ClientLDAP resource using the provided ClientLDAP item.
+	Create(ctx context.Context, item ClientLDAP) error
+	// Update modifies a ClientLDAP resource identified by its full path name using the provided ClientLDAP item.
+	Update(ctx context.Context, fullPathName string, item ClientLDAP) error
+	// Patch updates only the given fields of the ClientLDAP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a ClientLDAP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ClientLDAPAPI = &ClientLDAPResource{}
+
+// ClientSSLAPI is the interface of ClientSSLResource, which mocks implement in tests.
+type ClientSSLAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ClientSSLList, error)
+	Get(ctx context.Context, fullPathName string) (*ClientSSL, error)
+	Create(ctx context.Context, item ClientSSL) error
+	Update(ctx context.Context, fullPathName string, item ClientSSL) error
+	// Patch updates only the given fields of the ClientSSL identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ClientSSLAPI = &ClientSSLResource{}
+
+// ConnectorAPI is the interface of ConnectorResource, which mocks implement in tests.
+type ConnectorAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ConnectorList, error)
+	Get(ctx context.Context, fullPathName string) (*Connector, error)
+	Create(ctx context.Context, item Connector) error
+	Update(ctx context.Context, fullPathName string, item Connector) error
+	// Patch updates only the given fields of the Connector identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ConnectorAPI = &ConnectorResource{}
+
+// DNSAPI is the interface of DNSResource, which mocks implement in tests.
+type DNSAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DNSList, error)
+	Get(ctx context.Context, fullPathName string) (*DNS, error)
+	Create(ctx context.Context, item DNS) error
+	Update(ctx context.Context, fullPathName string, item DNS) error
+	// Patch updates only the given fields of the DNS identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ DNSAPI = &DNSResource{}
+
+// DiameterAPI is the interface of DiameterResource, which mocks implement in tests.
+type DiameterAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DiameterList, error)
+	Get(ctx context.Context, fullPathName string) (*Diameter, error)
+	Create(ctx context.Context, item Diameter) error
+	Update(ctx context.Context, fullPathName string, item Diameter) error
+	// Patch updates only the given fields of the Diameter identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ DiameterAPI = &DiameterResource{}
+
+// FIXAPI is the interface of FIXResource, which mocks implement in tests.
+type FIXAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FIXList, error)
+	Get(ctx context.Context, fullPathName string) (*FIX, error)
+	Create(ctx context.Context, item FIX) error
+	Update(ctx context.Context, fullPathName string, item FIX) error
+	// Patch updates only the given fields of the FIX identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ FIXAPI = &FIXResource{}
+
+// FTPAPI is the interface of FTPResource, which mocks implement in tests.
+type FTPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FTPList, error)
+	Get(ctx context.Context, fullPathName string) (*FTP, error)
+	Create(ctx context.Context, item FTP) error
+	Update(ctx context.Context, fullPathName string, item FTP) error
+	// Patch updates only the given fields of the FTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ FTPAPI = &FTPResource{}
+
+// FastHTTPAPI is the interface of FastHTTPResource, which mocks implement in tests.
+type FastHTTPAPI interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FastHTTPList, error)
+	Get(ctx context.Context, fullPathName string) (*FastHTTP, error)
+	Create(ctx context.Context, item FastHTTP) error
+	Update(ctx context.Context, fullPathName string, item FastHTTP) error
+	// Patch updates only the given fields of the FastHTTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ FastHTTPAPI = &FastHTTPResource{}
+
+// FastL4API is the interface of FastL4Resource, which mocks implement in tests.
+type FastL4API interface {
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FastL4List, error)
+	Get(ctx context.Context, fullPathName string) (*FastL4, error)
+	Create(ctx context.Context, item FastL4) error
+	Update(ctx context.Context, fullPathName string, item FastL4) error
+	// Patch updates only the given fields of the FastL4 identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ FastL4API = &FastL4Resource{}
+
+// GTPAPI is the interface of GTPResource, which mocks implement in tests.
+type GTPAPI interface {
+	// List retrieves a list of GTP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*GTPList, error)
+	// Get retrieves a GTP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*GTP, error)
+	// Create adds a new GTP resource using the provided GTP item.
+	Create(ctx context.Context, item GTP) error
+	// Update modifies a GTP resource identified by its full path name using the provided GTP item.
+	Update(ctx context.Context, fullPathName string, item GTP) error
+	// Patch updates only the given fields of the GTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a GTP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ GTPAPI = &GTPResource{}
+
+// HTMLAPI is the interface of HTMLResource, which mocks implement in tests.
+type HTMLAPI interface {
+	// List retrieves a list of HTML resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTMLList, error)
+	// Get retrieves an HTML resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTML, error)
+	// Create adds a new HTML resource using the provided HTML item.
+	Create(ctx context.Context, item HTML) error
+	// Update modifies an HTML resource identified by its full path name using the provided HTML item.
+	Update(ctx context.Context, fullPathName string, item HTML) error
+	// Patch updates only the given fields of the HTML identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTML resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTMLAPI = &HTMLResource{}
+
+// HTTP2API is the interface of HTTP2Resource, which mocks implement in tests.
+type HTTP2API interface {
+	// List retrieves a list of HTTP2 resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTP2List, error)
+	// Get retrieves an HTTP2 resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTTP2, error)
+	// Create adds a new HTTP2 resource using the provided HTTP2 item.
+	Create(ctx context.Context, item HTTP2) error
+	// Update modifies an HTTP2 resource identified by its full path name using the provided HTTP2 item.
+	Update(ctx context.Context, fullPathName string, item HTTP2) error
+	// Patch updates only the given fields of the HTTP2 identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTTP2 resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTTP2API = &HTTP2Resource{}
+
+// HTTP3API is the interface of HTTP3Resource, which mocks implement in tests.
+type HTTP3API interface {
+	// List retrieves a list of HTTP3 resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTP3List, error)
+	// Get retrieves an HTTP3 resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTTP3, error)
+	// Create adds a new HTTP3 resource using the provided HTTP3 item.
+	Create(ctx context.Context, item HTTP3) error
+	// Update modifies an HTTP3 resource identified by its full path name using the provided HTTP3 item.
+	Update(ctx context.Context, fullPathName string, item HTTP3) error
+	// Patch updates only the given fields of the HTTP3 identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTTP3 resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTTP3API = &HTTP3Resource{}
+
+// HTTPAPI is the interface of HTTPResource, which mocks implement in tests.
+type HTTPAPI interface {
+	// List retrieves a list of HTTP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPList, error)
+	// Get retrieves an HTTP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTTP, error)
+	// Create adds a new HTTP resource using the provided HTML item.
+	Create(ctx context.Context, item HTTP) error
+	// Update modifies an HTTP resource identified by its full path name using the provided HTTP item.
+	Update(ctx context.Context, fullPathName string, item HTTP) error
+	// Patch updates only the given fields of the HTTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTTP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTTPAPI = &HTTPResource{}
+
+// HTTPCompressionAPI is the interface of HTTPCompressionResource, which mocks implement in tests.
+type HTTPCompressionAPI interface {
+	// List retrieves a list of HTTPCompression resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPCompressionList, error)
+	// Get retrieves an HTTPCompression resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTTPCompression, error)
+	// Create adds a new HTTPCompression resource using the provided HTTPCompression item.
+	Create(ctx context.Context, item HTTPCompression) error
+	// Update modifies an HTTPCompression resource identified by its full path name using the provided HTTPCompression item.
+	Update(ctx context.Context, fullPathName string, item HTTPCompression) error
+	// Patch updates only the given fields of the HTTPCompression identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTTPCompression resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTTPCompressionAPI = &HTTPCompressionResource{}
+
+// HTTPProxyConnectAPI is the interface of HTTPProxyConnectResource, which mocks implement in tests.
+type HTTPProxyConnectAPI interface {
+	// List retrieves a list of HTTPProxyConnect resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPProxyConnectList, error)
+	// Get retrieves an HTTPProxyConnect resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTTPProxyConnect, error)
+	// Create adds a new HTTPProxyConnect resource using the provided HTTPProxyConnect item.
+	Create(ctx context.Context, item HTTPProxyConnect) error
+	// Update modifies an HTTPProxyConnect resource identified by its full path name using the provided HTTPProxyConnect item.
+	Update(ctx context.Context, fullPathName string, item HTTPProxyConnect) error
+	// Patch updates only the given fields of the HTTPProxyConnect identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTTPProxyConnect resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTTPProxyConnectAPI = &HTTPProxyConnectResource{}
+
+// HTTPRouterAPI is the interface of HTTPRouterResource, which mocks implement in tests.
+type HTTPRouterAPI interface {
+	// List retrieves a list of HTTPRouter resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPRouterList, error)
+	// Get retrieves an HTTPRouter resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*HTTPRouter, error)
+	// Create adds a new HTTPRouter resource using the provided HTTPRouter item.
+	Create(ctx context.Context, item HTTPRouter) error
+	// Update modifies an HTTPRouter resource identified by its full path name using the provided HTTPRouter item.
+	Update(ctx context.Context, fullPathName string, item HTTPRouter) error
+	// Patch updates only the given fields of the HTTPRouter identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an HTTPRouter resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ HTTPRouterAPI = &HTTPRouterResource{}
+
+// ICAPAPI is the interface of ICAPResource, which mocks implement in tests.
+type ICAPAPI interface {
+	// List retrieves a list of ICAP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ICAPList, error)
+	// Get retrieves an ICAP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*ICAP, error)
+	// Create adds a new ICAP resource using the provided ICAP item.
+	Create(ctx context.Context, item ICAP) error
+	// Update modifies an ICAP resource identified by its full path name using the provided ICAP item.
+	Update(ctx context.Context, fullPathName string, item ICAP) error
+	// Patch updates only the given fields of the ICAP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an ICAP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ICAPAPI = &ICAPResource{}
+
+// IMAPAPI is the interface of IMAPResource, which mocks implement in tests.
+type IMAPAPI interface {
+	// List retrieves a list of IMAP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*IMAPList, error)
+	// Get retrieves an IMAP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*IMAP, error)
+	// Create adds a new IMAP resource using the provided IMAP item.
+	Create(ctx context.Context, item IMAP) error
+	// Update modifies an IMAP resource identified by its full path name using the provided IMAP item.
+	Update(ctx context.Context, fullPathName string, item IMAP) error
+	// Patch updates only the given fields of the IMAP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an IMAP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ IMAPAPI = &IMAPResource{}
+
+// MQTTAPI is the interface of MQTTResource, which mocks implement in tests.
+type MQTTAPI interface {
+	// List retrieves a list of MQTT resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*MQTTList, error)
+	// Get retrieves an MQTT resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*MQTT, error)
+	// Create adds a new MQTT resource using the provided MQTT item.
+	Create(ctx context.Context, item MQTT) error
+	// Update modifies an MQTT resource identified by its full path name using the provided MQTT item.
+	Update(ctx context.Context, fullPathName string, item MQTT) error
+	// Patch updates only the given fields of the MQTT identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an MQTT resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ MQTTAPI = &MQTTResource{}
+
+// NTLMAPI is the interface of NTLMResource, which mocks implement in tests.
+type NTLMAPI interface {
+	// List retrieves a list of NTLM resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*NTLMList, error)
+	// Get retrieves an NTLM resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*NTLM, error)
+	// Create adds a new NTLM resource using the provided NTLM item.
+	Create(ctx context.Context, item NTLM) error
+	// Update modifies an NTLM resource identified by its full path name using the provided NTLM item.
+	Update(ctx context.Context, fullPathName string, item NTLM) error
+	// Patch updates only the given fields of the NTLM identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an NTLM resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ NTLMAPI = &NTLMResource{}
+
+// NetflowAPI is the interface of NetflowResource, which mocks implement in tests.
+type NetflowAPI interface {
+	// List retrieves a list of Netflow resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*NetflowList, error)
+	// Get retrieves a Netflow resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*Netflow, error)
+	// Create adds a new Netflow resource using the provided Netflow item.
+	Create(ctx context.Context, item Netflow) error
+	// Update modifies a Netflow resource identified by its full path name using the provided Netflow item.
+	Update(ctx context.Context, fullPathName string, item Netflow) error
+	// Patch updates only the given fields of the Netflow identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a Netflow resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ NetflowAPI = &NetflowResource{}
+
+// OCSPAPI is the interface of OCSPResource, which mocks implement in tests.
+type OCSPAPI interface {
+	// List retrieves a list of OCSP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*OCSPList, error)
+	// Get retrieves an OCSP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*OCSP, error)
+	// Create adds a new OCSP resource using the provided OCSP item.
+	Create(ctx context.Context, item OCSP) error
+	// Update modifies an OCSP resource identified by its full path name using the provided OCSP item.
+	Update(ctx context.Context, fullPathName string, item OCSP) error
+	// Patch updates only the given fields of the OCSP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an OCSP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ OCSPAPI = &OCSPResource{}
+
+// OneConnectAPI is the interface of OneConnectResource, which mocks implement in tests.
+type OneConnectAPI interface {
+	// List retrieves a list of OneConnect resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*OneConnectList, error)
+	// Get retrieves an OneConnect resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*OneConnect, error)
+	// Create adds a new OneConnect resource using the provided OneConnect item.
+	Create(ctx context.Context, item OneConnect) error
+	// Update modifies an OneConnect resource identified by its full path name using the provided OneConnect item.
+	Update(ctx context.Context, fullPathName string, item OneConnect) error
+	// Patch updates only the given fields of the OneConnect identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an OneConnect resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ OneConnectAPI = &OneConnectResource{}
+
+// POP3API is the interface of POP3Resource, which mocks implement in tests.
+type POP3API interface {
+	// List retrieves a list of POP3 resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*POP3List, error)
+	// Get retrieves a POP3 resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*POP3, error)
+	// Create adds a new POP3 resource using the provided POP3 item.
+	Create(ctx context.Context, item POP3) error
+	// Update modifies a POP3 resource identified by its full path name using the provided POP3 item.
+	Update(ctx context.Context, fullPathName string, item POP3) error
+	// Patch updates only the given fields of the POP3 identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a POP3 resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ POP3API = &POP3Resource{}
+
+// PPTPAPI is the interface of PPTPResource, which mocks implement in tests.
+type PPTPAPI interface {
+	// List retrieves a list of PPTP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PPTPList, error)
+	// Get retrieves a PPTP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*PPTP, error)
+	// Create adds a new PPTP resource using the provided PPTP item.
+	Create(ctx context.Context, item PPTP) error
+	// Update modifies a PPTP resource identified by its full path name using the provided PPTP item.
+	Update(ctx context.Context, fullPathName string, item PPTP) error
+	// Patch updates only the given fields of the PPTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a PPTP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ PPTPAPI = &PPTPResource{}
+
+// ProfileAPI is the interface of ProfileResource, which mocks implement in tests.
+type ProfileAPI interface {
+	CertificateAuthority() CertificateAuthorityAPI
+	ClientLDAP() ClientLDAPAPI
+	ClientSSL() ClientSSLAPI
+	Connector() ConnectorAPI
+	Diameter() DiameterAPI
+	DNS() DNSAPI
+	FastHTTP() FastHTTPAPI
+	FastL4() FastL4API
+	FIX() FIXAPI
+	FTP() FTPAPI
+	GTP() GTPAPI
+	HTML() HTMLAPI
+	HTTP() HTTPAPI
+	HTTPCompression() HTTPCompressionAPI
+	HTTPProxyConnect() HTTPProxyConnectAPI
+	HTTP2() HTTP2API
+	HTTP3() HTTP3API
+	HTTPRouter() HTTPRouterAPI
+	ICAP() ICAPAPI
+	IMAP() IMAPAPI
+	MQTT() MQTTAPI
+	Netflow() NetflowAPI
+	NTLM() NTLMAPI
+	OCSP() OCSPAPI
+	OneConnect() OneConnectAPI
+	POP3() POP3API
+	PPTP() PPTPAPI
+	QOE() QOEAPI
+	QUIC() QUICAPI
+	Radius() RADIUSAPI
+	Rewrite() RewriteAPI
+	RTSP() RTSPAPI
+	SCTP() SCTPAPI
+	ServerLDAP() ServerLDAPAPI
+	ServerSSL() ServerSSLAPI
+	Service() ServiceAPI
+	SIP() SIPAPI
+	SMTPS() SMTPSAPI
+	SOCKS() SocksAPI
+	Statistics() StatisticsAPI
+	Stream() StreamAPI
+	TCP() TCPAPI
+	TCPAnalytics() TCPAnalyticsAPI
+	TDR() TDRAPI
+	TFTP() TFTPAPI
+	UDP() UDPAPI
+	WebAcceleration() WebAccelerationAPI
+	WebSocket() WebSocketAPI
+	XML() XMLAPI
+}
+
+var _ ProfileAPI = &ProfileResource{}
+
+// QOEAPI is the interface of QOEResource, which mocks implement in tests.
+type QOEAPI interface {
+	// List retrieves a list of QOE resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*QOEList, error)
+	// Get retrieves a QOE resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*QOE, error)
+	// Create adds a new QOE resource using the provided QOE item.
+	Create(ctx context.Context, item QOE) error
+	// Update modifies a QOE resource identified by its full path name using the provided QOE item.
+	Update(ctx context.Context, fullPathName string, item QOE) error
+	// Patch updates only the given fields of the QOE identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a QOE resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ QOEAPI = &QOEResource{}
+
+// QUICAPI is the interface of QUICResource, which mocks implement in tests.
+type QUICAPI interface {
+	// List retrieves a list of QUIC resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*QUICList, error)
+	// Get retrieves a QUIC resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*QUIC, error)
+	// Create adds a new QUIC resource using the provided QUIC item.
+	Create(ctx context.Context, item QUIC) error
+	// Update modifies a QUIC resource identified by its full path name using the provided QUIC item.
+	Update(ctx context.Context, fullPathName string, item QUIC) error
+	// Patch updates only the given fields of the QUIC identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a QUIC resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ QUICAPI = &QUICResource{}
+
+// RADIUSAPI is the interface of RADIUSResource, which mocks implement in tests.
+type RADIUSAPI interface {
+	// List retrieves a list of RADIUS resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RADIUSList, error)
+	// Get retrieves a RADIUS resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*RADIUS, error)
+	// Create adds a new RADIUS resource using the provided RADIUS item.
+	Create(ctx context.Context, item RADIUS) error
+	// Update modifies a RADIUS resource identified by its full path name using the provided RADIUS item.
+	Update(ctx context.Context, fullPathName string, item RADIUS) error
+	// Patch updates only the given fields of the RADIUS identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a RADIUS resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ RADIUSAPI = &RADIUSResource{}
+
+// RTSPAPI is the interface of RTSPResource, which mocks implement in tests.
+type RTSPAPI interface {
+	// List retrieves a list of RTSP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RTSPList, error)
+	// Get retrieves an RTSP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*RTSP, error)
+	// Create adds a new RTSP resource using the provided RTSP item.
+	Create(ctx context.Context, item RTSP) error
+	// Update modifies an RTSP resource identified by its full path name using the provided RTSP item.
+	Update(ctx context.Context, fullPathName string, item RTSP) error
+	// Patch updates only the given fields of the RTSP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an RTSP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ RTSPAPI = &RTSPResource{}
+
+// RewriteAPI is the interface of RewriteResource, which mocks implement in tests.
+type RewriteAPI interface {
+	// List retrieves a list of Rewrite resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RewriteList, error)
+	// Get retrieves a Rewrite resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*Rewrite, error)
+	// Create adds a new Rewrite resource using the provided Rewrite item.
+	Create(ctx context.Context, item Rewrite) error
+	// Update modifies a Rewrite resource identified by its full path name using the provided Rewrite item.
+	Update(ctx context.Context, fullPathName string, item Rewrite) error
+	// Patch updates only the given fields of the Rewrite identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a Rewrite resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ RewriteAPI = &RewriteResource{}
+
+// SCTPAPI is the interface of SCTPResource, which mocks implement in tests.
+type SCTPAPI interface {
+	// List retrieves a list of SCTP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SCTPList, error)
+	// Get retrieves an SCTP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*SCTP, error)
+	// Create adds a new SCTP resource using the provided SCTP item.
+	Create(ctx context.Context, item SCTP) error
+	// Update modifies an SCTP resource identified by its full path name using the provided SCTP item.
+	Update(ctx context.Context, fullPathName string, item SCTP) error
+	// Patch updates only the given fields of the SCTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an SCTP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ SCTPAPI = &SCTPResource{}
+
+// SIPAPI is the interface of SIPResource, which mocks implement in tests.
+type SIPAPI interface {
+	// List retrieves a list of SIP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SIPList, error)
+	// Get retrieves a SIP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*SIP, error)
+	// Create adds a new SIP resource using the provided SIP item.
+	Create(ctx context.Context, item SIP) error
+	// Update modifies a SIP resource identified by its full path name using the provided SIP item.
+	Update(ctx context.Context, fullPathName string, item SIP) error
+	// Patch updates only the given fields of the SIP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a SIP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ SIPAPI = &SIPResource{}
+
+// SMTPSAPI is the interface of SMTPSResource, which mocks implement in tests.
+type SMTPSAPI interface {
+	// List retrieves a list of SMTPS resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPSList, error)
+	// Get retrieves an SMTPS resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*SMTPS, error)
+	// Create adds a new SMTPS resource using the provided SMTPS item.
+	Create(ctx context.Context, item SMTPS) error
+	// Update modifies an SMTPS resource identified by its full path name using the provided SMTPS item.
+	Update(ctx context.Context, fullPathName string, item SMTPS) error
+	// Patch updates only the given fields of the SMTPS identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an SMTPS resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ SMTPSAPI = &SMTPSResource{}
+
+// ServerLDAPAPI is the interface of ServerLDAPResource, which mocks implement in tests.
+type ServerLDAPAPI interface {
+	// List retrieves a list of ServerLDAP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServerLDAPList, error)
+	// Get retrieves a ServerLDAP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*ServerLDAP, error)
+	// Create adds a new ServerLDAP resource using the provided ServerLDAP item.
+	Create(ctx context.Context, item ServerLDAP) error
+	// Update modifies a ServerLDAP resource identified by its full path name using the provided ServerLDAP item.
+	Update(ctx context.Context, fullPathName string, item ServerLDAP) error
+	// Patch updates only the given fields of the ServerLDAP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a ServerLDAP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ServerLDAPAPI = &ServerLDAPResource{}
+
+// ServerSSLAPI is the interface of ServerSSLResource, which mocks implement in tests.
+type ServerSSLAPI interface {
+	// List retrieves a list of ServerSSL resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServerSSLList, error)
+	// Get retrieves a ServerSSL resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*ServerSSL, error)
+	// Create adds a new ServerSSL resource using the provided ServerSSL item.
+	Create(ctx context.Context, item ServerSSL) error
+	// Update modifies a ServerSSL resource identified by its full path name using the provided ServerSSL item.
+	Update(ctx context.Context, fullPathName string, item ServerSSL) error
+	// Patch updates only the given fields of the ServerSSL identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a ServerSSL resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ServerSSLAPI = &ServerSSLResource{}
+
+// ServiceAPI is the interface of ServiceResource, which mocks implement in tests.
+type ServiceAPI interface {
+	// List retrieves a list of Service resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServiceList, error)
+	// Get retrieves a Service resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*Service, error)
+	// Create adds a new Service resource using the provided Service item.
+	Create(ctx context.Context, item Service) error
+	// Update modifies a Service resource identified by its full path name using the provided Service item.
+	Update(ctx context.Context, fullPathName string, item Service) error
+	// Patch updates only the given fields of the Service identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a Service resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ ServiceAPI = &ServiceResource{}
+
+// SocksAPI is the interface of SocksResource, which mocks implement in tests.
+type SocksAPI interface {
+	// List retrieves a list of Socks resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SocksList, error)
+	// Get retrieves a Socks resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*Socks, error)
+	// Create adds a new Socks resource using the provided Socks item.
+	Create(ctx context.Context, item Socks) error
+	// Update modifies a Socks resource identified by its full path name using the provided Socks item.
+	Update(ctx context.Context, fullPathName string, item Socks) error
+	// Patch updates only the given fields of the Socks identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a Socks resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ SocksAPI = &SocksResource{}
+
+// StatisticsAPI is the interface of StatisticsResource, which mocks implement in tests.
+type StatisticsAPI interface {
+	// List retrieves a list of Statistics resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*StatisticsList, error)
+	// Get retrieves a Statistics resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*Statistics, error)
+	// Create adds a new Statistics resource using the provided Statistics item.
+	Create(ctx context.Context, item Statistics) error
+	// Update modifies a Statistics resource identified by its full path name using the provided Statistics item.
+	Update(ctx context.Context, fullPathName string, item Statistics) error
+	// Patch updates only the given fields of the Statistics identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a Statistics resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ StatisticsAPI = &StatisticsResource{}
+
+// StreamAPI is the interface of StreamResource, which mocks implement in tests.
+type StreamAPI interface {
+	// List retrieves a list of Stream resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*StreamList, error)
+	// Get retrieves a Stream resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*Stream, error)
+	// Create adds a new Stream resource using the provided Stream item.
+	Create(ctx context.Context, item Stream) error
+	// Update modifies a Stream resource identified by its full path name using the provided Stream item.
+	Update(ctx context.Context, fullPathName string, item Stream) error
+	// Patch updates only the given fields of the Stream identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a Stream resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ StreamAPI = &StreamResource{}
+
+// TCPAPI is the interface of TCPResource, which mocks implement in tests.
+type TCPAPI interface {
+	// List retrieves a list of TCP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPList, error)
+	// Get retrieves a TCP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*TCP, error)
+	// Create adds a new TCP resource using the provided TCP item.
+	Create(ctx context.Context, item TCP) error
+	// Update modifies a TCP resource identified by its full path name using the provided TCP item.
+	Update(ctx context.Context, fullPathName string, item TCP) error
+	// Patch updates only the given fields of the TCP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a TCP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ TCPAPI = &TCPResource{}
+
+// TCPAnalyticsAPI is the interface of TCPAnalyticsResource, which mocks implement in tests.
+type TCPAnalyticsAPI interface {
+	// List retrieves a list of TCPAnalytics resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TCPAnalyticsList, error)
+	// Get retrieves a TCPAnalytics resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*TCPAnalytics, error)
+	// Create adds a new TCPAnalytics resource using the provided TCPAnalytics item.
+	Create(ctx context.Context, item TCPAnalytics) error
+	// Update modifies a TCPAnalytics resource identified by its full path name using the provided TCPAnalytics item.
+	Update(ctx context.Context, fullPathName string, item TCPAnalytics) error
+	// Patch updates only the given fields of the TCPAnalytics identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a TCPAnalytics resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ TCPAnalyticsAPI = &TCPAnalyticsResource{}
+
+// TDRAPI is the interface of TDRResource, which mocks implement in tests.
+type TDRAPI interface {
+	// List retrieves a list of TDR resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TDRList, error)
+	// Get retrieves a TDR resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*TDR, error)
+	// Create adds a new TDR resource using the provided TDR item.
+	Create(ctx context.Context, item TDR) error
+	// Update modifies a TDR resource identified by its full path name using the provided TDR item.
+	Update(ctx context.Context, fullPathName string, item TDR) error
+	// Patch updates only the given fields of the TDR identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a TDR resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ TDRAPI = &TDRResource{}
+
+// TFTPAPI is the interface of TFTPResource, which mocks implement in tests.
+type TFTPAPI interface {
+	// List retrieves a list of TFTP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TFTPList, error)
+	// Get retrieves a TFTP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*TFTP, error)
+	// Create adds a new TFTP resource using the provided TFTP item.
+	Create(ctx context.Context, item TFTP) error
+	// Update modifies a TFTP resource identified by its full path name using the provided TFTP item.
+	Update(ctx context.Context, fullPathName string, item TFTP) error
+	// Patch updates only the given fields of the TFTP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a TFTP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ TFTPAPI = &TFTPResource{}
+
+// UDPAPI is the interface of UDPResource, which mocks implement in tests.
+type UDPAPI interface {
+	// List retrieves a list of UDP resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*UDPList, error)
+	// Get retrieves a UDP resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*UDP, error)
+	// Create adds a new UDP resource using the provided UDP item.
+	Create(ctx context.Context, item UDP) error
+	// Update modifies a UDP resource identified by its full path name using the provided UDP item.
+	Update(ctx context.Context, fullPathName string, item UDP) error
+	// Patch updates only the given fields of the UDP identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a UDP resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ UDPAPI = &UDPResource{}
+
+// WebAccelerationAPI is the interface of WebAccelerationResource, which mocks implement in tests.
+type WebAccelerationAPI interface {
+	// List retrieves a list of WebAcceleration resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WebAccelerationList, error)
+	// Get retrieves a WebAcceleration resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*WebAcceleration, error)
+	// Create adds a new WebAcceleration resource using the provided WebAcceleration item.
+	Create(ctx context.Context, item WebAcceleration) error
+	// Update modifies a WebAcceleration resource identified by its full path name using the provided WebAcceleration item.
+	Update(ctx context.Context, fullPathName string, item WebAcceleration) error
+	// Patch updates only the given fields of the WebAcceleration identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a WebAcceleration resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ WebAccelerationAPI = &WebAccelerationResource{}
+
+// WebSocketAPI is the interface of WebSocketResource, which mocks implement in tests.
+type WebSocketAPI interface {
+	// List retrieves a list of WebSocket resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*WebSocketList, error)
+	// Get retrieves a WebSocket resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*WebSocket, error)
+	// Create adds a new WebSocket resource using the provided WebSocket item.
+	Create(ctx context.Context, item WebSocket) error
+	// Update modifies a WebSocket resource identified by its full path name using the provided WebSocket item.
+	Update(ctx context.Context, fullPathName string, item WebSocket) error
+	// Patch updates only the given fields of the WebSocket identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes a WebSocket resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ WebSocketAPI = &WebSocketResource{}
+
+// XMLAPI is the interface of XMLResource, which mocks implement in tests.
+type XMLAPI interface {
+	// List retrieves a list of XML resources.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*XMLList, error)
+	// Get retrieves an XML resource by its full path name.
+	Get(ctx context.Context, fullPathName string) (*XML, error)
+	// Create adds a new XML resource using the provided XML item.
+	Create(ctx context.Context, item XML) error
+	// Update modifies an XML resource identified by its full path name using the provided XML item.
+	Update(ctx context.Context, fullPathName string, item XML) error
+	// Patch updates only the given fields of the XML identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete removes an XML resource by its full path name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ XMLAPI = &XMLResource{}
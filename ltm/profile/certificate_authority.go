@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type CertificateAuthorityList struct {
@@ -37,83 +35,37 @@ type CertificateAuthorityResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of CertificateAuthority resources.
-func (cr *CertificateAuthorityResource) List() (*CertificateAuthorityList, error) {
-	var items CertificateAuthorityList
-	// Perform a GET request to retrieve a list of CertificateAuthority resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(CertificateAuthorityEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CertificateAuthorityResource.
+func (cr *CertificateAuthorityResource) collection() *bigip.Collection[CertificateAuthority, CertificateAuthorityList] {
+	return bigip.NewCollection[CertificateAuthority, CertificateAuthorityList](cr.b, LtmManager, ProfileEndpoint, CertificateAuthorityEndpoint)
+}
 
-	// Unmarshal JSON response data into CertificateAuthorityList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of CertificateAuthority resources.
+func (cr *CertificateAuthorityResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CertificateAuthorityList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a CertificateAuthority resource by its full path name.
-func (cr *CertificateAuthorityResource) Get(fullPathName string) (*CertificateAuthority, error) {
-	var item CertificateAuthority
-	// Perform a GET request to retrieve a specific CertificateAuthority resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(CertificateAuthorityEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into CertificateAuthority struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *CertificateAuthorityResource) Get(ctx context.Context, fullPathName string) (*CertificateAuthority, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new CertificateAuthority resource using the provided CertificateAuthority item.
-func (cr *CertificateAuthorityResource) Create(item CertificateAuthority) error {
-	// Marshal the CertificateAuthority struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new CertificateAuthority resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(CertificateAuthorityEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *CertificateAuthorityResource) Create(ctx context.Context, item CertificateAuthority) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a CertificateAuthority resource identified by its full path name using the provided CertificateAuthority item.
-func (cr *CertificateAuthorityResource) Update(fullPathName string, item CertificateAuthority) error {
-	// Marshal the CertificateAuthority struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *CertificateAuthorityResource) Update(ctx context.Context, fullPathName string, item CertificateAuthority) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified CertificateAuthority resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(CertificateAuthorityEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the CertificateAuthority identified by fullPathName, see bigip.PatchBody.
+func (cr *CertificateAuthorityResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a CertificateAuthority resource by its full path name.
-func (cr *CertificateAuthorityResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified CertificateAuthority resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(CertificateAuthorityEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *CertificateAuthorityResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
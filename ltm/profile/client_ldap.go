@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ClientLDAPList struct {
@@ -33,74 +31,37 @@ type ClientLDAPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of ClientLDAP resources.
-func (cr *ClientLDAPResource) List() (*ClientLDAPList, error) {
-	var items ClientLDAPList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ClientLDAPResource.
+func (cr *ClientLDAPResource) collection() *bigip.Collection[ClientLDAP, ClientLDAPList] {
+	return bigip.NewCollection[ClientLDAP, ClientLDAPList](cr.b, LtmManager, ProfileEndpoint, ClientLDAPEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of ClientLDAP resources.
+func (cr *ClientLDAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ClientLDAPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a ClientLDAP resource by its full path name.
-func (cr *ClientLDAPResource) Get(fullPathName string) (*ClientLDAP, error) {
-	var item ClientLDAP
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *ClientLDAPResource) Get(ctx context.Context, fullPathName string) (*ClientLDAP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new ClientLDAP resource using the provided ClientLDAP item.
-func (cr *ClientLDAPResource) Create(item ClientLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ClientLDAPResource) Create(ctx context.Context, item ClientLDAP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a ClientLDAP resource identified by its full path name using the provided ClientLDAP item.
-func (cr *ClientLDAPResource) Update(fullPathName string, item ClientLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *ClientLDAPResource) Update(ctx context.Context, fullPathName string, item ClientLDAP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ClientLDAP identified by fullPathName, see bigip.PatchBody.
+func (cr *ClientLDAPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a ClientLDAP resource by its full path name.
-func (cr *ClientLDAPResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ClientLDAPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
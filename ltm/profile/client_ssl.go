@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ClientSSLList struct {
@@ -131,66 +129,32 @@ type ClientSSLResource struct {
 	b *bigip.BigIP
 }
 
-func (mir *ClientSSLResource) List() (*ClientSSLList, error) {
-	var items ClientSSLList
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ClientSSLResource.
+func (mir *ClientSSLResource) collection() *bigip.Collection[ClientSSL, ClientSSLList] {
+	return bigip.NewCollection[ClientSSL, ClientSSLList](mir.b, LtmManager, ProfileEndpoint, ClientSSLEndpoint)
+}
+
+func (mir *ClientSSLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ClientSSLList, error) {
+	return mir.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (mir *ClientSSLResource) Get(ctx context.Context, fullPathName string) (*ClientSSL, error) {
+	return mir.collection().Get(ctx, fullPathName)
 }
 
-func (mir *ClientSSLResource) Get(fullPathName string) (*ClientSSL, error) {
-	var item ClientSSL
-	res, err := mir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (mir *ClientSSLResource) Create(ctx context.Context, item ClientSSL) error {
+	return mir.collection().Create(ctx, item)
 }
 
-func (mir *ClientSSLResource) Create(item ClientSSL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *ClientSSLResource) Update(ctx context.Context, fullPathName string, item ClientSSL) error {
+	return mir.collection().Update(ctx, fullPathName, item)
 }
 
-func (mir *ClientSSLResource) Update(fullPathName string, item ClientSSL) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = mir.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ClientSSL identified by fullPathName, see bigip.PatchBody.
+func (mir *ClientSSLResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return mir.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (mir *ClientSSLResource) Delete(fullPathName string) error {
-	_, err := mir.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ClientSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (mir *ClientSSLResource) Delete(ctx context.Context, fullPathName string) error {
+	return mir.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ConnectorList struct {
@@ -34,66 +32,32 @@ type ConnectorResource struct {
 	b *bigip.BigIP
 }
 
-func (cr *ConnectorResource) List() (*ConnectorList, error) {
-	var items ConnectorList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ConnectorResource.
+func (cr *ConnectorResource) collection() *bigip.Collection[Connector, ConnectorList] {
+	return bigip.NewCollection[Connector, ConnectorList](cr.b, LtmManager, ProfileEndpoint, ConnectorEndpoint)
+}
+
+func (cr *ConnectorResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ConnectorList, error) {
+	return cr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (cr *ConnectorResource) Get(ctx context.Context, fullPathName string) (*Connector, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
-func (cr *ConnectorResource) Get(fullPathName string) (*Connector, error) {
-	var item Connector
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *ConnectorResource) Create(ctx context.Context, item Connector) error {
+	return cr.collection().Create(ctx, item)
 }
 
-func (cr *ConnectorResource) Create(item Connector) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ConnectorResource) Update(ctx context.Context, fullPathName string, item Connector) error {
+	return cr.collection().Update(ctx, fullPathName, item)
 }
 
-func (cr *ConnectorResource) Update(fullPathName string, item Connector) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Connector identified by fullPathName, see bigip.PatchBody.
+func (cr *ConnectorResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (cr *ConnectorResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ConnectorEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ConnectorResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
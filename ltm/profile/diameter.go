@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type DiameterList struct {
@@ -47,66 +45,32 @@ type DiameterResource struct {
 	b *bigip.BigIP
 }
 
-func (cr *DiameterResource) List() (*DiameterList, error) {
-	var items DiameterList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DiameterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DiameterResource.
+func (cr *DiameterResource) collection() *bigip.Collection[Diameter, DiameterList] {
+	return bigip.NewCollection[Diameter, DiameterList](cr.b, LtmManager, ProfileEndpoint, DiameterEndpoint)
+}
+
+func (cr *DiameterResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DiameterList, error) {
+	return cr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (cr *DiameterResource) Get(ctx context.Context, fullPathName string) (*Diameter, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
-func (cr *DiameterResource) Get(fullPathName string) (*Diameter, error) {
-	var item Diameter
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DiameterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *DiameterResource) Create(ctx context.Context, item Diameter) error {
+	return cr.collection().Create(ctx, item)
 }
 
-func (cr *DiameterResource) Create(item Diameter) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DiameterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *DiameterResource) Update(ctx context.Context, fullPathName string, item Diameter) error {
+	return cr.collection().Update(ctx, fullPathName, item)
 }
 
-func (cr *DiameterResource) Update(fullPathName string, item Diameter) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DiameterEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Diameter identified by fullPathName, see bigip.PatchBody.
+func (cr *DiameterResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (cr *DiameterResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DiameterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *DiameterResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
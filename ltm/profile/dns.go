@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type DNSList struct {
@@ -60,66 +58,32 @@ type DNSResource struct {
 	b *bigip.BigIP
 }
 
-func (cr *DNSResource) List() (*DNSList, error) {
-	var items DNSList
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DNSResource.
+func (cr *DNSResource) collection() *bigip.Collection[DNS, DNSList] {
+	return bigip.NewCollection[DNS, DNSList](cr.b, LtmManager, ProfileEndpoint, DNSEndpoint)
+}
+
+func (cr *DNSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DNSList, error) {
+	return cr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (cr *DNSResource) Get(ctx context.Context, fullPathName string) (*DNS, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
-func (cr *DNSResource) Get(fullPathName string) (*DNS, error) {
-	var item DNS
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *DNSResource) Create(ctx context.Context, item DNS) error {
+	return cr.collection().Create(ctx, item)
 }
 
-func (cr *DNSResource) Create(item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *DNSResource) Update(ctx context.Context, fullPathName string, item DNS) error {
+	return cr.collection().Update(ctx, fullPathName, item)
 }
 
-func (cr *DNSResource) Update(fullPathName string, item DNS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the DNS identified by fullPathName, see bigip.PatchBody.
+func (cr *DNSResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (cr *DNSResource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(DNSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *DNSResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type FastHTTPList struct {
@@ -55,66 +53,32 @@ type FastHTTPResource struct {
 	b *bigip.BigIP
 }
 
-func (fr *FastHTTPResource) List() (*FastHTTPList, error) {
-	var items FastHTTPList
-	res, err := fr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastHTTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FastHTTPResource.
+func (fr *FastHTTPResource) collection() *bigip.Collection[FastHTTP, FastHTTPList] {
+	return bigip.NewCollection[FastHTTP, FastHTTPList](fr.b, LtmManager, ProfileEndpoint, FastHTTPEndpoint)
+}
+
+func (fr *FastHTTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*FastHTTPList, error) {
+	return fr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (fr *FastHTTPResource) Get(ctx context.Context, fullPathName string) (*FastHTTP, error) {
+	return fr.collection().Get(ctx, fullPathName)
 }
 
-func (fr *FastHTTPResource) Get(fullPathName string) (*FastHTTP, error) {
-	var item FastHTTP
-	res, err := fr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastHTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (fr *FastHTTPResource) Create(ctx context.Context, item FastHTTP) error {
+	return fr.collection().Create(ctx, item)
 }
 
-func (fr *FastHTTPResource) Create(item FastHTTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = fr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastHTTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (fr *FastHTTPResource) Update(ctx context.Context, fullPathName string, item FastHTTP) error {
+	return fr.collection().Update(ctx, fullPathName, item)
 }
 
-func (fr *FastHTTPResource) Update(fullPathName string, item FastHTTP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = fr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastHTTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the FastHTTP identified by fullPathName, see bigip.PatchBody.
+func (fr *FastHTTPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return fr.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (fr *FastHTTPResource) Delete(fullPathName string) error {
-	_, err := fr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastHTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (fr *FastHTTPResource) Delete(ctx context.Context, fullPathName string) error {
+	return fr.collection().Delete(ctx, fullPathName)
 }
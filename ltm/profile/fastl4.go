@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type FastL4List struct {
@@ -89,66 +87,32 @@ type FastL4Resource struct {
 	b *bigip.BigIP
 }
 
-func (cr *FastL4Resource) List() (*FastL4List, error) {
-	var items FastL4List
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FastL4Resource.
+func (cr *FastL4Resource) collection() *bigip.Collection[FastL4, FastL4List] {
+	return bigip.NewCollection[FastL4, FastL4List](cr.b, LtmManager, ProfileEndpoint, FastL4Endpoint)
+}
+
+func (cr *FastL4Resource) List(ctx context.Context, opts ...*rest.ListOptions) (*FastL4List, error) {
+	return cr.collection().List(ctx, opts...)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (cr *FastL4Resource) Get(ctx context.Context, fullPathName string) (*FastL4, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
-func (cr *FastL4Resource) Get(fullPathName string) (*FastL4, error) {
-	var item FastL4
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *FastL4Resource) Create(ctx context.Context, item FastL4) error {
+	return cr.collection().Create(ctx, item)
 }
 
-func (cr *FastL4Resource) Create(item FastL4) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *FastL4Resource) Update(ctx context.Context, fullPathName string, item FastL4) error {
+	return cr.collection().Update(ctx, fullPathName, item)
 }
 
-func (cr *FastL4Resource) Update(fullPathName string, item FastL4) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the FastL4 identified by fullPathName, see bigip.PatchBody.
+func (cr *FastL4Resource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
-func (cr *FastL4Resource) Delete(fullPathName string) error {
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(FastL4Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *FastL4Resource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type GTPList struct {
@@ -33,83 +31,37 @@ type GTPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of GTP resources.
-func (cr *GTPResource) List() (*GTPList, error) {
-	var items GTPList
-	// Perform a GET request to retrieve a list of GTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing GTPResource.
+func (cr *GTPResource) collection() *bigip.Collection[GTP, GTPList] {
+	return bigip.NewCollection[GTP, GTPList](cr.b, LtmManager, ProfileEndpoint, GTPEndpoint)
+}
 
-	// Unmarshal JSON response data into GTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of GTP resources.
+func (cr *GTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*GTPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a GTP resource by its full path name.
-func (cr *GTPResource) Get(fullPathName string) (*GTP, error) {
-	var item GTP
-	// Perform a GET request to retrieve a specific GTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into GTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *GTPResource) Get(ctx context.Context, fullPathName string) (*GTP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new GTP resource using the provided GTP item.
-func (cr *GTPResource) Create(item GTP) error {
-	// Marshal the GTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new GTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *GTPResource) Create(ctx context.Context, item GTP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a GTP resource identified by its full path name using the provided GTP item.
-func (cr *GTPResource) Update(fullPathName string, item GTP) error {
-	// Marshal the GTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *GTPResource) Update(ctx context.Context, fullPathName string, item GTP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified GTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the GTP identified by fullPathName, see bigip.PatchBody.
+func (cr *GTPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a GTP resource by its full path name.
-func (cr *GTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified GTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(GTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *GTPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTMLList struct {
@@ -34,83 +32,37 @@ type HTMLResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTML resources.
-func (cr *HTMLResource) List() (*HTMLList, error) {
-	var items HTMLList
-	// Perform a GET request to retrieve a list of HTML resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTMLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTMLResource.
+func (cr *HTMLResource) collection() *bigip.Collection[HTML, HTMLList] {
+	return bigip.NewCollection[HTML, HTMLList](cr.b, LtmManager, ProfileEndpoint, HTMLEndpoint)
+}
 
-	// Unmarshal JSON response data into HTMLList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTML resources.
+func (cr *HTMLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTMLList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTML resource by its full path name.
-func (cr *HTMLResource) Get(fullPathName string) (*HTML, error) {
-	var item HTML
-	// Perform a GET request to retrieve a specific HTML resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTMLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTML struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTMLResource) Get(ctx context.Context, fullPathName string) (*HTML, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTML resource using the provided HTML item.
-func (cr *HTMLResource) Create(item HTML) error {
-	// Marshal the HTML struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTML resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTMLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTMLResource) Create(ctx context.Context, item HTML) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTML resource identified by its full path name using the provided HTML item.
-func (cr *HTMLResource) Update(fullPathName string, item HTML) error {
-	// Marshal the HTML struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTMLResource) Update(ctx context.Context, fullPathName string, item HTML) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTML resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTMLEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTML identified by fullPathName, see bigip.PatchBody.
+func (cr *HTMLResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTML resource by its full path name.
-func (cr *HTMLResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTML resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTMLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTMLResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTPList struct {
@@ -97,83 +95,37 @@ type HTTPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTTP resources.
-func (cr *HTTPResource) List() (*HTTPList, error) {
-	var items HTTPList
-	// Perform a GET request to retrieve a list of HTTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPResource.
+func (cr *HTTPResource) collection() *bigip.Collection[HTTP, HTTPList] {
+	return bigip.NewCollection[HTTP, HTTPList](cr.b, LtmManager, ProfileEndpoint, HTTPEndpoint)
+}
 
-	// Unmarshal JSON response data into HTTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTTP resources.
+func (cr *HTTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTTP resource by its full path name.
-func (cr *HTTPResource) Get(fullPathName string) (*HTTP, error) {
-	var item HTTP
-	// Perform a GET request to retrieve a specific HTTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTTPResource) Get(ctx context.Context, fullPathName string) (*HTTP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTP resource using the provided HTML item.
-func (cr *HTTPResource) Create(item HTTP) error {
-	// Marshal the HTML struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTML resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPResource) Create(ctx context.Context, item HTTP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTTP resource identified by its full path name using the provided HTTP item.
-func (cr *HTTPResource) Update(fullPathName string, item HTTP) error {
-	// Marshal the HTTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTTPResource) Update(ctx context.Context, fullPathName string, item HTTP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTP identified by fullPathName, see bigip.PatchBody.
+func (cr *HTTPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTTP resource by its full path name.
-func (cr *HTTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTP2List struct {
@@ -43,83 +41,37 @@ type HTTP2Resource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTTP2 resources.
-func (cr *HTTP2Resource) List() (*HTTP2List, error) {
-	var items HTTP2List
-	// Perform a GET request to retrieve a list of HTTP2 resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP2Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTP2Resource.
+func (cr *HTTP2Resource) collection() *bigip.Collection[HTTP2, HTTP2List] {
+	return bigip.NewCollection[HTTP2, HTTP2List](cr.b, LtmManager, ProfileEndpoint, HTTP2Endpoint)
+}
 
-	// Unmarshal JSON response data into HTTP2List struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTTP2 resources.
+func (cr *HTTP2Resource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTP2List, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTTP2 resource by its full path name.
-func (cr *HTTP2Resource) Get(fullPathName string) (*HTTP2, error) {
-	var item HTTP2
-	// Perform a GET request to retrieve a specific HTTP2 resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP2Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTP2 struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTTP2Resource) Get(ctx context.Context, fullPathName string) (*HTTP2, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTP2 resource using the provided HTTP2 item.
-func (cr *HTTP2Resource) Create(item HTTP2) error {
-	// Marshal the HTTP2 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTP2 resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP2Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTP2Resource) Create(ctx context.Context, item HTTP2) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTTP2 resource identified by its full path name using the provided HTTP2 item.
-func (cr *HTTP2Resource) Update(fullPathName string, item HTTP2) error {
-	// Marshal the HTTP2 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTTP2Resource) Update(ctx context.Context, fullPathName string, item HTTP2) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTTP2 resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP2Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTP2 identified by fullPathName, see bigip.PatchBody.
+func (cr *HTTP2Resource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTTP2 resource by its full path name.
-func (cr *HTTP2Resource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTP2 resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP2Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTP2Resource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
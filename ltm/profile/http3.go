@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTP3List struct {
@@ -29,87 +27,44 @@ type HTTP3 struct {
 
 const HTTP3Endpoint = "http3"
 
+// HTTP3MinVersion is the first TMOS version providing HTTP3 profiles.
+var HTTP3MinVersion = bigip.MustParseVersion("15.1")
+
 type HTTP3Resource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTTP3 resources.
-func (cr *HTTP3Resource) List() (*HTTP3List, error) {
-	var items HTTP3List
-	// Perform a GET request to retrieve a list of HTTP3 resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTP3Resource.
+func (cr *HTTP3Resource) collection() *bigip.Collection[HTTP3, HTTP3List] {
+	return bigip.NewCollection[HTTP3, HTTP3List](cr.b, LtmManager, ProfileEndpoint, HTTP3Endpoint).WithMinVersion(HTTP3MinVersion)
+}
 
-	// Unmarshal JSON response data into HTTP3List struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTTP3 resources.
+func (cr *HTTP3Resource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTP3List, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTTP3 resource by its full path name.
-func (cr *HTTP3Resource) Get(fullPathName string) (*HTTP3, error) {
-	var item HTTP3
-	// Perform a GET request to retrieve a specific HTTP3 resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTP3 struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTTP3Resource) Get(ctx context.Context, fullPathName string) (*HTTP3, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTP3 resource using the provided HTTP3 item.
-func (cr *HTTP3Resource) Create(item HTTP3) error {
-	// Marshal the HTTP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTP3 resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTP3Resource) Create(ctx context.Context, item HTTP3) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTTP3 resource identified by its full path name using the provided HTTP3 item.
-func (cr *HTTP3Resource) Update(fullPathName string, item HTTP3) error {
-	// Marshal the HTTP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTTP3Resource) Update(ctx context.Context, fullPathName string, item HTTP3) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTTP3 resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTP3 identified by fullPathName, see bigip.PatchBody.
+func (cr *HTTP3Resource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTTP3 resource by its full path name.
-func (cr *HTTP3Resource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTP3 resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTP3Resource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTPCompressionList struct {
@@ -53,83 +51,37 @@ type HTTPCompressionResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTTPCompression resources.
-func (cr *HTTPCompressionResource) List() (*HTTPCompressionList, error) {
-	var items HTTPCompressionList
-	// Perform a GET request to retrieve a list of HTTPCompression resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPCompressionResource.
+func (cr *HTTPCompressionResource) collection() *bigip.Collection[HTTPCompression, HTTPCompressionList] {
+	return bigip.NewCollection[HTTPCompression, HTTPCompressionList](cr.b, LtmManager, ProfileEndpoint, HTTPCompressionEndpoint)
+}
 
-	// Unmarshal JSON response data into HTTPCompressionList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTTPCompression resources.
+func (cr *HTTPCompressionResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPCompressionList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTTPCompression resource by its full path name.
-func (cr *HTTPCompressionResource) Get(fullPathName string) (*HTTPCompression, error) {
-	var item HTTPCompression
-	// Perform a GET request to retrieve a specific HTTPCompression resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPCompression struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTTPCompressionResource) Get(ctx context.Context, fullPathName string) (*HTTPCompression, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTPCompression resource using the provided HTTPCompression item.
-func (cr *HTTPCompressionResource) Create(item HTTPCompression) error {
-	// Marshal the HTTPCompression struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTPCompression resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPCompressionResource) Create(ctx context.Context, item HTTPCompression) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTTPCompression resource identified by its full path name using the provided HTTPCompression item.
-func (cr *HTTPCompressionResource) Update(fullPathName string, item HTTPCompression) error {
-	// Marshal the HTTPCompression struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTTPCompressionResource) Update(ctx context.Context, fullPathName string, item HTTPCompression) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTTPCompression resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTPCompression identified by fullPathName, see bigip.PatchBody.
+func (cr *HTTPCompressionResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTTPCompression resource by its full path name.
-func (cr *HTTPCompressionResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTPCompression resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPCompressionEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPCompressionResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
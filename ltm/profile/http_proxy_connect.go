@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTPProxyConnectList struct {
@@ -29,87 +27,44 @@ type HTTPProxyConnect struct {
 
 const HTTPProxyConnectEndpoint = "http-proxy-connect"
 
+// HTTPProxyConnectMinVersion is the first TMOS version providing HTTP proxy connect profiles.
+var HTTPProxyConnectMinVersion = bigip.MustParseVersion("13.0")
+
 type HTTPProxyConnectResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTTPProxyConnect resources.
-func (cr *HTTPProxyConnectResource) List() (*HTTPProxyConnectList, error) {
-	var items HTTPProxyConnectList
-	// Perform a GET request to retrieve a list of HTTPProxyConnect resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPProxyConnectEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPProxyConnectResource.
+func (cr *HTTPProxyConnectResource) collection() *bigip.Collection[HTTPProxyConnect, HTTPProxyConnectList] {
+	return bigip.NewCollection[HTTPProxyConnect, HTTPProxyConnectList](cr.b, LtmManager, ProfileEndpoint, HTTPProxyConnectEndpoint).WithMinVersion(HTTPProxyConnectMinVersion)
+}
 
-	// Unmarshal JSON response data into HTTPProxyConnectList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTTPProxyConnect resources.
+func (cr *HTTPProxyConnectResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPProxyConnectList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTTPProxyConnect resource by its full path name.
-func (cr *HTTPProxyConnectResource) Get(fullPathName string) (*HTTPProxyConnect, error) {
-	var item HTTPProxyConnect
-	// Perform a GET request to retrieve a specific HTTPProxyConnect resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPProxyConnectEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPProxyConnect struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTTPProxyConnectResource) Get(ctx context.Context, fullPathName string) (*HTTPProxyConnect, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTPProxyConnect resource using the provided HTTPProxyConnect item.
-func (cr *HTTPProxyConnectResource) Create(item HTTPProxyConnect) error {
-	// Marshal the HTTPProxyConnect struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTPProxyConnect resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPProxyConnectEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPProxyConnectResource) Create(ctx context.Context, item HTTPProxyConnect) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTTPProxyConnect resource identified by its full path name using the provided HTTPProxyConnect item.
-func (cr *HTTPProxyConnectResource) Update(fullPathName string, item HTTPProxyConnect) error {
-	// Marshal the HTTPProxyConnect struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTTPProxyConnectResource) Update(ctx context.Context, fullPathName string, item HTTPProxyConnect) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTTPProxyConnect resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPProxyConnectEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTPProxyConnect identified by fullPathName, see bigip.PatchBody.
+func (cr *HTTPProxyConnectResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTTPProxyConnect resource by its full path name.
-func (cr *HTTPProxyConnectResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTPProxyConnect resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPProxyConnectEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPProxyConnectResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type HTTPRouterList struct {
@@ -28,87 +26,44 @@ type HTTPRouter struct {
 
 const HTTPRouterEndpoint = "httprouter"
 
+// HTTPRouterMinVersion is the first TMOS version providing HTTP router profiles.
+var HTTPRouterMinVersion = bigip.MustParseVersion("14.1")
+
 type HTTPRouterResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of HTTPRouter resources.
-func (cr *HTTPRouterResource) List() (*HTTPRouterList, error) {
-	var items HTTPRouterList
-	// Perform a GET request to retrieve a list of HTTPRouter resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HTTPRouterResource.
+func (cr *HTTPRouterResource) collection() *bigip.Collection[HTTPRouter, HTTPRouterList] {
+	return bigip.NewCollection[HTTPRouter, HTTPRouterList](cr.b, LtmManager, ProfileEndpoint, HTTPRouterEndpoint).WithMinVersion(HTTPRouterMinVersion)
+}
 
-	// Unmarshal JSON response data into HTTPRouterList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of HTTPRouter resources.
+func (cr *HTTPRouterResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HTTPRouterList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an HTTPRouter resource by its full path name.
-func (cr *HTTPRouterResource) Get(fullPathName string) (*HTTPRouter, error) {
-	var item HTTPRouter
-	// Perform a GET request to retrieve a specific HTTPRouter resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into HTTPRouter struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *HTTPRouterResource) Get(ctx context.Context, fullPathName string) (*HTTPRouter, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new HTTPRouter resource using the provided HTTPRouter item.
-func (cr *HTTPRouterResource) Create(item HTTPRouter) error {
-	// Marshal the HTTPRouter struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new HTTPRouter resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPRouterResource) Create(ctx context.Context, item HTTPRouter) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an HTTPRouter resource identified by its full path name using the provided HTTPRouter item.
-func (cr *HTTPRouterResource) Update(fullPathName string, item HTTPRouter) error {
-	// Marshal the HTTPRouter struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *HTTPRouterResource) Update(ctx context.Context, fullPathName string, item HTTPRouter) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified HTTPRouter resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the HTTPRouter identified by fullPathName, see bigip.PatchBody.
+func (cr *HTTPRouterResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an HTTPRouter resource by its full path name.
-func (cr *HTTPRouterResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified HTTPRouter resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(HTTPRouterEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *HTTPRouterResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
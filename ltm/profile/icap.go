@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ICAPList struct {
@@ -37,83 +35,37 @@ type ICAPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of ICAP resources.
-func (cr *ICAPResource) List() (*ICAPList, error) {
-	var items ICAPList
-	// Perform a GET request to retrieve a list of ICAP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ICAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ICAPResource.
+func (cr *ICAPResource) collection() *bigip.Collection[ICAP, ICAPList] {
+	return bigip.NewCollection[ICAP, ICAPList](cr.b, LtmManager, ProfileEndpoint, ICAPEndpoint)
+}
 
-	// Unmarshal JSON response data into ICAPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of ICAP resources.
+func (cr *ICAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ICAPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an ICAP resource by its full path name.
-func (cr *ICAPResource) Get(fullPathName string) (*ICAP, error) {
-	var item ICAP
-	// Perform a GET request to retrieve a specific ICAP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ICAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into ICAP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *ICAPResource) Get(ctx context.Context, fullPathName string) (*ICAP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new ICAP resource using the provided ICAP item.
-func (cr *ICAPResource) Create(item ICAP) error {
-	// Marshal the ICAP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new ICAP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ICAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ICAPResource) Create(ctx context.Context, item ICAP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an ICAP resource identified by its full path name using the provided ICAP item.
-func (cr *ICAPResource) Update(fullPathName string, item ICAP) error {
-	// Marshal the ICAP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *ICAPResource) Update(ctx context.Context, fullPathName string, item ICAP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified ICAP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ICAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ICAP identified by fullPathName, see bigip.PatchBody.
+func (cr *ICAPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an ICAP resource by its full path name.
-func (cr *ICAPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified ICAP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ICAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ICAPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
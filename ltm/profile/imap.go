@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type IMAPList struct {
@@ -33,83 +31,37 @@ type IMAPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of IMAP resources.
-func (cr *IMAPResource) List() (*IMAPList, error) {
-	var items IMAPList
-	// Perform a GET request to retrieve a list of IMAP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing IMAPResource.
+func (cr *IMAPResource) collection() *bigip.Collection[IMAP, IMAPList] {
+	return bigip.NewCollection[IMAP, IMAPList](cr.b, LtmManager, ProfileEndpoint, IMAPEndpoint)
+}
 
-	// Unmarshal JSON response data into IMAPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of IMAP resources.
+func (cr *IMAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*IMAPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an IMAP resource by its full path name.
-func (cr *IMAPResource) Get(fullPathName string) (*IMAP, error) {
-	var item IMAP
-	// Perform a GET request to retrieve a specific IMAP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into IMAP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *IMAPResource) Get(ctx context.Context, fullPathName string) (*IMAP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new IMAP resource using the provided IMAP item.
-func (cr *IMAPResource) Create(item IMAP) error {
-	// Marshal the IMAP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new IMAP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *IMAPResource) Create(ctx context.Context, item IMAP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an IMAP resource identified by its full path name using the provided IMAP item.
-func (cr *IMAPResource) Update(fullPathName string, item IMAP) error {
-	// Marshal the IMAP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *IMAPResource) Update(ctx context.Context, fullPathName string, item IMAP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified IMAP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the IMAP identified by fullPathName, see bigip.PatchBody.
+func (cr *IMAPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an IMAP resource by its full path name.
-func (cr *IMAPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified IMAP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(IMAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *IMAPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
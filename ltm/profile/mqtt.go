@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type MQTTList struct {
@@ -28,87 +26,44 @@ type MQTT struct {
 
 const MQTTEndpoint = "mqtt"
 
+// MQTTMinVersion is the first TMOS version providing MQTT profiles.
+var MQTTMinVersion = bigip.MustParseVersion("13.0")
+
 type MQTTResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of MQTT resources.
-func (cr *MQTTResource) List() (*MQTTList, error) {
-	var items MQTTList
-	// Perform a GET request to retrieve a list of MQTT resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing MQTTResource.
+func (cr *MQTTResource) collection() *bigip.Collection[MQTT, MQTTList] {
+	return bigip.NewCollection[MQTT, MQTTList](cr.b, LtmManager, ProfileEndpoint, MQTTEndpoint).WithMinVersion(MQTTMinVersion)
+}
 
-	// Unmarshal JSON response data into MQTTList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of MQTT resources.
+func (cr *MQTTResource) List(ctx context.Context, opts ...*rest.ListOptions) (*MQTTList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an MQTT resource by its full path name.
-func (cr *MQTTResource) Get(fullPathName string) (*MQTT, error) {
-	var item MQTT
-	// Perform a GET request to retrieve a specific MQTT resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into MQTT struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *MQTTResource) Get(ctx context.Context, fullPathName string) (*MQTT, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new MQTT resource using the provided MQTT item.
-func (cr *MQTTResource) Create(item MQTT) error {
-	// Marshal the MQTT struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new MQTT resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *MQTTResource) Create(ctx context.Context, item MQTT) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an MQTT resource identified by its full path name using the provided MQTT item.
-func (cr *MQTTResource) Update(fullPathName string, item MQTT) error {
-	// Marshal the MQTT struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *MQTTResource) Update(ctx context.Context, fullPathName string, item MQTT) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified MQTT resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the MQTT identified by fullPathName, see bigip.PatchBody.
+func (cr *MQTTResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an MQTT resource by its full path name.
-func (cr *MQTTResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified MQTT resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(MQTTEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *MQTTResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
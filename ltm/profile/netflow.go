@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type NetflowList struct {
@@ -34,83 +32,37 @@ type NetflowResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of Netflow resources.
-func (cr *NetflowResource) List() (*NetflowList, error) {
-	var items NetflowList
-	// Perform a GET request to retrieve a list of Netflow resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NetflowEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing NetflowResource.
+func (cr *NetflowResource) collection() *bigip.Collection[Netflow, NetflowList] {
+	return bigip.NewCollection[Netflow, NetflowList](cr.b, LtmManager, ProfileEndpoint, NetflowEndpoint)
+}
 
-	// Unmarshal JSON response data into NetflowList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of Netflow resources.
+func (cr *NetflowResource) List(ctx context.Context, opts ...*rest.ListOptions) (*NetflowList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a Netflow resource by its full path name.
-func (cr *NetflowResource) Get(fullPathName string) (*Netflow, error) {
-	var item Netflow
-	// Perform a GET request to retrieve a specific Netflow resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NetflowEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Netflow struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *NetflowResource) Get(ctx context.Context, fullPathName string) (*Netflow, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Netflow resource using the provided Netflow item.
-func (cr *NetflowResource) Create(item Netflow) error {
-	// Marshal the Netflow struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Netflow resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NetflowEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *NetflowResource) Create(ctx context.Context, item Netflow) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a Netflow resource identified by its full path name using the provided Netflow item.
-func (cr *NetflowResource) Update(fullPathName string, item Netflow) error {
-	// Marshal the Netflow struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *NetflowResource) Update(ctx context.Context, fullPathName string, item Netflow) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified Netflow resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NetflowEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Netflow identified by fullPathName, see bigip.PatchBody.
+func (cr *NetflowResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a Netflow resource by its full path name.
-func (cr *NetflowResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Netflow resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NetflowEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *NetflowResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
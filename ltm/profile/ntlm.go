@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type NTLMList struct {
@@ -42,83 +40,37 @@ type NTLMResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of NTLM resources.
-func (cr *NTLMResource) List() (*NTLMList, error) {
-	var items NTLMList
-	// Perform a GET request to retrieve a list of NTLM resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NTLMEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing NTLMResource.
+func (cr *NTLMResource) collection() *bigip.Collection[NTLM, NTLMList] {
+	return bigip.NewCollection[NTLM, NTLMList](cr.b, LtmManager, ProfileEndpoint, NTLMEndpoint)
+}
 
-	// Unmarshal JSON response data into NTLMList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of NTLM resources.
+func (cr *NTLMResource) List(ctx context.Context, opts ...*rest.ListOptions) (*NTLMList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an NTLM resource by its full path name.
-func (cr *NTLMResource) Get(fullPathName string) (*NTLM, error) {
-	var item NTLM
-	// Perform a GET request to retrieve a specific NTLM resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NTLMEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into NTLM struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *NTLMResource) Get(ctx context.Context, fullPathName string) (*NTLM, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new NTLM resource using the provided NTLM item.
-func (cr *NTLMResource) Create(item NTLM) error {
-	// Marshal the NTLM struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new NTLM resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NTLMEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *NTLMResource) Create(ctx context.Context, item NTLM) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an NTLM resource identified by its full path name using the provided NTLM item.
-func (cr *NTLMResource) Update(fullPathName string, item NTLM) error {
-	// Marshal the NTLM struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *NTLMResource) Update(ctx context.Context, fullPathName string, item NTLM) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified NTLM resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NTLMEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the NTLM identified by fullPathName, see bigip.PatchBody.
+func (cr *NTLMResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an NTLM resource by its full path name.
-func (cr *NTLMResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified NTLM resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(NTLMEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *NTLMResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type OCSPList struct {
@@ -33,83 +31,37 @@ type OCSPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of OCSP resources.
-func (cr *OCSPResource) List() (*OCSPList, error) {
-	var items OCSPList
-	// Perform a GET request to retrieve a list of OCSP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing OCSPResource.
+func (cr *OCSPResource) collection() *bigip.Collection[OCSP, OCSPList] {
+	return bigip.NewCollection[OCSP, OCSPList](cr.b, LtmManager, ProfileEndpoint, OCSPEndpoint)
+}
 
-	// Unmarshal JSON response data into OCSPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of OCSP resources.
+func (cr *OCSPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*OCSPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an OCSP resource by its full path name.
-func (cr *OCSPResource) Get(fullPathName string) (*OCSP, error) {
-	var item OCSP
-	// Perform a GET request to retrieve a specific OCSP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into OCSP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *OCSPResource) Get(ctx context.Context, fullPathName string) (*OCSP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new OCSP resource using the provided OCSP item.
-func (cr *OCSPResource) Create(item OCSP) error {
-	// Marshal the OCSP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new OCSP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *OCSPResource) Create(ctx context.Context, item OCSP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an OCSP resource identified by its full path name using the provided OCSP item.
-func (cr *OCSPResource) Update(fullPathName string, item OCSP) error {
-	// Marshal the OCSP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *OCSPResource) Update(ctx context.Context, fullPathName string, item OCSP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified OCSP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the OCSP identified by fullPathName, see bigip.PatchBody.
+func (cr *OCSPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an OCSP resource by its full path name.
-func (cr *OCSPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified OCSP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OCSPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *OCSPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
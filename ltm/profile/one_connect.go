@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type OneConnectList struct {
@@ -39,83 +37,37 @@ type OneConnectResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of OneConnect resources.
-func (cr *OneConnectResource) List() (*OneConnectList, error) {
-	var items OneConnectList
-	// Perform a GET request to retrieve a list of OneConnect resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OneConnectEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing OneConnectResource.
+func (cr *OneConnectResource) collection() *bigip.Collection[OneConnect, OneConnectList] {
+	return bigip.NewCollection[OneConnect, OneConnectList](cr.b, LtmManager, ProfileEndpoint, OneConnectEndpoint)
+}
 
-	// Unmarshal JSON response data into OneConnectList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of OneConnect resources.
+func (cr *OneConnectResource) List(ctx context.Context, opts ...*rest.ListOptions) (*OneConnectList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an OneConnect resource by its full path name.
-func (cr *OneConnectResource) Get(fullPathName string) (*OneConnect, error) {
-	var item OneConnect
-	// Perform a GET request to retrieve a specific OneConnect resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OneConnectEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into OneConnect struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *OneConnectResource) Get(ctx context.Context, fullPathName string) (*OneConnect, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new OneConnect resource using the provided OneConnect item.
-func (cr *OneConnectResource) Create(item OneConnect) error {
-	// Marshal the OneConnect struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new OneConnect resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OneConnectEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *OneConnectResource) Create(ctx context.Context, item OneConnect) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an OneConnect resource identified by its full path name using the provided OneConnect item.
-func (cr *OneConnectResource) Update(fullPathName string, item OneConnect) error {
-	// Marshal the OneConnect struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *OneConnectResource) Update(ctx context.Context, fullPathName string, item OneConnect) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified OneConnect resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OneConnectEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the OneConnect identified by fullPathName, see bigip.PatchBody.
+func (cr *OneConnectResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an OneConnect resource by its full path name.
-func (cr *OneConnectResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified OneConnect resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(OneConnectEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *OneConnectResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type POP3List struct {
@@ -33,83 +31,37 @@ type POP3Resource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of POP3 resources.
-func (cr *POP3Resource) List() (*POP3List, error) {
-	var items POP3List
-	// Perform a GET request to retrieve a list of POP3 resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing POP3Resource.
+func (cr *POP3Resource) collection() *bigip.Collection[POP3, POP3List] {
+	return bigip.NewCollection[POP3, POP3List](cr.b, LtmManager, ProfileEndpoint, POP3Endpoint)
+}
 
-	// Unmarshal JSON response data into POP3List struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of POP3 resources.
+func (cr *POP3Resource) List(ctx context.Context, opts ...*rest.ListOptions) (*POP3List, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a POP3 resource by its full path name.
-func (cr *POP3Resource) Get(fullPathName string) (*POP3, error) {
-	var item POP3
-	// Perform a GET request to retrieve a specific POP3 resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into POP3 struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *POP3Resource) Get(ctx context.Context, fullPathName string) (*POP3, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new POP3 resource using the provided POP3 item.
-func (cr *POP3Resource) Create(item POP3) error {
-	// Marshal the POP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new POP3 resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *POP3Resource) Create(ctx context.Context, item POP3) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a POP3 resource identified by its full path name using the provided POP3 item.
-func (cr *POP3Resource) Update(fullPathName string, item POP3) error {
-	// Marshal the POP3 struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *POP3Resource) Update(ctx context.Context, fullPathName string, item POP3) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified POP3 resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the POP3 identified by fullPathName, see bigip.PatchBody.
+func (cr *POP3Resource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a POP3 resource by its full path name.
-func (cr *POP3Resource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified POP3 resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(POP3Endpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *POP3Resource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type PPTPList struct {
@@ -35,83 +33,37 @@ type PPTPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of PPTP resources.
-func (cr *PPTPResource) List() (*PPTPList, error) {
-	var items PPTPList
-	// Perform a GET request to retrieve a list of PPTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing PPTPResource.
+func (cr *PPTPResource) collection() *bigip.Collection[PPTP, PPTPList] {
+	return bigip.NewCollection[PPTP, PPTPList](cr.b, LtmManager, ProfileEndpoint, PPTPEndpoint)
+}
 
-	// Unmarshal JSON response data into PPTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of PPTP resources.
+func (cr *PPTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PPTPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a PPTP resource by its full path name.
-func (cr *PPTPResource) Get(fullPathName string) (*PPTP, error) {
-	var item PPTP
-	// Perform a GET request to retrieve a specific PPTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into PPTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *PPTPResource) Get(ctx context.Context, fullPathName string) (*PPTP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new PPTP resource using the provided PPTP item.
-func (cr *PPTPResource) Create(item PPTP) error {
-	// Marshal the PPTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new PPTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *PPTPResource) Create(ctx context.Context, item PPTP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a PPTP resource identified by its full path name using the provided PPTP item.
-func (cr *PPTPResource) Update(fullPathName string, item PPTP) error {
-	// Marshal the PPTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *PPTPResource) Update(ctx context.Context, fullPathName string, item PPTP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified PPTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the PPTP identified by fullPathName, see bigip.PatchBody.
+func (cr *PPTPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a PPTP resource by its full path name.
-func (cr *PPTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified PPTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(PPTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *PPTPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
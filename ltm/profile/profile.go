@@ -1,5 +1,7 @@
 package profile
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 const (
@@ -148,23 +150,23 @@ func NewProfile(b *bigip.BigIP) ProfileResource {
 	}
 }
 
-func (p ProfileResource) CertificateAuthority() *CertificateAuthorityResource {
+func (p ProfileResource) CertificateAuthority() CertificateAuthorityAPI {
 	return &p.certificateAuthority
 }
 
-func (p ProfileResource) ClientLDAP() *ClientLDAPResource { return &p.clientLDAP }
+func (p ProfileResource) ClientLDAP() ClientLDAPAPI { return &p.clientLDAP }
 
-func (p ProfileResource) ClientSSL() *ClientSSLResource { return &p.clientSSL }
+func (p ProfileResource) ClientSSL() ClientSSLAPI { return &p.clientSSL }
 
-func (p ProfileResource) Connector() *ConnectorResource { return &p.connector }
+func (p ProfileResource) Connector() ConnectorAPI { return &p.connector }
 
 //func (p ProfileResource) DHCPv4() *DHCPv4Resource { return &p.dhcpv4 }
 //
 //func (p ProfileResource) DHCPv6() *DHCPv6Resource { return &p.dhcpv6 }
 
-func (p ProfileResource) Diameter() *DiameterResource { return &p.diameter }
+func (p ProfileResource) Diameter() DiameterAPI { return &p.diameter }
 
-func (p ProfileResource) DNS() *DNSResource { return &p.dns }
+func (p ProfileResource) DNS() DNSAPI { return &p.dns }
 
 //func (p ProfileResource) DNSLogging() *DNSLoggingResource { return &p.dns_logging }
 //
@@ -172,35 +174,35 @@ func (p ProfileResource) DNS() *DNSResource { return &p.dns }
 //
 //func (p ProfileResource) DOHServer() *DOHServerResource { return &p.doh_server }
 
-func (p ProfileResource) FastHTTP() *FastHTTPResource { return &p.fasthttp }
+func (p ProfileResource) FastHTTP() FastHTTPAPI { return &p.fasthttp }
 
-func (p ProfileResource) FastL4() *FastL4Resource { return &p.fastL4 }
+func (p ProfileResource) FastL4() FastL4API { return &p.fastL4 }
 
-func (p ProfileResource) FIX() *FIXResource { return &p.fix }
+func (p ProfileResource) FIX() FIXAPI { return &p.fix }
 
-func (p ProfileResource) FTP() *FTPResource { return &p.ftp }
+func (p ProfileResource) FTP() FTPAPI { return &p.ftp }
 
 //func (p ProfileResource) GeoRedundancy() *GeoRedundancyResource { return &p.georedundancy }
 
-func (p ProfileResource) GTP() *GTPResource { return &p.gtp }
+func (p ProfileResource) GTP() GTPAPI { return &p.gtp }
 
-func (p ProfileResource) HTML() *HTMLResource { return &p.html }
+func (p ProfileResource) HTML() HTMLAPI { return &p.html }
 
-func (p ProfileResource) HTTP() *HTTPResource { return &p.http }
+func (p ProfileResource) HTTP() HTTPAPI { return &p.http }
 
-func (p ProfileResource) HTTPCompression() *HTTPCompressionResource { return &p.httpCompression }
+func (p ProfileResource) HTTPCompression() HTTPCompressionAPI { return &p.httpCompression }
 
-func (p ProfileResource) HTTPProxyConnect() *HTTPProxyConnectResource { return &p.httpProxyConnect }
+func (p ProfileResource) HTTPProxyConnect() HTTPProxyConnectAPI { return &p.httpProxyConnect }
 
-func (p ProfileResource) HTTP2() *HTTP2Resource { return &p.http2 }
+func (p ProfileResource) HTTP2() HTTP2API { return &p.http2 }
 
-func (p ProfileResource) HTTP3() *HTTP3Resource { return &p.http3 }
+func (p ProfileResource) HTTP3() HTTP3API { return &p.http3 }
 
-func (p ProfileResource) HTTPRouter() *HTTPRouterResource { return &p.httprouter }
+func (p ProfileResource) HTTPRouter() HTTPRouterAPI { return &p.httprouter }
 
-func (p ProfileResource) ICAP() *ICAPResource { return &p.icap }
+func (p ProfileResource) ICAP() ICAPAPI { return &p.icap }
 
-func (p ProfileResource) IMAP() *IMAPResource { return &p.imap }
+func (p ProfileResource) IMAP() IMAPAPI { return &p.imap }
 
 //func (p ProfileResource) IPOther() *IPOtherResource { return &p.ipother }
 //
@@ -208,29 +210,29 @@ func (p ProfileResource) IMAP() *IMAPResource { return &p.imap }
 //
 //func (p ProfileResource) MBLB() *MBLBResource { return &p.mblb }
 
-func (p ProfileResource) MQTT() *MQTTResource { return &p.mqtt }
+func (p ProfileResource) MQTT() MQTTAPI { return &p.mqtt }
 
 //func (p ProfileResource) MRRateLimit() *MRRateLimitResource { return &p.mr_ratelimit }
 
-func (p ProfileResource) Netflow() *NetflowResource { return &p.netflow }
+func (p ProfileResource) Netflow() NetflowAPI { return &p.netflow }
 
-func (p ProfileResource) NTLM() *NTLMResource { return &p.ntlm }
+func (p ProfileResource) NTLM() NTLMAPI { return &p.ntlm }
 
-func (p ProfileResource) OCSP() *OCSPResource { return &p.ocsp }
+func (p ProfileResource) OCSP() OCSPAPI { return &p.ocsp }
 
 //func (p ProfileResource) OCSPStaplingParams() *OCSPStaplingParamsResource { return &p.ocsp_stapling_params}
 
-func (p ProfileResource) OneConnect() *OneConnectResource { return &p.oneConnect }
+func (p ProfileResource) OneConnect() OneConnectAPI { return &p.oneConnect }
 
-func (p ProfileResource) POP3() *POP3Resource { return &p.pop3 }
+func (p ProfileResource) POP3() POP3API { return &p.pop3 }
 
-func (p ProfileResource) PPTP() *PPTPResource { return &p.pptp }
+func (p ProfileResource) PPTP() PPTPAPI { return &p.pptp }
 
-func (p ProfileResource) QOE() *QOEResource { return &p.qoe }
+func (p ProfileResource) QOE() QOEAPI { return &p.qoe }
 
-func (p ProfileResource) QUIC() *QUICResource { return &p.quic }
+func (p ProfileResource) QUIC() QUICAPI { return &p.quic }
 
-func (p ProfileResource) Radius() *RADIUSResource { return &p.radius }
+func (p ProfileResource) Radius() RADIUSAPI { return &p.radius }
 
 //func (p ProfileResource) RequestAdapt() *RequestAdaptResource { return &p.request_adapt }
 //
@@ -238,44 +240,44 @@ func (p ProfileResource) Radius() *RADIUSResource { return &p.radius }
 //
 //func (p ProfileResource) ResponseAdapt() *ResponseAdaptResource { return &p.response_adapt }
 
-func (p ProfileResource) Rewrite() *RewriteResource { return &p.rewrite }
+func (p ProfileResource) Rewrite() RewriteAPI { return &p.rewrite }
 
-func (p ProfileResource) RTSP() *RTSPResource { return &p.rtsp }
+func (p ProfileResource) RTSP() RTSPAPI { return &p.rtsp }
 
-func (p ProfileResource) SCTP() *SCTPResource { return &p.sctp }
+func (p ProfileResource) SCTP() SCTPAPI { return &p.sctp }
 
-func (p ProfileResource) ServerLDAP() *ServerLDAPResource { return &p.serverLDAP }
+func (p ProfileResource) ServerLDAP() ServerLDAPAPI { return &p.serverLDAP }
 
-func (p ProfileResource) ServerSSL() *ServerSSLResource { return &p.serverSSL }
+func (p ProfileResource) ServerSSL() ServerSSLAPI { return &p.serverSSL }
 
-func (p ProfileResource) Service() *ServiceResource { return &p.service }
+func (p ProfileResource) Service() ServiceAPI { return &p.service }
 
-func (p ProfileResource) SIP() *SIPResource { return &p.sip }
+func (p ProfileResource) SIP() SIPAPI { return &p.sip }
 
-func (p ProfileResource) SMTPS() *SMTPSResource { return &p.smtps }
+func (p ProfileResource) SMTPS() SMTPSAPI { return &p.smtps }
 
-func (p ProfileResource) SOCKS() *SocksResource { return &p.socks }
+func (p ProfileResource) SOCKS() SocksAPI { return &p.socks }
 
 //func (p ProfileResource) SplitSessionClient() *SplitSessionClientResource { return &p.splitsessionclient }
 //
 //func (p ProfileResource) SplitSessionServer() *SplitSessionServerResource { return &p.splitsessionserver }
 
-func (p ProfileResource) Statistics() *StatisticsResource { return &p.statistics }
+func (p ProfileResource) Statistics() StatisticsAPI { return &p.statistics }
 
-func (p ProfileResource) Stream() *StreamResource { return &p.stream }
+func (p ProfileResource) Stream() StreamAPI { return &p.stream }
 
-func (p ProfileResource) TCP() *TCPResource { return &p.tcp }
+func (p ProfileResource) TCP() TCPAPI { return &p.tcp }
 
-func (p ProfileResource) TCPAnalytics() *TCPAnalyticsResource { return &p.tcpAnalytics }
+func (p ProfileResource) TCPAnalytics() TCPAnalyticsAPI { return &p.tcpAnalytics }
 
-func (p ProfileResource) TDR() *TDRResource { return &p.tdr }
+func (p ProfileResource) TDR() TDRAPI { return &p.tdr }
 
-func (p ProfileResource) TFTP() *TFTPResource { return &p.tftp }
+func (p ProfileResource) TFTP() TFTPAPI { return &p.tftp }
 
-func (p ProfileResource) UDP() *UDPResource { return &p.udp }
+func (p ProfileResource) UDP() UDPAPI { return &p.udp }
 
-func (p ProfileResource) WebAcceleration() *WebAccelerationResource { return &p.webAcceleration }
+func (p ProfileResource) WebAcceleration() WebAccelerationAPI { return &p.webAcceleration }
 
-func (p ProfileResource) WebSocket() *WebSocketResource { return &p.websocket }
+func (p ProfileResource) WebSocket() WebSocketAPI { return &p.websocket }
 
-func (p ProfileResource) XML() *XMLResource { return &p.xml }
+func (p ProfileResource) XML() XMLAPI { return &p.xml }
@@ -0,0 +1,3891 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package profilemock provides mocks of the interfaces of package profile, which record their calls.
+package profilemock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/ltm/profile"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// CertificateAuthorityAPI is a mock of profile.CertificateAuthorityAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CertificateAuthorityAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.CertificateAuthorityList, error)
+	GetFunc    func(context.Context, string) (*profile.CertificateAuthority, error)
+	CreateFunc func(context.Context, profile.CertificateAuthority) error
+	UpdateFunc func(context.Context, string, profile.CertificateAuthority) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.CertificateAuthorityAPI = &CertificateAuthorityAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CertificateAuthorityAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.CertificateAuthorityList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CertificateAuthorityAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.CertificateAuthority, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CertificateAuthorityAPI) Create(ctx context.Context, item profile.CertificateAuthority) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CertificateAuthorityAPI) Update(ctx context.Context, fullPathName string, item profile.CertificateAuthority) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CertificateAuthorityAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CertificateAuthorityAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ClientLDAPAPI is a mock of profile.ClientLDAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ClientLDAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ClientLDAPList, error)
+	GetFunc    func(context.Context, string) (*profile.ClientLDAP, error)
+	CreateFunc func(context.Context, profile.ClientLDAP) error
+	UpdateFunc func(context.Context, string, profile.ClientLDAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ClientLDAPAPI = &ClientLDAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ClientLDAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ClientLDAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ClientLDAPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.ClientLDAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ClientLDAPAPI) Create(ctx context.Context, item profile.ClientLDAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ClientLDAPAPI) Update(ctx context.Context, fullPathName string, item profile.ClientLDAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ClientLDAPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ClientLDAPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ClientSSLAPI is a mock of profile.ClientSSLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ClientSSLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ClientSSLList, error)
+	GetFunc    func(context.Context, string) (*profile.ClientSSL, error)
+	CreateFunc func(context.Context, profile.ClientSSL) error
+	UpdateFunc func(context.Context, string, profile.ClientSSL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ClientSSLAPI = &ClientSSLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ClientSSLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ClientSSLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ClientSSLAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.ClientSSL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ClientSSLAPI) Create(ctx context.Context, item profile.ClientSSL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ClientSSLAPI) Update(ctx context.Context, fullPathName string, item profile.ClientSSL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ClientSSLAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ClientSSLAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ConnectorAPI is a mock of profile.ConnectorAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ConnectorAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ConnectorList, error)
+	GetFunc    func(context.Context, string) (*profile.Connector, error)
+	CreateFunc func(context.Context, profile.Connector) error
+	UpdateFunc func(context.Context, string, profile.Connector) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ConnectorAPI = &ConnectorAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ConnectorAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ConnectorList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ConnectorAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Connector, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ConnectorAPI) Create(ctx context.Context, item profile.Connector) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ConnectorAPI) Update(ctx context.Context, fullPathName string, item profile.Connector) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ConnectorAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ConnectorAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// DNSAPI is a mock of profile.DNSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DNSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.DNSList, error)
+	GetFunc    func(context.Context, string) (*profile.DNS, error)
+	CreateFunc func(context.Context, profile.DNS) error
+	UpdateFunc func(context.Context, string, profile.DNS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.DNSAPI = &DNSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DNSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.DNSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DNSAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.DNS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DNSAPI) Create(ctx context.Context, item profile.DNS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DNSAPI) Update(ctx context.Context, fullPathName string, item profile.DNS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DNSAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DNSAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// DiameterAPI is a mock of profile.DiameterAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DiameterAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.DiameterList, error)
+	GetFunc    func(context.Context, string) (*profile.Diameter, error)
+	CreateFunc func(context.Context, profile.Diameter) error
+	UpdateFunc func(context.Context, string, profile.Diameter) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.DiameterAPI = &DiameterAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DiameterAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.DiameterList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DiameterAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Diameter, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DiameterAPI) Create(ctx context.Context, item profile.Diameter) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DiameterAPI) Update(ctx context.Context, fullPathName string, item profile.Diameter) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DiameterAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DiameterAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// FIXAPI is a mock of profile.FIXAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FIXAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.FIXList, error)
+	GetFunc    func(context.Context, string) (*profile.FIX, error)
+	CreateFunc func(context.Context, profile.FIX) error
+	UpdateFunc func(context.Context, string, profile.FIX) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.FIXAPI = &FIXAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FIXAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.FIXList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FIXAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.FIX, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FIXAPI) Create(ctx context.Context, item profile.FIX) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FIXAPI) Update(ctx context.Context, fullPathName string, item profile.FIX) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FIXAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FIXAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// FTPAPI is a mock of profile.FTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.FTPList, error)
+	GetFunc    func(context.Context, string) (*profile.FTP, error)
+	CreateFunc func(context.Context, profile.FTP) error
+	UpdateFunc func(context.Context, string, profile.FTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.FTPAPI = &FTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.FTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.FTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FTPAPI) Create(ctx context.Context, item profile.FTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FTPAPI) Update(ctx context.Context, fullPathName string, item profile.FTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// FastHTTPAPI is a mock of profile.FastHTTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FastHTTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.FastHTTPList, error)
+	GetFunc    func(context.Context, string) (*profile.FastHTTP, error)
+	CreateFunc func(context.Context, profile.FastHTTP) error
+	UpdateFunc func(context.Context, string, profile.FastHTTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.FastHTTPAPI = &FastHTTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FastHTTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.FastHTTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FastHTTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.FastHTTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FastHTTPAPI) Create(ctx context.Context, item profile.FastHTTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FastHTTPAPI) Update(ctx context.Context, fullPathName string, item profile.FastHTTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FastHTTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FastHTTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// FastL4API is a mock of profile.FastL4API. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FastL4API struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.FastL4List, error)
+	GetFunc    func(context.Context, string) (*profile.FastL4, error)
+	CreateFunc func(context.Context, profile.FastL4) error
+	UpdateFunc func(context.Context, string, profile.FastL4) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.FastL4API = &FastL4API{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FastL4API) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.FastL4List, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FastL4API) Get(ctx context.Context, fullPathName string) (r0 *profile.FastL4, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FastL4API) Create(ctx context.Context, item profile.FastL4) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FastL4API) Update(ctx context.Context, fullPathName string, item profile.FastL4) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FastL4API) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FastL4API) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// GTPAPI is a mock of profile.GTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.GTPList, error)
+	GetFunc    func(context.Context, string) (*profile.GTP, error)
+	CreateFunc func(context.Context, profile.GTP) error
+	UpdateFunc func(context.Context, string, profile.GTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.GTPAPI = &GTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *GTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.GTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *GTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.GTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *GTPAPI) Create(ctx context.Context, item profile.GTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *GTPAPI) Update(ctx context.Context, fullPathName string, item profile.GTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *GTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *GTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTMLAPI is a mock of profile.HTMLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTMLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTMLList, error)
+	GetFunc    func(context.Context, string) (*profile.HTML, error)
+	CreateFunc func(context.Context, profile.HTML) error
+	UpdateFunc func(context.Context, string, profile.HTML) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTMLAPI = &HTMLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTMLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTMLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTMLAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.HTML, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTMLAPI) Create(ctx context.Context, item profile.HTML) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTMLAPI) Update(ctx context.Context, fullPathName string, item profile.HTML) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTMLAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTMLAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTTP2API is a mock of profile.HTTP2API. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTP2API struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTTP2List, error)
+	GetFunc    func(context.Context, string) (*profile.HTTP2, error)
+	CreateFunc func(context.Context, profile.HTTP2) error
+	UpdateFunc func(context.Context, string, profile.HTTP2) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTTP2API = &HTTP2API{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTP2API) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTTP2List, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTP2API) Get(ctx context.Context, fullPathName string) (r0 *profile.HTTP2, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTP2API) Create(ctx context.Context, item profile.HTTP2) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTP2API) Update(ctx context.Context, fullPathName string, item profile.HTTP2) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTP2API) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTP2API) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTTP3API is a mock of profile.HTTP3API. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTP3API struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTTP3List, error)
+	GetFunc    func(context.Context, string) (*profile.HTTP3, error)
+	CreateFunc func(context.Context, profile.HTTP3) error
+	UpdateFunc func(context.Context, string, profile.HTTP3) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTTP3API = &HTTP3API{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTP3API) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTTP3List, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTP3API) Get(ctx context.Context, fullPathName string) (r0 *profile.HTTP3, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTP3API) Create(ctx context.Context, item profile.HTTP3) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTP3API) Update(ctx context.Context, fullPathName string, item profile.HTTP3) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTP3API) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTP3API) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTTPAPI is a mock of profile.HTTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTTPList, error)
+	GetFunc    func(context.Context, string) (*profile.HTTP, error)
+	CreateFunc func(context.Context, profile.HTTP) error
+	UpdateFunc func(context.Context, string, profile.HTTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTTPAPI = &HTTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.HTTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPAPI) Create(ctx context.Context, item profile.HTTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPAPI) Update(ctx context.Context, fullPathName string, item profile.HTTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTTPCompressionAPI is a mock of profile.HTTPCompressionAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPCompressionAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTTPCompressionList, error)
+	GetFunc    func(context.Context, string) (*profile.HTTPCompression, error)
+	CreateFunc func(context.Context, profile.HTTPCompression) error
+	UpdateFunc func(context.Context, string, profile.HTTPCompression) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTTPCompressionAPI = &HTTPCompressionAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPCompressionAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTTPCompressionList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPCompressionAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.HTTPCompression, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPCompressionAPI) Create(ctx context.Context, item profile.HTTPCompression) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPCompressionAPI) Update(ctx context.Context, fullPathName string, item profile.HTTPCompression) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPCompressionAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPCompressionAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTTPProxyConnectAPI is a mock of profile.HTTPProxyConnectAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPProxyConnectAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTTPProxyConnectList, error)
+	GetFunc    func(context.Context, string) (*profile.HTTPProxyConnect, error)
+	CreateFunc func(context.Context, profile.HTTPProxyConnect) error
+	UpdateFunc func(context.Context, string, profile.HTTPProxyConnect) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTTPProxyConnectAPI = &HTTPProxyConnectAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPProxyConnectAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTTPProxyConnectList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPProxyConnectAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.HTTPProxyConnect, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPProxyConnectAPI) Create(ctx context.Context, item profile.HTTPProxyConnect) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPProxyConnectAPI) Update(ctx context.Context, fullPathName string, item profile.HTTPProxyConnect) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPProxyConnectAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPProxyConnectAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// HTTPRouterAPI is a mock of profile.HTTPRouterAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPRouterAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.HTTPRouterList, error)
+	GetFunc    func(context.Context, string) (*profile.HTTPRouter, error)
+	CreateFunc func(context.Context, profile.HTTPRouter) error
+	UpdateFunc func(context.Context, string, profile.HTTPRouter) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.HTTPRouterAPI = &HTTPRouterAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HTTPRouterAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.HTTPRouterList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPRouterAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.HTTPRouter, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HTTPRouterAPI) Create(ctx context.Context, item profile.HTTPRouter) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HTTPRouterAPI) Update(ctx context.Context, fullPathName string, item profile.HTTPRouter) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HTTPRouterAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HTTPRouterAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ICAPAPI is a mock of profile.ICAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ICAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ICAPList, error)
+	GetFunc    func(context.Context, string) (*profile.ICAP, error)
+	CreateFunc func(context.Context, profile.ICAP) error
+	UpdateFunc func(context.Context, string, profile.ICAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ICAPAPI = &ICAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ICAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ICAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ICAPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.ICAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ICAPAPI) Create(ctx context.Context, item profile.ICAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ICAPAPI) Update(ctx context.Context, fullPathName string, item profile.ICAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ICAPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ICAPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// IMAPAPI is a mock of profile.IMAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IMAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.IMAPList, error)
+	GetFunc    func(context.Context, string) (*profile.IMAP, error)
+	CreateFunc func(context.Context, profile.IMAP) error
+	UpdateFunc func(context.Context, string, profile.IMAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.IMAPAPI = &IMAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *IMAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.IMAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *IMAPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.IMAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *IMAPAPI) Create(ctx context.Context, item profile.IMAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *IMAPAPI) Update(ctx context.Context, fullPathName string, item profile.IMAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *IMAPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *IMAPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// MQTTAPI is a mock of profile.MQTTAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MQTTAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.MQTTList, error)
+	GetFunc    func(context.Context, string) (*profile.MQTT, error)
+	CreateFunc func(context.Context, profile.MQTT) error
+	UpdateFunc func(context.Context, string, profile.MQTT) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.MQTTAPI = &MQTTAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *MQTTAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.MQTTList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *MQTTAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.MQTT, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *MQTTAPI) Create(ctx context.Context, item profile.MQTT) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *MQTTAPI) Update(ctx context.Context, fullPathName string, item profile.MQTT) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *MQTTAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *MQTTAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// NTLMAPI is a mock of profile.NTLMAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NTLMAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.NTLMList, error)
+	GetFunc    func(context.Context, string) (*profile.NTLM, error)
+	CreateFunc func(context.Context, profile.NTLM) error
+	UpdateFunc func(context.Context, string, profile.NTLM) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.NTLMAPI = &NTLMAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NTLMAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.NTLMList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NTLMAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.NTLM, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NTLMAPI) Create(ctx context.Context, item profile.NTLM) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NTLMAPI) Update(ctx context.Context, fullPathName string, item profile.NTLM) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NTLMAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NTLMAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// NetflowAPI is a mock of profile.NetflowAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NetflowAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.NetflowList, error)
+	GetFunc    func(context.Context, string) (*profile.Netflow, error)
+	CreateFunc func(context.Context, profile.Netflow) error
+	UpdateFunc func(context.Context, string, profile.Netflow) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.NetflowAPI = &NetflowAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *NetflowAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.NetflowList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NetflowAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Netflow, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NetflowAPI) Create(ctx context.Context, item profile.Netflow) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NetflowAPI) Update(ctx context.Context, fullPathName string, item profile.Netflow) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *NetflowAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NetflowAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// OCSPAPI is a mock of profile.OCSPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type OCSPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.OCSPList, error)
+	GetFunc    func(context.Context, string) (*profile.OCSP, error)
+	CreateFunc func(context.Context, profile.OCSP) error
+	UpdateFunc func(context.Context, string, profile.OCSP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.OCSPAPI = &OCSPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *OCSPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.OCSPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *OCSPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.OCSP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *OCSPAPI) Create(ctx context.Context, item profile.OCSP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *OCSPAPI) Update(ctx context.Context, fullPathName string, item profile.OCSP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *OCSPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *OCSPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// OneConnectAPI is a mock of profile.OneConnectAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type OneConnectAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.OneConnectList, error)
+	GetFunc    func(context.Context, string) (*profile.OneConnect, error)
+	CreateFunc func(context.Context, profile.OneConnect) error
+	UpdateFunc func(context.Context, string, profile.OneConnect) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.OneConnectAPI = &OneConnectAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *OneConnectAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.OneConnectList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *OneConnectAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.OneConnect, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *OneConnectAPI) Create(ctx context.Context, item profile.OneConnect) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *OneConnectAPI) Update(ctx context.Context, fullPathName string, item profile.OneConnect) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *OneConnectAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *OneConnectAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// POP3API is a mock of profile.POP3API. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type POP3API struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.POP3List, error)
+	GetFunc    func(context.Context, string) (*profile.POP3, error)
+	CreateFunc func(context.Context, profile.POP3) error
+	UpdateFunc func(context.Context, string, profile.POP3) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.POP3API = &POP3API{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *POP3API) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.POP3List, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *POP3API) Get(ctx context.Context, fullPathName string) (r0 *profile.POP3, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *POP3API) Create(ctx context.Context, item profile.POP3) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *POP3API) Update(ctx context.Context, fullPathName string, item profile.POP3) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *POP3API) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *POP3API) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// PPTPAPI is a mock of profile.PPTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PPTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.PPTPList, error)
+	GetFunc    func(context.Context, string) (*profile.PPTP, error)
+	CreateFunc func(context.Context, profile.PPTP) error
+	UpdateFunc func(context.Context, string, profile.PPTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.PPTPAPI = &PPTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PPTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.PPTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *PPTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.PPTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *PPTPAPI) Create(ctx context.Context, item profile.PPTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *PPTPAPI) Update(ctx context.Context, fullPathName string, item profile.PPTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *PPTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *PPTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ProfileAPI is a mock of profile.ProfileAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ProfileAPI struct {
+	bigiptest.CallRecorder
+
+	CertificateAuthorityFunc func() profile.CertificateAuthorityAPI
+	ClientLDAPFunc           func() profile.ClientLDAPAPI
+	ClientSSLFunc            func() profile.ClientSSLAPI
+	ConnectorFunc            func() profile.ConnectorAPI
+	DiameterFunc             func() profile.DiameterAPI
+	DNSFunc                  func() profile.DNSAPI
+	FastHTTPFunc             func() profile.FastHTTPAPI
+	FastL4Func               func() profile.FastL4API
+	FIXFunc                  func() profile.FIXAPI
+	FTPFunc                  func() profile.FTPAPI
+	GTPFunc                  func() profile.GTPAPI
+	HTMLFunc                 func() profile.HTMLAPI
+	HTTPFunc                 func() profile.HTTPAPI
+	HTTPCompressionFunc      func() profile.HTTPCompressionAPI
+	HTTPProxyConnectFunc     func() profile.HTTPProxyConnectAPI
+	HTTP2Func                func() profile.HTTP2API
+	HTTP3Func                func() profile.HTTP3API
+	HTTPRouterFunc           func() profile.HTTPRouterAPI
+	ICAPFunc                 func() profile.ICAPAPI
+	IMAPFunc                 func() profile.IMAPAPI
+	MQTTFunc                 func() profile.MQTTAPI
+	NetflowFunc              func() profile.NetflowAPI
+	NTLMFunc                 func() profile.NTLMAPI
+	OCSPFunc                 func() profile.OCSPAPI
+	OneConnectFunc           func() profile.OneConnectAPI
+	POP3Func                 func() profile.POP3API
+	PPTPFunc                 func() profile.PPTPAPI
+	QOEFunc                  func() profile.QOEAPI
+	QUICFunc                 func() profile.QUICAPI
+	RadiusFunc               func() profile.RADIUSAPI
+	RewriteFunc              func() profile.RewriteAPI
+	RTSPFunc                 func() profile.RTSPAPI
+	SCTPFunc                 func() profile.SCTPAPI
+	ServerLDAPFunc           func() profile.ServerLDAPAPI
+	ServerSSLFunc            func() profile.ServerSSLAPI
+	ServiceFunc              func() profile.ServiceAPI
+	SIPFunc                  func() profile.SIPAPI
+	SMTPSFunc                func() profile.SMTPSAPI
+	SOCKSFunc                func() profile.SocksAPI
+	StatisticsFunc           func() profile.StatisticsAPI
+	StreamFunc               func() profile.StreamAPI
+	TCPFunc                  func() profile.TCPAPI
+	TCPAnalyticsFunc         func() profile.TCPAnalyticsAPI
+	TDRFunc                  func() profile.TDRAPI
+	TFTPFunc                 func() profile.TFTPAPI
+	UDPFunc                  func() profile.UDPAPI
+	WebAccelerationFunc      func() profile.WebAccelerationAPI
+	WebSocketFunc            func() profile.WebSocketAPI
+	XMLFunc                  func() profile.XMLAPI
+}
+
+var _ profile.ProfileAPI = &ProfileAPI{}
+
+// CertificateAuthority records the call and calls CertificateAuthorityFunc if it is set.
+func (m *ProfileAPI) CertificateAuthority() (r0 profile.CertificateAuthorityAPI) {
+	m.CallRecorder.Record("CertificateAuthority")
+	if m.CertificateAuthorityFunc != nil {
+		return m.CertificateAuthorityFunc()
+	}
+	return
+}
+
+// ClientLDAP records the call and calls ClientLDAPFunc if it is set.
+func (m *ProfileAPI) ClientLDAP() (r0 profile.ClientLDAPAPI) {
+	m.CallRecorder.Record("ClientLDAP")
+	if m.ClientLDAPFunc != nil {
+		return m.ClientLDAPFunc()
+	}
+	return
+}
+
+// ClientSSL records the call and calls ClientSSLFunc if it is set.
+func (m *ProfileAPI) ClientSSL() (r0 profile.ClientSSLAPI) {
+	m.CallRecorder.Record("ClientSSL")
+	if m.ClientSSLFunc != nil {
+		return m.ClientSSLFunc()
+	}
+	return
+}
+
+// Connector records the call and calls ConnectorFunc if it is set.
+func (m *ProfileAPI) Connector() (r0 profile.ConnectorAPI) {
+	m.CallRecorder.Record("Connector")
+	if m.ConnectorFunc != nil {
+		return m.ConnectorFunc()
+	}
+	return
+}
+
+// Diameter records the call and calls DiameterFunc if it is set.
+func (m *ProfileAPI) Diameter() (r0 profile.DiameterAPI) {
+	m.CallRecorder.Record("Diameter")
+	if m.DiameterFunc != nil {
+		return m.DiameterFunc()
+	}
+	return
+}
+
+// DNS records the call and calls DNSFunc if it is set.
+func (m *ProfileAPI) DNS() (r0 profile.DNSAPI) {
+	m.CallRecorder.Record("DNS")
+	if m.DNSFunc != nil {
+		return m.DNSFunc()
+	}
+	return
+}
+
+// FastHTTP records the call and calls FastHTTPFunc if it is set.
+func (m *ProfileAPI) FastHTTP() (r0 profile.FastHTTPAPI) {
+	m.CallRecorder.Record("FastHTTP")
+	if m.FastHTTPFunc != nil {
+		return m.FastHTTPFunc()
+	}
+	return
+}
+
+// FastL4 records the call and calls FastL4Func if it is set.
+func (m *ProfileAPI) FastL4() (r0 profile.FastL4API) {
+	m.CallRecorder.Record("FastL4")
+	if m.FastL4Func != nil {
+		return m.FastL4Func()
+	}
+	return
+}
+
+// FIX records the call and calls FIXFunc if it is set.
+func (m *ProfileAPI) FIX() (r0 profile.FIXAPI) {
+	m.CallRecorder.Record("FIX")
+	if m.FIXFunc != nil {
+		return m.FIXFunc()
+	}
+	return
+}
+
+// FTP records the call and calls FTPFunc if it is set.
+func (m *ProfileAPI) FTP() (r0 profile.FTPAPI) {
+	m.CallRecorder.Record("FTP")
+	if m.FTPFunc != nil {
+		return m.FTPFunc()
+	}
+	return
+}
+
+// GTP records the call and calls GTPFunc if it is set.
+func (m *ProfileAPI) GTP() (r0 profile.GTPAPI) {
+	m.CallRecorder.Record("GTP")
+	if m.GTPFunc != nil {
+		return m.GTPFunc()
+	}
+	return
+}
+
+// HTML records the call and calls HTMLFunc if it is set.
+func (m *ProfileAPI) HTML() (r0 profile.HTMLAPI) {
+	m.CallRecorder.Record("HTML")
+	if m.HTMLFunc != nil {
+		return m.HTMLFunc()
+	}
+	return
+}
+
+// HTTP records the call and calls HTTPFunc if it is set.
+func (m *ProfileAPI) HTTP() (r0 profile.HTTPAPI) {
+	m.CallRecorder.Record("HTTP")
+	if m.HTTPFunc != nil {
+		return m.HTTPFunc()
+	}
+	return
+}
+
+// HTTPCompression records the call and calls HTTPCompressionFunc if it is set.
+func (m *ProfileAPI) HTTPCompression() (r0 profile.HTTPCompressionAPI) {
+	m.CallRecorder.Record("HTTPCompression")
+	if m.HTTPCompressionFunc != nil {
+		return m.HTTPCompressionFunc()
+	}
+	return
+}
+
+// HTTPProxyConnect records the call and calls HTTPProxyConnectFunc if it is set.
+func (m *ProfileAPI) HTTPProxyConnect() (r0 profile.HTTPProxyConnectAPI) {
+	m.CallRecorder.Record("HTTPProxyConnect")
+	if m.HTTPProxyConnectFunc != nil {
+		return m.HTTPProxyConnectFunc()
+	}
+	return
+}
+
+// HTTP2 records the call and calls HTTP2Func if it is set.
+func (m *ProfileAPI) HTTP2() (r0 profile.HTTP2API) {
+	m.CallRecorder.Record("HTTP2")
+	if m.HTTP2Func != nil {
+		return m.HTTP2Func()
+	}
+	return
+}
+
+// HTTP3 records the call and calls HTTP3Func if it is set.
+func (m *ProfileAPI) HTTP3() (r0 profile.HTTP3API) {
+	m.CallRecorder.Record("HTTP3")
+	if m.HTTP3Func != nil {
+		return m.HTTP3Func()
+	}
+	return
+}
+
+// HTTPRouter records the call and calls HTTPRouterFunc if it is set.
+func (m *ProfileAPI) HTTPRouter() (r0 profile.HTTPRouterAPI) {
+	m.CallRecorder.Record("HTTPRouter")
+	if m.HTTPRouterFunc != nil {
+		return m.HTTPRouterFunc()
+	}
+	return
+}
+
+// ICAP records the call and calls ICAPFunc if it is set.
+func (m *ProfileAPI) ICAP() (r0 profile.ICAPAPI) {
+	m.CallRecorder.Record("ICAP")
+	if m.ICAPFunc != nil {
+		return m.ICAPFunc()
+	}
+	return
+}
+
+// IMAP records the call and calls IMAPFunc if it is set.
+func (m *ProfileAPI) IMAP() (r0 profile.IMAPAPI) {
+	m.CallRecorder.Record("IMAP")
+	if m.IMAPFunc != nil {
+		return m.IMAPFunc()
+	}
+	return
+}
+
+// MQTT records the call and calls MQTTFunc if it is set.
+func (m *ProfileAPI) MQTT() (r0 profile.MQTTAPI) {
+	m.CallRecorder.Record("MQTT")
+	if m.MQTTFunc != nil {
+		return m.MQTTFunc()
+	}
+	return
+}
+
+// Netflow records the call and calls NetflowFunc if it is set.
+func (m *ProfileAPI) Netflow() (r0 profile.NetflowAPI) {
+	m.CallRecorder.Record("Netflow")
+	if m.NetflowFunc != nil {
+		return m.NetflowFunc()
+	}
+	return
+}
+
+// NTLM records the call and calls NTLMFunc if it is set.
+func (m *ProfileAPI) NTLM() (r0 profile.NTLMAPI) {
+	m.CallRecorder.Record("NTLM")
+	if m.NTLMFunc != nil {
+		return m.NTLMFunc()
+	}
+	return
+}
+
+// OCSP records the call and calls OCSPFunc if it is set.
+func (m *ProfileAPI) OCSP() (r0 profile.OCSPAPI) {
+	m.CallRecorder.Record("OCSP")
+	if m.OCSPFunc != nil {
+		return m.OCSPFunc()
+	}
+	return
+}
+
+// OneConnect records the call and calls OneConnectFunc if it is set.
+func (m *ProfileAPI) OneConnect() (r0 profile.OneConnectAPI) {
+	m.CallRecorder.Record("OneConnect")
+	if m.OneConnectFunc != nil {
+		return m.OneConnectFunc()
+	}
+	return
+}
+
+// POP3 records the call and calls POP3Func if it is set.
+func (m *ProfileAPI) POP3() (r0 profile.POP3API) {
+	m.CallRecorder.Record("POP3")
+	if m.POP3Func != nil {
+		return m.POP3Func()
+	}
+	return
+}
+
+// PPTP records the call and calls PPTPFunc if it is set.
+func (m *ProfileAPI) PPTP() (r0 profile.PPTPAPI) {
+	m.CallRecorder.Record("PPTP")
+	if m.PPTPFunc != nil {
+		return m.PPTPFunc()
+	}
+	return
+}
+
+// QOE records the call and calls QOEFunc if it is set.
+func (m *ProfileAPI) QOE() (r0 profile.QOEAPI) {
+	m.CallRecorder.Record("QOE")
+	if m.QOEFunc != nil {
+		return m.QOEFunc()
+	}
+	return
+}
+
+// QUIC records the call and calls QUICFunc if it is set.
+func (m *ProfileAPI) QUIC() (r0 profile.QUICAPI) {
+	m.CallRecorder.Record("QUIC")
+	if m.QUICFunc != nil {
+		return m.QUICFunc()
+	}
+	return
+}
+
+// Radius records the call and calls RadiusFunc if it is set.
+func (m *ProfileAPI) Radius() (r0 profile.RADIUSAPI) {
+	m.CallRecorder.Record("Radius")
+	if m.RadiusFunc != nil {
+		return m.RadiusFunc()
+	}
+	return
+}
+
+// Rewrite records the call and calls RewriteFunc if it is set.
+func (m *ProfileAPI) Rewrite() (r0 profile.RewriteAPI) {
+	m.CallRecorder.Record("Rewrite")
+	if m.RewriteFunc != nil {
+		return m.RewriteFunc()
+	}
+	return
+}
+
+// RTSP records the call and calls RTSPFunc if it is set.
+func (m *ProfileAPI) RTSP() (r0 profile.RTSPAPI) {
+	m.CallRecorder.Record("RTSP")
+	if m.RTSPFunc != nil {
+		return m.RTSPFunc()
+	}
+	return
+}
+
+// SCTP records the call and calls SCTPFunc if it is set.
+func (m *ProfileAPI) SCTP() (r0 profile.SCTPAPI) {
+	m.CallRecorder.Record("SCTP")
+	if m.SCTPFunc != nil {
+		return m.SCTPFunc()
+	}
+	return
+}
+
+// ServerLDAP records the call and calls ServerLDAPFunc if it is set.
+func (m *ProfileAPI) ServerLDAP() (r0 profile.ServerLDAPAPI) {
+	m.CallRecorder.Record("ServerLDAP")
+	if m.ServerLDAPFunc != nil {
+		return m.ServerLDAPFunc()
+	}
+	return
+}
+
+// ServerSSL records the call and calls ServerSSLFunc if it is set.
+func (m *ProfileAPI) ServerSSL() (r0 profile.ServerSSLAPI) {
+	m.CallRecorder.Record("ServerSSL")
+	if m.ServerSSLFunc != nil {
+		return m.ServerSSLFunc()
+	}
+	return
+}
+
+// Service records the call and calls ServiceFunc if it is set.
+func (m *ProfileAPI) Service() (r0 profile.ServiceAPI) {
+	m.CallRecorder.Record("Service")
+	if m.ServiceFunc != nil {
+		return m.ServiceFunc()
+	}
+	return
+}
+
+// SIP records the call and calls SIPFunc if it is set.
+func (m *ProfileAPI) SIP() (r0 profile.SIPAPI) {
+	m.CallRecorder.Record("SIP")
+	if m.SIPFunc != nil {
+		return m.SIPFunc()
+	}
+	return
+}
+
+// SMTPS records the call and calls SMTPSFunc if it is set.
+func (m *ProfileAPI) SMTPS() (r0 profile.SMTPSAPI) {
+	m.CallRecorder.Record("SMTPS")
+	if m.SMTPSFunc != nil {
+		return m.SMTPSFunc()
+	}
+	return
+}
+
+// SOCKS records the call and calls SOCKSFunc if it is set.
+func (m *ProfileAPI) SOCKS() (r0 profile.SocksAPI) {
+	m.CallRecorder.Record("SOCKS")
+	if m.SOCKSFunc != nil {
+		return m.SOCKSFunc()
+	}
+	return
+}
+
+// Statistics records the call and calls StatisticsFunc if it is set.
+func (m *ProfileAPI) Statistics() (r0 profile.StatisticsAPI) {
+	m.CallRecorder.Record("Statistics")
+	if m.StatisticsFunc != nil {
+		return m.StatisticsFunc()
+	}
+	return
+}
+
+// Stream records the call and calls StreamFunc if it is set.
+func (m *ProfileAPI) Stream() (r0 profile.StreamAPI) {
+	m.CallRecorder.Record("Stream")
+	if m.StreamFunc != nil {
+		return m.StreamFunc()
+	}
+	return
+}
+
+// TCP records the call and calls TCPFunc if it is set.
+func (m *ProfileAPI) TCP() (r0 profile.TCPAPI) {
+	m.CallRecorder.Record("TCP")
+	if m.TCPFunc != nil {
+		return m.TCPFunc()
+	}
+	return
+}
+
+// TCPAnalytics records the call and calls TCPAnalyticsFunc if it is set.
+func (m *ProfileAPI) TCPAnalytics() (r0 profile.TCPAnalyticsAPI) {
+	m.CallRecorder.Record("TCPAnalytics")
+	if m.TCPAnalyticsFunc != nil {
+		return m.TCPAnalyticsFunc()
+	}
+	return
+}
+
+// TDR records the call and calls TDRFunc if it is set.
+func (m *ProfileAPI) TDR() (r0 profile.TDRAPI) {
+	m.CallRecorder.Record("TDR")
+	if m.TDRFunc != nil {
+		return m.TDRFunc()
+	}
+	return
+}
+
+// TFTP records the call and calls TFTPFunc if it is set.
+func (m *ProfileAPI) TFTP() (r0 profile.TFTPAPI) {
+	m.CallRecorder.Record("TFTP")
+	if m.TFTPFunc != nil {
+		return m.TFTPFunc()
+	}
+	return
+}
+
+// UDP records the call and calls UDPFunc if it is set.
+func (m *ProfileAPI) UDP() (r0 profile.UDPAPI) {
+	m.CallRecorder.Record("UDP")
+	if m.UDPFunc != nil {
+		return m.UDPFunc()
+	}
+	return
+}
+
+// WebAcceleration records the call and calls WebAccelerationFunc if it is set.
+func (m *ProfileAPI) WebAcceleration() (r0 profile.WebAccelerationAPI) {
+	m.CallRecorder.Record("WebAcceleration")
+	if m.WebAccelerationFunc != nil {
+		return m.WebAccelerationFunc()
+	}
+	return
+}
+
+// WebSocket records the call and calls WebSocketFunc if it is set.
+func (m *ProfileAPI) WebSocket() (r0 profile.WebSocketAPI) {
+	m.CallRecorder.Record("WebSocket")
+	if m.WebSocketFunc != nil {
+		return m.WebSocketFunc()
+	}
+	return
+}
+
+// XML records the call and calls XMLFunc if it is set.
+func (m *ProfileAPI) XML() (r0 profile.XMLAPI) {
+	m.CallRecorder.Record("XML")
+	if m.XMLFunc != nil {
+		return m.XMLFunc()
+	}
+	return
+}
+
+// QOEAPI is a mock of profile.QOEAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type QOEAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.QOEList, error)
+	GetFunc    func(context.Context, string) (*profile.QOE, error)
+	CreateFunc func(context.Context, profile.QOE) error
+	UpdateFunc func(context.Context, string, profile.QOE) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.QOEAPI = &QOEAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *QOEAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.QOEList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *QOEAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.QOE, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *QOEAPI) Create(ctx context.Context, item profile.QOE) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *QOEAPI) Update(ctx context.Context, fullPathName string, item profile.QOE) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *QOEAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *QOEAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// QUICAPI is a mock of profile.QUICAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type QUICAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.QUICList, error)
+	GetFunc    func(context.Context, string) (*profile.QUIC, error)
+	CreateFunc func(context.Context, profile.QUIC) error
+	UpdateFunc func(context.Context, string, profile.QUIC) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.QUICAPI = &QUICAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *QUICAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.QUICList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *QUICAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.QUIC, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *QUICAPI) Create(ctx context.Context, item profile.QUIC) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *QUICAPI) Update(ctx context.Context, fullPathName string, item profile.QUIC) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *QUICAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *QUICAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// RADIUSAPI is a mock of profile.RADIUSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RADIUSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.RADIUSList, error)
+	GetFunc    func(context.Context, string) (*profile.RADIUS, error)
+	CreateFunc func(context.Context, profile.RADIUS) error
+	UpdateFunc func(context.Context, string, profile.RADIUS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.RADIUSAPI = &RADIUSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RADIUSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.RADIUSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RADIUSAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.RADIUS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RADIUSAPI) Create(ctx context.Context, item profile.RADIUS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RADIUSAPI) Update(ctx context.Context, fullPathName string, item profile.RADIUS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RADIUSAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RADIUSAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// RTSPAPI is a mock of profile.RTSPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RTSPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.RTSPList, error)
+	GetFunc    func(context.Context, string) (*profile.RTSP, error)
+	CreateFunc func(context.Context, profile.RTSP) error
+	UpdateFunc func(context.Context, string, profile.RTSP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.RTSPAPI = &RTSPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RTSPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.RTSPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RTSPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.RTSP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RTSPAPI) Create(ctx context.Context, item profile.RTSP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RTSPAPI) Update(ctx context.Context, fullPathName string, item profile.RTSP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RTSPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RTSPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// RewriteAPI is a mock of profile.RewriteAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RewriteAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.RewriteList, error)
+	GetFunc    func(context.Context, string) (*profile.Rewrite, error)
+	CreateFunc func(context.Context, profile.Rewrite) error
+	UpdateFunc func(context.Context, string, profile.Rewrite) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.RewriteAPI = &RewriteAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RewriteAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.RewriteList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RewriteAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Rewrite, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RewriteAPI) Create(ctx context.Context, item profile.Rewrite) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RewriteAPI) Update(ctx context.Context, fullPathName string, item profile.Rewrite) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RewriteAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RewriteAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SCTPAPI is a mock of profile.SCTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SCTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.SCTPList, error)
+	GetFunc    func(context.Context, string) (*profile.SCTP, error)
+	CreateFunc func(context.Context, profile.SCTP) error
+	UpdateFunc func(context.Context, string, profile.SCTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.SCTPAPI = &SCTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SCTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.SCTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SCTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.SCTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SCTPAPI) Create(ctx context.Context, item profile.SCTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SCTPAPI) Update(ctx context.Context, fullPathName string, item profile.SCTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SCTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SCTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SIPAPI is a mock of profile.SIPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SIPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.SIPList, error)
+	GetFunc    func(context.Context, string) (*profile.SIP, error)
+	CreateFunc func(context.Context, profile.SIP) error
+	UpdateFunc func(context.Context, string, profile.SIP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.SIPAPI = &SIPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SIPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.SIPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SIPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.SIP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SIPAPI) Create(ctx context.Context, item profile.SIP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SIPAPI) Update(ctx context.Context, fullPathName string, item profile.SIP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SIPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SIPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SMTPSAPI is a mock of profile.SMTPSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SMTPSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.SMTPSList, error)
+	GetFunc    func(context.Context, string) (*profile.SMTPS, error)
+	CreateFunc func(context.Context, profile.SMTPS) error
+	UpdateFunc func(context.Context, string, profile.SMTPS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.SMTPSAPI = &SMTPSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SMTPSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.SMTPSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SMTPSAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.SMTPS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SMTPSAPI) Create(ctx context.Context, item profile.SMTPS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SMTPSAPI) Update(ctx context.Context, fullPathName string, item profile.SMTPS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SMTPSAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SMTPSAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ServerLDAPAPI is a mock of profile.ServerLDAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServerLDAPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ServerLDAPList, error)
+	GetFunc    func(context.Context, string) (*profile.ServerLDAP, error)
+	CreateFunc func(context.Context, profile.ServerLDAP) error
+	UpdateFunc func(context.Context, string, profile.ServerLDAP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ServerLDAPAPI = &ServerLDAPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServerLDAPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ServerLDAPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServerLDAPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.ServerLDAP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServerLDAPAPI) Create(ctx context.Context, item profile.ServerLDAP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServerLDAPAPI) Update(ctx context.Context, fullPathName string, item profile.ServerLDAP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServerLDAPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServerLDAPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ServerSSLAPI is a mock of profile.ServerSSLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServerSSLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ServerSSLList, error)
+	GetFunc    func(context.Context, string) (*profile.ServerSSL, error)
+	CreateFunc func(context.Context, profile.ServerSSL) error
+	UpdateFunc func(context.Context, string, profile.ServerSSL) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ServerSSLAPI = &ServerSSLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServerSSLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ServerSSLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServerSSLAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.ServerSSL, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServerSSLAPI) Create(ctx context.Context, item profile.ServerSSL) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServerSSLAPI) Update(ctx context.Context, fullPathName string, item profile.ServerSSL) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServerSSLAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServerSSLAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ServiceAPI is a mock of profile.ServiceAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServiceAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.ServiceList, error)
+	GetFunc    func(context.Context, string) (*profile.Service, error)
+	CreateFunc func(context.Context, profile.Service) error
+	UpdateFunc func(context.Context, string, profile.Service) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.ServiceAPI = &ServiceAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServiceAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.ServiceList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServiceAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Service, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServiceAPI) Create(ctx context.Context, item profile.Service) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServiceAPI) Update(ctx context.Context, fullPathName string, item profile.Service) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServiceAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServiceAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SocksAPI is a mock of profile.SocksAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SocksAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.SocksList, error)
+	GetFunc    func(context.Context, string) (*profile.Socks, error)
+	CreateFunc func(context.Context, profile.Socks) error
+	UpdateFunc func(context.Context, string, profile.Socks) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.SocksAPI = &SocksAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SocksAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.SocksList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SocksAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Socks, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SocksAPI) Create(ctx context.Context, item profile.Socks) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SocksAPI) Update(ctx context.Context, fullPathName string, item profile.Socks) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SocksAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SocksAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// StatisticsAPI is a mock of profile.StatisticsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type StatisticsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.StatisticsList, error)
+	GetFunc    func(context.Context, string) (*profile.Statistics, error)
+	CreateFunc func(context.Context, profile.Statistics) error
+	UpdateFunc func(context.Context, string, profile.Statistics) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.StatisticsAPI = &StatisticsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *StatisticsAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.StatisticsList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *StatisticsAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Statistics, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *StatisticsAPI) Create(ctx context.Context, item profile.Statistics) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *StatisticsAPI) Update(ctx context.Context, fullPathName string, item profile.Statistics) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *StatisticsAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *StatisticsAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// StreamAPI is a mock of profile.StreamAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type StreamAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.StreamList, error)
+	GetFunc    func(context.Context, string) (*profile.Stream, error)
+	CreateFunc func(context.Context, profile.Stream) error
+	UpdateFunc func(context.Context, string, profile.Stream) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.StreamAPI = &StreamAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *StreamAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.StreamList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *StreamAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.Stream, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *StreamAPI) Create(ctx context.Context, item profile.Stream) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *StreamAPI) Update(ctx context.Context, fullPathName string, item profile.Stream) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *StreamAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *StreamAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// TCPAPI is a mock of profile.TCPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.TCPList, error)
+	GetFunc    func(context.Context, string) (*profile.TCP, error)
+	CreateFunc func(context.Context, profile.TCP) error
+	UpdateFunc func(context.Context, string, profile.TCP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.TCPAPI = &TCPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.TCPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.TCP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPAPI) Create(ctx context.Context, item profile.TCP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPAPI) Update(ctx context.Context, fullPathName string, item profile.TCP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// TCPAnalyticsAPI is a mock of profile.TCPAnalyticsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TCPAnalyticsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.TCPAnalyticsList, error)
+	GetFunc    func(context.Context, string) (*profile.TCPAnalytics, error)
+	CreateFunc func(context.Context, profile.TCPAnalytics) error
+	UpdateFunc func(context.Context, string, profile.TCPAnalytics) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.TCPAnalyticsAPI = &TCPAnalyticsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TCPAnalyticsAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.TCPAnalyticsList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TCPAnalyticsAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.TCPAnalytics, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TCPAnalyticsAPI) Create(ctx context.Context, item profile.TCPAnalytics) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TCPAnalyticsAPI) Update(ctx context.Context, fullPathName string, item profile.TCPAnalytics) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TCPAnalyticsAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TCPAnalyticsAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// TDRAPI is a mock of profile.TDRAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TDRAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.TDRList, error)
+	GetFunc    func(context.Context, string) (*profile.TDR, error)
+	CreateFunc func(context.Context, profile.TDR) error
+	UpdateFunc func(context.Context, string, profile.TDR) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.TDRAPI = &TDRAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TDRAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.TDRList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TDRAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.TDR, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TDRAPI) Create(ctx context.Context, item profile.TDR) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TDRAPI) Update(ctx context.Context, fullPathName string, item profile.TDR) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TDRAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TDRAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// TFTPAPI is a mock of profile.TFTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TFTPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.TFTPList, error)
+	GetFunc    func(context.Context, string) (*profile.TFTP, error)
+	CreateFunc func(context.Context, profile.TFTP) error
+	UpdateFunc func(context.Context, string, profile.TFTP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.TFTPAPI = &TFTPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TFTPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.TFTPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TFTPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.TFTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TFTPAPI) Create(ctx context.Context, item profile.TFTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TFTPAPI) Update(ctx context.Context, fullPathName string, item profile.TFTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TFTPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TFTPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// UDPAPI is a mock of profile.UDPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type UDPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.UDPList, error)
+	GetFunc    func(context.Context, string) (*profile.UDP, error)
+	CreateFunc func(context.Context, profile.UDP) error
+	UpdateFunc func(context.Context, string, profile.UDP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.UDPAPI = &UDPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *UDPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.UDPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *UDPAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.UDP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *UDPAPI) Create(ctx context.Context, item profile.UDP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *UDPAPI) Update(ctx context.Context, fullPathName string, item profile.UDP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *UDPAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *UDPAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// WebAccelerationAPI is a mock of profile.WebAccelerationAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WebAccelerationAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.WebAccelerationList, error)
+	GetFunc    func(context.Context, string) (*profile.WebAcceleration, error)
+	CreateFunc func(context.Context, profile.WebAcceleration) error
+	UpdateFunc func(context.Context, string, profile.WebAcceleration) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.WebAccelerationAPI = &WebAccelerationAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *WebAccelerationAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.WebAccelerationList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *WebAccelerationAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.WebAcceleration, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *WebAccelerationAPI) Create(ctx context.Context, item profile.WebAcceleration) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *WebAccelerationAPI) Update(ctx context.Context, fullPathName string, item profile.WebAcceleration) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *WebAccelerationAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *WebAccelerationAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// WebSocketAPI is a mock of profile.WebSocketAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type WebSocketAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.WebSocketList, error)
+	GetFunc    func(context.Context, string) (*profile.WebSocket, error)
+	CreateFunc func(context.Context, profile.WebSocket) error
+	UpdateFunc func(context.Context, string, profile.WebSocket) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.WebSocketAPI = &WebSocketAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *WebSocketAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.WebSocketList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *WebSocketAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.WebSocket, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *WebSocketAPI) Create(ctx context.Context, item profile.WebSocket) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *WebSocketAPI) Update(ctx context.Context, fullPathName string, item profile.WebSocket) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *WebSocketAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *WebSocketAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// XMLAPI is a mock of profile.XMLAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type XMLAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*profile.XMLList, error)
+	GetFunc    func(context.Context, string) (*profile.XML, error)
+	CreateFunc func(context.Context, profile.XML) error
+	UpdateFunc func(context.Context, string, profile.XML) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ profile.XMLAPI = &XMLAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *XMLAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *profile.XMLList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *XMLAPI) Get(ctx context.Context, fullPathName string) (r0 *profile.XML, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *XMLAPI) Create(ctx context.Context, item profile.XML) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *XMLAPI) Update(ctx context.Context, fullPathName string, item profile.XML) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, fullPathName, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *XMLAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *XMLAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
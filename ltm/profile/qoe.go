@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type QOEList struct {
@@ -33,74 +31,37 @@ type QOEResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of QOE resources.
-func (qr *QOEResource) List() (*QOEList, error) {
-	var items QOEList
-	res, err := qr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing QOEResource.
+func (qr *QOEResource) collection() *bigip.Collection[QOE, QOEList] {
+	return bigip.NewCollection[QOE, QOEList](qr.b, LtmManager, ProfileEndpoint, QOEEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of QOE resources.
+func (qr *QOEResource) List(ctx context.Context, opts ...*rest.ListOptions) (*QOEList, error) {
+	return qr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a QOE resource by its full path name.
-func (qr *QOEResource) Get(fullPathName string) (*QOE, error) {
-	var item QOE
-	res, err := qr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (qr *QOEResource) Get(ctx context.Context, fullPathName string) (*QOE, error) {
+	return qr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new QOE resource using the provided QOE item.
-func (qr *QOEResource) Create(item QOE) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	_, err = qr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (qr *QOEResource) Create(ctx context.Context, item QOE) error {
+	return qr.collection().Create(ctx, item)
 }
 
 // Update modifies a QOE resource identified by its full path name using the provided QOE item.
-func (qr *QOEResource) Update(fullPathName string, item QOE) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (qr *QOEResource) Update(ctx context.Context, fullPathName string, item QOE) error {
+	return qr.collection().Update(ctx, fullPathName, item)
+}
 
-	_, err = qr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the QOE identified by fullPathName, see bigip.PatchBody.
+func (qr *QOEResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return qr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a QOE resource by its full path name.
-func (qr *QOEResource) Delete(fullPathName string) error {
-	_, err := qr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QOEEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (qr *QOEResource) Delete(ctx context.Context, fullPathName string) error {
+	return qr.collection().Delete(ctx, fullPathName)
 }
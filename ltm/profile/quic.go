@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type QUICList struct {
@@ -31,87 +29,44 @@ type QUIC struct {
 
 const QUICEndpoint = "quic"
 
+// QUICMinVersion is the first TMOS version providing QUIC profiles.
+var QUICMinVersion = bigip.MustParseVersion("15.1")
+
 type QUICResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of QUIC resources.
-func (cr *QUICResource) List() (*QUICList, error) {
-	var items QUICList
-	// Perform a GET request to retrieve a list of QUIC resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QUICEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing QUICResource.
+func (cr *QUICResource) collection() *bigip.Collection[QUIC, QUICList] {
+	return bigip.NewCollection[QUIC, QUICList](cr.b, LtmManager, ProfileEndpoint, QUICEndpoint).WithMinVersion(QUICMinVersion)
+}
 
-	// Unmarshal JSON response data into QUICList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of QUIC resources.
+func (cr *QUICResource) List(ctx context.Context, opts ...*rest.ListOptions) (*QUICList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a QUIC resource by its full path name.
-func (cr *QUICResource) Get(fullPathName string) (*QUIC, error) {
-	var item QUIC
-	// Perform a GET request to retrieve a specific QUIC resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QUICEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into QUIC struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *QUICResource) Get(ctx context.Context, fullPathName string) (*QUIC, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new QUIC resource using the provided QUIC item.
-func (cr *QUICResource) Create(item QUIC) error {
-	// Marshal the QUIC struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new QUIC resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QUICEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *QUICResource) Create(ctx context.Context, item QUIC) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a QUIC resource identified by its full path name using the provided QUIC item.
-func (cr *QUICResource) Update(fullPathName string, item QUIC) error {
-	// Marshal the QUIC struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *QUICResource) Update(ctx context.Context, fullPathName string, item QUIC) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified QUIC resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QUICEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the QUIC identified by fullPathName, see bigip.PatchBody.
+func (cr *QUICResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a QUIC resource by its full path name.
-func (cr *QUICResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified QUIC resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(QUICEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *QUICResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
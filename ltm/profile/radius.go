@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 //radius
@@ -39,83 +37,37 @@ type RADIUSResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of RADIUS resources.
-func (cr *RADIUSResource) List() (*RADIUSList, error) {
-	var items RADIUSList
-	// Perform a GET request to retrieve a list of RADIUS resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RADIUSResource.
+func (cr *RADIUSResource) collection() *bigip.Collection[RADIUS, RADIUSList] {
+	return bigip.NewCollection[RADIUS, RADIUSList](cr.b, LtmManager, ProfileEndpoint, RADIUSEndpoint)
+}
 
-	// Unmarshal JSON response data into RADIUSList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of RADIUS resources.
+func (cr *RADIUSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RADIUSList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a RADIUS resource by its full path name.
-func (cr *RADIUSResource) Get(fullPathName string) (*RADIUS, error) {
-	var item RADIUS
-	// Perform a GET request to retrieve a specific RADIUS resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into RADIUS struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *RADIUSResource) Get(ctx context.Context, fullPathName string) (*RADIUS, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new RADIUS resource using the provided RADIUS item.
-func (cr *RADIUSResource) Create(item RADIUS) error {
-	// Marshal the RADIUS struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new RADIUS resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *RADIUSResource) Create(ctx context.Context, item RADIUS) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a RADIUS resource identified by its full path name using the provided RADIUS item.
-func (cr *RADIUSResource) Update(fullPathName string, item RADIUS) error {
-	// Marshal the RADIUS struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *RADIUSResource) Update(ctx context.Context, fullPathName string, item RADIUS) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified RADIUS resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the RADIUS identified by fullPathName, see bigip.PatchBody.
+func (cr *RADIUSResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a RADIUS resource by its full path name.
-func (cr *RADIUSResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified RADIUS resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RADIUSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *RADIUSResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
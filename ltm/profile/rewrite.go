@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type RewriteList struct {
@@ -67,83 +65,37 @@ type RewriteResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of Rewrite resources.
-func (cr *RewriteResource) List() (*RewriteList, error) {
-	var items RewriteList
-	// Perform a GET request to retrieve a list of Rewrite resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RewriteResource.
+func (cr *RewriteResource) collection() *bigip.Collection[Rewrite, RewriteList] {
+	return bigip.NewCollection[Rewrite, RewriteList](cr.b, LtmManager, ProfileEndpoint, RewriteEndpoint)
+}
 
-	// Unmarshal JSON response data into RewriteList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of Rewrite resources.
+func (cr *RewriteResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RewriteList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a Rewrite resource by its full path name.
-func (cr *RewriteResource) Get(fullPathName string) (*Rewrite, error) {
-	var item Rewrite
-	// Perform a GET request to retrieve a specific Rewrite resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Rewrite struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *RewriteResource) Get(ctx context.Context, fullPathName string) (*Rewrite, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Rewrite resource using the provided Rewrite item.
-func (cr *RewriteResource) Create(item Rewrite) error {
-	// Marshal the Rewrite struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Rewrite resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *RewriteResource) Create(ctx context.Context, item Rewrite) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a Rewrite resource identified by its full path name using the provided Rewrite item.
-func (cr *RewriteResource) Update(fullPathName string, item Rewrite) error {
-	// Marshal the Rewrite struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *RewriteResource) Update(ctx context.Context, fullPathName string, item Rewrite) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified Rewrite resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Rewrite identified by fullPathName, see bigip.PatchBody.
+func (cr *RewriteResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a Rewrite resource by its full path name.
-func (cr *RewriteResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Rewrite resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RewriteEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *RewriteResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
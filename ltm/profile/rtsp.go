@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // RTSPList struct contains a list of RTSP resources.
@@ -50,83 +48,37 @@ type RTSPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of RTSP resources.
-func (cr *RTSPResource) List() (*RTSPList, error) {
-	var items RTSPList
-	// Perform a GET request to retrieve a list of RTSP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RTSPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing RTSPResource.
+func (cr *RTSPResource) collection() *bigip.Collection[RTSP, RTSPList] {
+	return bigip.NewCollection[RTSP, RTSPList](cr.b, LtmManager, ProfileEndpoint, RTSPEndpoint)
+}
 
-	// Unmarshal JSON response data into RTSPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of RTSP resources.
+func (cr *RTSPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RTSPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an RTSP resource by its full path name.
-func (cr *RTSPResource) Get(fullPathName string) (*RTSP, error) {
-	var item RTSP
-	// Perform a GET request to retrieve a specific RTSP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RTSPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into RTSP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *RTSPResource) Get(ctx context.Context, fullPathName string) (*RTSP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new RTSP resource using the provided RTSP item.
-func (cr *RTSPResource) Create(item RTSP) error {
-	// Marshal the RTSP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new RTSP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RTSPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *RTSPResource) Create(ctx context.Context, item RTSP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an RTSP resource identified by its full path name using the provided RTSP item.
-func (cr *RTSPResource) Update(fullPathName string, item RTSP) error {
-	// Marshal the RTSP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *RTSPResource) Update(ctx context.Context, fullPathName string, item RTSP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified RTSP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RTSPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the RTSP identified by fullPathName, see bigip.PatchBody.
+func (cr *RTSPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an RTSP resource by its full path name.
-func (cr *RTSPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified RTSP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(RTSPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *RTSPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
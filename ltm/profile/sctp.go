@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SCTPList struct {
@@ -62,83 +60,37 @@ type SCTPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of SCTP resources.
-func (cr *SCTPResource) List() (*SCTPList, error) {
-	var items SCTPList
-	// Perform a GET request to retrieve a list of SCTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SCTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SCTPResource.
+func (cr *SCTPResource) collection() *bigip.Collection[SCTP, SCTPList] {
+	return bigip.NewCollection[SCTP, SCTPList](cr.b, LtmManager, ProfileEndpoint, SCTPEndpoint)
+}
 
-	// Unmarshal JSON response data into SCTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of SCTP resources.
+func (cr *SCTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SCTPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an SCTP resource by its full path name.
-func (cr *SCTPResource) Get(fullPathName string) (*SCTP, error) {
-	var item SCTP
-	// Perform a GET request to retrieve a specific SCTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SCTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into SCTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *SCTPResource) Get(ctx context.Context, fullPathName string) (*SCTP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SCTP resource using the provided SCTP item.
-func (cr *SCTPResource) Create(item SCTP) error {
-	// Marshal the SCTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new SCTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SCTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SCTPResource) Create(ctx context.Context, item SCTP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an SCTP resource identified by its full path name using the provided SCTP item.
-func (cr *SCTPResource) Update(fullPathName string, item SCTP) error {
-	// Marshal the SCTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *SCTPResource) Update(ctx context.Context, fullPathName string, item SCTP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified SCTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SCTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SCTP identified by fullPathName, see bigip.PatchBody.
+func (cr *SCTPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an SCTP resource by its full path name.
-func (cr *SCTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified SCTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SCTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SCTPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
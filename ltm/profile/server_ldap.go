@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ServerLDAPList struct {
@@ -33,74 +31,37 @@ type ServerLDAPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of ServerLDAP resources.
-func (sr *ServerLDAPResource) List() (*ServerLDAPList, error) {
-	var items ServerLDAPList
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ServerLDAPResource.
+func (sr *ServerLDAPResource) collection() *bigip.Collection[ServerLDAP, ServerLDAPList] {
+	return bigip.NewCollection[ServerLDAP, ServerLDAPList](sr.b, LtmManager, ProfileEndpoint, ServerLDAPEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of ServerLDAP resources.
+func (sr *ServerLDAPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ServerLDAPList, error) {
+	return sr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a ServerLDAP resource by its full path name.
-func (sr *ServerLDAPResource) Get(fullPathName string) (*ServerLDAP, error) {
-	var item ServerLDAP
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (sr *ServerLDAPResource) Get(ctx context.Context, fullPathName string) (*ServerLDAP, error) {
+	return sr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new ServerLDAP resource using the provided ServerLDAP item.
-func (sr *ServerLDAPResource) Create(item ServerLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	_, err = sr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (sr *ServerLDAPResource) Create(ctx context.Context, item ServerLDAP) error {
+	return sr.collection().Create(ctx, item)
 }
 
 // Update modifies a ServerLDAP resource identified by its full path name using the provided ServerLDAP item.
-func (sr *ServerLDAPResource) Update(fullPathName string, item ServerLDAP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (sr *ServerLDAPResource) Update(ctx context.Context, fullPathName string, item ServerLDAP) error {
+	return sr.collection().Update(ctx, fullPathName, item)
+}
 
-	_, err = sr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ServerLDAP identified by fullPathName, see bigip.PatchBody.
+func (sr *ServerLDAPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return sr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a ServerLDAP resource by its full path name.
-func (sr *ServerLDAPResource) Delete(fullPathName string) error {
-	_, err := sr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerLDAPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (sr *ServerLDAPResource) Delete(ctx context.Context, fullPathName string) error {
+	return sr.collection().Delete(ctx, fullPathName)
 }
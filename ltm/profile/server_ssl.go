@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type ServerSSLList struct {
@@ -87,83 +85,37 @@ type ServerSSLResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of ServerSSL resources.
-func (cr *ServerSSLResource) List() (*ServerSSLList, error) {
-	var items ServerSSLList
-	// Perform a GET request to retrieve a list of ServerSSL resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ServerSSLResource.
+func (cr *ServerSSLResource) collection() *bigip.Collection[ServerSSL, ServerSSLList] {
+	return bigip.NewCollection[ServerSSL, ServerSSLList](cr.b, LtmManager, ProfileEndpoint, ServerSSLEndpoint)
+}
 
-	// Unmarshal JSON response data into ServerSSLList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of ServerSSL resources.
+func (cr *ServerSSLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ServerSSLList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a ServerSSL resource by its full path name.
-func (cr *ServerSSLResource) Get(fullPathName string) (*ServerSSL, error) {
-	var item ServerSSL
-	// Perform a GET request to retrieve a specific ServerSSL resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into ServerSSL struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *ServerSSLResource) Get(ctx context.Context, fullPathName string) (*ServerSSL, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new ServerSSL resource using the provided ServerSSL item.
-func (cr *ServerSSLResource) Create(item ServerSSL) error {
-	// Marshal the ServerSSL struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new ServerSSL resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ServerSSLResource) Create(ctx context.Context, item ServerSSL) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a ServerSSL resource identified by its full path name using the provided ServerSSL item.
-func (cr *ServerSSLResource) Update(fullPathName string, item ServerSSL) error {
-	// Marshal the ServerSSL struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *ServerSSLResource) Update(ctx context.Context, fullPathName string, item ServerSSL) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified ServerSSL resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the ServerSSL identified by fullPathName, see bigip.PatchBody.
+func (cr *ServerSSLResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a ServerSSL resource by its full path name.
-func (cr *ServerSSLResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified ServerSSL resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(ServerSSLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *ServerSSLResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SIPList struct {
@@ -52,83 +50,37 @@ type SIPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of SIP resources.
-func (cr *SIPResource) List() (*SIPList, error) {
-	var items SIPList
-	// Perform a GET request to retrieve a list of SIP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SIPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SIPResource.
+func (cr *SIPResource) collection() *bigip.Collection[SIP, SIPList] {
+	return bigip.NewCollection[SIP, SIPList](cr.b, LtmManager, ProfileEndpoint, SIPEndpoint)
+}
 
-	// Unmarshal JSON response data into SIPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of SIP resources.
+func (cr *SIPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SIPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a SIP resource by its full path name.
-func (cr *SIPResource) Get(fullPathName string) (*SIP, error) {
-	var item SIP
-	// Perform a GET request to retrieve a specific SIP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SIPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into SIP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *SIPResource) Get(ctx context.Context, fullPathName string) (*SIP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SIP resource using the provided SIP item.
-func (cr *SIPResource) Create(item SIP) error {
-	// Marshal the SIP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new SIP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SIPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SIPResource) Create(ctx context.Context, item SIP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a SIP resource identified by its full path name using the provided SIP item.
-func (cr *SIPResource) Update(fullPathName string, item SIP) error {
-	// Marshal the SIP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *SIPResource) Update(ctx context.Context, fullPathName string, item SIP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified SIP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SIPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SIP identified by fullPathName, see bigip.PatchBody.
+func (cr *SIPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a SIP resource by its full path name.
-func (cr *SIPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified SIP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SIPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SIPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
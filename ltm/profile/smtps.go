@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SMTPSList struct {
@@ -33,83 +31,37 @@ type SMTPSResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of SMTPS resources.
-func (cr *SMTPSResource) List() (*SMTPSList, error) {
-	var items SMTPSList
-	// Perform a GET request to retrieve a list of SMTPS resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SMTPSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SMTPSResource.
+func (cr *SMTPSResource) collection() *bigip.Collection[SMTPS, SMTPSList] {
+	return bigip.NewCollection[SMTPS, SMTPSList](cr.b, LtmManager, ProfileEndpoint, SMTPSEndpoint)
+}
 
-	// Unmarshal JSON response data into SMTPSList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of SMTPS resources.
+func (cr *SMTPSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPSList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an SMTPS resource by its full path name.
-func (cr *SMTPSResource) Get(fullPathName string) (*SMTPS, error) {
-	var item SMTPS
-	// Perform a GET request to retrieve a specific SMTPS resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SMTPSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into SMTPS struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *SMTPSResource) Get(ctx context.Context, fullPathName string) (*SMTPS, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new SMTPS resource using the provided SMTPS item.
-func (cr *SMTPSResource) Create(item SMTPS) error {
-	// Marshal the SMTPS struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new SMTPS resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SMTPSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SMTPSResource) Create(ctx context.Context, item SMTPS) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an SMTPS resource identified by its full path name using the provided SMTPS item.
-func (cr *SMTPSResource) Update(fullPathName string, item SMTPS) error {
-	// Marshal the SMTPS struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *SMTPSResource) Update(ctx context.Context, fullPathName string, item SMTPS) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified SMTPS resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SMTPSEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the SMTPS identified by fullPathName, see bigip.PatchBody.
+func (cr *SMTPSResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an SMTPS resource by its full path name.
-func (cr *SMTPSResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified SMTPS resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SMTPSEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SMTPSResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
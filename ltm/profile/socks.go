@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type SocksList struct {
@@ -44,83 +42,37 @@ type SocksResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of Socks resources.
-func (cr *SocksResource) List() (*SocksList, error) {
-	var items SocksList
-	// Perform a GET request to retrieve a list of Socks resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SocksResource.
+func (cr *SocksResource) collection() *bigip.Collection[Socks, SocksList] {
+	return bigip.NewCollection[Socks, SocksList](cr.b, LtmManager, ProfileEndpoint, SocksEndpoint)
+}
 
-	// Unmarshal JSON response data into SocksList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of Socks resources.
+func (cr *SocksResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SocksList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a Socks resource by its full path name.
-func (cr *SocksResource) Get(fullPathName string) (*Socks, error) {
-	var item Socks
-	// Perform a GET request to retrieve a specific Socks resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Socks struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *SocksResource) Get(ctx context.Context, fullPathName string) (*Socks, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Socks resource using the provided Socks item.
-func (cr *SocksResource) Create(item Socks) error {
-	// Marshal the Socks struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Socks resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SocksResource) Create(ctx context.Context, item Socks) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a Socks resource identified by its full path name using the provided Socks item.
-func (cr *SocksResource) Update(fullPathName string, item Socks) error {
-	// Marshal the Socks struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *SocksResource) Update(ctx context.Context, fullPathName string, item Socks) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified Socks resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Socks identified by fullPathName, see bigip.PatchBody.
+func (cr *SocksResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a Socks resource by its full path name.
-func (cr *SocksResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Socks resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(SocksEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *SocksResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type StatisticsList struct {
@@ -64,83 +62,37 @@ type StatisticsResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of Statistics resources.
-func (cr *StatisticsResource) List() (*StatisticsList, error) {
-	var items StatisticsList
-	// Perform a GET request to retrieve a list of Statistics resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StatisticsEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing StatisticsResource.
+func (cr *StatisticsResource) collection() *bigip.Collection[Statistics, StatisticsList] {
+	return bigip.NewCollection[Statistics, StatisticsList](cr.b, LtmManager, ProfileEndpoint, StatisticsEndpoint)
+}
 
-	// Unmarshal JSON response data into StatisticsList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of Statistics resources.
+func (cr *StatisticsResource) List(ctx context.Context, opts ...*rest.ListOptions) (*StatisticsList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a Statistics resource by its full path name.
-func (cr *StatisticsResource) Get(fullPathName string) (*Statistics, error) {
-	var item Statistics
-	// Perform a GET request to retrieve a specific Statistics resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StatisticsEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Statistics struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *StatisticsResource) Get(ctx context.Context, fullPathName string) (*Statistics, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Statistics resource using the provided Statistics item.
-func (cr *StatisticsResource) Create(item Statistics) error {
-	// Marshal the Statistics struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Statistics resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StatisticsEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *StatisticsResource) Create(ctx context.Context, item Statistics) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a Statistics resource identified by its full path name using the provided Statistics item.
-func (cr *StatisticsResource) Update(fullPathName string, item Statistics) error {
-	// Marshal the Statistics struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *StatisticsResource) Update(ctx context.Context, fullPathName string, item Statistics) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified Statistics resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StatisticsEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Statistics identified by fullPathName, see bigip.PatchBody.
+func (cr *StatisticsResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a Statistics resource by its full path name.
-func (cr *StatisticsResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Statistics resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StatisticsEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *StatisticsResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type StreamList struct {
@@ -36,83 +34,37 @@ type StreamResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of Stream resources.
-func (cr *StreamResource) List() (*StreamList, error) {
-	var items StreamList
-	// Perform a GET request to retrieve a list of Stream resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing StreamResource.
+func (cr *StreamResource) collection() *bigip.Collection[Stream, StreamList] {
+	return bigip.NewCollection[Stream, StreamList](cr.b, LtmManager, ProfileEndpoint, StreamEndpoint)
+}
 
-	// Unmarshal JSON response data into StreamList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of Stream resources.
+func (cr *StreamResource) List(ctx context.Context, opts ...*rest.ListOptions) (*StreamList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a Stream resource by its full path name.
-func (cr *StreamResource) Get(fullPathName string) (*Stream, error) {
-	var item Stream
-	// Perform a GET request to retrieve a specific Stream resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into Stream struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *StreamResource) Get(ctx context.Context, fullPathName string) (*Stream, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new Stream resource using the provided Stream item.
-func (cr *StreamResource) Create(item Stream) error {
-	// Marshal the Stream struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new Stream resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *StreamResource) Create(ctx context.Context, item Stream) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a Stream resource identified by its full path name using the provided Stream item.
-func (cr *StreamResource) Update(fullPathName string, item Stream) error {
-	// Marshal the Stream struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *StreamResource) Update(ctx context.Context, fullPathName string, item Stream) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified Stream resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Stream identified by fullPathName, see bigip.PatchBody.
+func (cr *StreamResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a Stream resource by its full path name.
-func (cr *StreamResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified Stream resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(StreamEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *StreamResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TCPList struct {
@@ -113,83 +111,37 @@ type TCPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of TCP resources.
-func (cr *TCPResource) List() (*TCPList, error) {
-	var items TCPList
-	// Perform a GET request to retrieve a list of TCP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPResource.
+func (cr *TCPResource) collection() *bigip.Collection[TCP, TCPList] {
+	return bigip.NewCollection[TCP, TCPList](cr.b, LtmManager, ProfileEndpoint, TCPEndpoint)
+}
 
-	// Unmarshal JSON response data into TCPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of TCP resources.
+func (cr *TCPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a TCP resource by its full path name.
-func (cr *TCPResource) Get(fullPathName string) (*TCP, error) {
-	var item TCP
-	// Perform a GET request to retrieve a specific TCP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TCP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *TCPResource) Get(ctx context.Context, fullPathName string) (*TCP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new TCP resource using the provided TCP item.
-func (cr *TCPResource) Create(item TCP) error {
-	// Marshal the TCP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new TCP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TCPResource) Create(ctx context.Context, item TCP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a TCP resource identified by its full path name using the provided TCP item.
-func (cr *TCPResource) Update(fullPathName string, item TCP) error {
-	// Marshal the TCP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *TCPResource) Update(ctx context.Context, fullPathName string, item TCP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified TCP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TCP identified by fullPathName, see bigip.PatchBody.
+func (cr *TCPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a TCP resource by its full path name.
-func (cr *TCPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified TCP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TCPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TCPAnalyticsList struct {
@@ -45,83 +43,37 @@ type TCPAnalyticsResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of TCPAnalytics resources.
-func (cr *TCPAnalyticsResource) List() (*TCPAnalyticsList, error) {
-	var items TCPAnalyticsList
-	// Perform a GET request to retrieve a list of TCPAnalytics resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPAnalyticsEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TCPAnalyticsResource.
+func (cr *TCPAnalyticsResource) collection() *bigip.Collection[TCPAnalytics, TCPAnalyticsList] {
+	return bigip.NewCollection[TCPAnalytics, TCPAnalyticsList](cr.b, LtmManager, ProfileEndpoint, TCPAnalyticsEndpoint)
+}
 
-	// Unmarshal JSON response data into TCPAnalyticsList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of TCPAnalytics resources.
+func (cr *TCPAnalyticsResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TCPAnalyticsList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a TCPAnalytics resource by its full path name.
-func (cr *TCPAnalyticsResource) Get(fullPathName string) (*TCPAnalytics, error) {
-	var item TCPAnalytics
-	// Perform a GET request to retrieve a specific TCPAnalytics resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPAnalyticsEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TCPAnalytics struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *TCPAnalyticsResource) Get(ctx context.Context, fullPathName string) (*TCPAnalytics, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new TCPAnalytics resource using the provided TCPAnalytics item.
-func (cr *TCPAnalyticsResource) Create(item TCPAnalytics) error {
-	// Marshal the TCPAnalytics struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new TCPAnalytics resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPAnalyticsEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TCPAnalyticsResource) Create(ctx context.Context, item TCPAnalytics) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a TCPAnalytics resource identified by its full path name using the provided TCPAnalytics item.
-func (cr *TCPAnalyticsResource) Update(fullPathName string, item TCPAnalytics) error {
-	// Marshal the TCPAnalytics struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *TCPAnalyticsResource) Update(ctx context.Context, fullPathName string, item TCPAnalytics) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified TCPAnalytics resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPAnalyticsEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TCPAnalytics identified by fullPathName, see bigip.PatchBody.
+func (cr *TCPAnalyticsResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a TCPAnalytics resource by its full path name.
-func (cr *TCPAnalyticsResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified TCPAnalytics resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TCPAnalyticsEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TCPAnalyticsResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TDRList struct {
@@ -43,83 +41,37 @@ type TDRResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of TDR resources.
-func (cr *TDRResource) List() (*TDRList, error) {
-	var items TDRList
-	// Perform a GET request to retrieve a list of TDR resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TDRResource.
+func (cr *TDRResource) collection() *bigip.Collection[TDR, TDRList] {
+	return bigip.NewCollection[TDR, TDRList](cr.b, LtmManager, ProfileEndpoint, TDREndpoint)
+}
 
-	// Unmarshal JSON response data into TDRList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of TDR resources.
+func (cr *TDRResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TDRList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a TDR resource by its full path name.
-func (cr *TDRResource) Get(fullPathName string) (*TDR, error) {
-	var item TDR
-	// Perform a GET request to retrieve a specific TDR resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TDR struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *TDRResource) Get(ctx context.Context, fullPathName string) (*TDR, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new TDR resource using the provided TDR item.
-func (cr *TDRResource) Create(item TDR) error {
-	// Marshal the TDR struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new TDR resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TDRResource) Create(ctx context.Context, item TDR) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a TDR resource identified by its full path name using the provided TDR item.
-func (cr *TDRResource) Update(fullPathName string, item TDR) error {
-	// Marshal the TDR struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *TDRResource) Update(ctx context.Context, fullPathName string, item TDR) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified TDR resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TDR identified by fullPathName, see bigip.PatchBody.
+func (cr *TDRResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a TDR resource by its full path name.
-func (cr *TDRResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified TDR resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TDREndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TDRResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type TFTPList struct {
@@ -35,83 +33,37 @@ type TFTPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of TFTP resources.
-func (cr *TFTPResource) List() (*TFTPList, error) {
-	var items TFTPList
-	// Perform a GET request to retrieve a list of TFTP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TFTPResource.
+func (cr *TFTPResource) collection() *bigip.Collection[TFTP, TFTPList] {
+	return bigip.NewCollection[TFTP, TFTPList](cr.b, LtmManager, ProfileEndpoint, TFTPEndpoint)
+}
 
-	// Unmarshal JSON response data into TFTPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of TFTP resources.
+func (cr *TFTPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TFTPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a TFTP resource by its full path name.
-func (cr *TFTPResource) Get(fullPathName string) (*TFTP, error) {
-	var item TFTP
-	// Perform a GET request to retrieve a specific TFTP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into TFTP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *TFTPResource) Get(ctx context.Context, fullPathName string) (*TFTP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new TFTP resource using the provided TFTP item.
-func (cr *TFTPResource) Create(item TFTP) error {
-	// Marshal the TFTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new TFTP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TFTPResource) Create(ctx context.Context, item TFTP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a TFTP resource identified by its full path name using the provided TFTP item.
-func (cr *TFTPResource) Update(fullPathName string, item TFTP) error {
-	// Marshal the TFTP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *TFTPResource) Update(ctx context.Context, fullPathName string, item TFTP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified TFTP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the TFTP identified by fullPathName, see bigip.PatchBody.
+func (cr *TFTPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a TFTP resource by its full path name.
-func (cr *TFTPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified TFTP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(TFTPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *TFTPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
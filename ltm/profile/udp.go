@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type UDPList struct {
@@ -49,83 +47,37 @@ type UDPResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of UDP resources.
-func (cr *UDPResource) List() (*UDPList, error) {
-	var items UDPList
-	// Perform a GET request to retrieve a list of UDP resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing UDPResource.
+func (cr *UDPResource) collection() *bigip.Collection[UDP, UDPList] {
+	return bigip.NewCollection[UDP, UDPList](cr.b, LtmManager, ProfileEndpoint, UDPEndpoint)
+}
 
-	// Unmarshal JSON response data into UDPList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of UDP resources.
+func (cr *UDPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*UDPList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a UDP resource by its full path name.
-func (cr *UDPResource) Get(fullPathName string) (*UDP, error) {
-	var item UDP
-	// Perform a GET request to retrieve a specific UDP resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into UDP struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *UDPResource) Get(ctx context.Context, fullPathName string) (*UDP, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new UDP resource using the provided UDP item.
-func (cr *UDPResource) Create(item UDP) error {
-	// Marshal the UDP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new UDP resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *UDPResource) Create(ctx context.Context, item UDP) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a UDP resource identified by its full path name using the provided UDP item.
-func (cr *UDPResource) Update(fullPathName string, item UDP) error {
-	// Marshal the UDP struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *UDPResource) Update(ctx context.Context, fullPathName string, item UDP) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified UDP resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the UDP identified by fullPathName, see bigip.PatchBody.
+func (cr *UDPResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a UDP resource by its full path name.
-func (cr *UDPResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified UDP resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(UDPEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *UDPResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
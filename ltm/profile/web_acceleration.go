@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type WebAccelerationList struct {
@@ -49,83 +47,37 @@ type WebAccelerationResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of WebAcceleration resources.
-func (cr *WebAccelerationResource) List() (*WebAccelerationList, error) {
-	var items WebAccelerationList
-	// Perform a GET request to retrieve a list of WebAcceleration resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing WebAccelerationResource.
+func (cr *WebAccelerationResource) collection() *bigip.Collection[WebAcceleration, WebAccelerationList] {
+	return bigip.NewCollection[WebAcceleration, WebAccelerationList](cr.b, LtmManager, ProfileEndpoint, WebAccelerationEndpoint)
+}
 
-	// Unmarshal JSON response data into WebAccelerationList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of WebAcceleration resources.
+func (cr *WebAccelerationResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WebAccelerationList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a WebAcceleration resource by its full path name.
-func (cr *WebAccelerationResource) Get(fullPathName string) (*WebAcceleration, error) {
-	var item WebAcceleration
-	// Perform a GET request to retrieve a specific WebAcceleration resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into WebAcceleration struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *WebAccelerationResource) Get(ctx context.Context, fullPathName string) (*WebAcceleration, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new WebAcceleration resource using the provided WebAcceleration item.
-func (cr *WebAccelerationResource) Create(item WebAcceleration) error {
-	// Marshal the WebAcceleration struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new WebAcceleration resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *WebAccelerationResource) Create(ctx context.Context, item WebAcceleration) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a WebAcceleration resource identified by its full path name using the provided WebAcceleration item.
-func (cr *WebAccelerationResource) Update(fullPathName string, item WebAcceleration) error {
-	// Marshal the WebAcceleration struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *WebAccelerationResource) Update(ctx context.Context, fullPathName string, item WebAcceleration) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified WebAcceleration resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the WebAcceleration identified by fullPathName, see bigip.PatchBody.
+func (cr *WebAccelerationResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a WebAcceleration resource by its full path name.
-func (cr *WebAccelerationResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified WebAcceleration resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebAccelerationEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *WebAccelerationResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type WebSocketList struct {
@@ -39,83 +37,37 @@ type WebSocketResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of WebSocket resources.
-func (cr *WebSocketResource) List() (*WebSocketList, error) {
-	var items WebSocketList
-	// Perform a GET request to retrieve a list of WebSocket resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebSocketEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing WebSocketResource.
+func (cr *WebSocketResource) collection() *bigip.Collection[WebSocket, WebSocketList] {
+	return bigip.NewCollection[WebSocket, WebSocketList](cr.b, LtmManager, ProfileEndpoint, WebSocketEndpoint)
+}
 
-	// Unmarshal JSON response data into WebSocketList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of WebSocket resources.
+func (cr *WebSocketResource) List(ctx context.Context, opts ...*rest.ListOptions) (*WebSocketList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves a WebSocket resource by its full path name.
-func (cr *WebSocketResource) Get(fullPathName string) (*WebSocket, error) {
-	var item WebSocket
-	// Perform a GET request to retrieve a specific WebSocket resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebSocketEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into WebSocket struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *WebSocketResource) Get(ctx context.Context, fullPathName string) (*WebSocket, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new WebSocket resource using the provided WebSocket item.
-func (cr *WebSocketResource) Create(item WebSocket) error {
-	// Marshal the WebSocket struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new WebSocket resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebSocketEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *WebSocketResource) Create(ctx context.Context, item WebSocket) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies a WebSocket resource identified by its full path name using the provided WebSocket item.
-func (cr *WebSocketResource) Update(fullPathName string, item WebSocket) error {
-	// Marshal the WebSocket struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *WebSocketResource) Update(ctx context.Context, fullPathName string, item WebSocket) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified WebSocket resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebSocketEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the WebSocket identified by fullPathName, see bigip.PatchBody.
+func (cr *WebSocketResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes a WebSocket resource by its full path name.
-func (cr *WebSocketResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified WebSocket resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(WebSocketEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *WebSocketResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
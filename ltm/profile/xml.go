@@ -2,10 +2,8 @@ package profile
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 type XMLList struct {
@@ -35,83 +33,37 @@ type XMLResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves a list of XML resources.
-func (cr *XMLResource) List() (*XMLList, error) {
-	var items XMLList
-	// Perform a GET request to retrieve a list of XML resource objects
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(XMLEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing XMLResource.
+func (cr *XMLResource) collection() *bigip.Collection[XML, XMLList] {
+	return bigip.NewCollection[XML, XMLList](cr.b, LtmManager, ProfileEndpoint, XMLEndpoint)
+}
 
-	// Unmarshal JSON response data into XMLList struct
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves a list of XML resources.
+func (cr *XMLResource) List(ctx context.Context, opts ...*rest.ListOptions) (*XMLList, error) {
+	return cr.collection().List(ctx, opts...)
 }
 
 // Get retrieves an XML resource by its full path name.
-func (cr *XMLResource) Get(fullPathName string) (*XML, error) {
-	var item XML
-	// Perform a GET request to retrieve a specific XML resource by its full path name
-	res, err := cr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(XMLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal JSON response data into XML struct
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (cr *XMLResource) Get(ctx context.Context, fullPathName string) (*XML, error) {
+	return cr.collection().Get(ctx, fullPathName)
 }
 
 // Create adds a new XML resource using the provided XML item.
-func (cr *XMLResource) Create(item XML) error {
-	// Marshal the XML struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-
-	// Perform a POST request to create a new XML resource using the JSON data
-	_, err = cr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(XMLEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *XMLResource) Create(ctx context.Context, item XML) error {
+	return cr.collection().Create(ctx, item)
 }
 
 // Update modifies an XML resource identified by its full path name using the provided XML item.
-func (cr *XMLResource) Update(fullPathName string, item XML) error {
-	// Marshal the XML struct into JSON data
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
+func (cr *XMLResource) Update(ctx context.Context, fullPathName string, item XML) error {
+	return cr.collection().Update(ctx, fullPathName, item)
+}
 
-	// Perform a PUT request to update the specified XML resource with the JSON data
-	_, err = cr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(XMLEndpoint).SubResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the XML identified by fullPathName, see bigip.PatchBody.
+func (cr *XMLResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return cr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete removes an XML resource by its full path name.
-func (cr *XMLResource) Delete(fullPathName string) error {
-	// Perform a DELETE request to delete the specified XML resource
-	_, err := cr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(ProfileEndpoint).SubResource(XMLEndpoint).SubResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (cr *XMLResource) Delete(ctx context.Context, fullPathName string) error {
+	return cr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A RuleList contains a list of iRule urations.
@@ -44,66 +42,32 @@ type RuleResource struct {
 	b *bigip.BigIP
 }
 
-func (rr *RuleResource) List() (*RuleList, error) {
-	res, err := rr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(RuleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	var rl RuleList
-	if err := json.Unmarshal(res, &rl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &rl, nil
+// collection returns the typed collection backing RuleResource.
+func (rr *RuleResource) collection() *bigip.Collection[Rule, RuleList] {
+	return bigip.NewCollection[Rule, RuleList](rr.b, LtmManager, RuleEndpoint)
 }
 
-func (rr *RuleResource) Get(name string) (*Rule, error) {
-	res, err := rr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(RuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+func (rr *RuleResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RuleList, error) {
+	return rr.collection().List(ctx, opts...)
+}
+
+func (rr *RuleResource) Get(ctx context.Context, name string) (*Rule, error) {
+	return rr.collection().Get(ctx, name)
+}
 
-	var rule Rule
-	if err := json.Unmarshal(res, &rule); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &rule, nil
+func (rr *RuleResource) Create(ctx context.Context, item Rule) error {
+	return rr.collection().Create(ctx, item)
 }
 
-func (rr *RuleResource) Create(item Rule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(RuleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rr *RuleResource) Update(ctx context.Context, name string, item Rule) error {
+	return rr.collection().Update(ctx, name, item)
 }
 
-func (rr *RuleResource) Update(name string, item Rule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(RuleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+// Patch updates only the given fields of the Rule identified by name, see bigip.PatchBody.
+func (rr *RuleResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return rr.collection().Patch(ctx, name, fields)
 }
 
-func (rr *RuleResource) Delete(name string) error {
-	_, err := rr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(RuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rr *RuleResource) Delete(ctx context.Context, name string) error {
+	return rr.collection().Delete(ctx, name)
 }
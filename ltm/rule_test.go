@@ -1,13 +1,13 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 
 	"testing"
 )
 
 func TestRuleResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -23,13 +23,13 @@ func TestRuleResource(t *testing.T) {
 		ApiAnonymous: ruleScript,
 	}
 
-	err = ruleResource.Create(rule)
+	err = ruleResource.Create(context.Background(), rule)
 	if err != nil {
 		t.Fatalf("Error creating iRule: %v", err)
 	}
 
 	// Get the iRule
-	ruleCheck, err := ruleResource.Get("/Common/test-rule")
+	ruleCheck, err := ruleResource.Get(context.Background(), "/Common/test-rule")
 	if err != nil {
 		t.Fatalf("Error getting iRule: %v", err)
 	}
@@ -42,13 +42,13 @@ func TestRuleResource(t *testing.T) {
 	updateruleScript := "when HTTP_REQUEST {HTTP::respond 200 content \"Hello, updated iRule!\"}"
 	// Update properties of the iRule
 	ruleCheck.ApiAnonymous = updateruleScript
-	err = ruleResource.Update("test-rule", *ruleCheck)
+	err = ruleResource.Update(context.Background(), "test-rule", *ruleCheck)
 	if err != nil {
 		t.Fatalf("Error updating iRule: %v", err)
 	}
 
 	// Validate that properties were updated
-	updatedRule, err := ruleResource.Get("/Common/test-rule")
+	updatedRule, err := ruleResource.Get(context.Background(), "/Common/test-rule")
 	if err != nil {
 		t.Fatalf("Error getting updated iRule: %v", err)
 	}
@@ -57,13 +57,13 @@ func TestRuleResource(t *testing.T) {
 	}
 
 	// Delete the iRule
-	err = ruleResource.Delete("test-rule")
+	err = ruleResource.Delete(context.Background(), "test-rule")
 	if err != nil {
 		t.Fatalf("Error deleting iRule: %v", err)
 	}
 
 	// Validate that iRule was deleted
-	if _, err := ruleResource.Get("/Common/test-rule"); err == nil {
+	if _, err := ruleResource.Get(context.Background(), "/Common/test-rule"); err == nil {
 		t.Error("iRule still exists after attempting deletion")
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -45,81 +46,50 @@ type SnatTranslationResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing SnatTranslationResource.
+func (str *SnatTranslationResource) collection() *bigip.Collection[SnatTranslation, SnatTranslationList] {
+	return bigip.NewCollection[SnatTranslation, SnatTranslationList](str.b, LtmManager, SnatTranslationEndpoint)
+}
+
 // SnatTranslationEndpoint represents the REST resource for managing SnatTranslation.
 const SnatTranslationEndpoint = "snat-translation"
 
 // List all the SnatTranslationstate instances.
-func (str *SnatTranslationResource) List() (*SnatTranslationList, error) {
-	res, err := str.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var items SnatTranslationList
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (str *SnatTranslationResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SnatTranslationList, error) {
+	return str.collection().List(ctx, opts...)
 }
 
 // Get a single SnatTranslation identified by name.
-func (str *SnatTranslationResource) Get(fullPathName string) (*SnatTranslation, error) {
-	res, err := str.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var item SnatTranslation
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (str *SnatTranslationResource) Get(ctx context.Context, fullPathName string) (*SnatTranslation, error) {
+	return str.collection().Get(ctx, fullPathName)
 }
 
 // Create a new SnatTranslation instance.
-func (str *SnatTranslationResource) Create(item SnatTranslation) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = str.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (str *SnatTranslationResource) Create(ctx context.Context, item SnatTranslation) error {
+	return str.collection().Create(ctx, item)
 }
 
 // Update a SnatTranslation instance identified by name.
-func (str *SnatTranslationResource) Update(fullPathName string, item SnatTranslation) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = str.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (str *SnatTranslationResource) Update(ctx context.Context, fullPathName string, item SnatTranslation) error {
+	return str.collection().Update(ctx, fullPathName, item)
+}
+
+// Patch updates only the given fields of the SnatTranslation identified by fullPathName, see bigip.PatchBody.
+func (str *SnatTranslationResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return str.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete a single SnatTranslation instance identified by name.
-func (str *SnatTranslationResource) Delete(fullPathName string) error {
-	_, err := str.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (str *SnatTranslationResource) Delete(ctx context.Context, fullPathName string) error {
+	return str.collection().Delete(ctx, fullPathName)
 }
 
 // Enabling a SnatTranslation item identified by the SnatTranslation name.
-func (str *SnatTranslationResource) Enable(name string) error {
+func (str *SnatTranslationResource) Enable(ctx context.Context, name string) error {
+	name, err := str.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := SnatTranslation{Enabled: true}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -127,7 +97,7 @@ func (str *SnatTranslationResource) Enable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = str.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -135,7 +105,11 @@ func (str *SnatTranslationResource) Enable(name string) error {
 }
 
 // Disabling a SnatTranslation item identified by the SnatTranslationname.
-func (str *SnatTranslationResource) Disable(name string) error {
+func (str *SnatTranslationResource) Disable(ctx context.Context, name string) error {
+	name, err := str.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := SnatTranslation{Disabled: true}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -143,7 +117,7 @@ func (str *SnatTranslationResource) Disable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = str.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SnatTranslationEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
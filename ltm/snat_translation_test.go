@@ -1,12 +1,12 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 	"testing"
 )
 
 func TestSnatTranslationstateResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -24,12 +24,12 @@ func TestSnatTranslationstateResource(t *testing.T) {
 		Address:         "152.16.11.11",
 		ConnectionLimit: 10,
 	}
-	if err := snatTranslationResource.Create(snatTranslationstate); err != nil {
+	if err := snatTranslationResource.Create(context.Background(), snatTranslationstate); err != nil {
 		t.Fatalf("Error creating SnatTranslationstate: %v", err)
 	}
 
 	// Get the SnatTranslationstate
-	snatTranslationstateCheck, err := snatTranslationResource.Get(fullPathName)
+	snatTranslationstateCheck, err := snatTranslationResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Fatalf("Error getting SnatTranslationstate: %v", err)
 	}
@@ -41,12 +41,12 @@ func TestSnatTranslationstateResource(t *testing.T) {
 
 	// Update the SnatTranslationstate
 	snatTranslationstateCheck.ConnectionLimit = 123
-	if err := snatTranslationResource.Update(fullPathName, *snatTranslationstateCheck); err != nil {
+	if err := snatTranslationResource.Update(context.Background(), fullPathName, *snatTranslationstateCheck); err != nil {
 		t.Fatalf("Error updating SnatTranslationstate: %v", err)
 	}
 
 	// Validate that properties were updated
-	snatTranslationstateUpdated, err := snatTranslationResource.Get(fullPathName)
+	snatTranslationstateUpdated, err := snatTranslationResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Fatalf("Error getting updated SnatTranslationstate: %v", err)
 	}
@@ -55,12 +55,12 @@ func TestSnatTranslationstateResource(t *testing.T) {
 	}
 
 	// Disable the SnatTranslation
-	if err := snatTranslationResource.Disable(fullPathName); err != nil {
+	if err := snatTranslationResource.Disable(context.Background(), fullPathName); err != nil {
 		t.Fatalf("Error disabling SnatTranslation: %v", err)
 	}
 
 	// Validate that SnatTranslation was disabled
-	snatTranslationStat, err := snatTranslationResource.Get(fullPathName)
+	snatTranslationStat, err := snatTranslationResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Error("SnatTranslation still exists after attempting deletion")
 	}
@@ -70,12 +70,12 @@ func TestSnatTranslationstateResource(t *testing.T) {
 	}
 
 	// Enable the SnatTranslation
-	if err := snatTranslationResource.Enable(fullPathName); err != nil {
+	if err := snatTranslationResource.Enable(context.Background(), fullPathName); err != nil {
 		t.Fatalf("Error Enabling SnatTranslation: %v", err)
 	}
 
 	// Validate that SnatTranslation was enbled
-	snatTranslationStat, err = snatTranslationResource.Get(fullPathName)
+	snatTranslationStat, err = snatTranslationResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Error("SnatTranslation still exists after attempting deletion")
 	}
@@ -85,12 +85,12 @@ func TestSnatTranslationstateResource(t *testing.T) {
 	}
 
 	// Delete the SnatTranslationstate
-	if err := snatTranslationResource.Delete(fullPathName); err != nil {
+	if err := snatTranslationResource.Delete(context.Background(), fullPathName); err != nil {
 		t.Fatalf("Error deleting SnatTranslationstate: %v", err)
 	}
 
 	// Validate that SnatTranslationstate was deleted
-	if _, err := snatTranslationResource.Get(fullPathName); err == nil {
+	if _, err := snatTranslationResource.Get(context.Background(), fullPathName); err == nil {
 		t.Error("SnatTranslationstate still exists after attempting deletion")
 	}
 }
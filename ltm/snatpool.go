@@ -2,10 +2,8 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SnatPoolList is a list contains multiple SnatPool objects.
@@ -34,75 +32,40 @@ type SnatPoolResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing SnatPoolResource.
+func (spr *SnatPoolResource) collection() *bigip.Collection[SnatPool, SnatPoolList] {
+	return bigip.NewCollection[SnatPool, SnatPoolList](spr.b, LtmManager, SnatPoolEndpoint)
+}
+
 // SnatPoolEndpoint represents the REST resource for managing SnatPool.
 const SnatPoolEndpoint = "snatpool"
 
 // List all the snatpool instances.
-func (spr *SnatPoolResource) List() (*SnatPoolList, error) {
-	res, err := spr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatPoolEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var spl SnatPoolList
-	if err := json.Unmarshal(res, &spl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &spl, nil
+func (spr *SnatPoolResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SnatPoolList, error) {
+	return spr.collection().List(ctx, opts...)
 }
 
 // Get a single snatpool identified by name.
-func (spr *SnatPoolResource) Get(fullPathName string) (*SnatPool, error) {
-	res, err := spr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatPoolEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var sp SnatPool
-	if err := json.Unmarshal(res, &sp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &sp, nil
+func (spr *SnatPoolResource) Get(ctx context.Context, fullPathName string) (*SnatPool, error) {
+	return spr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new snatpool instance.
-func (pr *SnatPoolResource) Create(item SnatPool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = pr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatPoolEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (pr *SnatPoolResource) Create(ctx context.Context, item SnatPool) error {
+	return pr.collection().Create(ctx, item)
 }
 
 // Update a snatpool instance identified by name.
-func (pr *SnatPoolResource) Update(fullPathName string, item SnatPool) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = pr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatPoolEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (pr *SnatPoolResource) Update(ctx context.Context, fullPathName string, item SnatPool) error {
+	return pr.collection().Update(ctx, fullPathName, item)
+}
+
+// Patch updates only the given fields of the SnatPool identified by fullPathName, see bigip.PatchBody.
+func (pr *SnatPoolResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return pr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete a single pool instance identified by name.
-func (pr *SnatPoolResource) Delete(fullPathName string) error {
-	_, err := pr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(SnatPoolEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (pr *SnatPoolResource) Delete(ctx context.Context, fullPathName string) error {
+	return pr.collection().Delete(ctx, fullPathName)
 }
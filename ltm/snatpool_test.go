@@ -1,12 +1,12 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 	"testing"
 )
 
 func TestSnatPoolResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -24,12 +24,12 @@ func TestSnatPoolResource(t *testing.T) {
 
 		Members: []string{"192.168.1.10"},
 	}
-	if err := snatPoolResource.Create(snatPool); err != nil {
+	if err := snatPoolResource.Create(context.Background(), snatPool); err != nil {
 		t.Fatalf("Error creating SnatPool: %v", err)
 	}
 
 	// Get the SnatPool
-	snatPoolCheck, err := snatPoolResource.Get(fullPathName)
+	snatPoolCheck, err := snatPoolResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Fatalf("Error getting SnatPool: %v", err)
 	}
@@ -41,12 +41,12 @@ func TestSnatPoolResource(t *testing.T) {
 
 	// Update the SnatPool
 	snatPoolCheck.Members = []string{"192.168.1.20"}
-	if err := snatPoolResource.Update(fullPathName, *snatPoolCheck); err != nil {
+	if err := snatPoolResource.Update(context.Background(), fullPathName, *snatPoolCheck); err != nil {
 		t.Fatalf("Error updating SnatPool: %v", err)
 	}
 
 	// Validate that properties were updated
-	snatPoolUpdated, err := snatPoolResource.Get(fullPathName)
+	snatPoolUpdated, err := snatPoolResource.Get(context.Background(), fullPathName)
 	if err != nil {
 		t.Fatalf("Error getting updated SnatPool: %v", err)
 	}
@@ -55,12 +55,12 @@ func TestSnatPoolResource(t *testing.T) {
 	}
 
 	// Delete the SnatPool
-	if err := snatPoolResource.Delete(fullPathName); err != nil {
+	if err := snatPoolResource.Delete(context.Background(), fullPathName); err != nil {
 		t.Fatalf("Error deleting SnatPool: %v", err)
 	}
 
 	// Validate that SnatPool was deleted
-	if _, err := snatPoolResource.Get(fullPathName); err == nil {
+	if _, err := snatPoolResource.Get(context.Background(), fullPathName); err == nil {
 		t.Error("SnatPool still exists after attempting deletion")
 	}
 }
@@ -2,10 +2,8 @@ package ltm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 //	You can use this traffic-matching-criteria component to configure the
@@ -56,25 +54,20 @@ type TrafficMatchingCriteriaResource struct {
 	b *bigip.BigIP
 }
 
-// List all TrafficMatchingCriteria details
-func (tmcr *TrafficMatchingCriteriaResource) List() (*TrafficMatchingCriteriaList, error) {
-	var nl TrafficMatchingCriteriaList
-	res, err := tmcr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TrafficMatchingCriteriaResource.
+func (tmcr *TrafficMatchingCriteriaResource) collection() *bigip.Collection[TrafficMatchingCriteria, TrafficMatchingCriteriaList] {
+	return bigip.NewCollection[TrafficMatchingCriteria, TrafficMatchingCriteriaList](tmcr.b, LtmManager, TrafficMatchingCriteriaEndpoint)
+}
 
-	if err := json.Unmarshal(res, &nl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &nl, nil
+// List all TrafficMatchingCriteria details
+func (tmcr *TrafficMatchingCriteriaResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TrafficMatchingCriteriaList, error) {
+	return tmcr.collection().List(ctx, opts...)
 }
 
 // ListName all TrafficMatchingCriteria fullpath name
-func (tmcr *TrafficMatchingCriteriaResource) ListName() ([]string, error) {
+func (tmcr *TrafficMatchingCriteriaResource) ListName(ctx context.Context) ([]string, error) {
 	items := &TrafficMatchingCriteriaList{}
-	items, err := tmcr.List()
+	items, err := tmcr.List(ctx, &rest.ListOptions{Select: []string{"fullPath"}})
 	if err != nil {
 		return nil, err
 	}
@@ -87,55 +80,26 @@ func (tmcr *TrafficMatchingCriteriaResource) ListName() ([]string, error) {
 }
 
 // Get a single TrafficMatchingCriteria details by the TrafficMatchingCriteria name
-func (tmcr *TrafficMatchingCriteriaResource) Get(fullPathName string) (*TrafficMatchingCriteria, error) {
-	var node TrafficMatchingCriteria
-	res, err := tmcr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &node); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &node, nil
+func (tmcr *TrafficMatchingCriteriaResource) Get(ctx context.Context, fullPathName string) (*TrafficMatchingCriteria, error) {
+	return tmcr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new TrafficMatchingCriteria item
-func (tmcr *TrafficMatchingCriteriaResource) Create(item TrafficMatchingCriteria) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = tmcr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (tmcr *TrafficMatchingCriteriaResource) Create(ctx context.Context, item TrafficMatchingCriteria) error {
+	return tmcr.collection().Create(ctx, item)
 }
 
 // Update the TrafficMatchingCriteria item identified by the TrafficMatchingCriteria name, otherwise an error will be reported.
-func (tmcr *TrafficMatchingCriteriaResource) Update(fullPathName string, item TrafficMatchingCriteria) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = tmcr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (tmcr *TrafficMatchingCriteriaResource) Update(ctx context.Context, fullPathName string, item TrafficMatchingCriteria) error {
+	return tmcr.collection().Update(ctx, fullPathName, item)
+}
+
+// Patch updates only the given fields of the TrafficMatchingCriteria identified by fullPathName, see bigip.PatchBody.
+func (tmcr *TrafficMatchingCriteriaResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return tmcr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete a single TrafficMatchingCriteria identified by the TrafficMatchingCriteria name. if it is not exist return error
-func (tmcr *TrafficMatchingCriteriaResource) Delete(fullPathName string) error {
-	_, err := tmcr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(TrafficMatchingCriteriaEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (tmcr *TrafficMatchingCriteriaResource) Delete(ctx context.Context, fullPathName string) error {
+	return tmcr.collection().Delete(ctx, fullPathName)
 }
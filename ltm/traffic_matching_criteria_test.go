@@ -1,13 +1,12 @@
 package ltm
 
 import (
+	"context"
 	"testing"
-
-	"github.com/lefeck/go-bigip"
 )
 
 func TestTrafficMatchingCriteriaResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.13.91", "admin", "MsTac@2001")
+	bigIP, err := newTestSession(t, "192.168.13.91", "admin", "MsTac@2001")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -31,12 +30,12 @@ func TestTrafficMatchingCriteriaResource(t *testing.T) {
 		SourceAddressList:   "/Common/pp2",
 		SourcePortInline:    0,
 	}
-	if err := tcResource.Create(tc); err != nil {
+	if err := tcResource.Create(context.Background(), tc); err != nil {
 		t.Fatalf("Error creating TrafficMatchingCriteria: %v", err)
 	}
 
 	// Get the TrafficMatchingCriteria
-	tcCheck, err := tcResource.Get("/Common/test_traffic_matching_criteria")
+	tcCheck, err := tcResource.Get(context.Background(), "/Common/test_traffic_matching_criteria")
 	if err != nil {
 		t.Fatalf("Error getting TrafficMatchingCriteria: %v", err)
 	}
@@ -48,12 +47,12 @@ func TestTrafficMatchingCriteriaResource(t *testing.T) {
 
 	// Update properties of the TrafficMatchingCriteria
 	tcCheck.DestinationPortInline = "0"
-	if err := tcResource.Update(tcCheck.FullPath, *tcCheck); err != nil {
+	if err := tcResource.Update(context.Background(), tcCheck.FullPath, *tcCheck); err != nil {
 		t.Fatalf("Error updating TrafficMatchingCriteria: %v", err)
 	}
 
 	// Validate that properties were updated
-	tcUpdated, err := tcResource.Get("/Common/test_traffic_matching_criteria")
+	tcUpdated, err := tcResource.Get(context.Background(), "/Common/test_traffic_matching_criteria")
 	if err != nil {
 		t.Fatalf("Error getting updated TrafficMatchingCriteria: %v", err)
 	}
@@ -62,12 +61,12 @@ func TestTrafficMatchingCriteriaResource(t *testing.T) {
 	}
 
 	// Delete the TrafficMatchingCriteria
-	if err := tcResource.Delete("/Common/test_traffic_matching_criteria"); err != nil {
+	if err := tcResource.Delete(context.Background(), "/Common/test_traffic_matching_criteria"); err != nil {
 		t.Fatalf("Error deleting TrafficMatchingCriteria: %v", err)
 	}
 
 	// Validate that TrafficMatchingCriteria was deleted
-	if _, err := tcResource.Get("/Common/test_traffic_matching_criteria"); err == nil {
+	if _, err := tcResource.Get(context.Background(), "/Common/test_traffic_matching_criteria"); err == nil {
 		t.Error("TrafficMatchingCriteria still exists after attempting deletion")
 	}
 }
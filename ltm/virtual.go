@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 	"time"
 )
@@ -106,40 +107,36 @@ type VirtualResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing VirtualResource.
+func (vr *VirtualResource) collection() *bigip.Collection[VirtualServer, VirtualServerList] {
+	return bigip.NewCollection[VirtualServer, VirtualServerList](vr.b, LtmManager, VirtualEndpoint)
+}
+
 // List all virtual server items
-func (vr *VirtualResource) List() (*VirtualServerList, error) {
+func (vr *VirtualResource) List(ctx context.Context, opts ...*rest.ListOptions) (*VirtualServerList, error) {
+	return vr.collection().List(ctx, opts...)
+}
 
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// Pages walks the virtual server collection one page at a time, see bigip.Collection.Pages.
+func (vr *VirtualResource) Pages(ctx context.Context, fn func(page *bigip.Page[VirtualServer]) error, opts ...*rest.ListOptions) error {
+	return vr.collection().Pages(ctx, fn, opts...)
+}
 
-	var vsl VirtualServerList
-	if err := json.Unmarshal(res, &vsl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vsl, nil
+// Each calls fn for every virtual server without loading the whole collection in memory.
+// Return bigip.ErrStopIteration from fn to stop early.
+func (vr *VirtualResource) Each(ctx context.Context, fn func(item *VirtualServer) error, opts ...*rest.ListOptions) error {
+	return vr.collection().Each(ctx, fn, opts...)
 }
 
 // List all the details of the virtual server, including: profile, policy, etc.
-func (vr *VirtualResource) ListDetail() (*VirtualServerList, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SetParams("expandSubcollections", "true").DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var vsl VirtualServerList
-	if err := json.Unmarshal(res, &vsl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vsl, nil
+func (vr *VirtualResource) ListDetail(ctx context.Context, opts ...*rest.ListOptions) (*VirtualServerList, error) {
+	opts = append(opts, &rest.ListOptions{ExpandSubcollections: true})
+	return vr.collection().List(ctx, opts...)
 }
 
 // ListVirtualServerName get all virtual server names
-func (vr *VirtualResource) ListVirtualServerName() ([]string, error) {
-	vsl, err := vr.List()
+func (vr *VirtualResource) ListVirtualServerName(ctx context.Context) ([]string, error) {
+	vsl, err := vr.List(ctx, &rest.ListOptions{Select: []string{"fullPath"}})
 	if err != nil {
 		return nil, err
 	}
@@ -152,62 +149,48 @@ func (vr *VirtualResource) ListVirtualServerName() ([]string, error) {
 }
 
 // Get a single virtual server identified by name.
-func (vr *VirtualResource) Get(fullPathName string) (*VirtualServer, error) {
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var vs VirtualServer
-	if err := json.Unmarshal(res, &vs); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vs, nil
+func (vr *VirtualResource) Get(ctx context.Context, fullPathName string) (*VirtualServer, error) {
+	return vr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new virtual server item
-func (vr *VirtualResource) Create(item VirtualServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VirtualResource) Create(ctx context.Context, item VirtualServer) error {
+	return vr.collection().Create(ctx, item)
 }
 
 // Update the virtual server identified by the virtual server name
-func (vr *VirtualResource) Update(name string, item VirtualServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VirtualResource) Update(ctx context.Context, name string, item VirtualServer) error {
+	return vr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the VirtualServer identified by name, see bigip.PatchBody.
+func (vr *VirtualResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return vr.collection().Patch(ctx, name, fields)
+}
+
+// UpdateIfUnchanged updates the virtual server identified by name only if it was not modified since
+// item was read, see bigip.Collection.UpdateIfUnchanged.
+func (vr *VirtualResource) UpdateIfUnchanged(ctx context.Context, name string, item VirtualServer) error {
+	return vr.collection().UpdateIfUnchanged(ctx, name, item)
+}
+
+// Modify reads the virtual server identified by name, changes it with mutate and updates it,
+// starting over if it was modified concurrently, see bigip.Collection.Modify.
+func (vr *VirtualResource) Modify(ctx context.Context, name string, mutate func(item *VirtualServer) error) error {
+	return vr.collection().Modify(ctx, name, mutate)
 }
 
 // Delete a single virtual server identified by the virtual server name. if it is not exist return error
-func (vr *VirtualResource) Delete(name string) error {
-	_, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VirtualResource) Delete(ctx context.Context, name string) error {
+	return vr.collection().Delete(ctx, name)
 }
 
 // Enabling a virtual server item identified by the virtual server name.
-func (vr *VirtualResource) Enable(name string) error {
+func (vr *VirtualResource) Enable(ctx context.Context, name string) error {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := VirtualServer{Enabled: true}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -215,7 +198,7 @@ func (vr *VirtualResource) Enable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -223,7 +206,11 @@ func (vr *VirtualResource) Enable(name string) error {
 }
 
 // Disabling a virtual server item identified by the virtual server name.
-func (vr *VirtualResource) Disable(name string) error {
+func (vr *VirtualResource) Disable(ctx context.Context, name string) error {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := VirtualServer{Disabled: true}
 
 	jsonData, err := json.Marshal(item)
@@ -232,7 +219,7 @@ func (vr *VirtualResource) Disable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(VirtualEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -240,14 +227,18 @@ func (vr *VirtualResource) Disable(name string) error {
 }
 
 // removes a single iRule from the virtual server identified by virtual server name.
-func (vr *VirtualResource) RemoveRuleForVirtualServer(vsName, ruleName string) error {
+func (vr *VirtualResource) RemoveRuleForVirtualServer(ctx context.Context, vsName, ruleName string) error {
+	vsName, err := vr.b.Qualify(vsName)
+	if err != nil {
+		return err
+	}
 	item := VirtualServer{
 		Rules: []string{
 			ruleName,
 		},
 	}
 	res, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(vsName).Body(item).DoRaw(context.Background())
+		Resource(VirtualEndpoint).ResourceInstance(vsName).Body(item).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -259,9 +250,13 @@ func (vr *VirtualResource) RemoveRuleForVirtualServer(vsName, ruleName string) e
 }
 
 // gets the iRules for a virtual server identified by name.
-func (vr *VirtualResource) GetRulesByVirtualServer(name string) ([]Rule, error) {
+func (vr *VirtualResource) GetRulesByVirtualServer(ctx context.Context, name string) ([]Rule, error) {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(VirtualEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -274,14 +269,18 @@ func (vr *VirtualResource) GetRulesByVirtualServer(name string) ([]Rule, error)
 }
 
 // adds an iRule to the virtual server identified by name.
-func (vr *VirtualResource) AddRuleForVirtualServer(vsName string, rule Rule) error {
+func (vr *VirtualResource) AddRuleForVirtualServer(ctx context.Context, vsName string, rule Rule) error {
+	vsName, err := vr.b.Qualify(vsName)
+	if err != nil {
+		return err
+	}
 	jsonData, err := json.Marshal(rule)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SubResource(RuleEndpoint).ResourceInstance(vsName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(VirtualEndpoint).SubResource(RuleEndpoint).ResourceInstance(vsName).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -50,40 +51,30 @@ type VirtualAddressResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves the list of all virtual addresses configured on the BIG-IP.
-func (vars *VirtualAddressResource) List() (*VirtualAddressList, error) {
-	var val VirtualAddressList
-	res, err := vars.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing VirtualAddressResource.
+func (vars *VirtualAddressResource) collection() *bigip.Collection[VirtualAddress, VirtualAddressList] {
+	return bigip.NewCollection[VirtualAddress, VirtualAddressList](vars.b, LtmManager, VirtualAddressEndpoint)
+}
 
-	if err := json.Unmarshal(res, &val); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &val, nil
+// List retrieves the list of all virtual addresses configured on the BIG-IP.
+func (vars *VirtualAddressResource) List(ctx context.Context, opts ...*rest.ListOptions) (*VirtualAddressList, error) {
+	return vars.collection().List(ctx, opts...)
 }
 
 // Get retrieves a single virtual address configuration identified by fullPathName.
-func (vars *VirtualAddressResource) Get(fullPathName string) (*VirtualAddress, error) {
-	var va VirtualAddress
-	res, err := vars.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &va); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &va, nil
+func (vars *VirtualAddressResource) Get(ctx context.Context, fullPathName string) (*VirtualAddress, error) {
+	return vars.collection().Get(ctx, fullPathName)
 }
 
 // GetAddressByVirtualServerName retrieves the IP address for a given virtual server identified by fullPathName.
-func (vars *VirtualAddressResource) GetAddressByVirtualServerName(fullPathName string) (string, error) {
+func (vars *VirtualAddressResource) GetAddressByVirtualServerName(ctx context.Context, fullPathName string) (string, error) {
+	fullPathName, err := vars.b.Qualify(fullPathName)
+	if err != nil {
+		return "", err
+	}
 	var va VirtualAddress
 	res, err := vars.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).ResourceInstance(fullPathName).DoRaw(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -93,33 +84,32 @@ func (vars *VirtualAddressResource) GetAddressByVirtualServerName(fullPathName s
 	return va.Address, nil
 }
 
+// Create adds a virtual address, which BIG-IP otherwise creates with the first virtual server using it.
+func (vr *VirtualAddressResource) Create(ctx context.Context, item VirtualAddress) error {
+	return vr.collection().Create(ctx, item)
+}
+
 // Update modifies a virtual address configuration identified by name with the given VirtualAddress object.
-func (vr *VirtualAddressResource) Update(name string, item VirtualAddress) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VirtualAddressResource) Update(ctx context.Context, name string, item VirtualAddress) error {
+	return vr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the VirtualAddress identified by name, see bigip.PatchBody.
+func (vr *VirtualAddressResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return vr.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single virtual address identified by the virtual address name. if it is not exist return error
-func (vr *VirtualAddressResource) Delete(name string) error {
-	_, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VirtualAddressResource) Delete(ctx context.Context, name string) error {
+	return vr.collection().Delete(ctx, name)
 }
 
 // Enabling a virtual address item identified by the virtual address.
-func (vr *VirtualAddressResource) Enable(name string) error {
+func (vr *VirtualAddressResource) Enable(ctx context.Context, name string) error {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := VirtualAddress{Enabled: "yes"}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
@@ -127,7 +117,7 @@ func (vr *VirtualAddressResource) Enable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -135,7 +125,11 @@ func (vr *VirtualAddressResource) Enable(name string) error {
 }
 
 // Disabling a virtual address item identified by the virtual address.
-func (vr *VirtualAddressResource) Disable(name string) error {
+func (vr *VirtualAddressResource) Disable(ctx context.Context, name string) error {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	item := VirtualAddress{Enabled: "no"}
 
 	jsonData, err := json.Marshal(item)
@@ -144,7 +138,7 @@ func (vr *VirtualAddressResource) Disable(name string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = vr.b.RestClient.Patch().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
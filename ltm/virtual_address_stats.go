@@ -47,9 +47,9 @@ type VirtualAddressStatsResource struct {
 	b *bigip.BigIP
 }
 
-func (vasr *VirtualAddressStatsResource) List() (*VirtualAddressStatsList, error) {
+func (vasr *VirtualAddressStatsResource) List(ctx context.Context) (*VirtualAddressStatsList, error) {
 	res, err := vasr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -58,12 +58,17 @@ func (vasr *VirtualAddressStatsResource) List() (*VirtualAddressStatsList, error
 	if err := json.Unmarshal(res, &vasl); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(vasr.b, vasl.Entries)
 	return &vasl, nil
 }
 
-func (vasr *VirtualAddressStatsResource) Get(name string) (*VirtualAddressStatsList, error) {
+func (vasr *VirtualAddressStatsResource) Get(ctx context.Context, name string) (*VirtualAddressStatsList, error) {
+	name, err := vasr.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	res, err := vasr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualAddressEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualAddressEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
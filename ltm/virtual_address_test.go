@@ -1,12 +1,12 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 	"testing"
 )
 
 func TestVirtualAddressResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -15,47 +15,58 @@ func TestVirtualAddressResource(t *testing.T) {
 		b: bigIP,
 	}
 
+	// Create a Virtual Address
+	if err := virtualAddressResource.Create(context.Background(), VirtualAddress{Name: "10.10.10.10", Partition: "Common", Address: "10.10.10.10"}); err != nil {
+		t.Fatalf("Error creating Virtual Address: %v", err)
+	}
+
 	// List all Virtual Addresses
-	virtualAddressList, err := virtualAddressResource.List()
+	virtualAddressList, err := virtualAddressResource.List(context.Background())
 	if err != nil {
 		t.Fatalf("Error getting VirtualAddress list: %v", err)
 	}
+	if len(virtualAddressList.Items) == 0 {
+		t.Fatal("VirtualAddress list is empty")
+	}
+
+	// Get the created Virtual Address
+	virtualAddress, err := virtualAddressResource.Get(context.Background(), "/Common/10.10.10.10")
+	if err != nil {
+		t.Fatalf("Error getting Virtual Address: %v", err)
+	}
+
+	// Validate properties
+	if virtualAddress.Name != "10.10.10.10" || virtualAddress.Address != "10.10.10.10" {
+		t.Errorf("Unexpected Virtual Address %+v", virtualAddress)
+	}
+
+	// Update Virtual Address
+	virtualAddress.Arp = "enabled"
+	if err := virtualAddressResource.Update(context.Background(), virtualAddress.Name, *virtualAddress); err != nil {
+		t.Fatalf("Error updating Virtual Address: %v", err)
+	}
+
+	// Check if ARP property was updated
+	updatedVASingle, err := virtualAddressResource.Get(context.Background(), virtualAddress.Name)
+	if err != nil {
+		t.Fatalf("Error getting updated Virtual Address: %v", err)
+	}
+	if updatedVASingle.Arp != "enabled" {
+		t.Error("Failed to update ARP property of Virtual Address")
+	}
+
+	// Disable Virtual Address
+	if err := virtualAddressResource.Disable(context.Background(), virtualAddress.Name); err != nil {
+		t.Fatalf("Error disabling Virtual Address: %v", err)
+	}
+
+	// Enable Virtual Address
+	if err := virtualAddressResource.Enable(context.Background(), virtualAddress.Name); err != nil {
+		t.Fatalf("Error enabling Virtual Address: %v", err)
+	}
 
-	// Get the first Virtual Address
-	if len(virtualAddressList.Items) > 0 {
-		virtualAddress, err := virtualAddressResource.Get(virtualAddressList.Items[0].FullPath)
-		if err != nil {
-			t.Fatalf("Error getting Virtual Address: %v", err)
-		}
-
-		// Validate properties
-		if virtualAddress.Name == "" {
-			t.Error("Virtual Address name is empty")
-		}
-
-		// Update Virtual Address
-		virtualAddress.Arp = "enabled"
-		if err := virtualAddressResource.Update(virtualAddress.Name, *virtualAddress); err != nil {
-			t.Fatalf("Error updating Virtual Address: %v", err)
-		}
-
-		// Check if ARP property was updated
-		updatedVASingle, err := virtualAddressResource.Get(virtualAddress.Name)
-		if err != nil {
-			t.Fatalf("Error getting updated Virtual Address: %v", err)
-		}
-		if updatedVASingle.Arp != "enabled" {
-			t.Error("Failed to update ARP property of Virtual Address")
-		}
-
-		// Disable Virtual Address
-		if err := virtualAddressResource.Disable(virtualAddress.Name); err != nil {
-			t.Fatalf("Error disabling Virtual Address: %v", err)
-		}
-
-		// Enable Virtual Address
-		if err := virtualAddressResource.Enable(virtualAddress.Name); err != nil {
-			t.Fatalf("Error enabling Virtual Address: %v", err)
-		}
+	// Delete Virtual Address
+	if err := virtualAddressResource.Delete(context.Background(), virtualAddress.Name); err != nil {
+		t.Fatalf("Error deleting Virtual Address: %v", err)
 	}
 }
@@ -157,9 +157,9 @@ type VirtualStatsResource struct {
 	b *bigip.BigIP
 }
 
-func (vsr *VirtualStatsResource) List() (*VirtualStatsList, error) {
+func (vsr *VirtualStatsResource) List(ctx context.Context) (*VirtualStatsList, error) {
 	res, err := vsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualEndpoint).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -168,12 +168,17 @@ func (vsr *VirtualStatsResource) List() (*VirtualStatsList, error) {
 	if err := json.Unmarshal(res, &vsl); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
 	}
+	bigip.RestrictEntries(vsr.b, vsl.Entries)
 	return &vsl, nil
 }
 
-func (vsr *VirtualStatsResource) Get(name string) (*VirtualStatsList, error) {
+func (vsr *VirtualStatsResource) Get(ctx context.Context, name string) (*VirtualStatsList, error) {
+	name, err := vsr.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	res, err := vsr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(LtmManager).
-		Resource(VirtualEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(VirtualEndpoint).SubResourceInstance(name).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,12 +1,12 @@
 package ltm
 
 import (
-	"github.com/lefeck/go-bigip"
+	"context"
 	"testing"
 )
 
 func TestVirtualResource(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	bigIP, err := newTestSession(t, "192.168.12.21", "admin", "1qaz@WSX3edc")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -25,12 +25,12 @@ func TestVirtualResource(t *testing.T) {
 			Type: "automap",
 		},
 	}
-	if err := vResource.Create(vs); err != nil {
+	if err := vResource.Create(context.Background(), vs); err != nil {
 		t.Fatalf("Error creating VirtualServer: %v", err)
 	}
 
 	// Get the VirtualServer
-	vsCheck, err := vResource.Get("/Common/test_virtual_server")
+	vsCheck, err := vResource.Get(context.Background(), "/Common/test_virtual_server")
 	if err != nil {
 		t.Fatalf("Error getting VirtualServer: %v", err)
 	}
@@ -42,12 +42,12 @@ func TestVirtualResource(t *testing.T) {
 
 	// Update properties of the VirtualServer
 	vsCheck.Description = "Test VirtualServer"
-	if err := vResource.Update(vsCheck.FullPath, *vsCheck); err != nil {
+	if err := vResource.Update(context.Background(), vsCheck.FullPath, *vsCheck); err != nil {
 		t.Fatalf("Error updating VirtualServer: %v", err)
 	}
 
 	// Validate that properties were updated
-	vsUpdated, err := vResource.Get("/Common/test_virtual_server")
+	vsUpdated, err := vResource.Get(context.Background(), "/Common/test_virtual_server")
 	if err != nil {
 		t.Fatalf("Error getting updated VirtualServer: %v", err)
 	}
@@ -56,22 +56,22 @@ func TestVirtualResource(t *testing.T) {
 	}
 
 	// Disable the VirtualServer
-	if err := vResource.Disable(vsCheck.FullPath); err != nil {
+	if err := vResource.Disable(context.Background(), vsCheck.FullPath); err != nil {
 		t.Fatalf("Error disabling VirtualServer: %v", err)
 	}
 
 	// Enable the VirtualServer
-	if err := vResource.Enable(vsCheck.FullPath); err != nil {
+	if err := vResource.Enable(context.Background(), vsCheck.FullPath); err != nil {
 		t.Fatalf("Error enabling VirtualServer: %v", err)
 	}
 
 	// Delete the VirtualServer
-	if err := vResource.Delete("/Common/test_virtual_server"); err != nil {
+	if err := vResource.Delete(context.Background(), "/Common/test_virtual_server"); err != nil {
 		t.Fatalf("Error deleting VirtualServer: %v", err)
 	}
 
 	// Validate that VirtualServer was deleted
-	if _, err := vResource.Get("/Common/test_virtual_server"); err == nil {
+	if _, err := vResource.Get(context.Background(), "/Common/test_virtual_server"); err == nil {
 		t.Error("VirtualServer still exists after attempting deletion")
 	}
 }
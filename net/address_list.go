@@ -2,10 +2,8 @@ package net
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // AddressList contains a list of Address.
@@ -34,72 +32,37 @@ type AddressResource struct {
 	b *bigip.BigIP
 }
 
-// List lists all the address configurations.
-func (ar *AddressResource) List() (*AddressList, error) {
-	var al AddressList
-	res, err := ar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing AddressResource.
+func (ar *AddressResource) collection() *bigip.Collection[Address, AddressList] {
+	return bigip.NewCollection[Address, AddressList](ar.b, NetManager, AddressEndpoint)
+}
 
-	if err := json.Unmarshal(res, &al); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &al, nil
+// List lists all the address configurations.
+func (ar *AddressResource) List(ctx context.Context, opts ...*rest.ListOptions) (*AddressList, error) {
+	return ar.collection().List(ctx, opts...)
 }
 
 // Get a single address configuration identified by fullPathName.
-func (ar *AddressResource) Get(fullPathName string) (*Address, error) {
-	var address Address
-	res, err := ar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &address); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &address, nil
+func (ar *AddressResource) Get(ctx context.Context, fullPathName string) (*Address, error) {
+	return ar.collection().Get(ctx, fullPathName)
 }
 
 // Create a new address configuration.
-func (ar *AddressResource) Create(item Address) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = ar.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (ar *AddressResource) Create(ctx context.Context, item Address) error {
+	return ar.collection().Create(ctx, item)
 }
 
 // Edit an address configuration identified by name.
-func (ar *AddressResource) Update(name string, item Address) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = ar.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (ar *AddressResource) Update(ctx context.Context, name string, item Address) error {
+	return ar.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Address identified by name, see bigip.PatchBody.
+func (ar *AddressResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return ar.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single address configuration identified by name.
-func (ar *AddressResource) Delete(name string) error {
-	_, err := ar.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(AddressEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (ar *AddressResource) Delete(ctx context.Context, name string) error {
+	return ar.collection().Delete(ctx, name)
 }
@@ -0,0 +1,180 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package net
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// AddressAPI is the interface of AddressResource, which mocks implement in tests.
+type AddressAPI interface {
+	// List lists all the address configurations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*AddressList, error)
+	// Get a single address configuration identified by fullPathName.
+	Get(ctx context.Context, fullPathName string) (*Address, error)
+	// Create a new address configuration.
+	Create(ctx context.Context, item Address) error
+	// Edit an address configuration identified by name.
+	Update(ctx context.Context, name string, item Address) error
+	// Patch updates only the given fields of the Address identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single address configuration identified by name.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ AddressAPI = &AddressResource{}
+
+// InetAPI is the interface of InetResource, which mocks implement in tests.
+type InetAPI interface {
+	// ListAll lists all interfaces uration.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*InterfaceList, error)
+	// Get a single interface uration identified by id.
+	Get(ctx context.Context, fullPathName string) (*Interface, error)
+	ShowStats(ctx context.Context, fullPathName string) (*InterfaceStatsList, error)
+}
+
+var _ InetAPI = &InetResource{}
+
+// InetStatsAPI is the interface of InetStatsResource, which mocks implement in tests.
+type InetStatsAPI interface {
+	List(ctx context.Context) (*InterfaceStatsList, error)
+}
+
+var _ InetStatsAPI = &InetStatsResource{}
+
+// NetAPI is the interface of Net, which mocks implement in tests.
+type NetAPI interface {
+	// Inet returns a InetResource used to query tm/net/interface API.
+	Inet() InetAPI
+	// InetStats returns a InetStatsResource used to query tm/net/interface/stats API.
+	InetStats() InetStatsAPI
+	// Route returns a RouteResource used to query tm/net/route API.
+	Route() RouteAPI
+	// Vlan returns a VlanResource used to query /tm/net/vlan API.
+	Vlan() VlanAPI
+	// Self returns a SelfResource used to query /tm/net/self API.
+	Self() SelfAPI
+	// RouteDomain returns a RouteDomainResource used to query /tm/net/route-domain API.
+	RouteDomain() RouteDomainAPI
+	// Trunk returns a TrunkResource used to query /tm/net/trunk API.
+	Trunk() TrunkAPI
+	// AddressList returns a AddressResource used to query /tm/net/address-list API.
+	AddressList() AddressAPI
+	// PortList returns a PortResource used to query /tm/net/port-list API.
+	PortList() PortAPI
+}
+
+var _ NetAPI = &Net{}
+
+// PortAPI is the interface of PortResource, which mocks implement in tests.
+type PortAPI interface {
+	// List all the port configurations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*PortList, error)
+	// Get a single Port configuration identified by fullPathName.
+	Get(ctx context.Context, fullPathName string) (*Port, error)
+	// Create a new Port configuration.
+	Create(ctx context.Context, item Port) error
+	// Edit an Port configuration identified by name.
+	Update(ctx context.Context, name string, item Port) error
+	// Patch updates only the given fields of the Port identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Port configuration identified by name.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ PortAPI = &PortResource{}
+
+// RouteAPI is the interface of RouteResource, which mocks implement in tests.
+type RouteAPI interface {
+	// List lists all the route urations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RouteList, error)
+	// Get a single route uration identified by id.
+	Get(ctx context.Context, fullPathName string) (*Route, error)
+	// Create a new route uration.
+	Create(ctx context.Context, item Route) error
+	// Edit a route uration identified by id.
+	Update(ctx context.Context, name string, item Route) error
+	// Patch updates only the given fields of the Route identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single route uration identified by id.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ RouteAPI = &RouteResource{}
+
+// RouteDomainAPI is the interface of RouteDomainResource, which mocks implement in tests.
+type RouteDomainAPI interface {
+	// List lists all the route domain urations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*RouteDomainList, error)
+	// Get a single route domain uration identified by name.
+	Get(ctx context.Context, fullPathName string) (*RouteDomain, error)
+	// Create a new route domain uration.
+	Create(ctx context.Context, item RouteDomain) error
+	// Update a route domain uration identified by name.
+	Edit(ctx context.Context, fullPathName string, item RouteDomain) error
+	// Patch updates only the given fields of the RouteDomain identified by fullPathName, see bigip.PatchBody.
+	Patch(ctx context.Context, fullPathName string, fields interface{}) error
+	// Delete a single route domain uration identified by name.
+	Delete(ctx context.Context, fullPathName string) error
+}
+
+var _ RouteDomainAPI = &RouteDomainResource{}
+
+// SelfAPI is the interface of SelfResource, which mocks implement in tests.
+type SelfAPI interface {
+	// ListAll lists all the self ip urations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SelfList, error)
+	// Get a single self ip uration identified by id.
+	Get(ctx context.Context, fullPathName string) (*Self, error)
+	// Create a new self ip uration.
+	Create(ctx context.Context, item Self) error
+	// Edit a self ip uration identified by id.
+	Edit(ctx context.Context, name string, item Self) error
+	// Patch updates only the given fields of the Self identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single self ip uration identified by id.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SelfAPI = &SelfResource{}
+
+// TrunkAPI is the interface of TrunkResource, which mocks implement in tests.
+type TrunkAPI interface {
+	// ListAll lists all the trunk urations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TrunkList, error)
+	// Get a single trunk uration identified by id.
+	Get(ctx context.Context, fullPathName string) (*Trunk, error)
+	// Create a new trunk uration.
+	Create(ctx context.Context, item Trunk) error
+	// Edit a trunk uration identified by id.
+	Edit(ctx context.Context, name string, item Trunk) error
+	// Patch updates only the given fields of the Trunk identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single trunk uration identified by id.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TrunkAPI = &TrunkResource{}
+
+// VlanAPI is the interface of VlanResource, which mocks implement in tests.
+type VlanAPI interface {
+	// ListAll lists all the vlan urations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*VlanList, error)
+	// Get a single vlan uration identified by name.
+	Get(ctx context.Context, fullPathName string) (*Vlan, error)
+	// Create a new vlan uration.
+	Create(ctx context.Context, item Vlan) error
+	// Edit a vlan uration identified by id.
+	Update(ctx context.Context, name string, item Vlan) error
+	// Patch updates only the given fields of the Vlan identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single vlan uration identified by id.
+	Delete(ctx context.Context, name string) error
+	// GetInterfaces gets all interfaces associated to the vlan identified by id.
+	GetVlanAssociatedInterfaces(ctx context.Context, name string) (*AssignedInterfaceList, error)
+	// Edit a vlan uration identified by id.
+	AddInterfaceForVlan(ctx context.Context, name string, item AssignedInterface) error
+}
+
+var _ VlanAPI = &VlanResource{}
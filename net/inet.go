@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A InterfaceList holds a list of Interface.
@@ -54,37 +55,25 @@ type InetResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing InetResource.
+func (ir *InetResource) collection() *bigip.Collection[Interface, InterfaceList] {
+	return bigip.NewCollection[Interface, InterfaceList](ir.b, NetManager, InterfaceEndpoint).WithoutPartition()
+}
+
 // ListAll lists all interfaces uration.
-func (ir *InetResource) List() (*InterfaceList, error) {
-	var items InterfaceList
-	res, err := ir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(InterfaceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (ir *InetResource) List(ctx context.Context, opts ...*rest.ListOptions) (*InterfaceList, error) {
+	return ir.collection().List(ctx, opts...)
 }
 
 // Get a single interface uration identified by id.
-func (ir *InetResource) Get(fullPathName string) (*Interface, error) {
-	var item Interface
-	res, err := ir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(InterfaceEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (ir *InetResource) Get(ctx context.Context, fullPathName string) (*Interface, error) {
+	return ir.collection().Get(ctx, fullPathName)
 }
 
-func (ir *InetResource) ShowStats(fullPathName string) (*InterfaceStatsList, error) {
+func (ir *InetResource) ShowStats(ctx context.Context, fullPathName string) (*InterfaceStatsList, error) {
 	var item InterfaceStatsList
 	res, err := ir.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(InterfaceEndpoint).SubResourceInstance(fullPathName).SubStatsResource(StatsEndpoint).DoRaw(context.Background())
+		Resource(InterfaceEndpoint).SubResourceInstance(fullPathName).SubStatsResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
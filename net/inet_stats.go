@@ -58,9 +58,9 @@ type InetStatsResource struct {
 	b *bigip.BigIP
 }
 
-func (isr *InetStatsResource) List() (*InterfaceStatsList, error) {
+func (isr *InetStatsResource) List(ctx context.Context) (*InterfaceStatsList, error) {
 	var item InterfaceStatsList
-	res, err := isr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(InterfaceStatsEndpoint).SubResource(StatsEndpoint).DoRaw(context.Background())
+	res, err := isr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(InterfaceStatsEndpoint).SubResource(StatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
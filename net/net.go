@@ -1,5 +1,7 @@
 package net
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen -types Net
+
 import (
 	"github.com/lefeck/go-bigip"
 )
@@ -34,46 +36,46 @@ func New(b *bigip.BigIP) Net {
 }
 
 // Inet returns a InetResource used to query tm/net/interface API.
-func (net Net) Inet() *InetResource {
+func (net Net) Inet() InetAPI {
 	return &net.inet
 }
 
 // InetStats returns a InetStatsResource used to query tm/net/interface/stats API.
-func (net Net) InetStats() *InetStatsResource {
+func (net Net) InetStats() InetStatsAPI {
 	return &net.inetStats
 }
 
 // Route returns a RouteResource used to query tm/net/route API.
-func (net Net) Route() *RouteResource {
+func (net Net) Route() RouteAPI {
 	return &net.route
 }
 
 // Vlan returns a VlanResource used to query /tm/net/vlan API.
-func (net Net) Vlan() *VlanResource {
+func (net Net) Vlan() VlanAPI {
 	return &net.vlan
 }
 
 // Self returns a SelfResource used to query /tm/net/self API.
-func (net Net) Self() *SelfResource {
+func (net Net) Self() SelfAPI {
 	return &net.self
 }
 
 // RouteDomain returns a RouteDomainResource used to query /tm/net/route-domain API.
-func (net Net) RouteDomain() *RouteDomainResource {
+func (net Net) RouteDomain() RouteDomainAPI {
 	return &net.routeDomain
 }
 
 // Trunk returns a TrunkResource used to query /tm/net/trunk API.
-func (net Net) Trunk() *TrunkResource {
+func (net Net) Trunk() TrunkAPI {
 	return &net.trunk
 }
 
 // AddressList returns a AddressResource used to query /tm/net/address-list API.
-func (net Net) AddressList() *AddressResource {
+func (net Net) AddressList() AddressAPI {
 	return &net.address
 }
 
 // PortList returns a PortResource used to query /tm/net/port-list API.
-func (net Net) PortList() *PortResource {
+func (net Net) PortList() PortAPI {
 	return &net.port
 }
@@ -0,0 +1,671 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package netmock provides mocks of the interfaces of package net, which record their calls.
+package netmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/net"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// AddressAPI is a mock of net.AddressAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type AddressAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*net.AddressList, error)
+	GetFunc    func(context.Context, string) (*net.Address, error)
+	CreateFunc func(context.Context, net.Address) error
+	UpdateFunc func(context.Context, string, net.Address) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ net.AddressAPI = &AddressAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *AddressAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.AddressList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *AddressAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Address, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *AddressAPI) Create(ctx context.Context, item net.Address) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *AddressAPI) Update(ctx context.Context, name string, item net.Address) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *AddressAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *AddressAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// InetAPI is a mock of net.InetAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type InetAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc      func(context.Context, ...*rest.ListOptions) (*net.InterfaceList, error)
+	GetFunc       func(context.Context, string) (*net.Interface, error)
+	ShowStatsFunc func(context.Context, string) (*net.InterfaceStatsList, error)
+}
+
+var _ net.InetAPI = &InetAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *InetAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.InterfaceList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *InetAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Interface, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// ShowStats records the call and calls ShowStatsFunc if it is set.
+func (m *InetAPI) ShowStats(ctx context.Context, fullPathName string) (r0 *net.InterfaceStatsList, r1 error) {
+	m.CallRecorder.Record("ShowStats", ctx, fullPathName)
+	if m.ShowStatsFunc != nil {
+		return m.ShowStatsFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// InetStatsAPI is a mock of net.InetStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type InetStatsAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*net.InterfaceStatsList, error)
+}
+
+var _ net.InetStatsAPI = &InetStatsAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *InetStatsAPI) List(ctx context.Context) (r0 *net.InterfaceStatsList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// NetAPI is a mock of net.NetAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NetAPI struct {
+	bigiptest.CallRecorder
+
+	InetFunc        func() net.InetAPI
+	InetStatsFunc   func() net.InetStatsAPI
+	RouteFunc       func() net.RouteAPI
+	VlanFunc        func() net.VlanAPI
+	SelfFunc        func() net.SelfAPI
+	RouteDomainFunc func() net.RouteDomainAPI
+	TrunkFunc       func() net.TrunkAPI
+	AddressListFunc func() net.AddressAPI
+	PortListFunc    func() net.PortAPI
+}
+
+var _ net.NetAPI = &NetAPI{}
+
+// Inet records the call and calls InetFunc if it is set.
+func (m *NetAPI) Inet() (r0 net.InetAPI) {
+	m.CallRecorder.Record("Inet")
+	if m.InetFunc != nil {
+		return m.InetFunc()
+	}
+	return
+}
+
+// InetStats records the call and calls InetStatsFunc if it is set.
+func (m *NetAPI) InetStats() (r0 net.InetStatsAPI) {
+	m.CallRecorder.Record("InetStats")
+	if m.InetStatsFunc != nil {
+		return m.InetStatsFunc()
+	}
+	return
+}
+
+// Route records the call and calls RouteFunc if it is set.
+func (m *NetAPI) Route() (r0 net.RouteAPI) {
+	m.CallRecorder.Record("Route")
+	if m.RouteFunc != nil {
+		return m.RouteFunc()
+	}
+	return
+}
+
+// Vlan records the call and calls VlanFunc if it is set.
+func (m *NetAPI) Vlan() (r0 net.VlanAPI) {
+	m.CallRecorder.Record("Vlan")
+	if m.VlanFunc != nil {
+		return m.VlanFunc()
+	}
+	return
+}
+
+// Self records the call and calls SelfFunc if it is set.
+func (m *NetAPI) Self() (r0 net.SelfAPI) {
+	m.CallRecorder.Record("Self")
+	if m.SelfFunc != nil {
+		return m.SelfFunc()
+	}
+	return
+}
+
+// RouteDomain records the call and calls RouteDomainFunc if it is set.
+func (m *NetAPI) RouteDomain() (r0 net.RouteDomainAPI) {
+	m.CallRecorder.Record("RouteDomain")
+	if m.RouteDomainFunc != nil {
+		return m.RouteDomainFunc()
+	}
+	return
+}
+
+// Trunk records the call and calls TrunkFunc if it is set.
+func (m *NetAPI) Trunk() (r0 net.TrunkAPI) {
+	m.CallRecorder.Record("Trunk")
+	if m.TrunkFunc != nil {
+		return m.TrunkFunc()
+	}
+	return
+}
+
+// AddressList records the call and calls AddressListFunc if it is set.
+func (m *NetAPI) AddressList() (r0 net.AddressAPI) {
+	m.CallRecorder.Record("AddressList")
+	if m.AddressListFunc != nil {
+		return m.AddressListFunc()
+	}
+	return
+}
+
+// PortList records the call and calls PortListFunc if it is set.
+func (m *NetAPI) PortList() (r0 net.PortAPI) {
+	m.CallRecorder.Record("PortList")
+	if m.PortListFunc != nil {
+		return m.PortListFunc()
+	}
+	return
+}
+
+// PortAPI is a mock of net.PortAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PortAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*net.PortList, error)
+	GetFunc    func(context.Context, string) (*net.Port, error)
+	CreateFunc func(context.Context, net.Port) error
+	UpdateFunc func(context.Context, string, net.Port) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ net.PortAPI = &PortAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *PortAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.PortList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *PortAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Port, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *PortAPI) Create(ctx context.Context, item net.Port) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *PortAPI) Update(ctx context.Context, name string, item net.Port) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *PortAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *PortAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RouteAPI is a mock of net.RouteAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RouteAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*net.RouteList, error)
+	GetFunc    func(context.Context, string) (*net.Route, error)
+	CreateFunc func(context.Context, net.Route) error
+	UpdateFunc func(context.Context, string, net.Route) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ net.RouteAPI = &RouteAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RouteAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.RouteList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RouteAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Route, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RouteAPI) Create(ctx context.Context, item net.Route) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *RouteAPI) Update(ctx context.Context, name string, item net.Route) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RouteAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RouteAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// RouteDomainAPI is a mock of net.RouteDomainAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RouteDomainAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*net.RouteDomainList, error)
+	GetFunc    func(context.Context, string) (*net.RouteDomain, error)
+	CreateFunc func(context.Context, net.RouteDomain) error
+	EditFunc   func(context.Context, string, net.RouteDomain) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ net.RouteDomainAPI = &RouteDomainAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *RouteDomainAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.RouteDomainList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *RouteDomainAPI) Get(ctx context.Context, fullPathName string) (r0 *net.RouteDomain, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *RouteDomainAPI) Create(ctx context.Context, item net.RouteDomain) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc if it is set.
+func (m *RouteDomainAPI) Edit(ctx context.Context, fullPathName string, item net.RouteDomain) (r0 error) {
+	m.CallRecorder.Record("Edit", ctx, fullPathName, item)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, fullPathName, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *RouteDomainAPI) Patch(ctx context.Context, fullPathName string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, fullPathName, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, fullPathName, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *RouteDomainAPI) Delete(ctx context.Context, fullPathName string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, fullPathName)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// SelfAPI is a mock of net.SelfAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SelfAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*net.SelfList, error)
+	GetFunc    func(context.Context, string) (*net.Self, error)
+	CreateFunc func(context.Context, net.Self) error
+	EditFunc   func(context.Context, string, net.Self) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ net.SelfAPI = &SelfAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SelfAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.SelfList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SelfAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Self, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SelfAPI) Create(ctx context.Context, item net.Self) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc if it is set.
+func (m *SelfAPI) Edit(ctx context.Context, name string, item net.Self) (r0 error) {
+	m.CallRecorder.Record("Edit", ctx, name, item)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SelfAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SelfAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TrunkAPI is a mock of net.TrunkAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TrunkAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*net.TrunkList, error)
+	GetFunc    func(context.Context, string) (*net.Trunk, error)
+	CreateFunc func(context.Context, net.Trunk) error
+	EditFunc   func(context.Context, string, net.Trunk) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ net.TrunkAPI = &TrunkAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TrunkAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.TrunkList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TrunkAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Trunk, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TrunkAPI) Create(ctx context.Context, item net.Trunk) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc if it is set.
+func (m *TrunkAPI) Edit(ctx context.Context, name string, item net.Trunk) (r0 error) {
+	m.CallRecorder.Record("Edit", ctx, name, item)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TrunkAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TrunkAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// VlanAPI is a mock of net.VlanAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VlanAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc                        func(context.Context, ...*rest.ListOptions) (*net.VlanList, error)
+	GetFunc                         func(context.Context, string) (*net.Vlan, error)
+	CreateFunc                      func(context.Context, net.Vlan) error
+	UpdateFunc                      func(context.Context, string, net.Vlan) error
+	PatchFunc                       func(context.Context, string, interface{}) error
+	DeleteFunc                      func(context.Context, string) error
+	GetVlanAssociatedInterfacesFunc func(context.Context, string) (*net.AssignedInterfaceList, error)
+	AddInterfaceForVlanFunc         func(context.Context, string, net.AssignedInterface) error
+}
+
+var _ net.VlanAPI = &VlanAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VlanAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *net.VlanList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VlanAPI) Get(ctx context.Context, fullPathName string) (r0 *net.Vlan, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *VlanAPI) Create(ctx context.Context, item net.Vlan) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *VlanAPI) Update(ctx context.Context, name string, item net.Vlan) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *VlanAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *VlanAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// GetVlanAssociatedInterfaces records the call and calls GetVlanAssociatedInterfacesFunc if it is set.
+func (m *VlanAPI) GetVlanAssociatedInterfaces(ctx context.Context, name string) (r0 *net.AssignedInterfaceList, r1 error) {
+	m.CallRecorder.Record("GetVlanAssociatedInterfaces", ctx, name)
+	if m.GetVlanAssociatedInterfacesFunc != nil {
+		return m.GetVlanAssociatedInterfacesFunc(ctx, name)
+	}
+	return
+}
+
+// AddInterfaceForVlan records the call and calls AddInterfaceForVlanFunc if it is set.
+func (m *VlanAPI) AddInterfaceForVlan(ctx context.Context, name string, item net.AssignedInterface) (r0 error) {
+	m.CallRecorder.Record("AddInterfaceForVlan", ctx, name, item)
+	if m.AddInterfaceForVlanFunc != nil {
+		return m.AddInterfaceForVlanFunc(ctx, name, item)
+	}
+	return
+}
@@ -2,10 +2,8 @@ package net
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A PortList contains a list of Port.
@@ -38,72 +36,37 @@ type PortResource struct {
 	b *bigip.BigIP
 }
 
-// List all the port configurations.
-func (ar *PortResource) List() (*PortList, error) {
-	var al PortList
-	res, err := ar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(PortEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing PortResource.
+func (ar *PortResource) collection() *bigip.Collection[Port, PortList] {
+	return bigip.NewCollection[Port, PortList](ar.b, NetManager, PortEndpoint)
+}
 
-	if err := json.Unmarshal(res, &al); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &al, nil
+// List all the port configurations.
+func (ar *PortResource) List(ctx context.Context, opts ...*rest.ListOptions) (*PortList, error) {
+	return ar.collection().List(ctx, opts...)
 }
 
 // Get a single Port configuration identified by fullPathName.
-func (ar *PortResource) Get(fullPathName string) (*Port, error) {
-	var Port Port
-	res, err := ar.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(PortEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &Port); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &Port, nil
+func (ar *PortResource) Get(ctx context.Context, fullPathName string) (*Port, error) {
+	return ar.collection().Get(ctx, fullPathName)
 }
 
 // Create a new Port configuration.
-func (ar *PortResource) Create(item Port) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = ar.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(PortEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (ar *PortResource) Create(ctx context.Context, item Port) error {
+	return ar.collection().Create(ctx, item)
 }
 
 // Edit an Port configuration identified by name.
-func (ar *PortResource) Update(name string, item Port) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = ar.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(PortEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (ar *PortResource) Update(ctx context.Context, name string, item Port) error {
+	return ar.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Port identified by name, see bigip.PatchBody.
+func (ar *PortResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return ar.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Port configuration identified by name.
-func (ar *PortResource) Delete(name string) error {
-	_, err := ar.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(PortEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (ar *PortResource) Delete(ctx context.Context, name string) error {
+	return ar.collection().Delete(ctx, name)
 }
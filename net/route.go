@@ -2,10 +2,8 @@ package net
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A RouteList holds a list of Route.
@@ -36,70 +34,37 @@ type RouteResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing RouteResource.
+func (rr *RouteResource) collection() *bigip.Collection[Route, RouteList] {
+	return bigip.NewCollection[Route, RouteList](rr.b, NetManager, RouteEndpoint)
+}
+
 // List lists all the route urations.
-func (rr *RouteResource) List() (*RouteList, error) {
-	var rl RouteList
-	res, err := rr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(RouteEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &rl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &rl, nil
+func (rr *RouteResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RouteList, error) {
+	return rr.collection().List(ctx, opts...)
 }
 
 // Get a single route uration identified by id.
-func (rr *RouteResource) Get(fullPathName string) (*Route, error) {
-	res, err := rr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	var route Route
-	if err := json.Unmarshal(res, &route); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &route, nil
+func (rr *RouteResource) Get(ctx context.Context, fullPathName string) (*Route, error) {
+	return rr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new route uration.
-func (rr *RouteResource) Create(item Route) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rr *RouteResource) Create(ctx context.Context, item Route) error {
+	return rr.collection().Create(ctx, item)
 }
 
 // Edit a route uration identified by id.
-func (rr *RouteResource) Update(name string, item Route) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rr *RouteResource) Update(ctx context.Context, name string, item Route) error {
+	return rr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Route identified by name, see bigip.PatchBody.
+func (rr *RouteResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return rr.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single route uration identified by id.
-func (rr *RouteResource) Delete(name string) error {
-	_, err := rr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rr *RouteResource) Delete(ctx context.Context, name string) error {
+	return rr.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package net
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A RouteDomainList includes a list of RouteDomain.
@@ -42,69 +40,37 @@ type RouteDomainResource struct {
 	b *bigip.BigIP
 }
 
+// collection returns the typed collection backing RouteDomainResource.
+func (rdr *RouteDomainResource) collection() *bigip.Collection[RouteDomain, RouteDomainList] {
+	return bigip.NewCollection[RouteDomain, RouteDomainList](rdr.b, NetManager, RouteDomainEndpoint)
+}
+
 // List lists all the route domain urations.
-func (rdr *RouteDomainResource) List() (*RouteDomainList, error) {
-	var items RouteDomainList
-	res, err := rdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).Resource(RouteDomainEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+func (rdr *RouteDomainResource) List(ctx context.Context, opts ...*rest.ListOptions) (*RouteDomainList, error) {
+	return rdr.collection().List(ctx, opts...)
 }
 
 // Get a single route domain uration identified by name.
-func (rdr *RouteDomainResource) Get(fullPathName string) (*RouteDomain, error) {
-	var item RouteDomain
-	res, err := rdr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (rdr *RouteDomainResource) Get(ctx context.Context, fullPathName string) (*RouteDomain, error) {
+	return rdr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new route domain uration.
-func (rdr *RouteDomainResource) Create(item RouteDomain) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rdr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rdr *RouteDomainResource) Create(ctx context.Context, item RouteDomain) error {
+	return rdr.collection().Create(ctx, item)
 }
 
 // Update a route domain uration identified by name.
-func (rdr *RouteDomainResource) Edit(fullPathName string, item RouteDomain) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = rdr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).ResourceInstance(fullPathName).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rdr *RouteDomainResource) Edit(ctx context.Context, fullPathName string, item RouteDomain) error {
+	return rdr.collection().Update(ctx, fullPathName, item)
+}
+
+// Patch updates only the given fields of the RouteDomain identified by fullPathName, see bigip.PatchBody.
+func (rdr *RouteDomainResource) Patch(ctx context.Context, fullPathName string, fields interface{}) error {
+	return rdr.collection().Patch(ctx, fullPathName, fields)
 }
 
 // Delete a single route domain uration identified by name.
-func (rdr *RouteDomainResource) Delete(fullPathName string) error {
-	_, err := rdr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(RouteDomainEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (rdr *RouteDomainResource) Delete(ctx context.Context, fullPathName string) error {
+	return rdr.collection().Delete(ctx, fullPathName)
 }
@@ -2,10 +2,8 @@ package net
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A SelfList holds a list of Self.
@@ -45,72 +43,37 @@ type SelfResource struct {
 	b *bigip.BigIP
 }
 
-// ListAll lists all the self ip urations.
-func (sr *SelfResource) List() (*SelfList, error) {
-	var sl SelfList
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SelfResource.
+func (sr *SelfResource) collection() *bigip.Collection[Self, SelfList] {
+	return bigip.NewCollection[Self, SelfList](sr.b, NetManager, SelfEndpoint)
+}
 
-	if err := json.Unmarshal(res, &sl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &sl, nil
+// ListAll lists all the self ip urations.
+func (sr *SelfResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SelfList, error) {
+	return sr.collection().List(ctx, opts...)
 }
 
 // Get a single self ip uration identified by id.
-func (sr *SelfResource) Get(fullPathName string) (*Self, error) {
-	var self Self
-	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &self); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &self, nil
+func (sr *SelfResource) Get(ctx context.Context, fullPathName string) (*Self, error) {
+	return sr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new self ip uration.
-func (sr *SelfResource) Create(item Self) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = sr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (sr *SelfResource) Create(ctx context.Context, item Self) error {
+	return sr.collection().Create(ctx, item)
 }
 
 // Edit a self ip uration identified by id.
-func (sr *SelfResource) Edit(name string, item Self) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = sr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (sr *SelfResource) Edit(ctx context.Context, name string, item Self) error {
+	return sr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Self identified by name, see bigip.PatchBody.
+func (sr *SelfResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return sr.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single self ip uration identified by id.
-func (sr *SelfResource) Delete(name string) error {
-	_, err := sr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(SelfEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (sr *SelfResource) Delete(ctx context.Context, name string) error {
+	return sr.collection().Delete(ctx, name)
 }
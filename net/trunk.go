@@ -2,10 +2,8 @@ package net
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A TrunkList holds a list of Trunks.
@@ -50,72 +48,37 @@ type TrunkResource struct {
 	b *bigip.BigIP
 }
 
-// ListAll lists all the trunk urations.
-func (tr *TrunkResource) List() (*TrunkList, error) {
-	var tl TrunkList
-	res, err := tr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(TrunkEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TrunkResource.
+func (tr *TrunkResource) collection() *bigip.Collection[Trunk, TrunkList] {
+	return bigip.NewCollection[Trunk, TrunkList](tr.b, NetManager, TrunkEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &tl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &tl, nil
+// ListAll lists all the trunk urations.
+func (tr *TrunkResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TrunkList, error) {
+	return tr.collection().List(ctx, opts...)
 }
 
 // Get a single trunk uration identified by id.
-func (tr *TrunkResource) Get(fullPathName string) (*Trunk, error) {
-	var trunk Trunk
-	res, err := tr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(TrunkEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &trunk); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &trunk, nil
+func (tr *TrunkResource) Get(ctx context.Context, fullPathName string) (*Trunk, error) {
+	return tr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new trunk uration.
-func (tr *TrunkResource) Create(item Trunk) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = tr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(TrunkEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (tr *TrunkResource) Create(ctx context.Context, item Trunk) error {
+	return tr.collection().Create(ctx, item)
 }
 
 // Edit a trunk uration identified by id.
-func (tr *TrunkResource) Edit(name string, item Trunk) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = tr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(TrunkEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (tr *TrunkResource) Edit(ctx context.Context, name string, item Trunk) error {
+	return tr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Trunk identified by name, see bigip.PatchBody.
+func (tr *TrunkResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return tr.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single trunk uration identified by id.
-func (tr *TrunkResource) Delete(name string) error {
-	_, err := tr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(TrunkEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (tr *TrunkResource) Delete(ctx context.Context, name string) error {
+	return tr.collection().Delete(ctx, name)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -72,84 +73,53 @@ type VlanResource struct {
 	b *bigip.BigIP
 }
 
-// ListAll lists all the vlan urations.
-func (vr *VlanResource) List() (*VlanList, error) {
-	var vl VlanList
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing VlanResource.
+func (vr *VlanResource) collection() *bigip.Collection[Vlan, VlanList] {
+	return bigip.NewCollection[Vlan, VlanList](vr.b, NetManager, VlanEndpoint)
+}
 
-	if err := json.Unmarshal(res, &vl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vl, nil
+// ListAll lists all the vlan urations.
+func (vr *VlanResource) List(ctx context.Context, opts ...*rest.ListOptions) (*VlanList, error) {
+	return vr.collection().List(ctx, opts...)
 }
 
 // Get a single vlan uration identified by name.
-func (vr *VlanResource) Get(fullPathName string) (*Vlan, error) {
-	var vlan Vlan
-	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &vlan); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &vlan, nil
+func (vr *VlanResource) Get(ctx context.Context, fullPathName string) (*Vlan, error) {
+	return vr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new vlan uration.
-func (vr *VlanResource) Create(item Vlan) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VlanResource) Create(ctx context.Context, item Vlan) error {
+	return vr.collection().Create(ctx, item)
 }
 
 // Edit a vlan uration identified by id.
-func (vr *VlanResource) Update(name string, item Vlan) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = vr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VlanResource) Update(ctx context.Context, name string, item Vlan) error {
+	return vr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Vlan identified by name, see bigip.PatchBody.
+func (vr *VlanResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return vr.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single vlan uration identified by id.
-func (vr *VlanResource) Delete(name string) error {
-	_, err := vr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (vr *VlanResource) Delete(ctx context.Context, name string) error {
+	return vr.collection().Delete(ctx, name)
 }
 
 // GetInterfaces gets all interfaces associated to the vlan identified by id.
-func (vr *VlanResource) GetVlanAssociatedInterfaces(name string) (*AssignedInterfaceList, error) {
+func (vr *VlanResource) GetVlanAssociatedInterfaces(ctx context.Context, name string) (*AssignedInterfaceList, error) {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return nil, err
+	}
 	var ail AssignedInterfaceList
 	//if err := vr.c.ReadQuery(bigip.GetBaseResource()+VlanEndpoint+"/"+id+"/interfaces", &list); err != nil {
 	//	return nil, err
 	//}
 	res, err := vr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).DoRaw(context.Background())
+		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -161,14 +131,18 @@ func (vr *VlanResource) GetVlanAssociatedInterfaces(name string) (*AssignedInter
 }
 
 // Edit a vlan uration identified by id.
-func (vr *VlanResource) AddInterfaceForVlan(name string, item AssignedInterface) error {
+func (vr *VlanResource) AddInterfaceForVlan(ctx context.Context, name string, item AssignedInterface) error {
+	name, err := vr.b.Qualify(name)
+	if err != nil {
+		return err
+	}
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = vr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(NetManager).
-		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(VlanEndpoint).ResourceInstance(name).SubResource(VlanInterfacesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,267 @@
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/transport"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultLoginProvider is the login provider used by token authentication when none is set.
+const DefaultLoginProvider = "tmos"
+
+// Option is a custom type that handles options
+type Option func(o *options)
+
+// options holds the settings collected from the options given to New.
+type options struct {
+	config        rest.Config
+	username      string
+	password      string
+	token         bool
+	loginProvider string
+	tokenTimeout  time.Duration
+	// defaultPartition is set by WithDefaultPartition.
+	defaultPartition string
+}
+
+// newOptions returns the settings for host with the given options applied.
+func newOptions(host string, opts ...Option) *options {
+	o := &options{
+		config: rest.Config{
+			Host: host,
+			ContentConfig: rest.ContentConfig{
+				ContentType: "application/json",
+			},
+			TLSClientConfig: transport.TLSConfig{Insecure: true},
+		},
+		loginProvider: DefaultLoginProvider,
+	}
+
+	// Apply any incoming options
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// New creates a new BigIP structure for host configured with the given options, for example:
+//
+//	b, err := bigip.New("192.168.13.91",
+//		bigip.WithTokenAuth("admin", "secret"),
+//		bigip.WithCAFile("/etc/ssl/bigip-ca.pem"),
+//		bigip.WithRequestTimeout(30*time.Second),
+//		bigip.WithRetry(rest.DefaultRetryPolicy()))
+//
+// With token authentication, New logs in before returning, so that wrong credentials
+// are reported immediately. Unless a TLS option such as WithCAFile or WithPinnedCertificate
+// or a transport with WithTransport is given, the certificate of the device is not verified.
+func New(host string, opts ...Option) (*BigIP, error) {
+	o := newOptions(host, opts...)
+
+	if o.config.Transport != nil {
+		// Skipping the certificate verification is only the default of the transports built by New.
+		// The login client is built from o.config too, so it is cleared there.
+		tls := o.config.TLSClientConfig
+		tls.Insecure = false
+		if tls.IsZero() {
+			o.config.TLSClientConfig = transport.TLSConfig{}
+		}
+	}
+	var source *TokenSource
+	config := o.config
+	switch {
+	case o.token:
+		source = &TokenSource{auth: newAuthPayload(o)}
+		if _, err := source.Token(); err != nil {
+			return nil, fmt.Errorf("generation token failed: %w", err)
+		}
+		config.TokenSource = source
+	case len(o.username) != 0:
+		config.Username = o.username
+		config.Password = o.password
+	}
+
+	restClient, err := restClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigIP{
+		RestClient:       restClient,
+		tokenSource:      source,
+		version:          &versionCache{},
+		defaultPartition: o.defaultPartition,
+	}, nil
+}
+
+// WithBasicAuth is an Option type function used for authenticating every request with a username and password.
+func WithBasicAuth(username, password string) Option {
+	return func(o *options) {
+		o.username = username
+		o.password = password
+		o.token = false
+	}
+}
+
+// WithTokenAuth is an Option type function used for logging in with a username and password
+// and authenticating the requests with the returned token, which is renewed automatically.
+func WithTokenAuth(username, password string) Option {
+	return func(o *options) {
+		o.username = username
+		o.password = password
+		o.token = true
+	}
+}
+
+// WithLoginProvider is an Option type function used for logging in through another
+// authentication provider than DefaultLoginProvider, such as "local" or a RADIUS or LDAP provider.
+// It implies token authentication.
+func WithLoginProvider(name string) Option {
+	return func(o *options) {
+		if len(name) != 0 {
+			o.loginProvider = name
+		}
+		o.token = true
+	}
+}
+
+// WithTimeout is an Option type function used for setting the lifetime of the tokens,
+// for example WithTimeout(time.Hour). BIG-IP accepts up to MaxTokenTimeout, a longer
+// timeout is lowered to it. If not set, the default lifetime of the device is used,
+// usually 20 minutes.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		if timeout > MaxTokenTimeout {
+			timeout = MaxTokenTimeout
+		}
+		o.tokenTimeout = timeout
+	}
+}
+
+// WithDefaultPartition is an Option type function used for qualifying bare names, such as
+// web, with a partition other than Common, such as /Tenant_A/web, and creating new objects
+// without a partition in it. Unlike BigIP.InPartition, the objects of other partitions can
+// still be listed and changed by their full path.
+func WithDefaultPartition(partition string) Option {
+	return func(o *options) {
+		o.defaultPartition = strings.Trim(partition, "/")
+	}
+}
+
+// WithRequestTimeout is an Option type function used for setting the maximum duration of every request.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.config.Timeout = timeout
+	}
+}
+
+// WithRetry is an Option type function used for retrying the requests that failed with
+// a transient error, see rest.DefaultRetryPolicy.
+func WithRetry(policy *rest.RetryPolicy) Option {
+	return func(o *options) {
+		o.config.Retry = policy
+	}
+}
+
+// WithUserAgent is an Option type function used for setting the User-Agent header of every request.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.config.UserAgent = userAgent
+	}
+}
+
+// WithProxy is an Option type function used for sending the requests through an HTTP proxy.
+// By default the proxy is taken from the HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(o *options) {
+		o.config.Proxy = http.ProxyURL(proxyURL)
+	}
+}
+
+// WithDialer is an Option type function used for opening the connections with dial,
+// for example the DialContext of a SOCKS5 dialer from golang.org/x/net/proxy.
+func WithDialer(dial func(ctx context.Context, network, address string) (net.Conn, error)) Option {
+	return func(o *options) {
+		o.config.Dial = dial
+	}
+}
+
+// WithWrapTransport is an Option type function used for adding a transport middleware,
+// for example to log or record the requests. It is called after the previous ones.
+func WithWrapTransport(fn transport.WrapperFunc) Option {
+	return func(o *options) {
+		o.config.Wrap(fn)
+	}
+}
+
+// WithTransport is an Option type function used for sending the requests with rt, for example
+// a transport shared by several clients or an httptest server client. The transport keeps its
+// own TLS settings, so it cannot be combined with the TLS, proxy and dialer options.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(o *options) {
+		o.config.Transport = rt
+	}
+}
+
+// WithTLSConfig is an Option type function used for replacing all the TLS settings.
+func WithTLSConfig(config transport.TLSConfig) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig = config
+	}
+}
+
+// WithCAFile is an Option type function used for verifying the device certificate
+// against the authorities of a PEM file instead of skipping the verification.
+func WithCAFile(caFile string) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig.Insecure = false
+		o.config.TLSClientConfig.CAFile = caFile
+	}
+}
+
+// WithServerName is an Option type function used for setting the name checked against the device certificate.
+func WithServerName(serverName string) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig.Insecure = false
+		o.config.TLSClientConfig.ServerName = serverName
+	}
+}
+
+// WithClientCertificate is an Option type function used for authenticating with a client certificate.
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig.CertFile = certFile
+		o.config.TLSClientConfig.KeyFile = keyFile
+	}
+}
+
+// WithMinTLSVersion is an Option type function used for setting the minimum TLS version, such as tls.VersionTLS13.
+func WithMinTLSVersion(version uint16) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig.MinVersion = version
+	}
+}
+
+// WithPinnedCertificate is an Option type function used for only accepting the device
+// certificates with the given SHA-256 fingerprints, which suits self-signed device certificates.
+func WithPinnedCertificate(fingerprints ...string) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig.Insecure = false
+		o.config.TLSClientConfig.PinnedSHA256 = append(o.config.TLSClientConfig.PinnedSHA256, fingerprints...)
+	}
+}
+
+// WithTrustOnFirstUse is an Option type function used for pinning the certificate presented
+// by the device on the first connection. tofu.OnPin can save the fingerprint for WithPinnedCertificate.
+func WithTrustOnFirstUse(tofu *transport.TrustOnFirstUse) Option {
+	return func(o *options) {
+		o.config.TLSClientConfig.Insecure = false
+		o.config.TLSClientConfig.TrustOnFirstUse = tofu
+	}
+}
@@ -0,0 +1,174 @@
+package bigip
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestNewBasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "secret" {
+			t.Errorf("Expected basic authentication, got %q", r.Header.Get("Authorization"))
+		}
+		if agent := r.Header.Get("User-Agent"); agent != "nightly-backup/1.0" {
+			t.Errorf("Expected User-Agent nightly-backup/1.0, got %s", agent)
+		}
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	var wrapped int
+	b, err := New(server.URL,
+		WithBasicAuth("admin", "secret"),
+		WithUserAgent("nightly-backup/1.0"),
+		WithRequestTimeout(5*time.Second),
+		WithRetry(rest.DefaultRetryPolicy()),
+		WithWrapTransport(func(rt http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				wrapped++
+				return rt.RoundTrip(req)
+			})
+		}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if b.RestClient.Client.Timeout != 5*time.Second || b.RestClient.Retry == nil {
+		t.Errorf("Expected the timeout and retry policy to be set, got %v and %v", b.RestClient.Client.Timeout, b.RestClient.Retry)
+	}
+
+	if _, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if wrapped != 1 {
+		t.Errorf("Expected the middleware to see 1 request, got %d", wrapped)
+	}
+}
+
+func TestNewTokenAuthThroughProxy(t *testing.T) {
+	ts := &tokenServer{timeout: 1200}
+	host := newTokenServer(t, ts)
+
+	// The proxy serves the requests for a host that does not resolve.
+	var proxied []string
+	target, _ := url.Parse(host)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Path)
+		r.URL.Scheme, r.URL.Host, r.RequestURI = target.Scheme, target.Host, ""
+		resp, err := http.DefaultTransport.RoundTrip(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		w.WriteHeader(resp.StatusCode)
+		io.Copy(w, resp.Body)
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	b, err := New("http://bigip.invalid", WithTokenAuth("admin", "admin"), WithProxy(proxyURL))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := NewCollection[testPool, testPoolList](b, "ltm", "pool").Create(context.Background(), testPool{Name: "a"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(proxied) != 2 || proxied[0] != "/mgmt/shared/authn/login" {
+		t.Errorf("Expected the login and the request to go through the proxy, got %v", proxied)
+	}
+}
+
+func TestNewWithDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	var dialed []string
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		dialed = append(dialed, address)
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	b, err := New("http://bigip.invalid:8443", WithBasicAuth("admin", "admin"), WithDialer(dial))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(dialed) != 1 || dialed[0] != "bigip.invalid:8443" {
+		t.Errorf("Expected the dialer to open the connection, got %v", dialed)
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestNewWithTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	// The client of the server trusts its certificate, which New would not verify by default.
+	var sent int
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return server.Client().Transport.RoundTrip(req)
+	})
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"), WithTransport(rt))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sent != 1 {
+		t.Errorf("Expected the transport to send 1 request, got %d", sent)
+	}
+
+	if _, err := New(server.URL, WithTransport(rt), WithCAFile("ca.pem")); err == nil {
+		t.Error("Expected an error when a custom transport is combined with a TLS option")
+	}
+}
+
+func TestNewWithTransportAndTokenAuth(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/mgmt/shared/authn/login" {
+			w.Write([]byte(`{"token":{"token":"T0K3N","timeout":1200,"startTime":"` + time.Now().Format(TimeFormat) + `"}}`))
+			return
+		}
+		if r.Header.Get("X-F5-Auth-Token") != "T0K3N" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	var sent []string
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent = append(sent, req.URL.Path)
+		return server.Client().Transport.RoundTrip(req)
+	})
+	b, err := New(server.URL, WithTokenAuth("admin", "admin"), WithTransport(rt))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := NewCollection[testPool, testPoolList](b, "ltm", "pool").List(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sent) != 2 || sent[0] != "/mgmt/shared/authn/login" {
+		t.Errorf("Expected the login and the list to use the transport, got %v", sent)
+	}
+}
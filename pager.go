@@ -0,0 +1,186 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items requested per page when the options do not set Top.
+const DefaultPageSize = 100
+
+// ErrStopIteration can be returned by the callback of Pages or Each to stop the
+// iteration early. It is not returned to the caller.
+var ErrStopIteration = errors.New("stop iteration")
+
+// Page is a single page of a collection, as returned by BIG-IP when $top is set.
+type Page[T any] struct {
+	Items            []T
+	CurrentItemCount int
+	ItemsPerPage     int
+	PageIndex        int
+	StartIndex       int
+	TotalItems       int
+	TotalPages       int
+	NextLink         string
+}
+
+// Pages walks the collection one page at a time using $top and $skip.
+// The page size is taken from the Top option, or DefaultPageSize if it is not set,
+// so only one page is held in memory at any time.
+func (c *Collection[T, L]) Pages(ctx context.Context, fn func(page *Page[T]) error, opts ...*rest.ListOptions) error {
+	return c.walk(ctx, func(page *Page[T], item *T) error {
+		if item != nil {
+			page.Items = append(page.Items, *item)
+			return nil
+		}
+		return fn(page)
+	}, opts...)
+}
+
+// Each calls fn for every item of the collection. Items are decoded one by one
+// from the response stream and never collected in a list.
+func (c *Collection[T, L]) Each(ctx context.Context, fn func(item *T) error, opts ...*rest.ListOptions) error {
+	return c.walk(ctx, func(page *Page[T], item *T) error {
+		if item != nil {
+			return fn(item)
+		}
+		return nil
+	}, opts...)
+}
+
+// walk requests every page of the collection. fn is called with each decoded item,
+// then once with a nil item when the page is complete.
+func (c *Collection[T, L]) walk(ctx context.Context, fn func(page *Page[T], item *T) error, opts ...*rest.ListOptions) error {
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	options := rest.MergeListOptions(opts...)
+	if c.partition != "" {
+		options = rest.MergeListOptions(&rest.ListOptions{Partition: c.partition}, options)
+	}
+	if options.Top <= 0 {
+		options.Top = DefaultPageSize
+	}
+
+	for {
+		page, err := c.page(ctx, options, fn)
+		if errors.Is(err, ErrStopIteration) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if page.NextLink == "" || page.CurrentItemCount == 0 {
+			return nil
+		}
+		options.Skip = nextSkip(page.NextLink, options.Skip+page.CurrentItemCount)
+	}
+}
+
+// page requests and decodes a single page of the collection.
+func (c *Collection[T, L]) page(ctx context.Context, options *rest.ListOptions, fn func(page *Page[T], item *T) error) (*Page[T], error) {
+	body, err := c.request(http.MethodGet).ListOptions(options).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	page := &Page[T]{}
+	if err := decodePage(json.NewDecoder(body), page, fn); err != nil {
+		return nil, err
+	}
+	if err := fn(page, nil); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// decodePage reads a collection object token by token, so that the items are
+// handed to fn as soon as they are decoded.
+func decodePage[T any](dec *json.Decoder, page *Page[T], fn func(page *Page[T], item *T) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode page: %w", err)
+		}
+		key, _ := tok.(string)
+		switch key {
+		case "items":
+			if err := expectDelim(dec, '['); err != nil {
+				return err
+			}
+			for dec.More() {
+				var item T
+				if err := dec.Decode(&item); err != nil {
+					return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+				}
+				if err := fn(page, &item); err != nil {
+					return err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return err
+			}
+			continue
+		case "currentItemCount":
+			err = dec.Decode(&page.CurrentItemCount)
+		case "itemsPerPage":
+			err = dec.Decode(&page.ItemsPerPage)
+		case "pageIndex":
+			err = dec.Decode(&page.PageIndex)
+		case "startIndex":
+			err = dec.Decode(&page.StartIndex)
+		case "totalItems":
+			err = dec.Decode(&page.TotalItems)
+		case "totalPages":
+			err = dec.Decode(&page.TotalPages)
+		case "nextLink":
+			err = dec.Decode(&page.NextLink)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to decode page: %w", err)
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+// expectDelim reads the next token and checks it is the given delimiter.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return fmt.Errorf("failed to decode page: unexpected end of data")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode page: %w", err)
+	}
+	if tok != delim {
+		return fmt.Errorf("failed to decode page: expected %v, got %v", delim, tok)
+	}
+	return nil
+}
+
+// nextSkip returns the $skip of the nextLink returned by BIG-IP, or fallback if it cannot be parsed.
+func nextSkip(nextLink string, fallback int) int {
+	u, err := url.Parse(nextLink)
+	if err != nil {
+		return fallback
+	}
+	skip, err := strconv.Atoi(u.Query().Get("$skip"))
+	if err != nil || skip <= 0 {
+		return fallback
+	}
+	return skip
+}
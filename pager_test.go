@@ -0,0 +1,93 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newPagedServer serves total items named item-<n> honouring $top and $skip.
+func newPagedServer(t *testing.T, total int, requests *[]string) *BigIP {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+		top, _ := strconv.Atoi(r.URL.Query().Get("$top"))
+		skip, _ := strconv.Atoi(r.URL.Query().Get("$skip"))
+		page := map[string]interface{}{
+			"kind":         "tm:ltm:pool:poolcollectionstate",
+			"itemsPerPage": top,
+			"pageIndex":    skip/top + 1,
+			"startIndex":   skip + 1,
+			"totalItems":   total,
+			"totalPages":   (total + top - 1) / top,
+		}
+		var items []testPool
+		for i := skip; i < skip+top && i < total; i++ {
+			items = append(items, testPool{Name: fmt.Sprintf("item-%d", i)})
+		}
+		page["items"] = items
+		page["currentItemCount"] = len(items)
+		if skip+top < total {
+			page["nextLink"] = fmt.Sprintf("https://localhost/mgmt/tm/ltm/pool?$top=%d&$skip=%d", top, skip+top)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestCollectionPages(t *testing.T) {
+	var requests []string
+	b := newPagedServer(t, 7, &requests)
+	c := NewCollection[testPool, testPoolList](b, "ltm", "pool")
+
+	var sizes []int
+	err := c.Pages(context.Background(), func(page *Page[testPool]) error {
+		sizes = append(sizes, len(page.Items))
+		return nil
+	}, &rest.ListOptions{Top: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fmt.Sprint(sizes) != "[3 3 1]" {
+		t.Errorf("Expected pages of [3 3 1] items, got %v", sizes)
+	}
+	expected := []string{"%24top=3", "%24skip=3&%24top=3", "%24skip=6&%24top=3"}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestCollectionEachStopsEarly(t *testing.T) {
+	var requests []string
+	b := newPagedServer(t, 250, &requests)
+	c := NewCollection[testPool, testPoolList](b, "ltm", "pool")
+
+	var names []string
+	err := c.Each(context.Background(), func(item *testPool) error {
+		names = append(names, item.Name)
+		if len(names) == 120 {
+			return ErrStopIteration
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(names) != 120 || names[119] != "item-119" {
+		t.Errorf("Expected to stop after item-119, got %d items", len(names))
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected 2 page requests with the default page size, got %d", len(requests))
+	}
+}
@@ -0,0 +1,145 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"strings"
+)
+
+// PartitionError is returned by a BigIP restricted with InPartition when an operation
+// targets an object of another partition.
+type PartitionError struct {
+	// Partition is the partition the BigIP is restricted to and Path the rejected object.
+	Partition string
+	Path      string
+}
+
+// Error implements the errors.Error interface
+func (err *PartitionError) Error() string {
+	return fmt.Sprintf("%s is outside partition %s", err.Path, err.Partition)
+}
+
+// InPartition returns a view of the BigIP restricted to a partition, for tools that must
+// only touch the objects of one tenant:
+//
+//	tenant := b.InPartition("Tenant_A")
+//	pools, err := ltm.New(tenant).Pool().List(ctx)     // only the pools of Tenant_A
+//	pool, err := ltm.New(tenant).Pool().Get(ctx, "web") // /Tenant_A/web
+//
+// The collections of the view only list the objects of the partition, qualify bare names
+// as /<partition>/<name> and return a *PartitionError for the objects of other partitions.
+// Objects of other partitions, such as /Common/http, can still be referenced in the
+// properties of an object. The resources that send their own requests apply the same
+// checks with Qualify and RestrictBody, and their stats lists only keep the entries of the
+// partition, see RestrictEntries. Collections of objects without a partition, such as the
+// auth tokens, are not restricted, see Collection.WithoutPartition. Requests built by the
+// caller with RestClient are not checked.
+func (b *BigIP) InPartition(partition string) *BigIP {
+	view := *b
+	view.partition = strings.Trim(partition, "/")
+	return &view
+}
+
+// Partition returns the partition the BigIP is restricted to, if any.
+func (b *BigIP) Partition() string {
+	return b.partition
+}
+
+// DefaultPartition returns the partition set with WithDefaultPartition, if any.
+func (b *BigIP) DefaultPartition() string {
+	return b.defaultPartition
+}
+
+// Qualify returns the full path of name. A bare name is qualified with the partition of
+// the BigIP, and a *PartitionError is returned for an object of another partition.
+// Without a partition, a bare name is qualified with the default partition, if any, and
+// other names are returned unchanged.
+func (b *BigIP) Qualify(name string) (string, error) {
+	if b.partition == "" {
+		return qualify(b.defaultPartition, name), nil
+	}
+	p, err := rest.ParsePath(name)
+	if err != nil {
+		return "", err
+	}
+	if p = p.WithPartition(b.partition); p.Partition != b.partition {
+		return "", &PartitionError{Partition: b.partition, Path: name}
+	}
+	return p.String(), nil
+}
+
+// RestrictEntries removes from the entries of a stats collection, keyed by the links to
+// the stats of every object such as https://localhost/mgmt/tm/ltm/pool/~Common~web/stats,
+// the entries of the objects outside the partition of the BigIP.
+func RestrictEntries[E any](b *BigIP, entries map[string]E) {
+	if b.partition == "" {
+		return
+	}
+	for link := range entries {
+		for _, segment := range strings.Split(link, "/") {
+			if strings.HasPrefix(segment, "~") {
+				if partitionOf(segment) != b.partition {
+					delete(entries, link)
+				}
+				break
+			}
+		}
+	}
+}
+
+// qualify prefixes a bare name with partition. Invalid paths are returned unchanged,
+// so that the request reports them.
+func qualify(partition, name string) string {
+	p, err := rest.ParsePath(name)
+	if partition == "" || err != nil || p.IsFull() {
+		return name
+	}
+	return p.WithPartition(partition).String()
+}
+
+// partitionOf returns the partition of a full path such as /Common/web or ~Common~web,
+// or an empty string for a bare name.
+func partitionOf(fullPath string) string {
+	p, _ := rest.ParsePath(fullPath)
+	return p.Partition
+}
+
+// RestrictBody checks that the JSON body of a new object created in a BigIP restricted to a
+// partition belongs to the partition, and sets its partition property if it is missing.
+// Without a partition, only the missing partition property is set to the default partition,
+// if any.
+func (b *BigIP) RestrictBody(data []byte) ([]byte, error) {
+	partition := b.partition
+	if partition == "" {
+		partition = b.defaultPartition
+	}
+	if partition == "" {
+		return data, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	var name, bodyPartition string
+	if raw, ok := object["name"]; ok {
+		json.Unmarshal(raw, &name)
+	}
+	if raw, ok := object["partition"]; ok {
+		json.Unmarshal(raw, &bodyPartition)
+	}
+	switch {
+	case b.partition != "" && bodyPartition != "" && strings.Trim(bodyPartition, "/") != b.partition:
+		return nil, &PartitionError{Partition: b.partition, Path: "/" + strings.Trim(bodyPartition, "/") + "/" + name}
+	case b.partition != "" && partitionOf(name) != "" && partitionOf(name) != b.partition:
+		return nil, &PartitionError{Partition: b.partition, Path: name}
+	case bodyPartition != "" || partitionOf(name) != "":
+		return data, nil
+	}
+	object["partition"], _ = json.Marshal(partition)
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	return data, nil
+}
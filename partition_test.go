@@ -0,0 +1,181 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestInPartition(t *testing.T) {
+	type call struct {
+		method, path, query, body string
+	}
+	var calls []call
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, call{r.Method, r.URL.Path, r.URL.RawQuery, string(body)})
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	tenant := b.InPartition("Tenant_A")
+	if tenant.Partition() != "Tenant_A" || b.Partition() != "" {
+		t.Fatalf("Expected only the view to be restricted, got %q and %q", tenant.Partition(), b.Partition())
+	}
+
+	ctx := context.Background()
+	pools := NewCollection[testPool, testPoolList](tenant, "ltm", "pool")
+	if _, err := pools.List(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := pools.Get(ctx, "web"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := pools.Patch(ctx, "~Tenant_A~web", map[string]string{"monitor": "/Common/http"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := pools.Create(ctx, testPool{Name: "api", Monitor: "/Common/http"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var partitionErr *PartitionError
+	for name, err := range map[string]error{
+		"get":       func() error { _, err := pools.Get(ctx, "/Common/web"); return err }(),
+		"delete":    pools.Delete(ctx, "~Common~web"),
+		"create":    pools.Create(ctx, testPool{Name: "api", Partition: "Common"}),
+		"full path": pools.Create(ctx, testPool{Name: "/Common/api"}),
+		"scope":     func() error { _, err := pools.WithPartition("Common").List(ctx); return err }(),
+	} {
+		if !errors.As(err, &partitionErr) || partitionErr.Partition != "Tenant_A" {
+			t.Errorf("%s: expected a PartitionError, got %v", name, err)
+		}
+	}
+	if _, err := tenant.Qualify("/Common/web"); !errors.As(err, &partitionErr) {
+		t.Errorf("Expected a PartitionError, got %v", err)
+	}
+
+	expected := []call{
+		{http.MethodGet, "/mgmt/tm/ltm/pool", "%24filter=partition+eq+Tenant_A", ""},
+		{http.MethodGet, "/mgmt/tm/ltm/pool/~Tenant_A~web", "", ""},
+		{http.MethodPatch, "/mgmt/tm/ltm/pool/~Tenant_A~web", "", `{"monitor":"/Common/http"}`},
+		{http.MethodPost, "/mgmt/tm/ltm/pool", "", `{"monitor":"/Common/http","name":"api","partition":"Tenant_A"}`},
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected %d calls, got %d: %v", len(expected), len(calls), calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Expected call %v, got %v", expected[i], calls[i])
+		}
+	}
+}
+
+func TestWithDefaultPartition(t *testing.T) {
+	type call struct {
+		method, path, query, body string
+	}
+	var calls []call
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, call{r.Method, r.URL.Path, r.URL.RawQuery, string(body)})
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"), WithDefaultPartition("/Tenant_A/"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if b.DefaultPartition() != "Tenant_A" || b.Partition() != "" {
+		t.Fatalf("Expected Tenant_A to be the default partition only, got %q and %q", b.DefaultPartition(), b.Partition())
+	}
+
+	ctx := context.Background()
+	pools := NewCollection[testPool, testPoolList](b, "ltm", "pool")
+	for _, err := range []error{
+		func() error { _, err := pools.List(ctx); return err }(),
+		func() error { _, err := pools.Get(ctx, "web"); return err }(),
+		pools.Delete(ctx, "/Common/web"),
+		pools.Create(ctx, testPool{Name: "api"}),
+		pools.Create(ctx, testPool{Name: "api", Partition: "Common"}),
+	} {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	expected := []call{
+		{http.MethodGet, "/mgmt/tm/ltm/pool", "", ""},
+		{http.MethodGet, "/mgmt/tm/ltm/pool/~Tenant_A~web", "", ""},
+		{http.MethodDelete, "/mgmt/tm/ltm/pool/~Common~web", "", ""},
+		{http.MethodPost, "/mgmt/tm/ltm/pool", "", `{"name":"api","partition":"Tenant_A"}`},
+		{http.MethodPost, "/mgmt/tm/ltm/pool", "", `{"name":"api","partition":"Common"}`},
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, calls)
+	}
+}
+
+func TestWithoutPartition(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, r.Method+" "+r.URL.RequestURI()+" "+string(body))
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ctx := context.Background()
+	images := NewCollection[testPool, testPoolList](b.InPartition("Tenant_A"), "sys", "software", "image").WithoutPartition()
+	if _, err := images.List(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := images.Get(ctx, "BIGIP-17.1.0.iso"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := images.Create(ctx, testPool{Name: "BIGIP-17.1.0.iso"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"GET /mgmt/tm/sys/software/image ",
+		"GET /mgmt/tm/sys/software/image/BIGIP-17.1.0.iso ",
+		`POST /mgmt/tm/sys/software/image {"name":"BIGIP-17.1.0.iso"}`,
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected %d calls, got %d: %v", len(expected), len(calls), calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Expected call %q, got %q", expected[i], calls[i])
+		}
+	}
+}
+
+func TestRestrictEntries(t *testing.T) {
+	entries := map[string]int{
+		"https://localhost/mgmt/tm/ltm/pool/~Tenant_A~web/stats":                       1,
+		"https://localhost/mgmt/tm/ltm/pool/~Common~web/stats":                         2,
+		"https://localhost/mgmt/tm/ltm/pool/~Tenant_A~web/members/~Common~n1:80/stats": 3,
+	}
+	b := &BigIP{}
+	RestrictEntries(b, entries)
+	if len(entries) != 3 {
+		t.Errorf("Expected the entries to be kept without a partition, got %v", entries)
+	}
+	RestrictEntries(b.InPartition("Tenant_A"), entries)
+	if len(entries) != 2 || entries["https://localhost/mgmt/tm/ltm/pool/~Common~web/stats"] != 0 {
+		t.Errorf("Expected only the entries of Tenant_A, got %v", entries)
+	}
+}
@@ -0,0 +1,98 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PatchBody encodes the fields of a partial update sent with PATCH.
+//
+// fields may be a map, a json.RawMessage, a []byte holding JSON or a struct.
+// For a struct, only the fields that are present are sent, whatever their omitempty option:
+// a pointer, slice, map or interface field is present when it is not nil,
+// any other field is present when it is not the zero value.
+// Use a pointer field to set a property to its zero value, for example:
+//
+//	type poolMonitor struct {
+//		Monitor     string  `json:"monitor"`
+//		Description *string `json:"description"`
+//	}
+func PatchBody(fields interface{}) ([]byte, error) {
+	switch t := fields.(type) {
+	case json.RawMessage:
+		return t, nil
+	case []byte:
+		return t, nil
+	}
+
+	v := reflect.ValueOf(fields)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, fmt.Errorf("patch fields may not be nil")
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		return json.Marshal(fields)
+	case reflect.Struct:
+		present := make(map[string]json.RawMessage)
+		if err := presentFields(v, present); err != nil {
+			return nil, err
+		}
+		return json.Marshal(present)
+	default:
+		return nil, fmt.Errorf("unsupported type used for patch fields: %T", fields)
+	}
+}
+
+// presentFields collects the JSON encoding of every present field of the struct v.
+func presentFields(v reflect.Value, present map[string]json.RawMessage) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			for value.Kind() == reflect.Ptr {
+				if value.IsNil() {
+					break
+				}
+				value = value.Elem()
+			}
+			if value.Kind() == reflect.Struct {
+				if err := presentFields(value, present); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() || !isPresent(value) {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		data, err := json.Marshal(value.Interface())
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON data: %w", err)
+		}
+		present[name] = data
+	}
+	return nil
+}
+
+// isPresent reports whether a struct field is explicitly set.
+func isPresent(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return !v.IsNil()
+	default:
+		return !v.IsZero()
+	}
+}
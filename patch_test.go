@@ -0,0 +1,67 @@
+package bigip
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPatchBody(t *testing.T) {
+	empty := ""
+	zero := 0
+	type Base struct {
+		Partition string `json:"partition,omitempty"`
+	}
+	type fields struct {
+		Base
+		Monitor     string   `json:"monitor,omitempty"`
+		Description *string  `json:"description,omitempty"`
+		SlowRamp    *int     `json:"slowRampTime,omitempty"`
+		Members     []string `json:"members"`
+		Ignored     string   `json:"-"`
+		Unset       *string  `json:"unset,omitempty"`
+		internal    string
+	}
+
+	tests := []struct {
+		name     string
+		fields   interface{}
+		expected string
+	}{
+		{
+			name:     "map",
+			fields:   map[string]interface{}{"monitor": "/Common/http"},
+			expected: `{"monitor":"/Common/http"}`,
+		},
+		{
+			name:     "raw",
+			fields:   json.RawMessage(`{"session":"user-disabled"}`),
+			expected: `{"session":"user-disabled"}`,
+		},
+		{
+			name:     "struct with explicit zero values",
+			fields:   fields{Monitor: "/Common/http", Description: &empty, SlowRamp: &zero, Ignored: "x", internal: "x"},
+			expected: `{"description":"","monitor":"/Common/http","slowRampTime":0}`,
+		},
+		{
+			name:     "pointer to struct with embedded fields and empty slice",
+			fields:   &fields{Base: Base{Partition: "Common"}, Members: []string{}},
+			expected: `{"members":[],"partition":"Common"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := PatchBody(test.fields)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, data)
+			}
+		})
+	}
+
+	if _, err := PatchBody("monitor"); err == nil {
+		t.Error("Expected error for a string, but got none")
+	}
+}
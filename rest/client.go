@@ -28,6 +28,10 @@ type RESTClient struct {
 	content ClientContentConfig
 	// Set specific behavior of the client.  If not set http.DefaultClient will be used.
 	Client *http.Client
+	// Retry is the retry policy applied to every request. If nil, requests are sent only once.
+	Retry *RetryPolicy
+	// headers are set on every request made by the client.
+	headers http.Header
 }
 
 var _ Interface = &RESTClient{}
@@ -52,6 +56,21 @@ func NewRESTClient(baseURL *url.URL, baseAPIPath string, config ClientContentCon
 	}, nil
 }
 
+// WithHeader returns a copy of the client that sets the given header on every request,
+// for example the X-F5-REST-Coordination-Id header of a transaction.
+func (c *RESTClient) WithHeader(key string, values ...string) *RESTClient {
+	cc := *c
+	cc.headers = make(http.Header, len(c.headers)+1)
+	for k, v := range c.headers {
+		cc.headers[k] = append([]string(nil), v...)
+	}
+	cc.headers.Del(key)
+	for _, value := range values {
+		cc.headers.Add(key, value)
+	}
+	return &cc
+}
+
 // Verb begins a request with a verb (GET, POST, PUT, DELETE).
 //
 // Example usage of RESTClient's request building interface:
@@ -1,8 +1,12 @@
 package rest
 
 import (
+	"context"
 	"github.com/lefeck/go-bigip/transport"
+	"golang.org/x/oauth2"
+	"net"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -13,11 +17,23 @@ type Config struct {
 	Username    string
 	Password    string
 	BearerToken string
+	// TokenSource returns the token of every request. It takes precedence over BearerToken.
+	TokenSource oauth2.TokenSource
 	//BearerTokenFile   string
+	// TLSClientConfig holds the TLS settings. The server certificate is verified unless Insecure is set.
+	TLSClientConfig transport.TLSConfig
 	// The maximum length of time to wait before giving up on a server request. A value of zero means no timeout.
-	Timeout       time.Duration
+	Timeout time.Duration
+	// UserAgent is set on every request if not empty.
+	UserAgent string
+	// Proxy returns the proxy of a request, http.ProxyFromEnvironment if nil.
+	Proxy func(*http.Request) (*url.URL, error)
+	// Dial opens the connections, for example through a SOCKS5 proxy.
+	Dial          func(ctx context.Context, network, address string) (net.Conn, error)
 	Transport     http.RoundTripper
 	WrapTransport transport.WrapperFunc
+	// Retry controls how failed requests are retried. If nil, requests are sent only once.
+	Retry *RetryPolicy
 }
 
 type ContentConfig struct {
@@ -40,6 +56,10 @@ func RESTClientForConfigAndClient(config *Config, httpClient *http.Client) (*RES
 	}
 	// Initialize http for the next step.
 	restClient, err := NewRESTClient(baseURL, baseAPIPath, clientContent, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	restClient.Retry = config.Retry
 
-	return restClient, err
+	return restClient, nil
 }
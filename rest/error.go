@@ -3,11 +3,22 @@ package rest
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 )
 
+// RequestError is returned when the BIG-IP answers a request with an error status.
 type RequestError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Method and URL identify the request that failed.
+	Method string `json:"-"`
+	URL    string `json:"-"`
+
+	// Code, Message and ErrStack are decoded from the F5 error body.
 	Code     int      `json:"code,omitempty"`
 	Message  string   `json:"message,omitempty"`
 	ErrStack []string `json:"errorStack,omitempty"`
@@ -23,9 +34,43 @@ func NewRequestError(body io.Reader) (*RequestError, error) {
 	return &reqErr, nil
 }
 
+// maxErrorBodySize limits how much of a non JSON error body ends up in the error message.
+const maxErrorBodySize = 4096
+
+// newResponseError builds a RequestError from an error response, whatever its content type.
+func newResponseError(resp *http.Response) (*RequestError, error) {
+	var reqErr *RequestError
+	if contentType := resp.Header.Get("Content-Type"); strings.Contains(contentType, "application/json") {
+		var err error
+		if reqErr, err = NewRequestError(resp.Body); err != nil {
+			return nil, err
+		}
+	} else {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		reqErr = &RequestError{Message: strings.TrimSpace(string(data))}
+	}
+	if reqErr.Message == "" {
+		reqErr.Message = resp.Status
+	}
+	if reqErr.Code == 0 {
+		reqErr.Code = resp.StatusCode
+	}
+	reqErr.StatusCode = resp.StatusCode
+	if resp.Request != nil {
+		reqErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			reqErr.URL = resp.Request.URL.String()
+		}
+	}
+	return reqErr, nil
+}
+
 // Error implements the errors.Error interface
 func (err RequestError) Error() string {
-	return fmt.Sprintf("%s (code: %d)", err.Message, err.Code)
+	if err.Method == "" {
+		return fmt.Sprintf("%s (code: %d)", err.Message, err.Code)
+	}
+	return fmt.Sprintf("%s %s: %s (code: %d)", err.Method, err.URL, err.Message, err.Code)
 }
 
 func (err RequestError) String() string {
@@ -35,3 +80,33 @@ func (err RequestError) String() string {
 	}
 	return buf.String()
 }
+
+// StatusCode returns the HTTP status code carried by err, or 0 if err is not a RequestError.
+func StatusCode(err error) int {
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err was caused by a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsConflict reports whether err was caused by a 409 Conflict response,
+// which BIG-IP returns when an object already exists.
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsUnauthorized reports whether err was caused by a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// IsServiceUnavailable reports whether err was caused by a 503 Service Unavailable response.
+func IsServiceUnavailable(err error) bool {
+	return StatusCode(err) == http.StatusServiceUnavailable
+}
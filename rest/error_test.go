@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestHandleError(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		status      int
+		body        string
+		message     string
+		check       func(error) bool
+	}{
+		{
+			name:        "JSON not found",
+			contentType: "application/json; charset=UTF-8",
+			status:      http.StatusNotFound,
+			body:        `{"code":404,"message":"01020036:3: The requested Pool (/Common/missing) was not found.","errorStack":[]}`,
+			message:     "01020036:3: The requested Pool (/Common/missing) was not found.",
+			check:       IsNotFound,
+		},
+		{
+			name:        "JSON conflict",
+			contentType: "application/json",
+			status:      http.StatusConflict,
+			body:        `{"code":409,"message":"already exists"}`,
+			message:     "already exists",
+			check:       IsConflict,
+		},
+		{
+			name:        "HTML unauthorized",
+			contentType: "text/html",
+			status:      http.StatusUnauthorized,
+			body:        "<html>Unauthorized</html>",
+			message:     "<html>Unauthorized</html>",
+			check:       IsUnauthorized,
+		},
+		{
+			name:        "Malformed JSON service unavailable",
+			contentType: "application/json",
+			status:      http.StatusServiceUnavailable,
+			body:        `{"code":503,`,
+			message:     "503 Service Unavailable",
+			check:       IsServiceUnavailable,
+		},
+		{
+			name:    "Empty service unavailable",
+			status:  http.StatusServiceUnavailable,
+			message: "503 Service Unavailable",
+			check:   IsServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.contentType != "" {
+					w.Header().Set("Content-Type", test.contentType)
+				}
+				w.WriteHeader(test.status)
+				fmt.Fprint(w, test.body)
+			}))
+			defer server.Close()
+
+			baseURL, _ := url.Parse(server.URL)
+			_, err := NewRequestWithClient(baseURL, "/mgmt", ClientContentConfig{}, http.DefaultClient).
+				Verb(http.MethodGet).DoRaw(context.Background())
+			if !test.check(err) {
+				t.Fatalf("Unexpected error type: %v", err)
+			}
+
+			reqErr := err.(*RequestError)
+			if reqErr.StatusCode != test.status || reqErr.Code != test.status {
+				t.Errorf("Expected status code %d, got %d (code %d)", test.status, reqErr.StatusCode, reqErr.Code)
+			}
+			if reqErr.Method != http.MethodGet || reqErr.URL != server.URL+"/mgmt" {
+				t.Errorf("Unexpected request %s %s", reqErr.Method, reqErr.URL)
+			}
+			if reqErr.Message != test.message {
+				t.Errorf("Expected message %q, got %q", test.message, reqErr.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Path is the full path of a BIG-IP object, such as /Common/app.app/vs or /Common/10.1.1.5%2:80.
+// It is made of a partition, an optional sub-path of folders, such as the .app folder of an
+// iApp, and a name, whose route domain is held apart.
+//
+// BIG-IP accepts full paths in URLs with "~" instead of "/", which Tilde returns:
+//
+//	p, _ := rest.ParsePath("/Common/app.app/vs")
+//	p.String() // "/Common/app.app/vs"
+//	p.Tilde()  // "~Common~app.app~vs"
+type Path struct {
+	// Partition is empty for a bare name.
+	Partition string
+	// SubPath lists the folders between the partition and the name, separated by "/".
+	SubPath string
+	// Name is the name of the object without its route domain, for example "10.1.1.5:80".
+	Name string
+	// RouteDomain is the route domain ID of an address name, for example "2", or empty.
+	RouteDomain string
+
+	// routeDomainAt is the position of the route domain in the parsed name, so that
+	// the name is rendered as it was written. It is zero if the Path was not parsed.
+	routeDomainAt int
+}
+
+// ParsePath parses a full path written with "/" or "~" separators, or a bare name.
+func ParsePath(s string) (Path, error) {
+	if s == "" {
+		return Path{}, fmt.Errorf("path may not be empty")
+	}
+
+	var segments []string
+	switch s[0] {
+	case '/', '~':
+		segments = strings.Split(s[1:], s[:1])
+	default:
+		if strings.ContainsAny(s, "/~") {
+			return Path{}, fmt.Errorf("invalid path %q: a full path must start with / or ~", s)
+		}
+		segments = []string{s}
+	}
+	for _, segment := range segments {
+		if segment == "" || segment == "." || segment == ".." || strings.ContainsAny(segment, "/~?#") {
+			return Path{}, fmt.Errorf("invalid path %q: invalid segment %q", s, segment)
+		}
+	}
+
+	var p Path
+	if s[0] == '/' || s[0] == '~' {
+		p.Partition = segments[0]
+		segments = segments[1:]
+		if len(segments) == 0 {
+			// The path of a partition itself, such as /Common.
+			return p, nil
+		}
+	}
+	p.SubPath = strings.Join(segments[:len(segments)-1], "/")
+	p.setName(segments[len(segments)-1])
+	return p, nil
+}
+
+// NewPath returns the Path of an object named name, which may hold a route domain,
+// in the given partition and sub-path.
+func NewPath(partition, subPath, name string) Path {
+	p := Path{Partition: strings.Trim(partition, "/~"), SubPath: strings.Trim(subPath, "/~")}
+	p.setName(name)
+	return p
+}
+
+// setName sets the name, splitting its route domain, such as %2 in 10.1.1.5%2:80.
+func (p *Path) setName(name string) {
+	p.Name, p.RouteDomain, p.routeDomainAt = name, "", 0
+	i := strings.IndexByte(name, '%')
+	if i < 0 {
+		return
+	}
+	j := i + 1
+	for j < len(name) && name[j] >= '0' && name[j] <= '9' {
+		j++
+	}
+	if j == i+1 {
+		return
+	}
+	p.Name = name[:i] + name[j:]
+	p.RouteDomain = name[i+1 : j]
+	p.routeDomainAt = i
+}
+
+// FullName returns the name with its route domain, for example "10.1.1.5%2:80".
+func (p Path) FullName() string {
+	if p.RouteDomain == "" {
+		return p.Name
+	}
+	at := p.routeDomainAt
+	if at <= 0 || at > len(p.Name) {
+		at = routeDomainPosition(p.Name)
+	}
+	return p.Name[:at] + "%" + p.RouteDomain + p.Name[at:]
+}
+
+// routeDomainPosition returns where the route domain goes in an address name: before the
+// port of 10.1.1.5:80 or 2001:db8::5.80, otherwise at the end.
+func routeDomainPosition(name string) int {
+	if net.ParseIP(name) != nil {
+		return len(name)
+	}
+	if host, _, err := net.SplitHostPort(name); err == nil && net.ParseIP(host) != nil {
+		return len(host)
+	}
+	if i := strings.LastIndexByte(name, '.'); i > 0 && strings.Contains(name[:i], ":") && net.ParseIP(name[:i]) != nil {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			return i
+		}
+	}
+	return len(name)
+}
+
+// IsFull reports whether the path holds a partition, otherwise it is a bare name.
+func (p Path) IsFull() bool {
+	return p.Partition != ""
+}
+
+// WithPartition returns the path in partition if it is a bare name, and p otherwise.
+func (p Path) WithPartition(partition string) Path {
+	if p.IsFull() {
+		return p
+	}
+	p.Partition = strings.Trim(partition, "/~")
+	return p
+}
+
+// String returns the path with "/" separators, for example "/Common/app.app/vs".
+func (p Path) String() string {
+	return p.join("/")
+}
+
+// Tilde returns the path with "~" separators, as used in URLs, for example "~Common~app.app~vs".
+func (p Path) Tilde() string {
+	return p.join("~")
+}
+
+// join renders the path with the given separator.
+func (p Path) join(sep string) string {
+	if !p.IsFull() {
+		return p.FullName()
+	}
+	s := sep + p.Partition
+	if p.SubPath != "" {
+		s += sep + strings.ReplaceAll(p.SubPath, "/", sep)
+	}
+	if name := p.FullName(); name != "" {
+		s += sep + name
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		in          string
+		partition   string
+		subPath     string
+		name        string
+		routeDomain string
+		slash       string
+		tilde       string
+	}{
+		{"web", "", "", "web", "", "web", "web"},
+		{"/Common/web", "Common", "", "web", "", "/Common/web", "~Common~web"},
+		{"~Common~web", "Common", "", "web", "", "/Common/web", "~Common~web"},
+		{"/Common/app.app/vs", "Common", "app.app", "vs", "", "/Common/app.app/vs", "~Common~app.app~vs"},
+		{"~Tenant_A~f1~f2~vs", "Tenant_A", "f1/f2", "vs", "", "/Tenant_A/f1/f2/vs", "~Tenant_A~f1~f2~vs"},
+		{"/Common/10.1.1.5%2:80", "Common", "", "10.1.1.5:80", "2", "/Common/10.1.1.5%2:80", "~Common~10.1.1.5%2:80"},
+		{"10.1.1.5%2", "", "", "10.1.1.5", "2", "10.1.1.5%2", "10.1.1.5%2"},
+		{"/Common/2001:db8::5%10.443", "Common", "", "2001:db8::5.443", "10", "/Common/2001:db8::5%10.443", "~Common~2001:db8::5%10.443"},
+		{"/Common/vs_10.1.1.5%2_80", "Common", "", "vs_10.1.1.5_80", "2", "/Common/vs_10.1.1.5%2_80", "~Common~vs_10.1.1.5%2_80"},
+		{"/Common", "Common", "", "", "", "/Common", "~Common"},
+	}
+	for _, test := range tests {
+		p, err := ParsePath(test.in)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+			continue
+		}
+		if p.Partition != test.partition || p.SubPath != test.subPath || p.Name != test.name || p.RouteDomain != test.routeDomain {
+			t.Errorf("%s: unexpected path %+v", test.in, p)
+		}
+		if p.String() != test.slash || p.Tilde() != test.tilde {
+			t.Errorf("%s: expected %s and %s, got %s and %s", test.in, test.slash, test.tilde, p.String(), p.Tilde())
+		}
+	}
+
+	for _, in := range []string{"", "/", "/Common//web", "Common/web", "/Common/../web", "/Common/a~b", "/Common/web?x"} {
+		if _, err := ParsePath(in); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+}
+
+func TestNewPath(t *testing.T) {
+	tests := []struct {
+		path Path
+		want string
+	}{
+		{NewPath("Common", "", "10.1.1.5%2:80"), "/Common/10.1.1.5%2:80"},
+		{Path{Partition: "Common", Name: "10.1.1.5:80", RouteDomain: "2"}, "/Common/10.1.1.5%2:80"},
+		{Path{Partition: "Common", Name: "2001:db8::5.80", RouteDomain: "3"}, "/Common/2001:db8::5%3.80"},
+		{Path{Partition: "Common", Name: "2001:db8::5", RouteDomain: "3"}, "/Common/2001:db8::5%3"},
+		{Path{Name: "web"}.WithPartition("Tenant_A"), "/Tenant_A/web"},
+		{NewPath("/Common/", "app.app", "vs").WithPartition("Tenant_A"), "/Common/app.app/vs"},
+	}
+	for _, test := range tests {
+		if got := test.path.String(); got != test.want {
+			t.Errorf("Expected %s, got %s", test.want, got)
+		}
+	}
+}
+
+func TestResourceInstancePath(t *testing.T) {
+	base, _ := url.Parse("https://bigip.local")
+	c, _ := NewRESTClient(base, "", ClientContentConfig{}, nil)
+
+	u := c.Get().Prefix("mgmt").ResourceCategory("tm").ManagerName("ltm").Resource("pool").
+		ResourceInstance("/Common/app.app/web").SubResource("members").SubResourceInstance("/Common/10.1.1.5%2:80").URL()
+	if want := "https://bigip.local/mgmt/tm/ltm/pool/~Common~app.app~web/members/~Common~10.1.1.5%252:80"; u.String() != want {
+		t.Errorf("Expected %s, got %s", want, u.String())
+	}
+
+	req := c.Get().ResourceInstance("/Common/web").ResourceInstance("/Common/api")
+	if req.Error() == nil {
+		t.Error("Expected an error when the instance is set twice")
+	}
+	if req := c.Get().ResourceInstance("Common/web"); req.Error() == nil {
+		t.Error("Expected an error for a relative path")
+	}
+}
+
+func TestInvalidPathNotSent(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+	base, _ := url.Parse(server.URL)
+	c, _ := NewRESTClient(base, "", ClientContentConfig{}, nil)
+
+	// Without the instance, the request would target the whole collection.
+	if _, err := c.Delete().Prefix("mgmt").ResourceCategory("tm").ManagerName("ltm").Resource("pool").
+		ResourceInstance("Common/web").DoRaw(context.Background()); err == nil {
+		t.Error("Expected an error for a relative path")
+	}
+	if requests != 0 {
+		t.Errorf("Expected no request to be sent, got %d", requests)
+	}
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListOptions holds the OData query parameters supported by BIG-IP collections.
+type ListOptions struct {
+	// Select limits the properties returned for every item, for example []string{"name", "fullPath"}.
+	Select []string
+	// Filter is a raw $filter expression, for example "partition eq Common".
+	Filter string
+	// Partition only returns the items of the given partition. It is combined with Filter.
+	Partition string
+	// Top is the maximum number of items returned in one page. Zero means no limit.
+	Top int
+	// Skip is the number of items skipped before the first returned item.
+	Skip int
+	// ExpandSubcollections embeds sub-collections, such as the members of a pool, in every item.
+	ExpandSubcollections bool
+	// Options are passed as tmsh options, for example "recursive" or "all-properties".
+	Options []string
+}
+
+// Values encodes the options as URL query parameters.
+func (o *ListOptions) Values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if len(o.Select) != 0 {
+		values.Set("$select", strings.Join(o.Select, ","))
+	}
+	if filter := o.filter(); filter != "" {
+		values.Set("$filter", filter)
+	}
+	if o.Top > 0 {
+		values.Set("$top", strconv.Itoa(o.Top))
+	}
+	if o.Skip > 0 {
+		values.Set("$skip", strconv.Itoa(o.Skip))
+	}
+	if o.ExpandSubcollections {
+		values.Set("expandSubcollections", "true")
+	}
+	if len(o.Options) != 0 {
+		values.Set("options", strings.Join(o.Options, ","))
+	}
+	return values
+}
+
+// filter combines the partition and the raw filter expression.
+func (o *ListOptions) filter() string {
+	var exprs []string
+	if o.Partition != "" {
+		exprs = append(exprs, "partition eq "+strings.Trim(o.Partition, "/"))
+	}
+	if o.Filter != "" {
+		exprs = append(exprs, o.Filter)
+	}
+	return joinFilters(exprs)
+}
+
+// joinFilters combines filter expressions, wrapping each one in parentheses when there are several
+// so that an expression holding "or" keeps its meaning.
+func joinFilters(exprs []string) string {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	wrapped := make([]string, len(exprs))
+	for i, expr := range exprs {
+		wrapped[i] = "(" + expr + ")"
+	}
+	return strings.Join(wrapped, " and ")
+}
+
+// MergeListOptions merges several options into one. Later options override earlier ones,
+// except for Select and Options which are appended and Filter which is combined with "and",
+// each expression in parentheses.
+func MergeListOptions(opts ...*ListOptions) *ListOptions {
+	merged := &ListOptions{}
+	var filters []string
+	for _, o := range opts {
+		if o == nil {
+			continue
+		}
+		merged.Select = append(merged.Select, o.Select...)
+		merged.Options = append(merged.Options, o.Options...)
+		if filter := o.filter(); filter != "" {
+			filters = append(filters, filter)
+		}
+		if o.Top != 0 {
+			merged.Top = o.Top
+		}
+		if o.Skip != 0 {
+			merged.Skip = o.Skip
+		}
+		merged.ExpandSubcollections = merged.ExpandSubcollections || o.ExpandSubcollections
+	}
+	merged.Filter = joinFilters(filters)
+	return merged
+}
+
+// ListOptions adds the query parameters of opts to the request.
+func (r *Request) ListOptions(opts ...*ListOptions) *Request {
+	if r.err != nil {
+		return r
+	}
+	for key, values := range MergeListOptions(opts...).Values() {
+		for _, value := range values {
+			r.SetParams(key, value)
+		}
+	}
+	return r
+}
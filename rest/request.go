@@ -3,7 +3,7 @@ package rest
 import (
 	"bytes"
 	"context"
-	"errors"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,9 +15,9 @@ import (
 )
 
 type Request struct {
-	c       *RESTClient
-	timeout time.Duration
-	//maxRetries       int
+	c                *RESTClient
+	timeout          time.Duration
+	retry            *RetryPolicy
 	verb             string
 	pathPrefix       string
 	subpath          string
@@ -47,9 +47,9 @@ func NewRequest(c *RESTClient) *Request {
 		timeout = c.Client.Timeout
 	}
 	r := Request{
-		c:       c,
-		timeout: timeout,
-		//maxRetries: 10,
+		c:          c,
+		timeout:    timeout,
+		retry:      c.Retry,
 		pathPrefix: pathPrefix,
 	}
 	switch {
@@ -58,6 +58,9 @@ func NewRequest(c *RESTClient) *Request {
 	case len(c.content.ContentType) > 0:
 		r.SetHeader("Accept", c.content.ContentType+", */*")
 	}
+	for key, values := range c.headers {
+		r.SetHeader(key, values...)
+	}
 	return &r
 }
 
@@ -75,6 +78,12 @@ func (r *Request) Verb(verb string) *Request {
 	return r
 }
 
+// Retry overrides the retry policy of the client for this request. A nil policy disables retries.
+func (r *Request) Retry(policy *RetryPolicy) *Request {
+	r.retry = policy
+	return r
+}
+
 /*
 https://localhost/mgmt/tm/sys/restricted-module
 https://IP/mgmt/tm/<module name>/<subresource>
@@ -130,32 +139,43 @@ func (r *Request) Resource(resource string) *Request {
 	return r
 }
 
+// ResourceInstance sets the full path of the resource object, for example "/Common/web" or a bare name.
+// The segments are joined with "/" and the path is sent in its "~" form, see Path.
 func (r *Request) ResourceInstance(fullPaths ...string) *Request {
 	if r.err != nil {
 		return r
 	}
-	fullPath := path.Join(fullPaths...)
+	fullPath := strings.Join(fullPaths, "/")
 	if len(r.fullPath) != 0 {
-		fmt.Errorf("fullPath already set to %q, cannot change to %q", r.fullPath, fullPath)
+		r.err = fmt.Errorf("fullPath already set to %q, cannot change to %q", r.fullPath, fullPath)
 		return r
 	}
-	newfullPath := convertSubPath(fullPath)
-	r.fullPath = newfullPath
-
+	p, err := ParsePath(fullPath)
+	if err != nil {
+		r.err = err
+		return r
+	}
+	r.fullPath = p.Tilde()
 	return r
 }
 
+// SubResourceInstance sets the full path of the sub-resource object, such as a pool member.
+// The segments are joined with "/" and the path is sent in its "~" form, see Path.
 func (r *Request) SubResourceInstance(subFullPaths ...string) *Request {
 	if r.err != nil {
 		return r
 	}
-	subfullPath := path.Join(subFullPaths...)
+	subFullPath := strings.Join(subFullPaths, "/")
 	if len(r.subFullPath) != 0 {
-		fmt.Errorf("subfullPath already set to %q, cannot change to %q", r.subFullPath, subFullPaths)
+		r.err = fmt.Errorf("subFullPath already set to %q, cannot change to %q", r.subFullPath, subFullPath)
+		return r
+	}
+	p, err := ParsePath(subFullPath)
+	if err != nil {
+		r.err = err
 		return r
 	}
-	newsubFullPath := convertSubPath(subfullPath)
-	r.subFullPath = newsubFullPath
+	r.subFullPath = p.Tilde()
 	return r
 }
 
@@ -198,15 +218,6 @@ func (r *Request) SubStatsResource(subStatsResources ...string) *Request {
 	return r
 }
 
-// Convert subpath to appropriate method
-func convertSubPath(path string) string {
-	parts := strings.Split(path, "/")
-	for i := 1; i < len(parts); i++ {
-		parts[i] = strings.ReplaceAll(parts[i], "/", "~")
-	}
-	return strings.Join(parts, "~")
-}
-
 // Name sets the name of a resource to access
 func (r *Request) ManagerName(managerName string) *Request {
 	if r.err != nil {
@@ -239,11 +250,13 @@ func (r *Request) AbsPath(segments ...string) *Request {
 	return r
 }
 
+// SetParams sets the query parameter paramName to s, replacing any previous value.
 func (r *Request) SetParams(paramName, s string) *Request {
-	if r.params != nil {
-		return r
+	if r.params == nil {
+		r.params = make(url.Values)
 	}
-	return r.setParams(paramName, s)
+	r.params.Set(paramName, s)
+	return r
 }
 
 func (r *Request) setParams(paramName, value string) *Request {
@@ -270,14 +283,19 @@ func (r *Request) Error() error {
 }
 
 // HandleError checks if a HTTP response contains an error and returns it.
+// The returned error is a *RequestError, which can be inspected with IsNotFound, IsConflict and friends.
 func (r *Request) HandleError(resp *http.Response) error {
 	if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusPartialContent {
-		if contentType := resp.Header.Get("Content-Type"); !strings.Contains(contentType, "application/json") {
-			return fmt.Errorf("The http response error status code: %s \n", resp.Status)
-		}
-		errResp, err := NewRequestError(resp.Body)
+		errResp, err := newResponseError(resp)
 		if err != nil {
-			return errors.New("cannot read error message from response body: " + err.Error())
+			// The body could not be decoded, but the status code still tells what happened.
+			errResp = &RequestError{StatusCode: resp.StatusCode, Code: resp.StatusCode, Message: resp.Status}
+			if resp.Request != nil {
+				errResp.Method = resp.Request.Method
+				if resp.Request.URL != nil {
+					errResp.URL = resp.Request.URL.String()
+				}
+			}
 		}
 		return errResp
 	}
@@ -330,33 +348,138 @@ func ValidatePathSegmentName(name string, prefix bool) []string {
 }
 
 func (r *Request) request(ctx context.Context, fn func(req *http.Request, resp *http.Response)) error {
-	client := r.c.Client
-	if client == nil {
-		client = http.DefaultClient
-	}
 	if r.timeout > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, r.timeout)
 		defer cancel()
 	}
 
-	req, err := r.newHTTPRequest(ctx)
+	req, resp, err := r.do(ctx)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
+
+	fn(req, resp)
+
+	return nil
+}
+
+// do sends the request, retrying it according to the retry policy, and returns
+// the first successful response. The caller must close the response body.
+func (r *Request) do(ctx context.Context) (*http.Request, *http.Response, error) {
+	// An error found while building the request, such as an invalid path, must not
+	// send the request to another URL.
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+	client := r.c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	attempts := r.retry.attempts()
+	if r.body != nil {
+		// A plain io.Reader can only be read once, so the body must be rewound before each retry.
+		if _, ok := r.body.(io.Seeker); !ok {
+			attempts = 1
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			if seeker, ok := r.body.(io.Seeker); ok {
+				if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+		req, err := r.newHTTPRequest(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			retryable := isIdempotent(r.verb) || isDialError(err)
+			if attempt >= attempts || !retryable || ctx.Err() != nil {
+				return nil, nil, err
+			}
+			if err := r.wait(ctx, r.retry.backoff(attempt)); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		if attempt < attempts && isIdempotent(r.verb) && r.retry.retryableStatus(resp.StatusCode) {
+			delay, ok := r.retry.retryAfter(resp)
+			if !ok {
+				delay = r.retry.backoff(attempt)
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if err := r.wait(ctx, delay); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		if err := r.HandleError(resp); err != nil {
+			resp.Body.Close()
+			return nil, nil, err
+		}
+		return req, resp, nil
+	}
+}
 
-	resp, err := client.Do(req)
+// Stream executes the request and returns the response body without reading it,
+// so that large collections can be decoded as they arrive. The caller must close the body.
+func (r *Request) Stream(ctx context.Context) (io.ReadCloser, error) {
+	resp, err := r.StreamResponse(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
-	if err := r.HandleError(resp); err != nil {
-		return err
+	return resp.Body, nil
+}
+
+// StreamResponse is like Stream but also returns the status and headers of the response,
+// for example the Content-Range of a file chunk. The caller must close the body.
+func (r *Request) StreamResponse(ctx context.Context) (*http.Response, error) {
+	cancel := context.CancelFunc(func() {})
+	if r.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
 	}
 
-	fn(req, resp)
+	_, resp, err := r.do(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
 
-	return nil
+// cancelReadCloser releases the context of a streamed request once its body is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// wait blocks for the given delay or until the context is done.
+func (r *Request) wait(ctx context.Context, delay time.Duration) error {
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
 }
 
 // Body makes the request use obj as the body. Optional.
@@ -391,14 +514,30 @@ func (r *Request) Body(obj interface{}) *Request {
 
 // DoRaw executes the request but does not process the response body.
 func (r *Request) DoRaw(ctx context.Context) ([]byte, error) {
+	return r.Do(ctx).Raw()
+}
+
+// Do executes the request and returns its Result, which holds the status code and headers
+// of the response besides its body, for example the 202 Accepted of an asynchronous task:
+//
+//	var task TaskStatus
+//	result := req.Do(ctx)
+//	if err := result.Into(&task); err != nil { ... }
+//	if result.Code == http.StatusAccepted { ... }
+func (r *Request) Do(ctx context.Context) Result {
 	var result Result
 	err := r.request(ctx, func(req *http.Request, resp *http.Response) {
+		result.Code = resp.StatusCode
+		result.Header = resp.Header
+		result.ContentType = resp.Header.Get("Content-Type")
+		result.ETag = resp.Header.Get("ETag")
 		result.Body, result.Err = io.ReadAll(resp.Body)
 	})
 	if err != nil {
-		return nil, err
+		result.Code = StatusCode(err)
+		result.Err = err
 	}
-	return result.Body, result.Err
+	return result
 }
 
 // Result contains the result of calling Request.Do().
@@ -406,7 +545,36 @@ type Result struct {
 	Body        []byte
 	ContentType string
 	Err         error
-	Code        int
+	// Code is the HTTP status code of the response, or 0 if no response was received.
+	Code int
+	// Header holds the headers of a successful response, such as X-F5-REST-Coordination-Id.
+	Header http.Header
+	ETag   string
+}
+
+// Raw returns the body of the response and the error of the request.
+func (r Result) Raw() ([]byte, error) {
+	return r.Body, r.Err
+}
+
+// Error returns the error of the request, a *RequestError for an error status.
+func (r Result) Error() error {
+	return r.Err
+}
+
+// Into decodes the JSON body of the response into obj. It returns the error of the
+// request if it failed, and does nothing for an empty body, such as the one of a DELETE.
+func (r Result) Into(obj interface{}) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if len(bytes.TrimSpace(r.Body)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.Body, obj); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return nil
 }
 
 // URL returns the current working URL. Check the result of Error() to ensure
@@ -152,3 +152,98 @@ func TestDoRaw(t *testing.T) {
 		t.Fatalf("Expected response %q, got %q", expectedResponse, resp)
 	}
 }
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/test/missing" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"01020036:3: The requested pool was not found."}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.Header().Set("ETag", `"5"`)
+		w.Header().Set("X-F5-REST-Coordination-Id", "1234")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"_id":"a1b2","_taskState":"STARTED"}`))
+	}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+	client := &RESTClient{Base: baseURL, baseAPIPath: "/test", Client: http.DefaultClient}
+	result := NewRequest(client).Verb("POST").Do(context.Background())
+	if result.Code != http.StatusAccepted || result.ETag != `"5"` || result.Header.Get("X-F5-REST-Coordination-Id") != "1234" ||
+		result.ContentType != "application/json; charset=UTF-8" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	var task struct {
+		ID    string `json:"_id"`
+		State string `json:"_taskState"`
+	}
+	if err := result.Into(&task); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if task.ID != "a1b2" || task.State != "STARTED" {
+		t.Errorf("Expected the body to be decoded, got %+v", task)
+	}
+
+	result = NewRequest(client).Verb("GET").Resource("missing").Do(context.Background())
+	if result.Code != http.StatusNotFound || !IsNotFound(result.Error()) {
+		t.Errorf("Expected a 404 result, got %+v", result)
+	}
+	if err := result.Into(&task); !IsNotFound(err) {
+		t.Errorf("Expected Into to return the request error, got %v", err)
+	}
+}
+
+func TestListOptions(t *testing.T) {
+	baseURL, _ := url.Parse("https://localhost")
+	restClient := &RESTClient{Base: baseURL, Client: http.DefaultClient}
+
+	tests := []struct {
+		name     string
+		opts     []*ListOptions
+		expected string
+	}{
+		{
+			name:     "no options",
+			expected: "",
+		},
+		{
+			name: "all options",
+			opts: []*ListOptions{{
+				Select:               []string{"name", "fullPath"},
+				Partition:            "Common",
+				Filter:               "name eq web",
+				Top:                  10,
+				Skip:                 20,
+				ExpandSubcollections: true,
+				Options:              []string{"recursive"},
+			}},
+			expected: "%24filter=%28partition+eq+Common%29+and+%28name+eq+web%29&%24select=name%2CfullPath&%24skip=20&%24top=10&expandSubcollections=true&options=recursive",
+		},
+		{
+			name:     "merged options",
+			opts:     []*ListOptions{{Partition: "Tenant_A", Top: 5}, nil, {Select: []string{"name"}, Top: 50}},
+			expected: "%24filter=partition+eq+Tenant_A&%24select=name&%24top=50",
+		},
+		{
+			name:     "merged filters",
+			opts:     []*ListOptions{{Partition: "Tenant_A", Filter: "name eq web or name eq api"}, {Filter: "description eq prod"}},
+			expected: "%24filter=%28%28partition+eq+Tenant_A%29+and+%28name+eq+web+or+name+eq+api%29%29+and+%28description+eq+prod%29",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := NewRequest(restClient).Verb("GET").SetParams("ver", "15.1.0").ListOptions(tc.opts...)
+			expected := "ver=15.1.0"
+			if tc.expected != "" {
+				expected = tc.expected + "&" + expected
+			}
+			if query := req.URL().RawQuery; query != expected {
+				t.Errorf("Expected query %q, got %q", expected, query)
+			}
+		})
+	}
+}
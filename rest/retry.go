@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how a Request is retried when restjavad is overloaded
+// or the connection to the device fails.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value lower than 2 disables retries.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. Every further retry doubles it.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0 to 1) of the backoff that is randomized, so that
+	// many clients do not hit the device at the same moment.
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that are retried for idempotent verbs.
+	// If empty, 429, 502, 503 and 504 are retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for most BIG-IP devices.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.5,
+	}
+}
+
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// attempts returns the number of attempts allowed by the policy.
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns the delay before the given retry, starting from 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := float64(p.MinBackoff) * math.Pow(2, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		d -= d * jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// retryableStatus reports whether the status code of resp should be retried.
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	codes := p.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotent reports whether a request with the given verb can be safely sent twice.
+func isIdempotent(verb string) bool {
+	switch verb {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isDialError reports whether err happened before the request reached the device,
+// in which case even a non-idempotent request can be sent again.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryAfter returns the delay of the Retry-After header, bounded by MaxBackoff so that
+// a device cannot stall a request for longer than the policy allows.
+func (p *RetryPolicy) retryAfter(resp *http.Response) (time.Duration, bool) {
+	d, ok := parseRetryAfter(resp)
+	if ok && p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d, ok
+}
+
+// parseRetryAfter parses the Retry-After header, which holds either seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newRetryTestRequest(t *testing.T, handler http.HandlerFunc, policy *RetryPolicy) (*RESTClient, func()) {
+	server := httptest.NewServer(handler)
+	baseURL, _ := url.Parse(server.URL)
+	client, err := NewRESTClient(baseURL, "/mgmt", ClientContentConfig{}, http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	client.Retry = policy
+	return client, server.Close
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	calls := 0
+	client, closeFn := newRetryTestRequest(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}, &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond})
+	defer closeFn()
+
+	res, err := client.Get().Prefix("tm").DoRaw(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(res) != "ok" || calls != 3 {
+		t.Errorf("Expected body %q after 3 calls, got %q after %d calls", "ok", res, calls)
+	}
+}
+
+func TestRetrySkipsNonIdempotentRequest(t *testing.T) {
+	calls := 0
+	client, closeFn := newRetryTestRequest(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond})
+	defer closeFn()
+
+	_, err := client.Post().Prefix("tm").Body(strings.NewReader("{}")).DoRaw(context.Background())
+	if err == nil {
+		t.Fatal("Expected error, but got none")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryRewindsBody(t *testing.T) {
+	var bodies []string
+	client, closeFn := newRetryTestRequest(t, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}, &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Hour})
+	defer closeFn()
+
+	_, err := client.Put().Prefix("tm").Body(strings.NewReader(`{"name":"test"}`)).DoRaw(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Errorf("Expected the same body to be sent twice, got %q", bodies)
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	client, closeFn := newRetryTestRequest(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, &RetryPolicy{MaxAttempts: 5, MinBackoff: time.Hour})
+	defer closeFn()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Get().Prefix("tm").DoRaw(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	tests := []struct {
+		retry    int
+		expected time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+	}
+	for _, tc := range tests {
+		if d := policy.backoff(tc.retry); d != tc.expected {
+			t.Errorf("Expected backoff %v for retry %d, got %v", tc.expected, tc.retry, d)
+		}
+	}
+}
+
+func TestRetryAfterBoundedByMaxBackoff(t *testing.T) {
+	calls := 0
+	client, closeFn := newRetryTestRequest(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}, &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+	defer closeFn()
+
+	start := time.Now()
+	if _, err := client.Get().Prefix("tm").DoRaw(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected Retry-After to be bounded by MaxBackoff, waited %v", elapsed)
+	}
+}
@@ -53,6 +53,11 @@ func (c *Config) TransportConfig() (*transport.Config, error) {
 		Username:      c.Username,
 		Password:      c.Password,
 		BearerToken:   c.BearerToken,
+		TokenSource:   c.TokenSource,
+		TLS:           c.TLSClientConfig,
+		UserAgent:     c.UserAgent,
+		Proxy:         c.Proxy,
+		DialContext:   c.Dial,
 		//BearerTokenFile: c.BearerTokenFile,
 	}
 	return conf, nil
@@ -1,5 +1,7 @@
 package alert
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import (
 	"context"
 	"encoding/json"
@@ -28,10 +30,10 @@ type AlertResource struct {
 }
 
 // ListAll  lists all the Alert configurations.
-func (r *AlertResource) List() (*AlertConfigList, error) {
+func (r *AlertResource) List(ctx context.Context) (*AlertConfigList, error) {
 	var items AlertConfigList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(AlertEndpoint).DoRaw(context.Background())
+		Resource(AlertEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
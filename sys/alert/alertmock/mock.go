@@ -0,0 +1,29 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package alertmock provides mocks of the interfaces of package alert, which record their calls.
+package alertmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/sys/alert"
+)
+
+// AlertAPI is a mock of alert.AlertAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type AlertAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*alert.AlertConfigList, error)
+}
+
+var _ alert.AlertAPI = &AlertAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *AlertAPI) List(ctx context.Context) (r0 *alert.AlertConfigList, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
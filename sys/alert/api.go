@@ -0,0 +1,15 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package alert
+
+import (
+	"context"
+)
+
+// AlertAPI is the interface of AlertResource, which mocks implement in tests.
+type AlertAPI interface {
+	// ListAll  lists all the Alert configurations.
+	List(ctx context.Context) (*AlertConfigList, error)
+}
+
+var _ AlertAPI = &AlertResource{}
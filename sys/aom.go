@@ -28,10 +28,10 @@ type AOMResource struct {
 }
 
 // List retrieves all AOM details.
-func (r *AOMResource) Show() (*AOM, error) {
+func (r *AOMResource) Show(ctx context.Context) (*AOM, error) {
 	var items AOM
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(AOMEndpoint).DoRaw(context.Background())
+		Resource(AOMEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -43,14 +43,14 @@ func (r *AOMResource) Show() (*AOM, error) {
 }
 
 // Update modifies the AOM item identified by the AOM name.
-func (r *AOMResource) Update(name string, item AOM) error {
+func (r *AOMResource) Update(ctx context.Context, name string, item AOM) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(AOMEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(AOMEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
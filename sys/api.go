@@ -0,0 +1,535 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package sys
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/application"
+	"github.com/lefeck/go-bigip/sys/crypto"
+	"github.com/lefeck/go-bigip/sys/disk"
+	"github.com/lefeck/go-bigip/sys/ipfix"
+	"github.com/lefeck/go-bigip/sys/pfman"
+	"github.com/lefeck/go-bigip/sys/raid"
+	"github.com/lefeck/go-bigip/sys/software"
+)
+
+// AOMAPI is the interface of AOMResource, which mocks implement in tests.
+type AOMAPI interface {
+	// List retrieves all AOM details.
+	Show(ctx context.Context) (*AOM, error)
+	// Update modifies the AOM item identified by the AOM name.
+	Update(ctx context.Context, name string, item AOM) error
+}
+
+var _ AOMAPI = &AOMResource{}
+
+// CPUStatsAPI is the interface of CPUStatsResource, which mocks implement in tests.
+type CPUStatsAPI interface {
+	Show(ctx context.Context) (*CPUList, error)
+}
+
+var _ CPUStatsAPI = &CPUStatsResource{}
+
+// ClockAPI is the interface of ClockResource, which mocks implement in tests.
+type ClockAPI interface {
+	// Get retrieves the details of a single Clock by node name.
+	Show(ctx context.Context) (*Clock, error)
+	// Create creates a new Clock item.
+	Create(ctx context.Context, item Clock) error
+	// Update modifies the Clock item identified by the Clock name.
+	Update(ctx context.Context, name string, item Clock) error
+	// Delete a single Clock identified by the Clock name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ClockAPI = &ClockResource{}
+
+// ClusterAPI is the interface of ClusterResource, which mocks implement in tests.
+type ClusterAPI interface {
+	// List retrieves all Cluster details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ClusterList, error)
+	// Get retrieves the details of a single Cluster by node name.
+	Get(ctx context.Context, name string) (*Cluster, error)
+	// Create creates a new Cluster item.
+	Create(ctx context.Context, item Cluster) error
+	// Update modifies the Cluster item identified by the Cluster name.
+	Update(ctx context.Context, name string, item Cluster) error
+	// Patch updates only the given fields of the Cluster identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Cluster identified by the Cluster name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ClusterAPI = &ClusterResource{}
+
+// ConfigAPI is the interface of ConfigResource, which mocks implement in tests.
+type ConfigAPI interface {
+	// Save saves the running configuration, like "tmsh save sys config".
+	// The command runs as an asynchronous task, so it is not bound by the request timeout.
+	Save(ctx context.Context, options ...map[string]interface{}) error
+	// Load loads the saved configuration, like "tmsh load sys config".
+	// Options such as {"file": "/var/local/scf/backup.scf"} or {"merge": ""} are passed to tmsh.
+	Load(ctx context.Context, options ...map[string]interface{}) error
+	// Run runs the given command as a /mgmt/tm/task/sys/config task and waits for it to complete.
+	Run(ctx context.Context, item ConfigCommand) error
+}
+
+var _ ConfigAPI = &ConfigResource{}
+
+// ConnectionAPI is the interface of ConnectionResource, which mocks implement in tests.
+type ConnectionAPI interface {
+	// List all connection details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ConnectionList, error)
+	// Get a single connection details by the node name
+	Get(ctx context.Context, name string) (*Connection, error)
+	// Create a new connection item
+	Create(ctx context.Context, item Connection) error
+	// Update the connection item identified by the connection name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item Connection) error
+	// Patch updates only the given fields of the Connection identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single connection identified by the connection name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ConnectionAPI = &ConnectionResource{}
+
+// ConsoleAPI is the interface of ConsoleResource, which mocks implement in tests.
+type ConsoleAPI interface {
+	// List all console details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ConsoleList, error)
+	// Get a single console details by the node name
+	Get(ctx context.Context, name string) (*Console, error)
+	// Create a new console item
+	Create(ctx context.Context, item Console) error
+	// Update the console item identified by the console name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item Console) error
+	// Patch updates only the given fields of the Console identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single console identified by the console name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ConsoleAPI = &ConsoleResource{}
+
+// DBAPI is the interface of DBResource, which mocks implement in tests.
+type DBAPI interface {
+	// ListAll lists all the db configurations.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DBList, error)
+	// Get a single db configuration identified by fullPathName.
+	Get(ctx context.Context, fullPathName string) (*DB, error)
+	// Create a new db configuration.
+	Create(ctx context.Context, item DB) error
+	// Edit a db configuration identified by name.
+	Update(ctx context.Context, name string, item DB) error
+	// Patch updates only the given fields of the DB identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single db configuration identified by name.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ DBAPI = &DBResource{}
+
+// FeatureModuleAPI is the interface of FeatureModuleResource, which mocks implement in tests.
+type FeatureModuleAPI interface {
+	// List retrieves all FeatureModule details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*FeatureModuleList, error)
+	// Get retrieves the details of a single FeatureModule by node name.
+	Get(ctx context.Context, name string) (*FeatureModule, error)
+	// Create creates a new FeatureModule item.
+	Create(ctx context.Context, item FeatureModule) error
+	// Update modifies the FeatureModule item identified by the FeatureModule name.
+	Update(ctx context.Context, name string, item FeatureModule) error
+	// Patch updates only the given fields of the FeatureModule identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single FeatureModule identified by the FeatureModule name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ FeatureModuleAPI = &FeatureModuleResource{}
+
+// GlobalSettingsAPI is the interface of GlobalSettingsResource, which mocks implement in tests.
+type GlobalSettingsAPI interface {
+	// about global-settting configuration link to https://clouddocs.f5.com/cli/tmsh-reference/v15/modules/sys/sys_global-settings.html
+	// List retrieves all GlobalSettings details.
+	Show(ctx context.Context) (*GlobalSettings, error)
+	// Update modifies the GlobalSettings item identified by the GlobalSettings name.
+	Update(ctx context.Context, name string, item GlobalSettings) error
+}
+
+var _ GlobalSettingsAPI = &GlobalSettingsResource{}
+
+// HTTPDAPI is the interface of HTTPDResource, which mocks implement in tests.
+type HTTPDAPI interface {
+	// Get the HTTPD configurations.
+	Get(ctx context.Context) (*HTTPDConfig, error)
+}
+
+var _ HTTPDAPI = &HTTPDResource{}
+
+// IControlSOAPAPI is the interface of IControlSOAPResource, which mocks implement in tests.
+type IControlSOAPAPI interface {
+	// Show retrieves IControlSOAP details.
+	Show(ctx context.Context) (*IControlSOAP, error)
+}
+
+var _ IControlSOAPAPI = &IControlSOAPResource{}
+
+// LicenseAPI is the interface of LicenseResource, which mocks implement in tests.
+type LicenseAPI interface {
+	// Get retrieves the details of a single License by node name.
+	Get(ctx context.Context) (*License, error)
+	// The Activate method activates a license using the given registration key.
+	Activate(ctx context.Context, registrationKey string) error
+}
+
+var _ LicenseAPI = &LicenseResource{}
+
+// ManagementDHCPAPI is the interface of ManagementDHCPResource, which mocks implement in tests.
+type ManagementDHCPAPI interface {
+	// List all management DHCP details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementDHCPList, error)
+	// Get a single management DHCP details by the node name
+	Get(ctx context.Context, name string) (*ManagementDHCP, error)
+	// Create a new management DHCP item
+	Create(ctx context.Context, item ManagementDHCP) error
+	// Update the management DHCP item identified by the management DHCP name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item ManagementDHCP) error
+	// Patch updates only the given fields of the ManagementDHCP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single management DHCP identified by the management DHCP name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ManagementDHCPAPI = &ManagementDHCPResource{}
+
+// ManagementIPAPI is the interface of ManagementIPResource, which mocks implement in tests.
+type ManagementIPAPI interface {
+	// List all management IP details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementIPList, error)
+	// Get a single management IP details by the node name
+	Get(ctx context.Context, name string) (*ManagementIP, error)
+	// Create a new management IP item
+	Create(ctx context.Context, item ManagementIP) error
+	// Update the management IP item identified by the management IP name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item ManagementIP) error
+	// Patch updates only the given fields of the ManagementIP identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single management IP identified by the management IP name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ManagementIPAPI = &ManagementIPResource{}
+
+// ManagementOVSDBAPI is the interface of ManagementOVSDBResource, which mocks implement in tests.
+type ManagementOVSDBAPI interface {
+	// List all management OVSDB details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementOVSDB, error)
+	// Update the management OVSDB item identified by the management OVSDB name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item ManagementOVSDB) error
+	// Patch updates only the given fields of the ManagementOVSDB identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single management OVSDB identified by the management OVSDB name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ManagementOVSDBAPI = &ManagementOVSDBResource{}
+
+// ManagementRouteAPI is the interface of ManagementRouteResource, which mocks implement in tests.
+type ManagementRouteAPI interface {
+	// List all management route details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementRouteList, error)
+	// Get a single management route details by the node name
+	Get(ctx context.Context, name string) (*ManagementRoute, error)
+	// Create a new management route item
+	Create(ctx context.Context, item ManagementRoute) error
+	// Update the management route item identified by the management route name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item ManagementRoute) error
+	// Patch updates only the given fields of the ManagementRoute identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single management route identified by the management route name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ManagementRouteAPI = &ManagementRouteResource{}
+
+// MemoryStatsAPI is the interface of MemoryStatsResource, which mocks implement in tests.
+type MemoryStatsAPI interface {
+	All(ctx context.Context) (*MemoryStatsList, error)
+}
+
+var _ MemoryStatsAPI = &MemoryStatsResource{}
+
+// NTPAPI is the interface of NTPResource, which mocks implement in tests.
+type NTPAPI interface {
+	// Get a single ntp configuration identified by fullPathName.
+	Get(ctx context.Context) (*NTP, error)
+	// Create a new ntp configuration.
+	Create(ctx context.Context, item NTP) error
+	// Edit a ntp configuration identified by name.
+	Update(ctx context.Context, name string, item NTP) error
+	AddServersForNTP(ctx context.Context, rs ...string) error
+	// Delete a single ntp configuration identified by name.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ NTPAPI = &NTPResource{}
+
+// OutboundSMTPAPI is the interface of OutboundSMTPResource, which mocks implement in tests.
+type OutboundSMTPAPI interface {
+	// Show retrieves all OutboundSMTP details.
+	Show(ctx context.Context) (*OutboundSMTP, error)
+}
+
+var _ OutboundSMTPAPI = &OutboundSMTPResource{}
+
+// ProvisionAPI is the interface of ProvisionResource, which mocks implement in tests.
+type ProvisionAPI interface {
+	// List retrieves all Provision details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ProvisionList, error)
+	// Get retrieves the details of a single Provision by node name.
+	Get(ctx context.Context, name string) (*Provision, error)
+}
+
+var _ ProvisionAPI = &ProvisionResource{}
+
+// SMTPServerAPI is the interface of SMTPServerResource, which mocks implement in tests.
+type SMTPServerAPI interface {
+	// List retrieves all SMTPServer details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPServerList, error)
+	// Get retrieves the details of a single SMTPServer by node name.
+	Get(ctx context.Context, name string) (*SMTPServer, error)
+	// Create creates a new SMTPServer item.
+	Create(ctx context.Context, item SMTPServer) error
+	// Update modifies the SMTPServer item identified by the SMTPServer name.
+	Update(ctx context.Context, name string, item SMTPServer) error
+	// Patch updates only the given fields of the SMTPServer identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single SMTPServer identified by the SMTPServer name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SMTPServerAPI = &SMTPServerResource{}
+
+// SNMPAPI is the interface of SNMPResource, which mocks implement in tests.
+type SNMPAPI interface {
+	// list all the snmp configurations.
+	Get(ctx context.Context) (*SNMP, error)
+	// Create a new snmp configuration.
+	Create(ctx context.Context, item SNMP) error
+	// Edit a snmp configuration identified by name.
+	Update(ctx context.Context, name string, item SNMP) error
+	// Delete a single snmp configuration identified by name.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SNMPAPI = &SNMPResource{}
+
+// SSHDAPI is the interface of SSHDResource, which mocks implement in tests.
+type SSHDAPI interface {
+	// ListAll  lists all the SSHD configurations.
+	List(ctx context.Context) (*SSHD, error)
+}
+
+var _ SSHDAPI = &SSHDResource{}
+
+// ScriptdAPI is the interface of ScriptdResource, which mocks implement in tests.
+type ScriptdAPI interface {
+	// List all scriptd details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ScriptdList, error)
+	// Get a single scriptd details by the node name
+	Get(ctx context.Context, name string) (*Scriptd, error)
+	// Create a new scriptd item
+	Create(ctx context.Context, item Scriptd) error
+	// Update the scriptd item identified by the scriptd name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item Scriptd) error
+	// Patch updates only the given fields of the Scriptd identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single scriptd identified by the scriptd name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ScriptdAPI = &ScriptdResource{}
+
+// ServiceAPI is the interface of ServiceResource, which mocks implement in tests.
+type ServiceAPI interface {
+	// List all service details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServiceList, error)
+	// Get a single service details by the node name
+	Get(ctx context.Context, name string) (*Service, error)
+	// Create a new service item
+	Create(ctx context.Context, item Service) error
+	// Update the service item identified by the service name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item Service) error
+	// Patch updates only the given fields of the Service identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single service identified by the service name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ServiceAPI = &ServiceResource{}
+
+// StateMirroringAPI is the interface of StateMirroringResource, which mocks implement in tests.
+type StateMirroringAPI interface {
+	// List all state mirroring details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*StateMirroringList, error)
+	// Get a single state mirroring details by the node name
+	Get(ctx context.Context, name string) (*StateMirroring, error)
+	// Create a new state mirroring item
+	Create(ctx context.Context, item StateMirroring) error
+	// Update the state mirroring item identified by the state mirroring name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item StateMirroring) error
+	// Patch updates only the given fields of the StateMirroring identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single state mirroring identified by the state mirroring name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ StateMirroringAPI = &StateMirroringResource{}
+
+// SyncSysFilesAPI is the interface of SyncSysFilesResource, which mocks implement in tests.
+type SyncSysFilesAPI interface {
+	// List all sync sys files details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*SyncSysFilesList, error)
+	// Get a single sync sys files details by the node name
+	Get(ctx context.Context, name string) (*SyncSysFiles, error)
+	// Create a new sync sys files item
+	Create(ctx context.Context, item SyncSysFiles) error
+	// Update the sync sys files item identified by the sync sys files name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item SyncSysFiles) error
+	// Patch updates only the given fields of the SyncSysFiles identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single sync sys files identified by the sync sys files name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SyncSysFilesAPI = &SyncSysFilesResource{}
+
+// SysAPI is the interface of Sys, which mocks implement in tests.
+type SysAPI interface {
+	// aOM returns a configured AOMResource.
+	AOM() AOMAPI
+	// application returns a configured ApplicationResource.
+	Application() application.ApplicationAPI
+	// autoscaleGroup returns a configured AutoscaleGroupResource.
+	AutoscaleGroup() *AutoscaleGroupResource
+	// clock returns a configured ClockResource.
+	Clock() ClockAPI
+	// cluster returns a configured ClusterResource.
+	Cluster() ClusterAPI
+	// config returns a configured ConfigResource.
+	Config() ConfigAPI
+	// connection returns a configured ConnectionResource.
+	Connection() ConnectionAPI
+	// console returns a configured ConsoleResource.
+	Console() ConsoleAPI
+	// console returns a configured ConsoleResource.
+	CPUStats() CPUStatsAPI
+	// crypto returns a configured CryptoResource.
+	Crypto() crypto.CryptoAPI
+	// dB returns a configured DBResource.
+	DB() DBAPI
+	// dNS returns a configured DNSResource.
+	DNS() *DNSResource
+	// daemonHA returns a configured DaemonHAResource.
+	DaemonHA() *DaemonHAResource
+	// dataStor returns a configured DataStorResource.
+	DataStor() *DataStorResource
+	// disk returns a configured DiskResource.
+	Disk() disk.DiskAPI
+	// featureModule returns a configured FeatureModuleResource.
+	FeatureModule() FeatureModuleAPI
+	// fixConnection returns a configured FixConnectionResource.
+	FixConnection() *FixConnectionResource
+	// hAGroup returns a configured HAGroupResource.
+	HAGroup() *HAGroupResource
+	// hTTPD returns a configured HTTPDResource.
+	HTTPD() HTTPDAPI
+	// iPFix returns a configured IPFixResource.
+	IPFix() ipfix.IPFixAPI
+	// iPFixElement returns a configured IPFixElementResource.
+	IPFixElement() ipfix.IPFixAPI
+	// license returns a configured LicenseResource.
+	License() LicenseAPI
+	// managementDHCP returns a configured ManagementDHCPResource.
+	ManagementDHCP() ManagementDHCPAPI
+	// managementIP returns a configured ManagementIPResource.
+	ManagementIP() ManagementIPAPI
+	// managementOVSDB returns a configured ManagementOVSDBResource.
+	ManagementOVSDB() ManagementOVSDBAPI
+	// managementRoute returns a configured ManagementRouteResource.
+	ManagementRoute() ManagementRouteAPI
+	MemoryStats() MemoryStatsAPI
+	// nTP returns a configured NTPResource.
+	NTP() NTPAPI
+	// outboundSMTP returns a configured OutboundSMTPResource.
+	OutboundSMTP() OutboundSMTPAPI
+	// pFMan returns a configured PFManResource.
+	PFMan() pfman.PFManAPI
+	// provision returns a configured ProvisionResource.
+	Provision() ProvisionAPI
+	// rAID returns a configured RAIDResource.
+	RAID() raid.RAIDAPI
+	// restrictedModule returns a configured RestrictedModuleResource.
+	RestrictedModule() *RestrictedModuleResource
+	// sMTPServer returns a configured SMTPServerResource.
+	SMTPServer() SMTPServerAPI
+	// sNMP returns a configured SNMPResource.
+	SNMP() SNMPAPI
+	// sSHD returns a configured SSHDResource.
+	SSHD() SSHDAPI
+	// scriptd returns a configured ScriptdResource.
+	Scriptd() ScriptdAPI
+	// server returns a configured ServerResource.
+	Service() ServiceAPI
+	// software returns a configured SoftwareResource.
+	Software() software.SoftwareAPI
+	// stateMirroring returns a configured StateMirroringResource.
+	StateMirroring() StateMirroringAPI
+	// syncSysFiles returns a configured SyncSysFilesResource.
+	SyncSysFiles() SyncSysFilesAPI
+	// syslog returns a configured SyslogResource.
+	Syslog() SyslogAPI
+	// uCS returns a configured UCSResource.
+	UCS() UCSAPI
+}
+
+var _ SysAPI = &Sys{}
+
+// SyslogAPI is the interface of SyslogResource, which mocks implement in tests.
+type SyslogAPI interface {
+	AddRemoteServers(ctx context.Context, rs ...RemoteServer) error
+	// List all syslog details
+	Get(ctx context.Context) (*Syslog, error)
+	// Update the syslog item identified by the syslog name, otherwise an error will be reported.
+	Update(ctx context.Context, item Syslog) error
+	// Delete a single syslog identified by the syslog name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ SyslogAPI = &SyslogResource{}
+
+// UCSAPI is the interface of UCSResource, which mocks implement in tests.
+type UCSAPI interface {
+	// List all ucs details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*UCSList, error)
+	// Get a single ucs details by the node name
+	Get(ctx context.Context, name string) (*UCS, error)
+	// Create a new ucs item
+	Create(ctx context.Context, item UCS) error
+	// Update the ucs item identified by the ucs name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item UCS) error
+	// Patch updates only the given fields of the UCS identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single ucs identified by the ucs name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+	// Save saves the configuration into the UCS archive /var/local/ucs/<name>.
+	// The command runs as an asynchronous task, as it usually takes longer than the request timeout.
+	Save(ctx context.Context, name string, options ...map[string]interface{}) error
+	// Load restores the configuration from the UCS archive /var/local/ucs/<name>.
+	// Options such as {"no-license": ""} or {"passphrase": "secret"} are passed to tmsh.
+	Load(ctx context.Context, name string, options ...map[string]interface{}) error
+}
+
+var _ UCSAPI = &UCSResource{}
@@ -0,0 +1,90 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package application
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// APLScriptAPI is the interface of APLScriptResource, which mocks implement in tests.
+type APLScriptAPI interface {
+	// List retrieves all APLScript details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*APLScriptList, error)
+	// Get retrieves the details of a single APLScript by node name.
+	Get(ctx context.Context, name string) (*APLScript, error)
+	// Create creates a new APLScript item.
+	Create(ctx context.Context, item APLScript) error
+	// Update modifies the APLScript item identified by the APLScript name.
+	Update(ctx context.Context, name string, item APLScript) error
+	// Patch updates only the given fields of the APLScript identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single APLScript identified by the APLScript name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ APLScriptAPI = &APLScriptResource{}
+
+// ApplicationAPI is the interface of ApplicationResource, which mocks implement in tests.
+type ApplicationAPI interface {
+	Service() ServiceAPI
+	APLScript() APLScriptAPI
+	CustomStat() CustomStatAPI
+	Template() TemplateAPI
+}
+
+var _ ApplicationAPI = &ApplicationResource{}
+
+// CustomStatAPI is the interface of CustomStatResource, which mocks implement in tests.
+type CustomStatAPI interface {
+	// List retrieves all CustomStat details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CustomStatList, error)
+	// Get retrieves the details of a single CustomStat by node name.
+	Get(ctx context.Context, name string) (*CustomStat, error)
+	// Create creates a new CustomStat item.
+	Create(ctx context.Context, item CustomStat) error
+	// Update modifies the CustomStat item identified by the CustomStat name.
+	Update(ctx context.Context, name string, item CustomStat) error
+	// Patch updates only the given fields of the CustomStat identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single CustomStat identified by the CustomStat name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ CustomStatAPI = &CustomStatResource{}
+
+// ServiceAPI is the interface of ServiceResource, which mocks implement in tests.
+type ServiceAPI interface {
+	// List retrieves all Service details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServiceList, error)
+	// Get retrieves the details of a single Service by node name.
+	Get(ctx context.Context, name string) (*Service, error)
+	// Create creates a new Service item.
+	Create(ctx context.Context, item Service) error
+	// Update modifies the Service item identified by the Service name.
+	Update(ctx context.Context, name string, item Service) error
+	// Patch updates only the given fields of the Service identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Service identified by the Service name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ServiceAPI = &ServiceResource{}
+
+// TemplateAPI is the interface of TemplateResource, which mocks implement in tests.
+type TemplateAPI interface {
+	// List retrieves all Template details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*TemplateList, error)
+	// Get retrieves the details of a single Template by node name.
+	Get(ctx context.Context, name string) (*Template, error)
+	// Create creates a new Template item.
+	Create(ctx context.Context, item Template) error
+	// Update modifies the Template item identified by the Template name.
+	Update(ctx context.Context, name string, item Template) error
+	// Patch updates only the given fields of the Template identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Template identified by the Template name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ TemplateAPI = &TemplateResource{}
@@ -2,11 +2,9 @@ package application
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // APLScriptList holds a list of APLScript configurations.
@@ -43,72 +41,37 @@ type APLScriptResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all APLScript details.
-func (r *APLScriptResource) List() (*APLScriptList, error) {
-	var items APLScriptList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing APLScriptResource.
+func (r *APLScriptResource) collection() *bigip.Collection[APLScript, APLScriptList] {
+	return bigip.NewCollection[APLScript, APLScriptList](r.b, SysManager, ApplicationEndpoint, APLScriptEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all APLScript details.
+func (r *APLScriptResource) List(ctx context.Context, opts ...*rest.ListOptions) (*APLScriptList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single APLScript by node name.
-func (r *APLScriptResource) Get(name string) (*APLScript, error) {
-	var item APLScript
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *APLScriptResource) Get(ctx context.Context, name string) (*APLScript, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new APLScript item.
-func (r *APLScriptResource) Create(item APLScript) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *APLScriptResource) Create(ctx context.Context, item APLScript) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the APLScript item identified by the APLScript name.
-func (r *APLScriptResource) Update(name string, item APLScript) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *APLScriptResource) Update(ctx context.Context, name string, item APLScript) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single APLScript identified by the APLScript name. if it is not exist return error
-func (r *APLScriptResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(APLScriptEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the APLScript identified by name, see bigip.PatchBody.
+func (r *APLScriptResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single APLScript identified by the APLScript name. if it is not exist return error
+func (r *APLScriptResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
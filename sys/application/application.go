@@ -1,5 +1,7 @@
 package application
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // ApplicationEndpoint represents the REST resource for managing Application.
@@ -25,15 +27,15 @@ func NewApplication(b *bigip.BigIP) ApplicationResource {
 	}
 }
 
-func (app ApplicationResource) Service() *ServiceResource {
+func (app ApplicationResource) Service() ServiceAPI {
 	return &app.service
 }
-func (app ApplicationResource) APLScript() *APLScriptResource {
+func (app ApplicationResource) APLScript() APLScriptAPI {
 	return &app.aplScript
 }
-func (app ApplicationResource) CustomStat() *CustomStatResource {
+func (app ApplicationResource) CustomStat() CustomStatAPI {
 	return &app.customStat
 }
-func (app ApplicationResource) Template() *TemplateResource {
+func (app ApplicationResource) Template() TemplateAPI {
 	return &app.template
 }
@@ -0,0 +1,336 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package applicationmock provides mocks of the interfaces of package application, which record their calls.
+package applicationmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/application"
+)
+
+// APLScriptAPI is a mock of application.APLScriptAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type APLScriptAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*application.APLScriptList, error)
+	GetFunc    func(context.Context, string) (*application.APLScript, error)
+	CreateFunc func(context.Context, application.APLScript) error
+	UpdateFunc func(context.Context, string, application.APLScript) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ application.APLScriptAPI = &APLScriptAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *APLScriptAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *application.APLScriptList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *APLScriptAPI) Get(ctx context.Context, name string) (r0 *application.APLScript, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *APLScriptAPI) Create(ctx context.Context, item application.APLScript) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *APLScriptAPI) Update(ctx context.Context, name string, item application.APLScript) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *APLScriptAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *APLScriptAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ApplicationAPI is a mock of application.ApplicationAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ApplicationAPI struct {
+	bigiptest.CallRecorder
+
+	ServiceFunc    func() application.ServiceAPI
+	APLScriptFunc  func() application.APLScriptAPI
+	CustomStatFunc func() application.CustomStatAPI
+	TemplateFunc   func() application.TemplateAPI
+}
+
+var _ application.ApplicationAPI = &ApplicationAPI{}
+
+// Service records the call and calls ServiceFunc if it is set.
+func (m *ApplicationAPI) Service() (r0 application.ServiceAPI) {
+	m.CallRecorder.Record("Service")
+	if m.ServiceFunc != nil {
+		return m.ServiceFunc()
+	}
+	return
+}
+
+// APLScript records the call and calls APLScriptFunc if it is set.
+func (m *ApplicationAPI) APLScript() (r0 application.APLScriptAPI) {
+	m.CallRecorder.Record("APLScript")
+	if m.APLScriptFunc != nil {
+		return m.APLScriptFunc()
+	}
+	return
+}
+
+// CustomStat records the call and calls CustomStatFunc if it is set.
+func (m *ApplicationAPI) CustomStat() (r0 application.CustomStatAPI) {
+	m.CallRecorder.Record("CustomStat")
+	if m.CustomStatFunc != nil {
+		return m.CustomStatFunc()
+	}
+	return
+}
+
+// Template records the call and calls TemplateFunc if it is set.
+func (m *ApplicationAPI) Template() (r0 application.TemplateAPI) {
+	m.CallRecorder.Record("Template")
+	if m.TemplateFunc != nil {
+		return m.TemplateFunc()
+	}
+	return
+}
+
+// CustomStatAPI is a mock of application.CustomStatAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CustomStatAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*application.CustomStatList, error)
+	GetFunc    func(context.Context, string) (*application.CustomStat, error)
+	CreateFunc func(context.Context, application.CustomStat) error
+	UpdateFunc func(context.Context, string, application.CustomStat) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ application.CustomStatAPI = &CustomStatAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CustomStatAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *application.CustomStatList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CustomStatAPI) Get(ctx context.Context, name string) (r0 *application.CustomStat, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CustomStatAPI) Create(ctx context.Context, item application.CustomStat) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CustomStatAPI) Update(ctx context.Context, name string, item application.CustomStat) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CustomStatAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CustomStatAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ServiceAPI is a mock of application.ServiceAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServiceAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*application.ServiceList, error)
+	GetFunc    func(context.Context, string) (*application.Service, error)
+	CreateFunc func(context.Context, application.Service) error
+	UpdateFunc func(context.Context, string, application.Service) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ application.ServiceAPI = &ServiceAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServiceAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *application.ServiceList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServiceAPI) Get(ctx context.Context, name string) (r0 *application.Service, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServiceAPI) Create(ctx context.Context, item application.Service) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServiceAPI) Update(ctx context.Context, name string, item application.Service) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServiceAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServiceAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// TemplateAPI is a mock of application.TemplateAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type TemplateAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*application.TemplateList, error)
+	GetFunc    func(context.Context, string) (*application.Template, error)
+	CreateFunc func(context.Context, application.Template) error
+	UpdateFunc func(context.Context, string, application.Template) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ application.TemplateAPI = &TemplateAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *TemplateAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *application.TemplateList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *TemplateAPI) Get(ctx context.Context, name string) (r0 *application.Template, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *TemplateAPI) Create(ctx context.Context, item application.Template) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *TemplateAPI) Update(ctx context.Context, name string, item application.Template) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *TemplateAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *TemplateAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
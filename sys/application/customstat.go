@@ -2,10 +2,8 @@ package application
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CustomStatList holds a list of CustomStat configurations.
@@ -28,72 +26,37 @@ type CustomStatResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all CustomStat details.
-func (r *CustomStatResource) List() (*CustomStatList, error) {
-	var items CustomStatList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CustomStatResource.
+func (r *CustomStatResource) collection() *bigip.Collection[CustomStat, CustomStatList] {
+	return bigip.NewCollection[CustomStat, CustomStatList](r.b, SysManager, ApplicationEndpoint, CustomStatEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all CustomStat details.
+func (r *CustomStatResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CustomStatList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single CustomStat by node name.
-func (r *CustomStatResource) Get(name string) (*CustomStat, error) {
-	var item CustomStat
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CustomStatResource) Get(ctx context.Context, name string) (*CustomStat, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new CustomStat item.
-func (r *CustomStatResource) Create(item CustomStat) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CustomStatResource) Create(ctx context.Context, item CustomStat) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the CustomStat item identified by the CustomStat name.
-func (r *CustomStatResource) Update(name string, item CustomStat) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CustomStatResource) Update(ctx context.Context, name string, item CustomStat) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single CustomStat identified by the CustomStat name. if it is not exist return error
-func (r *CustomStatResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(CustomStatEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the CustomStat identified by name, see bigip.PatchBody.
+func (r *CustomStatResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single CustomStat identified by the CustomStat name. if it is not exist return error
+func (r *CustomStatResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package application
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ServiceList holds a list of Service configurations.
@@ -28,72 +26,37 @@ type ServiceResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Service details.
-func (r *ServiceResource) List() (*ServiceList, error) {
-	var items ServiceList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(ServiceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ServiceResource.
+func (r *ServiceResource) collection() *bigip.Collection[Service, ServiceList] {
+	return bigip.NewCollection[Service, ServiceList](r.b, SysManager, ApplicationEndpoint, ServiceEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Service details.
+func (r *ServiceResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ServiceList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Service by node name.
-func (r *ServiceResource) Get(name string) (*Service, error) {
-	var item Service
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(ServiceEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ServiceResource) Get(ctx context.Context, name string) (*Service, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Service item.
-func (r *ServiceResource) Create(item Service) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(ServiceEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServiceResource) Create(ctx context.Context, item Service) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Service item identified by the Service name.
-func (r *ServiceResource) Update(name string, item Service) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(ServiceEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServiceResource) Update(ctx context.Context, name string, item Service) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Service identified by the Service name. if it is not exist return error
-func (r *ServiceResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(ServiceEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Service identified by name, see bigip.PatchBody.
+func (r *ServiceResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Service identified by the Service name. if it is not exist return error
+func (r *ServiceResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
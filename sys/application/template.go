@@ -2,10 +2,8 @@ package application
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // TemplateList holds a list of Template configurations.
@@ -48,72 +46,37 @@ type TemplateResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Template details.
-func (r *TemplateResource) List() (*TemplateList, error) {
-	var items TemplateList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing TemplateResource.
+func (r *TemplateResource) collection() *bigip.Collection[Template, TemplateList] {
+	return bigip.NewCollection[Template, TemplateList](r.b, SysManager, ApplicationEndpoint, TemplateEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Template details.
+func (r *TemplateResource) List(ctx context.Context, opts ...*rest.ListOptions) (*TemplateList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Template by node name.
-func (r *TemplateResource) Get(name string) (*Template, error) {
-	var item Template
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *TemplateResource) Get(ctx context.Context, name string) (*Template, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Template item.
-func (r *TemplateResource) Create(item Template) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TemplateResource) Create(ctx context.Context, item Template) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Template item identified by the Template name.
-func (r *TemplateResource) Update(name string, item Template) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *TemplateResource) Update(ctx context.Context, name string, item Template) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Template identified by the Template name. if it is not exist return error
-func (r *TemplateResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationEndpoint).SubResource(TemplateEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Template identified by name, see bigip.PatchBody.
+func (r *TemplateResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Template identified by the Template name. if it is not exist return error
+func (r *TemplateResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -49,10 +49,10 @@ type ClockResource struct {
 }
 
 // Get retrieves the details of a single Clock by node name.
-func (r *ClockResource) Show() (*Clock, error) {
+func (r *ClockResource) Show(ctx context.Context) (*Clock, error) {
 	var item Clock
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).DoRaw(context.Background())
+		Resource(ClockEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -63,14 +63,14 @@ func (r *ClockResource) Show() (*Clock, error) {
 }
 
 // Create creates a new Clock item.
-func (r *ClockResource) Create(item Clock) error {
+func (r *ClockResource) Create(ctx context.Context, item Clock) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ClockEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -78,14 +78,14 @@ func (r *ClockResource) Create(item Clock) error {
 }
 
 // Update modifies the Clock item identified by the Clock name.
-func (r *ClockResource) Update(name string, item Clock) error {
+func (r *ClockResource) Update(ctx context.Context, name string, item Clock) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ClockEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -93,9 +93,9 @@ func (r *ClockResource) Update(name string, item Clock) error {
 }
 
 // Delete a single Clock identified by the Clock name. if it is not exist return error
-func (r *ClockResource) Delete(name string) error {
+func (r *ClockResource) Delete(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClockEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(ClockEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ClusterList holds a list of Cluster configurations.
@@ -27,71 +25,37 @@ type ClusterResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Cluster details.
-func (r *ClusterResource) List() (*ClusterList, error) {
-	var items ClusterList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ClusterResource.
+func (r *ClusterResource) collection() *bigip.Collection[Cluster, ClusterList] {
+	return bigip.NewCollection[Cluster, ClusterList](r.b, SysManager, ClusterEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Cluster details.
+func (r *ClusterResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ClusterList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Cluster by node name.
-func (r *ClusterResource) Get(name string) (*Cluster, error) {
-	var item Cluster
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ClusterResource) Get(ctx context.Context, name string) (*Cluster, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Cluster item.
-func (r *ClusterResource) Create(item Cluster) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ClusterResource) Create(ctx context.Context, item Cluster) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Cluster item identified by the Cluster name.
-func (r *ClusterResource) Update(name string, item Cluster) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ClusterResource) Update(ctx context.Context, name string, item Cluster) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Cluster identified by name, see bigip.PatchBody.
+func (r *ClusterResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Cluster identified by the Cluster name. If it does not exist, return an error.
-func (r *ClusterResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ClusterEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ClusterResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -0,0 +1,38 @@
+package sys
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+)
+
+// ConfigEndpoint represents the REST resource for saving and loading the system configuration.
+const ConfigEndpoint = "config"
+
+// ConfigCommand holds a save or load command of the system configuration.
+type ConfigCommand struct {
+	Command string                   `json:"command"`
+	Name    string                   `json:"name,omitempty"`
+	Options []map[string]interface{} `json:"options,omitempty"`
+}
+
+// ConfigResource provides an API to save and load the system configuration.
+type ConfigResource struct {
+	b *bigip.BigIP
+}
+
+// Save saves the running configuration, like "tmsh save sys config".
+// The command runs as an asynchronous task, so it is not bound by the request timeout.
+func (r *ConfigResource) Save(ctx context.Context, options ...map[string]interface{}) error {
+	return r.Run(ctx, ConfigCommand{Command: "save", Options: options})
+}
+
+// Load loads the saved configuration, like "tmsh load sys config".
+// Options such as {"file": "/var/local/scf/backup.scf"} or {"merge": ""} are passed to tmsh.
+func (r *ConfigResource) Load(ctx context.Context, options ...map[string]interface{}) error {
+	return r.Run(ctx, ConfigCommand{Command: "load", Options: options})
+}
+
+// Run runs the given command as a /mgmt/tm/task/sys/config task and waits for it to complete.
+func (r *ConfigResource) Run(ctx context.Context, item ConfigCommand) error {
+	return r.b.RunTask(ctx, SysManager, ConfigEndpoint, item, nil)
+}
@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ConnectionList holds a list of Connection configuration.
@@ -27,72 +25,37 @@ type ConnectionResource struct {
 	b *bigip.BigIP
 }
 
-// List all connection details
-func (r *ConnectionResource) List() (*ConnectionList, error) {
-	var items ConnectionList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ConnectionResource.
+func (r *ConnectionResource) collection() *bigip.Collection[Connection, ConnectionList] {
+	return bigip.NewCollection[Connection, ConnectionList](r.b, SysManager, ConnectionEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all connection details
+func (r *ConnectionResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ConnectionList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single connection details by the node name
-func (r *ConnectionResource) Get(name string) (*Connection, error) {
-	var item Connection
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ConnectionResource) Get(ctx context.Context, name string) (*Connection, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new connection item
-func (r *ConnectionResource) Create(item Connection) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConnectionResource) Create(ctx context.Context, item Connection) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the connection item identified by the connection name, otherwise an error will be reported.
-func (r *ConnectionResource) Update(name string, item Connection) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConnectionResource) Update(ctx context.Context, name string, item Connection) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single connection identified by the connection name. if it is not exist return error
-func (r *ConnectionResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConnectionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Connection identified by name, see bigip.PatchBody.
+func (r *ConnectionResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single connection identified by the connection name. if it is not exist return error
+func (r *ConnectionResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -6,10 +6,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ConsoleList holds a list of Console configuration.
@@ -31,72 +29,37 @@ type ConsoleResource struct {
 	b *bigip.BigIP
 }
 
-// List all console details
-func (r *ConsoleResource) List() (*ConsoleList, error) {
-	var items ConsoleList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ConsoleResource.
+func (r *ConsoleResource) collection() *bigip.Collection[Console, ConsoleList] {
+	return bigip.NewCollection[Console, ConsoleList](r.b, SysManager, ConsoleEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all console details
+func (r *ConsoleResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ConsoleList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single console details by the node name
-func (r *ConsoleResource) Get(name string) (*Console, error) {
-	var item Console
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ConsoleResource) Get(ctx context.Context, name string) (*Console, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new console item
-func (r *ConsoleResource) Create(item Console) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConsoleResource) Create(ctx context.Context, item Console) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the console item identified by the console name, otherwise an error will be reported.
-func (r *ConsoleResource) Update(name string, item Console) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConsoleResource) Update(ctx context.Context, name string, item Console) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single console identified by the console name. if it is not exist return error
-func (r *ConsoleResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ConsoleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Console identified by name, see bigip.PatchBody.
+func (r *ConsoleResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single console identified by the console name. if it is not exist return error
+func (r *ConsoleResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
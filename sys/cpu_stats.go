@@ -153,10 +153,10 @@ type CPUStatsResource struct {
 	b *bigip.BigIP
 }
 
-func (r *CPUStatsResource) Show() (*CPUList, error) {
+func (r *CPUStatsResource) Show(ctx context.Context) (*CPUList, error) {
 	var items CPUList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CPUStatsEndpoint).DoRaw(context.Background())
+		Resource(CPUStatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,146 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package crypto
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// CertAPI is the interface of CertResource, which mocks implement in tests.
+type CertAPI interface {
+	// List retrieves all Cert details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CertList, error)
+	// Get retrieves the details of a single Cert by node name.
+	Get(ctx context.Context, name string) (*Cert, error)
+	// Create creates a new Cert item.
+	Create(ctx context.Context, item Cert) error
+	// Update modifies the Cert item identified by the Cert name.
+	Update(ctx context.Context, name string, item Cert) error
+	// Patch updates only the given fields of the Cert identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Cert identified by the Cert name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ CertAPI = &CertResource{}
+
+// CheckCertAPI is the interface of CheckCertResource, which mocks implement in tests.
+type CheckCertAPI interface {
+	// List retrieves all CheckCert details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CheckCertList, error)
+	// Get retrieves the details of a single CheckCert by node name.
+	Get(ctx context.Context, name string) (*CheckCert, error)
+	// Create creates a new CheckCert item.
+	Create(ctx context.Context, item CheckCert) error
+	// Update modifies the CheckCert item identified by the CheckCert name.
+	Update(ctx context.Context, name string, item CheckCert) error
+	// Patch updates only the given fields of the CheckCert identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single CheckCert identified by the CheckCert name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ CheckCertAPI = &CheckCertResource{}
+
+// ClientAPI is the interface of ClientResource, which mocks implement in tests.
+type ClientAPI interface {
+	// List retrieves all Client details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ClientList, error)
+	// Get retrieves the details of a single Client by node name.
+	Get(ctx context.Context, name string) (*Client, error)
+	// Create creates a new Client item.
+	Create(ctx context.Context, item Client) error
+	// Update modifies the Client item identified by the Client name.
+	Update(ctx context.Context, name string, item Client) error
+	// Patch updates only the given fields of the Client identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Client identified by the Client name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ClientAPI = &ClientResource{}
+
+// CrlAPI is the interface of CrlResource, which mocks implement in tests.
+type CrlAPI interface {
+	// List retrieves all Crl details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CrlList, error)
+	// Get retrieves the details of a single Crl by node name.
+	Get(ctx context.Context, name string) (*Crl, error)
+	// Create creates a new Crl item.
+	Create(ctx context.Context, item Crl) error
+	// Update modifies the Crl item identified by the Crl name.
+	Update(ctx context.Context, name string, item Crl) error
+	// Patch updates only the given fields of the Crl identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Crl identified by the Crl name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ CrlAPI = &CrlResource{}
+
+// CryptoAPI is the interface of CryptoResource, which mocks implement in tests.
+type CryptoAPI interface {
+	Cert() CertAPI
+	Client() ClientAPI
+	CRL() CrlAPI
+	CSR() CsrAPI
+	Key() KeyAPI
+	Server() ServerAPI
+}
+
+var _ CryptoAPI = &CryptoResource{}
+
+// CsrAPI is the interface of CsrResource, which mocks implement in tests.
+type CsrAPI interface {
+	// List retrieves all Csr details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CsrList, error)
+	// Get retrieves the details of a single Csr by node name.
+	Get(ctx context.Context, name string) (*Csr, error)
+	// Create creates a new Csr item.
+	Create(ctx context.Context, item Csr) error
+	// Update modifies the Csr item identified by the Csr name.
+	Update(ctx context.Context, name string, item Csr) error
+	// Patch updates only the given fields of the Csr identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Csr identified by the Csr name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ CsrAPI = &CsrResource{}
+
+// KeyAPI is the interface of KeyResource, which mocks implement in tests.
+type KeyAPI interface {
+	// List retrieves all Key details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*KeyList, error)
+	// Get retrieves the details of a single Key by node name.
+	Get(ctx context.Context, name string) (*Key, error)
+	// Create creates a new Key item.
+	Create(ctx context.Context, item Key) error
+	// Update modifies the Key item identified by the Key name.
+	Update(ctx context.Context, name string, item Key) error
+	// Patch updates only the given fields of the Key identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Key identified by the Key name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ KeyAPI = &KeyResource{}
+
+// ServerAPI is the interface of ServerResource, which mocks implement in tests.
+type ServerAPI interface {
+	// List retrieves all Server details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ServerList, error)
+	// Get retrieves the details of a single Server by node name.
+	Get(ctx context.Context, name string) (*Server, error)
+	// Create creates a new Server item.
+	Create(ctx context.Context, item Server) error
+	// Update modifies the Server item identified by the Server name.
+	Update(ctx context.Context, name string, item Server) error
+	// Patch updates only the given fields of the Server identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Server identified by the Server name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ServerAPI = &ServerResource{}
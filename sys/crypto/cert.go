@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CertList holds a list of Cert configurations.
@@ -42,71 +40,37 @@ type CertResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Cert details.
-func (r *CertResource) List() (*CertList, error) {
-	var items CertList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CertEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CertResource.
+func (r *CertResource) collection() *bigip.Collection[Cert, CertList] {
+	return bigip.NewCollection[Cert, CertList](r.b, SysManager, CryptoEndpoint, CertEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Cert details.
+func (r *CertResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CertList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Cert by node name.
-func (r *CertResource) Get(name string) (*Cert, error) {
-	var item Cert
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CertEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CertResource) Get(ctx context.Context, name string) (*Cert, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Cert item.
-func (r *CertResource) Create(item Cert) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CertEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CertResource) Create(ctx context.Context, item Cert) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Cert item identified by the Cert name.
-func (r *CertResource) Update(name string, item Cert) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CertEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CertResource) Update(ctx context.Context, name string, item Cert) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Cert identified by name, see bigip.PatchBody.
+func (r *CertResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Cert identified by the Cert name. If it does not exist, return an error.
-func (r *CertResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CertEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CertResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
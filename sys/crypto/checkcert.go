@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CheckCertList holds a list of CheckCert configurations.
@@ -27,71 +25,37 @@ type CheckCertResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all CheckCert details.
-func (r *CheckCertResource) List() (*CheckCertList, error) {
-	var items CheckCertList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CheckCertResource.
+func (r *CheckCertResource) collection() *bigip.Collection[CheckCert, CheckCertList] {
+	return bigip.NewCollection[CheckCert, CheckCertList](r.b, SysManager, CryptoEndpoint, CheckCertEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all CheckCert details.
+func (r *CheckCertResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CheckCertList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single CheckCert by node name.
-func (r *CheckCertResource) Get(name string) (*CheckCert, error) {
-	var item CheckCert
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CheckCertResource) Get(ctx context.Context, name string) (*CheckCert, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new CheckCert item.
-func (r *CheckCertResource) Create(item CheckCert) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CheckCertResource) Create(ctx context.Context, item CheckCert) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the CheckCert item identified by the CheckCert name.
-func (r *CheckCertResource) Update(name string, item CheckCert) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CheckCertResource) Update(ctx context.Context, name string, item CheckCert) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the CheckCert identified by name, see bigip.PatchBody.
+func (r *CheckCertResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single CheckCert identified by the CheckCert name. If it does not exist, return an error.
-func (r *CheckCertResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CheckCertEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CheckCertResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
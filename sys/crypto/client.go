@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ClientList holds a list of Client configurations.
@@ -27,71 +25,37 @@ type ClientResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Client details.
-func (r *ClientResource) List() (*ClientList, error) {
-	var items ClientList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ClientResource.
+func (r *ClientResource) collection() *bigip.Collection[Client, ClientList] {
+	return bigip.NewCollection[Client, ClientList](r.b, SysManager, CryptoEndpoint, ClientEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Client details.
+func (r *ClientResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ClientList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Client by node name.
-func (r *ClientResource) Get(name string) (*Client, error) {
-	var item Client
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ClientResource) Get(ctx context.Context, name string) (*Client, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Client item.
-func (r *ClientResource) Create(item Client) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ClientResource) Create(ctx context.Context, item Client) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Client item identified by the Client name.
-func (r *ClientResource) Update(name string, item Client) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ClientResource) Update(ctx context.Context, name string, item Client) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Client identified by name, see bigip.PatchBody.
+func (r *ClientResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Client identified by the Client name. If it does not exist, return an error.
-func (r *ClientResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ClientEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ClientResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
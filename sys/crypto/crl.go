@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CrlList holds a list of Crl configurations.
@@ -30,71 +28,37 @@ type CrlResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Crl details.
-func (r *CrlResource) List() (*CrlList, error) {
-	var items CrlList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CrlResource.
+func (r *CrlResource) collection() *bigip.Collection[Crl, CrlList] {
+	return bigip.NewCollection[Crl, CrlList](r.b, SysManager, CryptoEndpoint, CrlEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Crl details.
+func (r *CrlResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CrlList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Crl by node name.
-func (r *CrlResource) Get(name string) (*Crl, error) {
-	var item Crl
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CrlResource) Get(ctx context.Context, name string) (*Crl, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Crl item.
-func (r *CrlResource) Create(item Crl) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CrlResource) Create(ctx context.Context, item Crl) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Crl item identified by the Crl name.
-func (r *CrlResource) Update(name string, item Crl) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CrlResource) Update(ctx context.Context, name string, item Crl) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Crl identified by name, see bigip.PatchBody.
+func (r *CrlResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Crl identified by the Crl name. If it does not exist, return an error.
-func (r *CrlResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CrlEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CrlResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
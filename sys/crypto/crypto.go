@@ -1,5 +1,7 @@
 package crypto
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // CryptoConfigList holds a list of Crypto configuration.
@@ -29,24 +31,24 @@ func NewCrypto(b *bigip.BigIP) CryptoResource {
 	}
 }
 
-func (crypto *CryptoResource) Cert() CertResource {
-	return crypto.cert
+func (crypto *CryptoResource) Cert() CertAPI {
+	return &crypto.cert
 }
 
-func (crypto *CryptoResource) Client() ClientResource {
-	return crypto.client
+func (crypto *CryptoResource) Client() ClientAPI {
+	return &crypto.client
 }
 
-func (crypto *CryptoResource) CRL() CrlResource {
-	return crypto.crl
+func (crypto *CryptoResource) CRL() CrlAPI {
+	return &crypto.crl
 }
-func (crypto *CryptoResource) CSR() CsrResource {
-	return crypto.csr
+func (crypto *CryptoResource) CSR() CsrAPI {
+	return &crypto.csr
 }
-func (crypto *CryptoResource) Key() KeyResource {
-	return crypto.key
+func (crypto *CryptoResource) Key() KeyAPI {
+	return &crypto.key
 }
 
-func (crypto *CryptoResource) Server() ServerResource {
-	return crypto.server
+func (crypto *CryptoResource) Server() ServerAPI {
+	return &crypto.server
 }
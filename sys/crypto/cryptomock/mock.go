@@ -0,0 +1,563 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package cryptomock provides mocks of the interfaces of package crypto, which record their calls.
+package cryptomock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/crypto"
+)
+
+// CertAPI is a mock of crypto.CertAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CertAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.CertList, error)
+	GetFunc    func(context.Context, string) (*crypto.Cert, error)
+	CreateFunc func(context.Context, crypto.Cert) error
+	UpdateFunc func(context.Context, string, crypto.Cert) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.CertAPI = &CertAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CertAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.CertList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CertAPI) Get(ctx context.Context, name string) (r0 *crypto.Cert, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CertAPI) Create(ctx context.Context, item crypto.Cert) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CertAPI) Update(ctx context.Context, name string, item crypto.Cert) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CertAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CertAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// CheckCertAPI is a mock of crypto.CheckCertAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CheckCertAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.CheckCertList, error)
+	GetFunc    func(context.Context, string) (*crypto.CheckCert, error)
+	CreateFunc func(context.Context, crypto.CheckCert) error
+	UpdateFunc func(context.Context, string, crypto.CheckCert) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.CheckCertAPI = &CheckCertAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CheckCertAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.CheckCertList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CheckCertAPI) Get(ctx context.Context, name string) (r0 *crypto.CheckCert, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CheckCertAPI) Create(ctx context.Context, item crypto.CheckCert) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CheckCertAPI) Update(ctx context.Context, name string, item crypto.CheckCert) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CheckCertAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CheckCertAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ClientAPI is a mock of crypto.ClientAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ClientAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.ClientList, error)
+	GetFunc    func(context.Context, string) (*crypto.Client, error)
+	CreateFunc func(context.Context, crypto.Client) error
+	UpdateFunc func(context.Context, string, crypto.Client) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.ClientAPI = &ClientAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ClientAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.ClientList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ClientAPI) Get(ctx context.Context, name string) (r0 *crypto.Client, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ClientAPI) Create(ctx context.Context, item crypto.Client) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ClientAPI) Update(ctx context.Context, name string, item crypto.Client) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ClientAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ClientAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// CrlAPI is a mock of crypto.CrlAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CrlAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.CrlList, error)
+	GetFunc    func(context.Context, string) (*crypto.Crl, error)
+	CreateFunc func(context.Context, crypto.Crl) error
+	UpdateFunc func(context.Context, string, crypto.Crl) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.CrlAPI = &CrlAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CrlAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.CrlList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CrlAPI) Get(ctx context.Context, name string) (r0 *crypto.Crl, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CrlAPI) Create(ctx context.Context, item crypto.Crl) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CrlAPI) Update(ctx context.Context, name string, item crypto.Crl) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CrlAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CrlAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// CryptoAPI is a mock of crypto.CryptoAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CryptoAPI struct {
+	bigiptest.CallRecorder
+
+	CertFunc   func() crypto.CertAPI
+	ClientFunc func() crypto.ClientAPI
+	CRLFunc    func() crypto.CrlAPI
+	CSRFunc    func() crypto.CsrAPI
+	KeyFunc    func() crypto.KeyAPI
+	ServerFunc func() crypto.ServerAPI
+}
+
+var _ crypto.CryptoAPI = &CryptoAPI{}
+
+// Cert records the call and calls CertFunc if it is set.
+func (m *CryptoAPI) Cert() (r0 crypto.CertAPI) {
+	m.CallRecorder.Record("Cert")
+	if m.CertFunc != nil {
+		return m.CertFunc()
+	}
+	return
+}
+
+// Client records the call and calls ClientFunc if it is set.
+func (m *CryptoAPI) Client() (r0 crypto.ClientAPI) {
+	m.CallRecorder.Record("Client")
+	if m.ClientFunc != nil {
+		return m.ClientFunc()
+	}
+	return
+}
+
+// CRL records the call and calls CRLFunc if it is set.
+func (m *CryptoAPI) CRL() (r0 crypto.CrlAPI) {
+	m.CallRecorder.Record("CRL")
+	if m.CRLFunc != nil {
+		return m.CRLFunc()
+	}
+	return
+}
+
+// CSR records the call and calls CSRFunc if it is set.
+func (m *CryptoAPI) CSR() (r0 crypto.CsrAPI) {
+	m.CallRecorder.Record("CSR")
+	if m.CSRFunc != nil {
+		return m.CSRFunc()
+	}
+	return
+}
+
+// Key records the call and calls KeyFunc if it is set.
+func (m *CryptoAPI) Key() (r0 crypto.KeyAPI) {
+	m.CallRecorder.Record("Key")
+	if m.KeyFunc != nil {
+		return m.KeyFunc()
+	}
+	return
+}
+
+// Server records the call and calls ServerFunc if it is set.
+func (m *CryptoAPI) Server() (r0 crypto.ServerAPI) {
+	m.CallRecorder.Record("Server")
+	if m.ServerFunc != nil {
+		return m.ServerFunc()
+	}
+	return
+}
+
+// CsrAPI is a mock of crypto.CsrAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CsrAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.CsrList, error)
+	GetFunc    func(context.Context, string) (*crypto.Csr, error)
+	CreateFunc func(context.Context, crypto.Csr) error
+	UpdateFunc func(context.Context, string, crypto.Csr) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.CsrAPI = &CsrAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CsrAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.CsrList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CsrAPI) Get(ctx context.Context, name string) (r0 *crypto.Csr, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CsrAPI) Create(ctx context.Context, item crypto.Csr) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CsrAPI) Update(ctx context.Context, name string, item crypto.Csr) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CsrAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CsrAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// KeyAPI is a mock of crypto.KeyAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type KeyAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.KeyList, error)
+	GetFunc    func(context.Context, string) (*crypto.Key, error)
+	CreateFunc func(context.Context, crypto.Key) error
+	UpdateFunc func(context.Context, string, crypto.Key) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.KeyAPI = &KeyAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *KeyAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.KeyList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *KeyAPI) Get(ctx context.Context, name string) (r0 *crypto.Key, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *KeyAPI) Create(ctx context.Context, item crypto.Key) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *KeyAPI) Update(ctx context.Context, name string, item crypto.Key) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *KeyAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *KeyAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ServerAPI is a mock of crypto.ServerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*crypto.ServerList, error)
+	GetFunc    func(context.Context, string) (*crypto.Server, error)
+	CreateFunc func(context.Context, crypto.Server) error
+	UpdateFunc func(context.Context, string, crypto.Server) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ crypto.ServerAPI = &ServerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *crypto.ServerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServerAPI) Get(ctx context.Context, name string) (r0 *crypto.Server, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServerAPI) Create(ctx context.Context, item crypto.Server) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServerAPI) Update(ctx context.Context, name string, item crypto.Server) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServerAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServerAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
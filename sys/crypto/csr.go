@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CsrList holds a list of Csr configurations.
@@ -27,71 +25,37 @@ type CsrResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Csr details.
-func (r *CsrResource) List() (*CsrList, error) {
-	var items CsrList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CsrResource.
+func (r *CsrResource) collection() *bigip.Collection[Csr, CsrList] {
+	return bigip.NewCollection[Csr, CsrList](r.b, SysManager, CryptoEndpoint, CsrEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Csr details.
+func (r *CsrResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CsrList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Csr by node name.
-func (r *CsrResource) Get(name string) (*Csr, error) {
-	var item Csr
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CsrResource) Get(ctx context.Context, name string) (*Csr, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Csr item.
-func (r *CsrResource) Create(item Csr) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CsrResource) Create(ctx context.Context, item Csr) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Csr item identified by the Csr name.
-func (r *CsrResource) Update(name string, item Csr) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CsrResource) Update(ctx context.Context, name string, item Csr) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Csr identified by name, see bigip.PatchBody.
+func (r *CsrResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Csr identified by the Csr name. If it does not exist, return an error.
-func (r *CsrResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(CsrEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CsrResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
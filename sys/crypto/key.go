@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // KeyList holds a list of CryptoKey configuration.
@@ -35,71 +33,37 @@ type KeyResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Key details.
-func (r *KeyResource) List() (*KeyList, error) {
-	var items KeyList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(KeyEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing KeyResource.
+func (r *KeyResource) collection() *bigip.Collection[Key, KeyList] {
+	return bigip.NewCollection[Key, KeyList](r.b, SysManager, CryptoEndpoint, KeyEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Key details.
+func (r *KeyResource) List(ctx context.Context, opts ...*rest.ListOptions) (*KeyList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Key by node name.
-func (r *KeyResource) Get(name string) (*Key, error) {
-	var item Key
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(KeyEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *KeyResource) Get(ctx context.Context, name string) (*Key, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Key item.
-func (r *KeyResource) Create(item Key) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(KeyEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *KeyResource) Create(ctx context.Context, item Key) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Key item identified by the Key name.
-func (r *KeyResource) Update(name string, item Key) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(KeyEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *KeyResource) Update(ctx context.Context, name string, item Key) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Key identified by name, see bigip.PatchBody.
+func (r *KeyResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Key identified by the Key name. If it does not exist, return an error.
-func (r *KeyResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(KeyEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *KeyResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package crypto
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ServerList holds a list of CryptoServer configuration.
@@ -35,71 +33,37 @@ type ServerResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Server details.
-func (r *ServerResource) List() (*ServerList, error) {
-	var items ServerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ServerResource.
+func (r *ServerResource) collection() *bigip.Collection[Server, ServerList] {
+	return bigip.NewCollection[Server, ServerList](r.b, SysManager, CryptoEndpoint, ServerEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Server details.
+func (r *ServerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ServerList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Server by node name.
-func (r *ServerResource) Get(name string) (*Server, error) {
-	var item Server
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ServerResource) Get(ctx context.Context, name string) (*Server, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Server item.
-func (r *ServerResource) Create(item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServerResource) Create(ctx context.Context, item Server) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Server item identified by the Server name.
-func (r *ServerResource) Update(name string, item Server) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServerResource) Update(ctx context.Context, name string, item Server) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Server identified by name, see bigip.PatchBody.
+func (r *ServerResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Server identified by the Server name. If it does not exist, return an error.
-func (r *ServerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(CryptoEndpoint).SubResource(ServerEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServerResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -6,10 +6,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // A DBList holds a list of DB.
@@ -38,72 +36,37 @@ type DBResource struct {
 	b *bigip.BigIP
 }
 
-// ListAll lists all the db configurations.
-func (dr *DBResource) List() (*DBList, error) {
-	var dl DBList
-	res, err := dr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(DBEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DBResource.
+func (dr *DBResource) collection() *bigip.Collection[DB, DBList] {
+	return bigip.NewCollection[DB, DBList](dr.b, SysManager, DBEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &dl); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &dl, nil
+// ListAll lists all the db configurations.
+func (dr *DBResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DBList, error) {
+	return dr.collection().List(ctx, opts...)
 }
 
 // Get a single db configuration identified by fullPathName.
-func (dr *DBResource) Get(fullPathName string) (*DB, error) {
-	var db DB
-	res, err := dr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(DBEndpoint).ResourceInstance(fullPathName).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(res, &db); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &db, nil
+func (dr *DBResource) Get(ctx context.Context, fullPathName string) (*DB, error) {
+	return dr.collection().Get(ctx, fullPathName)
 }
 
 // Create a new db configuration.
-func (dr *DBResource) Create(item DB) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = dr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(DBEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (dr *DBResource) Create(ctx context.Context, item DB) error {
+	return dr.collection().Create(ctx, item)
 }
 
 // Edit a db configuration identified by name.
-func (dr *DBResource) Update(name string, item DB) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = dr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(DBEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (dr *DBResource) Update(ctx context.Context, name string, item DB) error {
+	return dr.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the DB identified by name, see bigip.PatchBody.
+func (dr *DBResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return dr.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single db configuration identified by name.
-func (dr *DBResource) Delete(name string) error {
-	_, err := dr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(DBEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (dr *DBResource) Delete(ctx context.Context, name string) error {
+	return dr.collection().Delete(ctx, name)
 }
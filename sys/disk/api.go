@@ -0,0 +1,52 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package disk
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// ApplicationVolumeAPI is the interface of ApplicationVolumeResource, which mocks implement in tests.
+type ApplicationVolumeAPI interface {
+	// List all application volume details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ApplicationVolumeList, error)
+	// Get a single application volume details by the node name
+	Get(ctx context.Context, name string) (*ApplicationVolume, error)
+	// Create a new application volume item
+	Create(ctx context.Context, item ApplicationVolume) error
+	// Update the application volume item identified by the application volume name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item ApplicationVolume) error
+	// Patch updates only the given fields of the ApplicationVolume identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single application volume identified by the application volume name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ApplicationVolumeAPI = &ApplicationVolumeResource{}
+
+// DiskAPI is the interface of DiskResource, which mocks implement in tests.
+type DiskAPI interface {
+	LogicalDisk() LogicalDiskAPI
+	ApplicationVolume() ApplicationVolumeAPI
+}
+
+var _ DiskAPI = &DiskResource{}
+
+// LogicalDiskAPI is the interface of LogicalDiskResource, which mocks implement in tests.
+type LogicalDiskAPI interface {
+	// List all logical disk details
+	List(ctx context.Context, opts ...*rest.ListOptions) (*LogicalDiskList, error)
+	// Get a single logical disk details by the node name
+	Get(ctx context.Context, name string) (*LogicalDisk, error)
+	// Create a new logical disk item
+	Create(ctx context.Context, item LogicalDisk) error
+	// Update the logical disk item identified by the logical disk name, otherwise an error will be reported.
+	Update(ctx context.Context, name string, item LogicalDisk) error
+	// Patch updates only the given fields of the LogicalDisk identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single logical disk identified by the logical disk name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ LogicalDiskAPI = &LogicalDiskResource{}
@@ -2,10 +2,8 @@ package disk
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ApplicationVolumeList holds a list of ApplicationVolume configuration.
@@ -27,72 +25,37 @@ type ApplicationVolumeResource struct {
 	b *bigip.BigIP
 }
 
-// List all application volume details
-func (r *ApplicationVolumeResource) List() (*ApplicationVolumeList, error) {
-	var items ApplicationVolumeList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationVolumeEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ApplicationVolumeResource.
+func (r *ApplicationVolumeResource) collection() *bigip.Collection[ApplicationVolume, ApplicationVolumeList] {
+	return bigip.NewCollection[ApplicationVolume, ApplicationVolumeList](r.b, SysManager, ApplicationVolumeEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all application volume details
+func (r *ApplicationVolumeResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ApplicationVolumeList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single application volume details by the node name
-func (r *ApplicationVolumeResource) Get(name string) (*ApplicationVolume, error) {
-	var item ApplicationVolume
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationVolumeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ApplicationVolumeResource) Get(ctx context.Context, name string) (*ApplicationVolume, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new application volume item
-func (r *ApplicationVolumeResource) Create(item ApplicationVolume) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationVolumeEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ApplicationVolumeResource) Create(ctx context.Context, item ApplicationVolume) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the application volume item identified by the application volume name, otherwise an error will be reported.
-func (r *ApplicationVolumeResource) Update(name string, item ApplicationVolume) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationVolumeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ApplicationVolumeResource) Update(ctx context.Context, name string, item ApplicationVolume) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single application volume identified by the application volume name. if it is not exist return error
-func (r *ApplicationVolumeResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ApplicationVolumeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the ApplicationVolume identified by name, see bigip.PatchBody.
+func (r *ApplicationVolumeResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single application volume identified by the application volume name. if it is not exist return error
+func (r *ApplicationVolumeResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
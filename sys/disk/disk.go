@@ -1,5 +1,7 @@
 package disk
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // DiskEndpoint represents the REST resource for managing Disk.
@@ -21,17 +23,13 @@ func NewDisk(b *bigip.BigIP) DiskResource {
 	}
 }
 
-type Disker interface {
-	LogicalDisk() *LogicalDiskResource
-	ApplicationVolume() *ApplicationVolumeResource
-}
-
-var _ Disker = DiskResource{}
+// Disker is the former name of DiskAPI.
+type Disker = DiskAPI
 
-func (m DiskResource) LogicalDisk() *LogicalDiskResource {
+func (m DiskResource) LogicalDisk() LogicalDiskAPI {
 	return &m.logicalDisk
 }
 
-func (m DiskResource) ApplicationVolume() *ApplicationVolumeResource {
+func (m DiskResource) ApplicationVolume() ApplicationVolumeAPI {
 	return &m.applicationVolume
 }
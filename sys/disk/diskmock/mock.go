@@ -0,0 +1,178 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package diskmock provides mocks of the interfaces of package disk, which record their calls.
+package diskmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/disk"
+)
+
+// ApplicationVolumeAPI is a mock of disk.ApplicationVolumeAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ApplicationVolumeAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*disk.ApplicationVolumeList, error)
+	GetFunc    func(context.Context, string) (*disk.ApplicationVolume, error)
+	CreateFunc func(context.Context, disk.ApplicationVolume) error
+	UpdateFunc func(context.Context, string, disk.ApplicationVolume) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ disk.ApplicationVolumeAPI = &ApplicationVolumeAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ApplicationVolumeAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *disk.ApplicationVolumeList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ApplicationVolumeAPI) Get(ctx context.Context, name string) (r0 *disk.ApplicationVolume, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ApplicationVolumeAPI) Create(ctx context.Context, item disk.ApplicationVolume) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ApplicationVolumeAPI) Update(ctx context.Context, name string, item disk.ApplicationVolume) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ApplicationVolumeAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ApplicationVolumeAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// DiskAPI is a mock of disk.DiskAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DiskAPI struct {
+	bigiptest.CallRecorder
+
+	LogicalDiskFunc       func() disk.LogicalDiskAPI
+	ApplicationVolumeFunc func() disk.ApplicationVolumeAPI
+}
+
+var _ disk.DiskAPI = &DiskAPI{}
+
+// LogicalDisk records the call and calls LogicalDiskFunc if it is set.
+func (m *DiskAPI) LogicalDisk() (r0 disk.LogicalDiskAPI) {
+	m.CallRecorder.Record("LogicalDisk")
+	if m.LogicalDiskFunc != nil {
+		return m.LogicalDiskFunc()
+	}
+	return
+}
+
+// ApplicationVolume records the call and calls ApplicationVolumeFunc if it is set.
+func (m *DiskAPI) ApplicationVolume() (r0 disk.ApplicationVolumeAPI) {
+	m.CallRecorder.Record("ApplicationVolume")
+	if m.ApplicationVolumeFunc != nil {
+		return m.ApplicationVolumeFunc()
+	}
+	return
+}
+
+// LogicalDiskAPI is a mock of disk.LogicalDiskAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LogicalDiskAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*disk.LogicalDiskList, error)
+	GetFunc    func(context.Context, string) (*disk.LogicalDisk, error)
+	CreateFunc func(context.Context, disk.LogicalDisk) error
+	UpdateFunc func(context.Context, string, disk.LogicalDisk) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ disk.LogicalDiskAPI = &LogicalDiskAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *LogicalDiskAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *disk.LogicalDiskList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *LogicalDiskAPI) Get(ctx context.Context, name string) (r0 *disk.LogicalDisk, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *LogicalDiskAPI) Create(ctx context.Context, item disk.LogicalDisk) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *LogicalDiskAPI) Update(ctx context.Context, name string, item disk.LogicalDisk) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *LogicalDiskAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *LogicalDiskAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
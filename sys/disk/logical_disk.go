@@ -6,10 +6,8 @@ package disk
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // LogicalDiskList holds a list of LogicalDisk configuration.
@@ -41,72 +39,37 @@ type LogicalDiskResource struct {
 	b *bigip.BigIP
 }
 
-// List all logical disk details
-func (r *LogicalDiskResource) List() (*LogicalDiskList, error) {
-	var items LogicalDiskList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing LogicalDiskResource.
+func (r *LogicalDiskResource) collection() *bigip.Collection[LogicalDisk, LogicalDiskList] {
+	return bigip.NewCollection[LogicalDisk, LogicalDiskList](r.b, SysManager, LogicalDiskEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all logical disk details
+func (r *LogicalDiskResource) List(ctx context.Context, opts ...*rest.ListOptions) (*LogicalDiskList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single logical disk details by the node name
-func (r *LogicalDiskResource) Get(name string) (*LogicalDisk, error) {
-	var item LogicalDisk
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *LogicalDiskResource) Get(ctx context.Context, name string) (*LogicalDisk, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new logical disk item
-func (r *LogicalDiskResource) Create(item LogicalDisk) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LogicalDiskResource) Create(ctx context.Context, item LogicalDisk) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the logical disk item identified by the logical disk name, otherwise an error will be reported.
-func (r *LogicalDiskResource) Update(name string, item LogicalDisk) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *LogicalDiskResource) Update(ctx context.Context, name string, item LogicalDisk) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single logical disk identified by the logical disk name. if it is not exist return error
-func (r *LogicalDiskResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LogicalDiskEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the LogicalDisk identified by name, see bigip.PatchBody.
+func (r *LogicalDiskResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single logical disk identified by the logical disk name. if it is not exist return error
+func (r *LogicalDiskResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
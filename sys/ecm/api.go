@@ -0,0 +1,47 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package ecm
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// CloudProviderAPI is the interface of CloudProviderResource, which mocks implement in tests.
+type CloudProviderAPI interface {
+	// List retrieves all CloudProvider details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*CloudProviderList, error)
+	// Get retrieves the details of a single CloudProvider by node name.
+	Get(ctx context.Context, name string) (*CloudProvider, error)
+	// Create creates a new CloudProvider item.
+	Create(ctx context.Context, item CloudProvider) error
+	// Update modifies the CloudProvider item identified by the CloudProvider name.
+	Update(ctx context.Context, name string, item CloudProvider) error
+	// Patch updates only the given fields of the CloudProvider identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single CloudProvider identified by the CloudProvider name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ CloudProviderAPI = &CloudProviderResource{}
+
+// ConfigAPI is the interface of ConfigResource, which mocks implement in tests.
+type ConfigAPI interface {
+	// Get retrieves the details of a single Config by node name.
+	Get(ctx context.Context, name string) (*Config, error)
+	// Create creates a new Config item.
+	Create(ctx context.Context, item Config) error
+	// Update modifies the Config item identified by the Config name.
+	Update(ctx context.Context, name string, item Config) error
+	// Delete a single Config identified by the Config name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ConfigAPI = &ConfigResource{}
+
+// ECMAPI is the interface of ECMResource, which mocks implement in tests.
+type ECMAPI interface {
+	Element() ConfigAPI
+}
+
+var _ ECMAPI = &ECMResource{}
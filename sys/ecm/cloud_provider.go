@@ -2,10 +2,8 @@ package ecm
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // CloudProviderList holds a list of CloudProvider configurations.
@@ -37,72 +35,37 @@ type CloudProviderResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all CloudProvider details.
-func (r *CloudProviderResource) List() (*CloudProviderList, error) {
-	var items CloudProviderList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing CloudProviderResource.
+func (r *CloudProviderResource) collection() *bigip.Collection[CloudProvider, CloudProviderList] {
+	return bigip.NewCollection[CloudProvider, CloudProviderList](r.b, SysManager, ECMEndpoint, CloudProviderEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all CloudProvider details.
+func (r *CloudProviderResource) List(ctx context.Context, opts ...*rest.ListOptions) (*CloudProviderList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single CloudProvider by node name.
-func (r *CloudProviderResource) Get(name string) (*CloudProvider, error) {
-	var item CloudProvider
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *CloudProviderResource) Get(ctx context.Context, name string) (*CloudProvider, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new CloudProvider item.
-func (r *CloudProviderResource) Create(item CloudProvider) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CloudProviderResource) Create(ctx context.Context, item CloudProvider) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the CloudProvider item identified by the CloudProvider name.
-func (r *CloudProviderResource) Update(name string, item CloudProvider) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *CloudProviderResource) Update(ctx context.Context, name string, item CloudProvider) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single CloudProvider identified by the CloudProvider name. If it does not exist, return an error.
-func (r *CloudProviderResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(CloudProviderEndpoint).SubResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the CloudProvider identified by name, see bigip.PatchBody.
+func (r *CloudProviderResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single CloudProvider identified by the CloudProvider name. If it does not exist, return an error.
+func (r *CloudProviderResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
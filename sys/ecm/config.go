@@ -34,10 +34,10 @@ type ConfigResource struct {
 }
 
 // Get retrieves the details of a single Config by node name.
-func (r *ConfigResource) Get(name string) (*Config, error) {
+func (r *ConfigResource) Get(ctx context.Context, name string) (*Config, error) {
 	var item Config
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -48,14 +48,14 @@ func (r *ConfigResource) Get(name string) (*Config, error) {
 }
 
 // Create creates a new Config item.
-func (r *ConfigResource) Create(item Config) error {
+func (r *ConfigResource) Create(ctx context.Context, item Config) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -63,14 +63,14 @@ func (r *ConfigResource) Create(item Config) error {
 }
 
 // Update modifies the Config item identified by the Config name.
-func (r *ConfigResource) Update(name string, item Config) error {
+func (r *ConfigResource) Update(ctx context.Context, name string, item Config) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -78,9 +78,9 @@ func (r *ConfigResource) Update(name string, item Config) error {
 }
 
 // Delete a single Config identified by the Config name. If it does not exist, return an error.
-func (r *ConfigResource) Delete(name string) error {
+func (r *ConfigResource) Delete(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).DoRaw(context.Background())
+		Resource(ECMEndpoint).SubResource(ConfigEndpoint).SubResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
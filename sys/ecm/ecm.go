@@ -4,6 +4,8 @@
 
 package ecm
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 const SysManager = "sys"
@@ -21,6 +23,6 @@ func NewECM(b *bigip.BigIP) ECMResource {
 	}
 }
 
-func (ecm ECMResource) Element() *ConfigResource {
+func (ecm ECMResource) Element() ConfigAPI {
 	return &ecm.config
 }
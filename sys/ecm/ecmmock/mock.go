@@ -0,0 +1,148 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package ecmmock provides mocks of the interfaces of package ecm, which record their calls.
+package ecmmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/ecm"
+)
+
+// CloudProviderAPI is a mock of ecm.CloudProviderAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CloudProviderAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*ecm.CloudProviderList, error)
+	GetFunc    func(context.Context, string) (*ecm.CloudProvider, error)
+	CreateFunc func(context.Context, ecm.CloudProvider) error
+	UpdateFunc func(context.Context, string, ecm.CloudProvider) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ ecm.CloudProviderAPI = &CloudProviderAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *CloudProviderAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ecm.CloudProviderList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *CloudProviderAPI) Get(ctx context.Context, name string) (r0 *ecm.CloudProvider, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *CloudProviderAPI) Create(ctx context.Context, item ecm.CloudProvider) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *CloudProviderAPI) Update(ctx context.Context, name string, item ecm.CloudProvider) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *CloudProviderAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *CloudProviderAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ConfigAPI is a mock of ecm.ConfigAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ConfigAPI struct {
+	bigiptest.CallRecorder
+
+	GetFunc    func(context.Context, string) (*ecm.Config, error)
+	CreateFunc func(context.Context, ecm.Config) error
+	UpdateFunc func(context.Context, string, ecm.Config) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ ecm.ConfigAPI = &ConfigAPI{}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ConfigAPI) Get(ctx context.Context, name string) (r0 *ecm.Config, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ConfigAPI) Create(ctx context.Context, item ecm.Config) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ConfigAPI) Update(ctx context.Context, name string, item ecm.Config) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ConfigAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ECMAPI is a mock of ecm.ECMAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ECMAPI struct {
+	bigiptest.CallRecorder
+
+	ElementFunc func() ecm.ConfigAPI
+}
+
+var _ ecm.ECMAPI = &ECMAPI{}
+
+// Element records the call and calls ElementFunc if it is set.
+func (m *ECMAPI) Element() (r0 ecm.ConfigAPI) {
+	m.CallRecorder.Record("Element")
+	if m.ElementFunc != nil {
+		return m.ElementFunc()
+	}
+	return
+}
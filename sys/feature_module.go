@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // FeatureModuleList holds a list of FeatureModule configurations.
@@ -33,72 +31,37 @@ type FeatureModuleResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all FeatureModule details.
-func (r *FeatureModuleResource) List() (*FeatureModuleList, error) {
-	var items FeatureModuleList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing FeatureModuleResource.
+func (r *FeatureModuleResource) collection() *bigip.Collection[FeatureModule, FeatureModuleList] {
+	return bigip.NewCollection[FeatureModule, FeatureModuleList](r.b, SysManager, FeatureModuleEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all FeatureModule details.
+func (r *FeatureModuleResource) List(ctx context.Context, opts ...*rest.ListOptions) (*FeatureModuleList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single FeatureModule by node name.
-func (r *FeatureModuleResource) Get(name string) (*FeatureModule, error) {
-	var item FeatureModule
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *FeatureModuleResource) Get(ctx context.Context, name string) (*FeatureModule, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new FeatureModule item.
-func (r *FeatureModuleResource) Create(item FeatureModule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FeatureModuleResource) Create(ctx context.Context, item FeatureModule) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the FeatureModule item identified by the FeatureModule name.
-func (r *FeatureModuleResource) Update(name string, item FeatureModule) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *FeatureModuleResource) Update(ctx context.Context, name string, item FeatureModule) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single FeatureModule identified by the FeatureModule name. If it does not exist, return an error.
-func (r *FeatureModuleResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(FeatureModuleEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the FeatureModule identified by name, see bigip.PatchBody.
+func (r *FeatureModuleResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single FeatureModule identified by the FeatureModule name. If it does not exist, return an error.
+func (r *FeatureModuleResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -50,10 +50,10 @@ type GlobalSettingsResource struct {
 
 // about global-settting configuration link to https://clouddocs.f5.com/cli/tmsh-reference/v15/modules/sys/sys_global-settings.html
 // List retrieves all GlobalSettings details.
-func (r *GlobalSettingsResource) Show() (*GlobalSettings, error) {
+func (r *GlobalSettingsResource) Show(ctx context.Context) (*GlobalSettings, error) {
 	var items GlobalSettings
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(GlobalSettingsEndpoint).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -65,14 +65,14 @@ func (r *GlobalSettingsResource) Show() (*GlobalSettings, error) {
 }
 
 // Update modifies the GlobalSettings item identified by the GlobalSettings name.
-func (r *GlobalSettingsResource) Update(name string, item GlobalSettings) error {
+func (r *GlobalSettingsResource) Update(ctx context.Context, name string, item GlobalSettings) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(GlobalSettingsEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(GlobalSettingsEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -52,10 +52,10 @@ type HTTPDResource struct {
 }
 
 // Get the HTTPD configurations.
-func (r *HTTPDResource) Get() (*HTTPDConfig, error) {
+func (r *HTTPDResource) Get(ctx context.Context) (*HTTPDConfig, error) {
 	var item HTTPDConfig
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(HTTPDEndpoint).DoRaw(context.Background())
+		Resource(HTTPDEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -27,10 +27,10 @@ type IControlSOAPResource struct {
 }
 
 // Show retrieves IControlSOAP details.
-func (r *IControlSOAPResource) Show() (*IControlSOAP, error) {
+func (r *IControlSOAPResource) Show(ctx context.Context) (*IControlSOAP, error) {
 	var items IControlSOAP
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(IControlSOAPEndpoint).DoRaw(context.Background())
+		Resource(IControlSOAPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,25 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package ipfix
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// IPFixAPI is the interface of IPFixResource, which mocks implement in tests.
+type IPFixAPI interface {
+	Element() IPFixElementAPI
+}
+
+var _ IPFixAPI = &IPFixResource{}
+
+// IPFixElementAPI is the interface of IPFixElementResource, which mocks implement in tests.
+type IPFixElementAPI interface {
+	// List retrieves all IPFixElement details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*IPFixElementList, error)
+	// Get retrieves the details of a single IPFixElement by node name.
+	Get(ctx context.Context, name string) (*IPFixElement, error)
+}
+
+var _ IPFixElementAPI = &IPFixElementResource{}
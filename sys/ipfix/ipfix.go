@@ -1,5 +1,7 @@
 package ipfix
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // IPFixEndpoint represents the REST resource for managing IPFix.
@@ -16,6 +18,6 @@ func NewIPFix(b *bigip.BigIP) IPFixResource {
 	}
 }
 
-func (ipfix IPFixResource) Element() *IPFixElementResource {
+func (ipfix IPFixResource) Element() IPFixElementAPI {
 	return &ipfix.iPFixElement
 }
@@ -2,9 +2,8 @@ package ipfix
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // IPFixElementList holds a list of IPFixElement configurations.
@@ -36,31 +35,17 @@ type IPFixElementResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all IPFixElement details.
-func (r *IPFixElementResource) List() (*IPFixElementList, error) {
-	var items IPFixElementList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(IPFixEndpoint).SubResource(IPFixElementEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing IPFixElementResource.
+func (r *IPFixElementResource) collection() *bigip.Collection[IPFixElement, IPFixElementList] {
+	return bigip.NewCollection[IPFixElement, IPFixElementList](r.b, SysManager, IPFixEndpoint, IPFixElementEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all IPFixElement details.
+func (r *IPFixElementResource) List(ctx context.Context, opts ...*rest.ListOptions) (*IPFixElementList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single IPFixElement by node name.
-func (r *IPFixElementResource) Get(name string) (*IPFixElement, error) {
-	var item IPFixElement
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(IPFixEndpoint).SubResource(IPFixElementEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *IPFixElementResource) Get(ctx context.Context, name string) (*IPFixElement, error) {
+	return r.collection().Get(ctx, name)
 }
@@ -0,0 +1,59 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package ipfixmock provides mocks of the interfaces of package ipfix, which record their calls.
+package ipfixmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/ipfix"
+)
+
+// IPFixAPI is a mock of ipfix.IPFixAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IPFixAPI struct {
+	bigiptest.CallRecorder
+
+	ElementFunc func() ipfix.IPFixElementAPI
+}
+
+var _ ipfix.IPFixAPI = &IPFixAPI{}
+
+// Element records the call and calls ElementFunc if it is set.
+func (m *IPFixAPI) Element() (r0 ipfix.IPFixElementAPI) {
+	m.CallRecorder.Record("Element")
+	if m.ElementFunc != nil {
+		return m.ElementFunc()
+	}
+	return
+}
+
+// IPFixElementAPI is a mock of ipfix.IPFixElementAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IPFixElementAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context, ...*rest.ListOptions) (*ipfix.IPFixElementList, error)
+	GetFunc  func(context.Context, string) (*ipfix.IPFixElement, error)
+}
+
+var _ ipfix.IPFixElementAPI = &IPFixElementAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *IPFixElementAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *ipfix.IPFixElementList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *IPFixElementAPI) Get(ctx context.Context, name string) (r0 *ipfix.IPFixElement, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
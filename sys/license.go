@@ -138,10 +138,10 @@ type LicenseResource struct {
 }
 
 // Get retrieves the details of a single License by node name.
-func (r *LicenseResource) Get() (*License, error) {
+func (r *LicenseResource) Get(ctx context.Context) (*License, error) {
 	var item License
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LicenseEndpoint).DoRaw(context.Background())
+		Resource(LicenseEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +157,7 @@ type licenseKey struct {
 }
 
 // The Activate method activates a license using the given registration key.
-func (r *LicenseResource) Activate(registrationKey string) error {
+func (r *LicenseResource) Activate(ctx context.Context, registrationKey string) error {
 	item := licenseKey{
 		Command:         "install",
 		RegistrationKey: registrationKey,
@@ -169,7 +169,7 @@ func (r *LicenseResource) Activate(registrationKey string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(LicenseEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(LicenseEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
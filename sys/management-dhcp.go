@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ManagementDHCPList holds a list of ManagementDHCP configuration.
@@ -34,72 +32,37 @@ type ManagementDHCPResource struct {
 	b *bigip.BigIP
 }
 
-// List all management DHCP details
-func (r *ManagementDHCPResource) List() (*ManagementDHCPList, error) {
-	var items ManagementDHCPList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementDHCPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ManagementDHCPResource.
+func (r *ManagementDHCPResource) collection() *bigip.Collection[ManagementDHCP, ManagementDHCPList] {
+	return bigip.NewCollection[ManagementDHCP, ManagementDHCPList](r.b, SysManager, ManagementDHCPEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all management DHCP details
+func (r *ManagementDHCPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementDHCPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single management DHCP details by the node name
-func (r *ManagementDHCPResource) Get(name string) (*ManagementDHCP, error) {
-	var item ManagementDHCP
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementDHCPEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ManagementDHCPResource) Get(ctx context.Context, name string) (*ManagementDHCP, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new management DHCP item
-func (r *ManagementDHCPResource) Create(item ManagementDHCP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementDHCPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementDHCPResource) Create(ctx context.Context, item ManagementDHCP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the management DHCP item identified by the management DHCP name, otherwise an error will be reported.
-func (r *ManagementDHCPResource) Update(name string, item ManagementDHCP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementDHCPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementDHCPResource) Update(ctx context.Context, name string, item ManagementDHCP) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single management DHCP identified by the management DHCP name. if it is not exist return error
-func (r *ManagementDHCPResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementDHCPEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the ManagementDHCP identified by name, see bigip.PatchBody.
+func (r *ManagementDHCPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single management DHCP identified by the management DHCP name. if it is not exist return error
+func (r *ManagementDHCPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
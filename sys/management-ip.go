@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ManagementIPConfigList holds a list of ManagementIP configuration.
@@ -32,72 +30,37 @@ type ManagementIPResource struct {
 	b *bigip.BigIP
 }
 
-// List all management IP details
-func (r *ManagementIPResource) List() (*ManagementIPList, error) {
-	var items ManagementIPList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ManagementIPResource.
+func (r *ManagementIPResource) collection() *bigip.Collection[ManagementIP, ManagementIPList] {
+	return bigip.NewCollection[ManagementIP, ManagementIPList](r.b, SysManager, ManagementIPEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all management IP details
+func (r *ManagementIPResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementIPList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single management IP details by the node name
-func (r *ManagementIPResource) Get(name string) (*ManagementIP, error) {
-	var item ManagementIP
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ManagementIPResource) Get(ctx context.Context, name string) (*ManagementIP, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new management IP item
-func (r *ManagementIPResource) Create(item ManagementIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementIPResource) Create(ctx context.Context, item ManagementIP) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the management IP item identified by the management IP name, otherwise an error will be reported.
-func (r *ManagementIPResource) Update(name string, item ManagementIP) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementIPResource) Update(ctx context.Context, name string, item ManagementIP) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single management IP identified by the management IP name. if it is not exist return error
-func (r *ManagementIPResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementIPEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the ManagementIP identified by name, see bigip.PatchBody.
+func (r *ManagementIPResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single management IP identified by the management IP name. if it is not exist return error
+func (r *ManagementIPResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
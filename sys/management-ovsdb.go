@@ -6,10 +6,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 	"time"
 )
 
@@ -43,42 +41,27 @@ type ManagementOVSDBResource struct {
 	b *bigip.BigIP
 }
 
-// List all management OVSDB details
-func (r *ManagementOVSDBResource) List() (*ManagementOVSDB, error) {
-	var items ManagementOVSDB
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementOVSDBEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ManagementOVSDBResource.
+func (r *ManagementOVSDBResource) collection() *bigip.Collection[ManagementOVSDB, ManagementOVSDB] {
+	return bigip.NewCollection[ManagementOVSDB, ManagementOVSDB](r.b, SysManager, ManagementOVSDBEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all management OVSDB details
+func (r *ManagementOVSDBResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementOVSDB, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Update the management OVSDB item identified by the management OVSDB name, otherwise an error will be reported.
-func (r *ManagementOVSDBResource) Update(name string, item ManagementOVSDB) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementOVSDBResource) Update(ctx context.Context, name string, item ManagementOVSDB) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the ManagementOVSDB identified by name, see bigip.PatchBody.
+func (r *ManagementOVSDBResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single management OVSDB identified by the management OVSDB name. if it is not exist return error
-func (r *ManagementOVSDBResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementOVSDBEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementOVSDBResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
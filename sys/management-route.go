@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ManagementRouteList holds a list of ManagementRoute configuration.
@@ -37,72 +35,37 @@ type ManagementRouteResource struct {
 	b *bigip.BigIP
 }
 
-// List all management route details
-func (r *ManagementRouteResource) List() (*ManagementRouteList, error) {
-	var items ManagementRouteList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementRouteEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ManagementRouteResource.
+func (r *ManagementRouteResource) collection() *bigip.Collection[ManagementRoute, ManagementRouteList] {
+	return bigip.NewCollection[ManagementRoute, ManagementRouteList](r.b, SysManager, ManagementRouteEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all management route details
+func (r *ManagementRouteResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ManagementRouteList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single management route details by the node name
-func (r *ManagementRouteResource) Get(name string) (*ManagementRoute, error) {
-	var item ManagementRoute
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementRouteEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ManagementRouteResource) Get(ctx context.Context, name string) (*ManagementRoute, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new management route item
-func (r *ManagementRouteResource) Create(item ManagementRoute) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementRouteEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementRouteResource) Create(ctx context.Context, item ManagementRoute) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the management route item identified by the management route name, otherwise an error will be reported.
-func (r *ManagementRouteResource) Update(name string, item ManagementRoute) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementRouteEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ManagementRouteResource) Update(ctx context.Context, name string, item ManagementRoute) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single management route identified by the management route name. if it is not exist return error
-func (r *ManagementRouteResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ManagementRouteEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the ManagementRoute identified by name, see bigip.PatchBody.
+func (r *ManagementRouteResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single management route identified by the management route name. if it is not exist return error
+func (r *ManagementRouteResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -92,10 +92,10 @@ type MemoryStatsResource struct {
 	b *bigip.BigIP
 }
 
-func (r *MemoryStatsResource) All() (*MemoryStatsList, error) {
+func (r *MemoryStatsResource) All(ctx context.Context) (*MemoryStatsList, error) {
 	var items MemoryStatsList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(MemoryStatsEndpoint).DoRaw(context.Background())
+		Resource(MemoryStatsEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -29,10 +29,10 @@ type NTPResource struct {
 }
 
 // Get a single ntp configuration identified by fullPathName.
-func (nr *NTPResource) Get() (*NTP, error) {
+func (nr *NTPResource) Get(ctx context.Context) (*NTP, error) {
 	var ntp NTP
 	res, err := nr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).DoRaw(context.Background())
+		Resource(NTPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -44,14 +44,14 @@ func (nr *NTPResource) Get() (*NTP, error) {
 }
 
 // Create a new ntp configuration.
-func (nr *NTPResource) Create(item NTP) error {
+func (nr *NTPResource) Create(ctx context.Context, item NTP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -59,25 +59,25 @@ func (nr *NTPResource) Create(item NTP) error {
 }
 
 // Edit a ntp configuration identified by name.
-func (nr *NTPResource) Update(name string, item NTP) error {
+func (nr *NTPResource) Update(ctx context.Context, name string, item NTP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NTPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (nr *NTPResource) AddServersForNTP(rs ...string) error {
+func (nr *NTPResource) AddServersForNTP(ctx context.Context, rs ...string) error {
 	if len(rs) == 0 {
 		return nil
 	}
-	item, err := nr.Get()
+	item, err := nr.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -89,7 +89,7 @@ func (nr *NTPResource) AddServersForNTP(rs ...string) error {
 	}
 	jsonString := string(jsonData)
 	_, err = nr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(NTPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -98,9 +98,9 @@ func (nr *NTPResource) AddServersForNTP(rs ...string) error {
 }
 
 // Delete a single ntp configuration identified by name.
-func (nr *NTPResource) Delete(name string) error {
+func (nr *NTPResource) Delete(ctx context.Context, name string) error {
 	_, err := nr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(NTPEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(NTPEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -24,10 +24,10 @@ type OutboundSMTPResource struct {
 }
 
 // Show retrieves all OutboundSMTP details.
-func (r *OutboundSMTPResource) Show() (*OutboundSMTP, error) {
+func (r *OutboundSMTPResource) Show(ctx context.Context) (*OutboundSMTP, error) {
 	var item OutboundSMTP
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(OutboundSMTPEndpoint).DoRaw(context.Background())
+		Resource(OutboundSMTPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package pfman
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// ConsumerAPI is the interface of ConsumerResource, which mocks implement in tests.
+type ConsumerAPI interface {
+	// List retrieves all Consumer details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ConsumerList, error)
+	// Get retrieves the details of a single Consumer by node name.
+	Get(ctx context.Context, name string) (*Consumer, error)
+	// Create creates a new Consumer item.
+	Create(ctx context.Context, item Consumer) error
+	// Update modifies the Consumer item identified by the Consumer name.
+	Update(ctx context.Context, name string, item Consumer) error
+	// Patch updates only the given fields of the Consumer identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Consumer identified by the Consumer name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ ConsumerAPI = &ConsumerResource{}
+
+// DeviceAPI is the interface of DeviceResource, which mocks implement in tests.
+type DeviceAPI interface {
+	// List retrieves all Device details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*DeviceList, error)
+	// Get retrieves the details of a single Device by node name.
+	Get(ctx context.Context, name string) (*Device, error)
+	// Create creates a new Device item.
+	Create(ctx context.Context, item Device) error
+	// Update modifies the Device item identified by the Device name.
+	Update(ctx context.Context, name string, item Device) error
+	// Patch updates only the given fields of the Device identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Device identified by the Device name. If it does not exist, return an error.
+	Delete(ctx context.Context, name string) error
+}
+
+var _ DeviceAPI = &DeviceResource{}
+
+// PFManAPI is the interface of PFManResource, which mocks implement in tests.
+type PFManAPI interface {
+	Device() DeviceAPI
+	Consumer() ConsumerAPI
+}
+
+var _ PFManAPI = &PFManResource{}
@@ -2,10 +2,8 @@ package pfman
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ConsumerList holds a list of Consumer configurations.
@@ -27,71 +25,37 @@ type ConsumerResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Consumer details.
-func (r *ConsumerResource) List() (*ConsumerList, error) {
-	var items ConsumerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ConsumerResource.
+func (r *ConsumerResource) collection() *bigip.Collection[Consumer, ConsumerList] {
+	return bigip.NewCollection[Consumer, ConsumerList](r.b, SysManager, PFManEndpoint, ConsumerEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Consumer details.
+func (r *ConsumerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ConsumerList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Consumer by node name.
-func (r *ConsumerResource) Get(name string) (*Consumer, error) {
-	var item Consumer
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ConsumerResource) Get(ctx context.Context, name string) (*Consumer, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Consumer item.
-func (r *ConsumerResource) Create(item Consumer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConsumerResource) Create(ctx context.Context, item Consumer) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Consumer item identified by the Consumer name.
-func (r *ConsumerResource) Update(name string, item Consumer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConsumerResource) Update(ctx context.Context, name string, item Consumer) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Consumer identified by name, see bigip.PatchBody.
+func (r *ConsumerResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Consumer identified by the Consumer name. If it does not exist, return an error.
-func (r *ConsumerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(ConsumerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ConsumerResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
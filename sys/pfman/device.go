@@ -2,10 +2,8 @@ package pfman
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // DeviceList holds a list of Device configurations.
@@ -27,72 +25,37 @@ type DeviceResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Device details.
-func (r *DeviceResource) List() (*DeviceList, error) {
-	var items DeviceList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing DeviceResource.
+func (r *DeviceResource) collection() *bigip.Collection[Device, DeviceList] {
+	return bigip.NewCollection[Device, DeviceList](r.b, SysManager, PFManEndpoint, DeviceEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Device details.
+func (r *DeviceResource) List(ctx context.Context, opts ...*rest.ListOptions) (*DeviceList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Device by node name.
-func (r *DeviceResource) Get(name string) (*Device, error) {
-	var item Device
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *DeviceResource) Get(ctx context.Context, name string) (*Device, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Device item.
-func (r *DeviceResource) Create(item Device) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *DeviceResource) Create(ctx context.Context, item Device) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Device item identified by the Device name.
-func (r *DeviceResource) Update(name string, item Device) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *DeviceResource) Update(ctx context.Context, name string, item Device) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Device identified by the Device name. If it does not exist, return an error.
-func (r *DeviceResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(PFManEndpoint).SubResource(DeviceEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Device identified by name, see bigip.PatchBody.
+func (r *DeviceResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Device identified by the Device name. If it does not exist, return an error.
+func (r *DeviceResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -1,5 +1,7 @@
 package pfman
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 // PFManEndpoint represents the REST resource for managing PFMan.
@@ -19,10 +21,10 @@ func NewPFMan(b *bigip.BigIP) PFManResource {
 	}
 }
 
-func (pfm *PFManResource) Device() *DeviceResource {
+func (pfm *PFManResource) Device() DeviceAPI {
 	return &pfm.device
 }
 
-func (pfm *PFManResource) Consumer() *ConsumerResource {
+func (pfm *PFManResource) Consumer() ConsumerAPI {
 	return &pfm.consumer
 }
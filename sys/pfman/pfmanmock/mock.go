@@ -0,0 +1,178 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package pfmanmock provides mocks of the interfaces of package pfman, which record their calls.
+package pfmanmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/pfman"
+)
+
+// ConsumerAPI is a mock of pfman.ConsumerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ConsumerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*pfman.ConsumerList, error)
+	GetFunc    func(context.Context, string) (*pfman.Consumer, error)
+	CreateFunc func(context.Context, pfman.Consumer) error
+	UpdateFunc func(context.Context, string, pfman.Consumer) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ pfman.ConsumerAPI = &ConsumerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ConsumerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *pfman.ConsumerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ConsumerAPI) Get(ctx context.Context, name string) (r0 *pfman.Consumer, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ConsumerAPI) Create(ctx context.Context, item pfman.Consumer) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ConsumerAPI) Update(ctx context.Context, name string, item pfman.Consumer) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ConsumerAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ConsumerAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// DeviceAPI is a mock of pfman.DeviceAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DeviceAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*pfman.DeviceList, error)
+	GetFunc    func(context.Context, string) (*pfman.Device, error)
+	CreateFunc func(context.Context, pfman.Device) error
+	UpdateFunc func(context.Context, string, pfman.Device) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ pfman.DeviceAPI = &DeviceAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DeviceAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *pfman.DeviceList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DeviceAPI) Get(ctx context.Context, name string) (r0 *pfman.Device, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DeviceAPI) Create(ctx context.Context, item pfman.Device) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DeviceAPI) Update(ctx context.Context, name string, item pfman.Device) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DeviceAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DeviceAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// PFManAPI is a mock of pfman.PFManAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type PFManAPI struct {
+	bigiptest.CallRecorder
+
+	DeviceFunc   func() pfman.DeviceAPI
+	ConsumerFunc func() pfman.ConsumerAPI
+}
+
+var _ pfman.PFManAPI = &PFManAPI{}
+
+// Device records the call and calls DeviceFunc if it is set.
+func (m *PFManAPI) Device() (r0 pfman.DeviceAPI) {
+	m.CallRecorder.Record("Device")
+	if m.DeviceFunc != nil {
+		return m.DeviceFunc()
+	}
+	return
+}
+
+// Consumer records the call and calls ConsumerFunc if it is set.
+func (m *PFManAPI) Consumer() (r0 pfman.ConsumerAPI) {
+	m.CallRecorder.Record("Consumer")
+	if m.ConsumerFunc != nil {
+		return m.ConsumerFunc()
+	}
+	return
+}
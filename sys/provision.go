@@ -2,9 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ProvisionList holds a list of Provision configurations.
@@ -35,31 +34,17 @@ type ProvisionResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Provision details.
-func (r *ProvisionResource) List() (*ProvisionList, error) {
-	var items ProvisionList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ProvisionEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ProvisionResource.
+func (r *ProvisionResource) collection() *bigip.Collection[Provision, ProvisionList] {
+	return bigip.NewCollection[Provision, ProvisionList](r.b, SysManager, ProvisionEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Provision details.
+func (r *ProvisionResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ProvisionList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Provision by node name.
-func (r *ProvisionResource) Get(name string) (*Provision, error) {
-	var item Provision
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ProvisionEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ProvisionResource) Get(ctx context.Context, name string) (*Provision, error) {
+	return r.collection().Get(ctx, name)
 }
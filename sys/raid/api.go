@@ -0,0 +1,22 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package raid
+
+import (
+	"context"
+)
+
+// DiskAPI is the interface of DiskResource, which mocks implement in tests.
+type DiskAPI interface {
+	// Get a single logical disk details by the node name
+	Show(ctx context.Context) (*DiskList, error)
+}
+
+var _ DiskAPI = &DiskResource{}
+
+// RAIDAPI is the interface of RAIDResource, which mocks implement in tests.
+type RAIDAPI interface {
+	Disk() DiskAPI
+}
+
+var _ RAIDAPI = &RAIDResource{}
@@ -46,10 +46,10 @@ type DiskResource struct {
 }
 
 // Get a single logical disk details by the node name
-func (r *DiskResource) Show() (*DiskList, error) {
+func (r *DiskResource) Show(ctx context.Context) (*DiskList, error) {
 	var item DiskList
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(RAIDEndpoint).Resource(DiskEndpoint).DoRaw(context.Background())
+		Resource(RAIDEndpoint).SubResource(DiskEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package raid
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import "github.com/lefeck/go-bigip"
 
 const SysManager = "sys"
@@ -18,6 +20,6 @@ func NewRAID(b *bigip.BigIP) RAIDResource {
 	}
 }
 
-func (raid *RAIDResource) Disk() *DiskResource {
+func (raid *RAIDResource) Disk() DiskAPI {
 	return &raid.disk
 }
@@ -0,0 +1,48 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package raidmock provides mocks of the interfaces of package raid, which record their calls.
+package raidmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/sys/raid"
+)
+
+// DiskAPI is a mock of raid.DiskAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DiskAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc func(context.Context) (*raid.DiskList, error)
+}
+
+var _ raid.DiskAPI = &DiskAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *DiskAPI) Show(ctx context.Context) (r0 *raid.DiskList, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// RAIDAPI is a mock of raid.RAIDAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type RAIDAPI struct {
+	bigiptest.CallRecorder
+
+	DiskFunc func() raid.DiskAPI
+}
+
+var _ raid.RAIDAPI = &RAIDAPI{}
+
+// Disk records the call and calls DiskFunc if it is set.
+func (m *RAIDAPI) Disk() (r0 raid.DiskAPI) {
+	m.CallRecorder.Record("Disk")
+	if m.DiskFunc != nil {
+		return m.DiskFunc()
+	}
+	return
+}
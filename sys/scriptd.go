@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ScriptdList holds a list of Scriptd configuration.
@@ -27,72 +25,37 @@ type ScriptdResource struct {
 	b *bigip.BigIP
 }
 
-// List all scriptd details
-func (r *ScriptdResource) List() (*ScriptdList, error) {
-	var items ScriptdList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ScriptdEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ScriptdResource.
+func (r *ScriptdResource) collection() *bigip.Collection[Scriptd, ScriptdList] {
+	return bigip.NewCollection[Scriptd, ScriptdList](r.b, SysManager, ScriptdEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all scriptd details
+func (r *ScriptdResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ScriptdList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single scriptd details by the node name
-func (r *ScriptdResource) Get(name string) (*Scriptd, error) {
-	var item Scriptd
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ScriptdEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ScriptdResource) Get(ctx context.Context, name string) (*Scriptd, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new scriptd item
-func (r *ScriptdResource) Create(item Scriptd) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ScriptdEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ScriptdResource) Create(ctx context.Context, item Scriptd) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the scriptd item identified by the scriptd name, otherwise an error will be reported.
-func (r *ScriptdResource) Update(name string, item Scriptd) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ScriptdEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ScriptdResource) Update(ctx context.Context, name string, item Scriptd) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single scriptd identified by the scriptd name. if it is not exist return error
-func (r *ScriptdResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ScriptdEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Scriptd identified by name, see bigip.PatchBody.
+func (r *ScriptdResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single scriptd identified by the scriptd name. if it is not exist return error
+func (r *ScriptdResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
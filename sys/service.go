@@ -2,11 +2,9 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // ServiceList holds a list of Service configuration.
@@ -37,72 +35,37 @@ type ServiceResource struct {
 	b *bigip.BigIP
 }
 
-// List all service details
-func (r *ServiceResource) List() (*ServiceList, error) {
-	var items ServiceList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ServiceEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ServiceResource.
+func (r *ServiceResource) collection() *bigip.Collection[Service, ServiceList] {
+	return bigip.NewCollection[Service, ServiceList](r.b, SysManager, ServiceEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all service details
+func (r *ServiceResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ServiceList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single service details by the node name
-func (r *ServiceResource) Get(name string) (*Service, error) {
-	var item Service
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ServiceEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ServiceResource) Get(ctx context.Context, name string) (*Service, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new service item
-func (r *ServiceResource) Create(item Service) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ServiceEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServiceResource) Create(ctx context.Context, item Service) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the service item identified by the service name, otherwise an error will be reported.
-func (r *ServiceResource) Update(name string, item Service) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ServiceEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ServiceResource) Update(ctx context.Context, name string, item Service) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single service identified by the service name. if it is not exist return error
-func (r *ServiceResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(ServiceEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Service identified by name, see bigip.PatchBody.
+func (r *ServiceResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single service identified by the service name. if it is not exist return error
+func (r *ServiceResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SMTPServerList holds a list of SMTPServer configurations.
@@ -28,72 +26,37 @@ type SMTPServerResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all SMTPServer details.
-func (r *SMTPServerResource) List() (*SMTPServerList, error) {
-	var items SMTPServerList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SMTPServerResource.
+func (r *SMTPServerResource) collection() *bigip.Collection[SMTPServer, SMTPServerList] {
+	return bigip.NewCollection[SMTPServer, SMTPServerList](r.b, SysManager, SMTPServerEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all SMTPServer details.
+func (r *SMTPServerResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SMTPServerList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single SMTPServer by node name.
-func (r *SMTPServerResource) Get(name string) (*SMTPServer, error) {
-	var item SMTPServer
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *SMTPServerResource) Get(ctx context.Context, name string) (*SMTPServer, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new SMTPServer item.
-func (r *SMTPServerResource) Create(item SMTPServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SMTPServerResource) Create(ctx context.Context, item SMTPServer) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the SMTPServer item identified by the SMTPServer name.
-func (r *SMTPServerResource) Update(name string, item SMTPServer) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SMTPServerResource) Update(ctx context.Context, name string, item SMTPServer) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single SMTPServer identified by the SMTPServer name. if it is not exist return error
-func (r *SMTPServerResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SMTPServerEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the SMTPServer identified by name, see bigip.PatchBody.
+func (r *SMTPServerResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single SMTPServer identified by the SMTPServer name. if it is not exist return error
+func (r *SMTPServerResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -66,10 +66,10 @@ type SNMPResource struct {
 }
 
 // list all the snmp configurations.
-func (sr *SNMPResource) Get() (*SNMP, error) {
+func (sr *SNMPResource) Get(ctx context.Context) (*SNMP, error) {
 	var sl SNMP
 	res, err := sr.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).DoRaw(context.Background())
+		Resource(SNMPEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -81,14 +81,14 @@ func (sr *SNMPResource) Get() (*SNMP, error) {
 }
 
 // Create a new snmp configuration.
-func (sr *SNMPResource) Create(item SNMP) error {
+func (sr *SNMPResource) Create(ctx context.Context, item SNMP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = sr.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SNMPEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -96,14 +96,14 @@ func (sr *SNMPResource) Create(item SNMP) error {
 }
 
 // Edit a snmp configuration identified by name.
-func (sr *SNMPResource) Update(name string, item SNMP) error {
+func (sr *SNMPResource) Update(ctx context.Context, name string, item SNMP) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = sr.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SNMPEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -111,9 +111,9 @@ func (sr *SNMPResource) Update(name string, item SNMP) error {
 }
 
 // Delete a single snmp configuration identified by name.
-func (sr *SNMPResource) Delete(name string) error {
+func (sr *SNMPResource) Delete(ctx context.Context, name string) error {
 	_, err := sr.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SNMPEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(SNMPEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
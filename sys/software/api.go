@@ -0,0 +1,136 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package software
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+)
+
+// BlockDeviceHotfixAPI is the interface of BlockDeviceHotfixResource, which mocks implement in tests.
+type BlockDeviceHotfixAPI interface {
+	// List retrieves all BlockDeviceHotfix details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*BlockDeviceHotfixList, error)
+	// Get retrieves the details of a single BlockDeviceHotfix by node name.
+	Get(ctx context.Context, name string) (*BlockDeviceHotfix, error)
+	// Create creates a new BlockDeviceHotfix item.
+	Create(ctx context.Context, item BlockDeviceHotfix) error
+	// Update modifies the BlockDeviceHotfix item identified by the BlockDeviceHotfix name.
+	Update(ctx context.Context, name string, item BlockDeviceHotfix) error
+	// Patch updates only the given fields of the BlockDeviceHotfix identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single BlockDeviceHotfix identified by the BlockDeviceHotfix name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ BlockDeviceHotfixAPI = &BlockDeviceHotfixResource{}
+
+// BlockDeviceImageAPI is the interface of BlockDeviceImageResource, which mocks implement in tests.
+type BlockDeviceImageAPI interface {
+	// List retrieves all BlockDeviceImage details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*BlockDeviceImageList, error)
+	// Get retrieves the details of a single BlockDeviceImage by node name.
+	Get(ctx context.Context, name string) (*BlockDeviceImage, error)
+	// Create creates a new BlockDeviceImage item.
+	Create(ctx context.Context, item BlockDeviceImage) error
+	// Update modifies the BlockDeviceImage item identified by the BlockDeviceImage name.
+	Update(ctx context.Context, name string, item BlockDeviceImage) error
+	// Patch updates only the given fields of the BlockDeviceImage identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single BlockDeviceImage identified by the BlockDeviceImage name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ BlockDeviceImageAPI = &BlockDeviceImageResource{}
+
+// HotfixAPI is the interface of HotfixResource, which mocks implement in tests.
+type HotfixAPI interface {
+	// List retrieves all Hotfix details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*HotfixList, error)
+	// Get retrieves the details of a single Hotfix by node name.
+	Get(ctx context.Context, name string) (*Hotfix, error)
+	// Create creates a new Hotfix item.
+	Create(ctx context.Context, item Hotfix) error
+	// Update modifies the Hotfix item identified by the Hotfix name.
+	Update(ctx context.Context, name string, item Hotfix) error
+	// Patch updates only the given fields of the Hotfix identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Hotfix identified by the Hotfix name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ HotfixAPI = &HotfixResource{}
+
+// ImageAPI is the interface of ImageResource, which mocks implement in tests.
+type ImageAPI interface {
+	// List retrieves all Image details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*ImageList, error)
+	// Get retrieves the details of a single Image by node name.
+	Get(ctx context.Context, name string) (*Image, error)
+	// Create creates a new Image item.
+	Create(ctx context.Context, item Image) error
+	// Update modifies the Image item identified by the Image name.
+	Update(ctx context.Context, name string, item Image) error
+	// Patch updates only the given fields of the Image identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Image identified by the Image name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+	// Install installs the image /shared/images/<name> on volume, such as HD1.2, and waits for the
+	// installation to finish. Options such as {"create-volume": true} or {"reboot": true} are passed
+	// to tmsh. BIG-IP has no task endpoint for an installation: the command returns at once and the
+	// progress is reported by the status of the volume, so the volume is polled with bigip.Poll.
+	Install(ctx context.Context, name string, volume string, options ...map[string]interface{}) error
+}
+
+var _ ImageAPI = &ImageResource{}
+
+// SoftwareAPI is the interface of SoftwareResource, which mocks implement in tests.
+type SoftwareAPI interface {
+	Image() ImageAPI
+	Update() *UpdateResource
+	UpdateStatus() UpdateStatusAPI
+	Volume() VolumeAPI
+	BlockDeviceHotfix() BlockDeviceHotfixAPI
+	BlockDeviceImage() BlockDeviceImageAPI
+	Hotfix() HotfixAPI
+}
+
+var _ SoftwareAPI = &SoftwareResource{}
+
+// UpdateStatusAPI is the interface of UpdateStatusResource, which mocks implement in tests.
+type UpdateStatusAPI interface {
+	// Show retrieves the details of a single Update
+	Show(ctx context.Context) (*Update, error)
+	// List retrieves all UpdateStatus details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*UpdateStatusList, error)
+	// Get retrieves the details of a single UpdateStatus by node name.
+	Get(ctx context.Context, name string) (*UpdateStatus, error)
+	// Create creates a new UpdateStatus item.
+	Create(ctx context.Context, item UpdateStatus) error
+	// Update modifies the UpdateStatus item identified by the UpdateStatus name.
+	Update(ctx context.Context, name string, item UpdateStatus) error
+	// Patch updates only the given fields of the UpdateStatus identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single UpdateStatus identified by the UpdateStatus name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ UpdateStatusAPI = &UpdateStatusResource{}
+
+// VolumeAPI is the interface of VolumeResource, which mocks implement in tests.
+type VolumeAPI interface {
+	// List retrieves all Volume details.
+	List(ctx context.Context, opts ...*rest.ListOptions) (*VolumeList, error)
+	// Get retrieves the details of a single Volume by node name.
+	Get(ctx context.Context, name string) (*Volume, error)
+	// Create creates a new Volume item.
+	Create(ctx context.Context, item Volume) error
+	// Update modifies the Volume item identified by the Volume name.
+	Update(ctx context.Context, name string, item Volume) error
+	// Patch updates only the given fields of the Volume identified by name, see bigip.PatchBody.
+	Patch(ctx context.Context, name string, fields interface{}) error
+	// Delete a single Volume identified by the Volume name. if it is not exist return error
+	Delete(ctx context.Context, name string) error
+}
+
+var _ VolumeAPI = &VolumeResource{}
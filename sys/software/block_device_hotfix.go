@@ -2,10 +2,8 @@ package software
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // BlockDeviceHotfixList holds a list of BlockDeviceHotfix configurations.
@@ -27,72 +25,37 @@ type BlockDeviceHotfixResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all BlockDeviceHotfix details.
-func (r *BlockDeviceHotfixResource) List() (*BlockDeviceHotfixList, error) {
-	var items BlockDeviceHotfixList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing BlockDeviceHotfixResource.
+func (r *BlockDeviceHotfixResource) collection() *bigip.Collection[BlockDeviceHotfix, BlockDeviceHotfixList] {
+	return bigip.NewCollection[BlockDeviceHotfix, BlockDeviceHotfixList](r.b, SysManager, SoftwareEndpoint, BlockDeviceHotfixEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all BlockDeviceHotfix details.
+func (r *BlockDeviceHotfixResource) List(ctx context.Context, opts ...*rest.ListOptions) (*BlockDeviceHotfixList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single BlockDeviceHotfix by node name.
-func (r *BlockDeviceHotfixResource) Get(name string) (*BlockDeviceHotfix, error) {
-	var item BlockDeviceHotfix
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *BlockDeviceHotfixResource) Get(ctx context.Context, name string) (*BlockDeviceHotfix, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new BlockDeviceHotfix item.
-func (r *BlockDeviceHotfixResource) Create(item BlockDeviceHotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BlockDeviceHotfixResource) Create(ctx context.Context, item BlockDeviceHotfix) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the BlockDeviceHotfix item identified by the BlockDeviceHotfix name.
-func (r *BlockDeviceHotfixResource) Update(name string, item BlockDeviceHotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BlockDeviceHotfixResource) Update(ctx context.Context, name string, item BlockDeviceHotfix) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single BlockDeviceHotfix identified by the BlockDeviceHotfix name. if it is not exist return error
-func (r *BlockDeviceHotfixResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceHotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the BlockDeviceHotfix identified by name, see bigip.PatchBody.
+func (r *BlockDeviceHotfixResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single BlockDeviceHotfix identified by the BlockDeviceHotfix name. if it is not exist return error
+func (r *BlockDeviceHotfixResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
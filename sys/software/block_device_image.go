@@ -2,10 +2,8 @@ package software
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // BlockDeviceImageList holds a list of BlockDeviceImage configurations.
@@ -27,72 +25,37 @@ type BlockDeviceImageResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all BlockDeviceImage details.
-func (r *BlockDeviceImageResource) List() (*BlockDeviceImageList, error) {
-	var items BlockDeviceImageList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing BlockDeviceImageResource.
+func (r *BlockDeviceImageResource) collection() *bigip.Collection[BlockDeviceImage, BlockDeviceImageList] {
+	return bigip.NewCollection[BlockDeviceImage, BlockDeviceImageList](r.b, SysManager, SoftwareEndpoint, BlockDeviceImageEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all BlockDeviceImage details.
+func (r *BlockDeviceImageResource) List(ctx context.Context, opts ...*rest.ListOptions) (*BlockDeviceImageList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single BlockDeviceImage by node name.
-func (r *BlockDeviceImageResource) Get(name string) (*BlockDeviceImage, error) {
-	var item BlockDeviceImage
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *BlockDeviceImageResource) Get(ctx context.Context, name string) (*BlockDeviceImage, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new BlockDeviceImage item.
-func (r *BlockDeviceImageResource) Create(item BlockDeviceImage) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BlockDeviceImageResource) Create(ctx context.Context, item BlockDeviceImage) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the BlockDeviceImage item identified by the BlockDeviceImage name.
-func (r *BlockDeviceImageResource) Update(name string, item BlockDeviceImage) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *BlockDeviceImageResource) Update(ctx context.Context, name string, item BlockDeviceImage) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single BlockDeviceImage identified by the BlockDeviceImage name. if it is not exist return error
-func (r *BlockDeviceImageResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(BlockDeviceImageEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the BlockDeviceImage identified by name, see bigip.PatchBody.
+func (r *BlockDeviceImageResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single BlockDeviceImage identified by the BlockDeviceImage name. if it is not exist return error
+func (r *BlockDeviceImageResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
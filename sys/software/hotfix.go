@@ -2,10 +2,8 @@ package software
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // HotfixList holds a list of Hotfix configurations.
@@ -39,72 +37,37 @@ type HotfixResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Hotfix details.
-func (r *HotfixResource) List() (*HotfixList, error) {
-	var items HotfixList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing HotfixResource.
+func (r *HotfixResource) collection() *bigip.Collection[Hotfix, HotfixList] {
+	return bigip.NewCollection[Hotfix, HotfixList](r.b, SysManager, SoftwareEndpoint, HotfixEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Hotfix details.
+func (r *HotfixResource) List(ctx context.Context, opts ...*rest.ListOptions) (*HotfixList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Hotfix by node name.
-func (r *HotfixResource) Get(name string) (*Hotfix, error) {
-	var item Hotfix
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *HotfixResource) Get(ctx context.Context, name string) (*Hotfix, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Hotfix item.
-func (r *HotfixResource) Create(item Hotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HotfixResource) Create(ctx context.Context, item Hotfix) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Hotfix item identified by the Hotfix name.
-func (r *HotfixResource) Update(name string, item Hotfix) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *HotfixResource) Update(ctx context.Context, name string, item Hotfix) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Hotfix identified by the Hotfix name. if it is not exist return error
-func (r *HotfixResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(HotfixEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Hotfix identified by name, see bigip.PatchBody.
+func (r *HotfixResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Hotfix identified by the Hotfix name. if it is not exist return error
+func (r *HotfixResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/lefeck/go-bigip"
+	"github.com/lefeck/go-bigip/rest"
 	"strings"
 )
 
@@ -39,77 +40,85 @@ type Image struct {
 // ImageEndpoint represents the REST resource for managing Image.
 const ImageEndpoint = "image"
 
+// ImageInstall holds the command installing an image on a volume.
+type ImageInstall struct {
+	Command string                   `json:"command"`
+	Name    string                   `json:"name"`
+	Volume  string                   `json:"volume"`
+	Options []map[string]interface{} `json:"options,omitempty"`
+}
+
 // ImageResource provides an API to manage Image configurations.
 type ImageResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Image details.
-func (r *ImageResource) List() (*ImageList, error) {
-	var items ImageList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(ImageEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing ImageResource.
+func (r *ImageResource) collection() *bigip.Collection[Image, ImageList] {
+	return bigip.NewCollection[Image, ImageList](r.b, SysManager, SoftwareEndpoint, ImageEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Image details.
+func (r *ImageResource) List(ctx context.Context, opts ...*rest.ListOptions) (*ImageList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Image by node name.
-func (r *ImageResource) Get(name string) (*Image, error) {
-	var item Image
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(ImageEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *ImageResource) Get(ctx context.Context, name string) (*Image, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Image item.
-func (r *ImageResource) Create(item Image) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(ImageEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ImageResource) Create(ctx context.Context, item Image) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Image item identified by the Image name.
-func (r *ImageResource) Update(name string, item Image) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(ImageEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *ImageResource) Update(ctx context.Context, name string, item Image) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the Image identified by name, see bigip.PatchBody.
+func (r *ImageResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single Image identified by the Image name. if it is not exist return error
-func (r *ImageResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(ImageEndpoint).ResourceInstance(name).DoRaw(context.Background())
+func (r *ImageResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
+}
+
+// Install installs the image /shared/images/<name> on volume, such as HD1.2, and waits for the
+// installation to finish. Options such as {"create-volume": true} or {"reboot": true} are passed
+// to tmsh. BIG-IP has no task endpoint for an installation: the command returns at once and the
+// progress is reported by the status of the volume, so the volume is polled with bigip.Poll.
+func (r *ImageResource) Install(ctx context.Context, name, volume string, options ...map[string]interface{}) error {
+	data, err := json.Marshal(ImageInstall{Command: "install", Name: name, Volume: volume, Options: options})
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).
+		ManagerName(SysManager).Resource(SoftwareEndpoint).SubResource(ImageEndpoint).Body(data).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	volumes := VolumeResource{b: r.b}
+	return bigip.Poll(ctx, func(ctx context.Context) (bool, error) {
+		item, err := volumes.Get(ctx, volume)
+		if rest.IsNotFound(err) {
+			// A volume created by the installation shows up once it has started.
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case item.Status == "complete":
+			return true, nil
+		case strings.HasPrefix(item.Status, "failed"):
+			return false, fmt.Errorf("installation of %s on %s %s", name, volume, item.Status)
+		}
+		return false, nil
+	})
 }
@@ -0,0 +1,52 @@
+package software
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newInstallServer(t *testing.T, status string) *bigip.BigIP {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mgmt/tm/sys/software/image":
+			body, _ := io.ReadAll(r.Body)
+			expected := `{"command":"install","name":"BIGIP-17.1.0.iso","volume":"HD1.2","options":[{"create-volume":true}]}`
+			if string(body) != expected {
+				t.Errorf("Expected body %s, got %s", expected, body)
+			}
+			w.Write(body)
+		case "GET /mgmt/tm/sys/software/volume/HD1.2":
+			w.Write([]byte(`{"name":"HD1.2","status":"` + status + `"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := bigip.NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestImageInstall(t *testing.T) {
+	b := newInstallServer(t, "complete")
+	if err := NewSoftware(b).Image().Install(context.Background(), "BIGIP-17.1.0.iso", "HD1.2", map[string]interface{}{"create-volume": true}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestImageInstallFailed(t *testing.T) {
+	b := newInstallServer(t, "failed (Media verification failed)")
+	err := NewSoftware(b).Image().Install(context.Background(), "BIGIP-17.1.0.iso", "HD1.2", map[string]interface{}{"create-volume": true})
+	if err == nil || !strings.Contains(err.Error(), "Media verification failed") {
+		t.Fatalf("Expected the failure of the volume, got %v", err)
+	}
+}
@@ -1,11 +1,13 @@
 package software
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen
+
 import (
 	"github.com/lefeck/go-bigip"
 )
 
 // SoftwareEndpoint represents the REST resource for managing Software.
-const SoftwareEndpoint = "/software"
+const SoftwareEndpoint = "software"
 
 // SysEndpoint represents the REST resource for managing Disk.
 const SysManager = "sys"
@@ -34,19 +36,10 @@ func NewSoftware(b *bigip.BigIP) SoftwareResource {
 	}
 }
 
-type Software interface {
-	Image() *ImageResource
-	Update() *UpdateResource
-	UpdateStatus() *UpdateStatusResource
-	Volume() *VolumeResource
-	BlockDeviceHotfix() *BlockDeviceHotfixResource
-	BlockDeviceImage() *BlockDeviceImageResource
-	Hotfix() *HotfixResource
-}
-
-var _ Software = SoftwareResource{}
+// Software is the former name of SoftwareAPI.
+type Software = SoftwareAPI
 
-func (m SoftwareResource) Image() *ImageResource {
+func (m SoftwareResource) Image() ImageAPI {
 	return &m.image
 }
 
@@ -54,22 +47,22 @@ func (m SoftwareResource) Update() *UpdateResource {
 	return &m.update
 }
 
-func (m SoftwareResource) UpdateStatus() *UpdateStatusResource {
+func (m SoftwareResource) UpdateStatus() UpdateStatusAPI {
 	return &m.updateStatus
 }
 
-func (m SoftwareResource) Volume() *VolumeResource {
+func (m SoftwareResource) Volume() VolumeAPI {
 	return &m.volume
 }
 
-func (m SoftwareResource) BlockDeviceHotfix() *BlockDeviceHotfixResource {
+func (m SoftwareResource) BlockDeviceHotfix() BlockDeviceHotfixAPI {
 	return &m.blockDeviceHotfix
 }
 
-func (m SoftwareResource) BlockDeviceImage() *BlockDeviceImageResource {
+func (m SoftwareResource) BlockDeviceImage() BlockDeviceImageAPI {
 	return &m.blockDeviceImage
 }
 
-func (m SoftwareResource) Hotfix() *HotfixResource {
+func (m SoftwareResource) Hotfix() HotfixAPI {
 	return &m.hotfix
 }
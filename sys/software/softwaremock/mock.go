@@ -0,0 +1,524 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package softwaremock provides mocks of the interfaces of package software, which record their calls.
+package softwaremock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys/software"
+)
+
+// BlockDeviceHotfixAPI is a mock of software.BlockDeviceHotfixAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type BlockDeviceHotfixAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*software.BlockDeviceHotfixList, error)
+	GetFunc    func(context.Context, string) (*software.BlockDeviceHotfix, error)
+	CreateFunc func(context.Context, software.BlockDeviceHotfix) error
+	UpdateFunc func(context.Context, string, software.BlockDeviceHotfix) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ software.BlockDeviceHotfixAPI = &BlockDeviceHotfixAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *BlockDeviceHotfixAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *software.BlockDeviceHotfixList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *BlockDeviceHotfixAPI) Get(ctx context.Context, name string) (r0 *software.BlockDeviceHotfix, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *BlockDeviceHotfixAPI) Create(ctx context.Context, item software.BlockDeviceHotfix) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *BlockDeviceHotfixAPI) Update(ctx context.Context, name string, item software.BlockDeviceHotfix) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *BlockDeviceHotfixAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *BlockDeviceHotfixAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// BlockDeviceImageAPI is a mock of software.BlockDeviceImageAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type BlockDeviceImageAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*software.BlockDeviceImageList, error)
+	GetFunc    func(context.Context, string) (*software.BlockDeviceImage, error)
+	CreateFunc func(context.Context, software.BlockDeviceImage) error
+	UpdateFunc func(context.Context, string, software.BlockDeviceImage) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ software.BlockDeviceImageAPI = &BlockDeviceImageAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *BlockDeviceImageAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *software.BlockDeviceImageList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *BlockDeviceImageAPI) Get(ctx context.Context, name string) (r0 *software.BlockDeviceImage, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *BlockDeviceImageAPI) Create(ctx context.Context, item software.BlockDeviceImage) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *BlockDeviceImageAPI) Update(ctx context.Context, name string, item software.BlockDeviceImage) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *BlockDeviceImageAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *BlockDeviceImageAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// HotfixAPI is a mock of software.HotfixAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HotfixAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*software.HotfixList, error)
+	GetFunc    func(context.Context, string) (*software.Hotfix, error)
+	CreateFunc func(context.Context, software.Hotfix) error
+	UpdateFunc func(context.Context, string, software.Hotfix) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ software.HotfixAPI = &HotfixAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *HotfixAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *software.HotfixList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HotfixAPI) Get(ctx context.Context, name string) (r0 *software.Hotfix, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *HotfixAPI) Create(ctx context.Context, item software.Hotfix) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *HotfixAPI) Update(ctx context.Context, name string, item software.Hotfix) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *HotfixAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *HotfixAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ImageAPI is a mock of software.ImageAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ImageAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc    func(context.Context, ...*rest.ListOptions) (*software.ImageList, error)
+	GetFunc     func(context.Context, string) (*software.Image, error)
+	CreateFunc  func(context.Context, software.Image) error
+	UpdateFunc  func(context.Context, string, software.Image) error
+	PatchFunc   func(context.Context, string, interface{}) error
+	DeleteFunc  func(context.Context, string) error
+	InstallFunc func(context.Context, string, string, ...map[string]interface{}) error
+}
+
+var _ software.ImageAPI = &ImageAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ImageAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *software.ImageList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ImageAPI) Get(ctx context.Context, name string) (r0 *software.Image, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ImageAPI) Create(ctx context.Context, item software.Image) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ImageAPI) Update(ctx context.Context, name string, item software.Image) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ImageAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ImageAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// Install records the call and calls InstallFunc if it is set.
+func (m *ImageAPI) Install(ctx context.Context, name string, volume string, options ...map[string]interface{}) (r0 error) {
+	m.CallRecorder.Record("Install", ctx, name, volume, options)
+	if m.InstallFunc != nil {
+		return m.InstallFunc(ctx, name, volume, options...)
+	}
+	return
+}
+
+// SoftwareAPI is a mock of software.SoftwareAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SoftwareAPI struct {
+	bigiptest.CallRecorder
+
+	ImageFunc             func() software.ImageAPI
+	UpdateFunc            func() *software.UpdateResource
+	UpdateStatusFunc      func() software.UpdateStatusAPI
+	VolumeFunc            func() software.VolumeAPI
+	BlockDeviceHotfixFunc func() software.BlockDeviceHotfixAPI
+	BlockDeviceImageFunc  func() software.BlockDeviceImageAPI
+	HotfixFunc            func() software.HotfixAPI
+}
+
+var _ software.SoftwareAPI = &SoftwareAPI{}
+
+// Image records the call and calls ImageFunc if it is set.
+func (m *SoftwareAPI) Image() (r0 software.ImageAPI) {
+	m.CallRecorder.Record("Image")
+	if m.ImageFunc != nil {
+		return m.ImageFunc()
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SoftwareAPI) Update() (r0 *software.UpdateResource) {
+	m.CallRecorder.Record("Update")
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc()
+	}
+	return
+}
+
+// UpdateStatus records the call and calls UpdateStatusFunc if it is set.
+func (m *SoftwareAPI) UpdateStatus() (r0 software.UpdateStatusAPI) {
+	m.CallRecorder.Record("UpdateStatus")
+	if m.UpdateStatusFunc != nil {
+		return m.UpdateStatusFunc()
+	}
+	return
+}
+
+// Volume records the call and calls VolumeFunc if it is set.
+func (m *SoftwareAPI) Volume() (r0 software.VolumeAPI) {
+	m.CallRecorder.Record("Volume")
+	if m.VolumeFunc != nil {
+		return m.VolumeFunc()
+	}
+	return
+}
+
+// BlockDeviceHotfix records the call and calls BlockDeviceHotfixFunc if it is set.
+func (m *SoftwareAPI) BlockDeviceHotfix() (r0 software.BlockDeviceHotfixAPI) {
+	m.CallRecorder.Record("BlockDeviceHotfix")
+	if m.BlockDeviceHotfixFunc != nil {
+		return m.BlockDeviceHotfixFunc()
+	}
+	return
+}
+
+// BlockDeviceImage records the call and calls BlockDeviceImageFunc if it is set.
+func (m *SoftwareAPI) BlockDeviceImage() (r0 software.BlockDeviceImageAPI) {
+	m.CallRecorder.Record("BlockDeviceImage")
+	if m.BlockDeviceImageFunc != nil {
+		return m.BlockDeviceImageFunc()
+	}
+	return
+}
+
+// Hotfix records the call and calls HotfixFunc if it is set.
+func (m *SoftwareAPI) Hotfix() (r0 software.HotfixAPI) {
+	m.CallRecorder.Record("Hotfix")
+	if m.HotfixFunc != nil {
+		return m.HotfixFunc()
+	}
+	return
+}
+
+// UpdateStatusAPI is a mock of software.UpdateStatusAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type UpdateStatusAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc   func(context.Context) (*software.Update, error)
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*software.UpdateStatusList, error)
+	GetFunc    func(context.Context, string) (*software.UpdateStatus, error)
+	CreateFunc func(context.Context, software.UpdateStatus) error
+	UpdateFunc func(context.Context, string, software.UpdateStatus) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ software.UpdateStatusAPI = &UpdateStatusAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *UpdateStatusAPI) Show(ctx context.Context) (r0 *software.Update, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// List records the call and calls ListFunc if it is set.
+func (m *UpdateStatusAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *software.UpdateStatusList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *UpdateStatusAPI) Get(ctx context.Context, name string) (r0 *software.UpdateStatus, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *UpdateStatusAPI) Create(ctx context.Context, item software.UpdateStatus) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *UpdateStatusAPI) Update(ctx context.Context, name string, item software.UpdateStatus) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *UpdateStatusAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *UpdateStatusAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// VolumeAPI is a mock of software.VolumeAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type VolumeAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*software.VolumeList, error)
+	GetFunc    func(context.Context, string) (*software.Volume, error)
+	CreateFunc func(context.Context, software.Volume) error
+	UpdateFunc func(context.Context, string, software.Volume) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ software.VolumeAPI = &VolumeAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *VolumeAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *software.VolumeList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *VolumeAPI) Get(ctx context.Context, name string) (r0 *software.Volume, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *VolumeAPI) Create(ctx context.Context, item software.Volume) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *VolumeAPI) Update(ctx context.Context, name string, item software.Volume) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *VolumeAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *VolumeAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
@@ -27,10 +27,10 @@ type UpdateResource struct {
 }
 
 // Show retrieves the details of a single Update
-func (r *UpdateStatusResource) Show() (*Update, error) {
+func (r *UpdateStatusResource) Show(ctx context.Context) (*Update, error) {
 	var item Update
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateEndpoint).DoRaw(context.Background())
+		Resource(SoftwareEndpoint).SubResource(UpdateEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
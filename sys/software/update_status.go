@@ -2,10 +2,8 @@ package software
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // VolumeList holds a list of UpdateStatus configurations.
@@ -49,72 +47,37 @@ type UpdateStatusResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all UpdateStatus details.
-func (r *UpdateStatusResource) List() (*UpdateStatusList, error) {
-	var items UpdateStatusList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing UpdateStatusResource.
+func (r *UpdateStatusResource) collection() *bigip.Collection[UpdateStatus, UpdateStatusList] {
+	return bigip.NewCollection[UpdateStatus, UpdateStatusList](r.b, SysManager, SoftwareEndpoint, UpdateStatusEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all UpdateStatus details.
+func (r *UpdateStatusResource) List(ctx context.Context, opts ...*rest.ListOptions) (*UpdateStatusList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single UpdateStatus by node name.
-func (r *UpdateStatusResource) Get(name string) (*UpdateStatus, error) {
-	var item UpdateStatus
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *UpdateStatusResource) Get(ctx context.Context, name string) (*UpdateStatus, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new UpdateStatus item.
-func (r *UpdateStatusResource) Create(item UpdateStatus) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UpdateStatusResource) Create(ctx context.Context, item UpdateStatus) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the UpdateStatus item identified by the UpdateStatus name.
-func (r *UpdateStatusResource) Update(name string, item UpdateStatus) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UpdateStatusResource) Update(ctx context.Context, name string, item UpdateStatus) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single UpdateStatus identified by the UpdateStatus name. if it is not exist return error
-func (r *UpdateStatusResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(UpdateStatusEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the UpdateStatus identified by name, see bigip.PatchBody.
+func (r *UpdateStatusResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single UpdateStatus identified by the UpdateStatus name. if it is not exist return error
+func (r *UpdateStatusResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
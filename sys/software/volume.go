@@ -2,10 +2,8 @@ package software
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // VolumeList holds a list of Volume configurations.
@@ -49,72 +47,37 @@ type VolumeResource struct {
 	b *bigip.BigIP
 }
 
-// List retrieves all Volume details.
-func (r *VolumeResource) List() (*VolumeList, error) {
-	var items VolumeList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing VolumeResource.
+func (r *VolumeResource) collection() *bigip.Collection[Volume, VolumeList] {
+	return bigip.NewCollection[Volume, VolumeList](r.b, SysManager, SoftwareEndpoint, VolumeEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List retrieves all Volume details.
+func (r *VolumeResource) List(ctx context.Context, opts ...*rest.ListOptions) (*VolumeList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get retrieves the details of a single Volume by node name.
-func (r *VolumeResource) Get(name string) (*Volume, error) {
-	var item Volume
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *VolumeResource) Get(ctx context.Context, name string) (*Volume, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create creates a new Volume item.
-func (r *VolumeResource) Create(item Volume) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *VolumeResource) Create(ctx context.Context, item Volume) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update modifies the Volume item identified by the Volume name.
-func (r *VolumeResource) Update(name string, item Volume) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *VolumeResource) Update(ctx context.Context, name string, item Volume) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single Volume identified by the Volume name. if it is not exist return error
-func (r *VolumeResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SoftwareEndpoint).SubResource(VolumeEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the Volume identified by name, see bigip.PatchBody.
+func (r *VolumeResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single Volume identified by the Volume name. if it is not exist return error
+func (r *VolumeResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -33,10 +33,10 @@ type SSHDResource struct {
 }
 
 // ListAll  lists all the SSHD configurations.
-func (r *SSHDResource) List() (*SSHD, error) {
+func (r *SSHDResource) List(ctx context.Context) (*SSHD, error) {
 	var item SSHD
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SSHDEndpoint).DoRaw(context.Background())
+		Resource(SSHDEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
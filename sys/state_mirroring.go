@@ -6,10 +6,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // StateMirroringList holds a list of StateMirroring configuration.
@@ -31,72 +29,37 @@ type StateMirroringResource struct {
 	b *bigip.BigIP
 }
 
-// List all state mirroring details
-func (r *StateMirroringResource) List() (*StateMirroringList, error) {
-	var items StateMirroringList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing StateMirroringResource.
+func (r *StateMirroringResource) collection() *bigip.Collection[StateMirroring, StateMirroringList] {
+	return bigip.NewCollection[StateMirroring, StateMirroringList](r.b, SysManager, StateMirroringEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all state mirroring details
+func (r *StateMirroringResource) List(ctx context.Context, opts ...*rest.ListOptions) (*StateMirroringList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single state mirroring details by the node name
-func (r *StateMirroringResource) Get(name string) (*StateMirroring, error) {
-	var item StateMirroring
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *StateMirroringResource) Get(ctx context.Context, name string) (*StateMirroring, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new state mirroring item
-func (r *StateMirroringResource) Create(item StateMirroring) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *StateMirroringResource) Create(ctx context.Context, item StateMirroring) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the state mirroring item identified by the state mirroring name, otherwise an error will be reported.
-func (r *StateMirroringResource) Update(name string, item StateMirroring) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *StateMirroringResource) Update(ctx context.Context, name string, item StateMirroring) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single state mirroring identified by the state mirroring name. if it is not exist return error
-func (r *StateMirroringResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(StateMirroringEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the StateMirroring identified by name, see bigip.PatchBody.
+func (r *StateMirroringResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single state mirroring identified by the state mirroring name. if it is not exist return error
+func (r *StateMirroringResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
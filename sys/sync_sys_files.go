@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 )
 
 // SyncSysFilesList holds a list of SyncSysFiles configuration.
@@ -27,72 +25,37 @@ type SyncSysFilesResource struct {
 	b *bigip.BigIP
 }
 
-// List all sync sys files details
-func (r *SyncSysFilesResource) List() (*SyncSysFilesList, error) {
-	var items SyncSysFilesList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing SyncSysFilesResource.
+func (r *SyncSysFilesResource) collection() *bigip.Collection[SyncSysFiles, SyncSysFilesList] {
+	return bigip.NewCollection[SyncSysFiles, SyncSysFilesList](r.b, SysManager, SyncSysFilesEndpoint)
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all sync sys files details
+func (r *SyncSysFilesResource) List(ctx context.Context, opts ...*rest.ListOptions) (*SyncSysFilesList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single sync sys files details by the node name
-func (r *SyncSysFilesResource) Get(name string) (*SyncSysFiles, error) {
-	var item SyncSysFiles
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *SyncSysFilesResource) Get(ctx context.Context, name string) (*SyncSysFiles, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new sync sys files item
-func (r *SyncSysFilesResource) Create(item SyncSysFiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SyncSysFilesResource) Create(ctx context.Context, item SyncSysFiles) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the sync sys files item identified by the sync sys files name, otherwise an error will be reported.
-func (r *SyncSysFilesResource) Update(name string, item SyncSysFiles) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *SyncSysFilesResource) Update(ctx context.Context, name string, item SyncSysFiles) error {
+	return r.collection().Update(ctx, name, item)
 }
 
-// Delete a single sync sys files identified by the sync sys files name. if it is not exist return error
-func (r *SyncSysFilesResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyncSysFilesEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+// Patch updates only the given fields of the SyncSysFiles identified by name, see bigip.PatchBody.
+func (r *SyncSysFilesResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
+}
 
-	return nil
+// Delete a single sync sys files identified by the sync sys files name. if it is not exist return error
+func (r *SyncSysFilesResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
 }
@@ -1,5 +1,7 @@
 package sys
 
+//go:generate go run github.com/lefeck/go-bigip/internal/cmd/apigen -types Sys
+
 import (
 	"github.com/lefeck/go-bigip"
 	"github.com/lefeck/go-bigip/sys/application"
@@ -22,6 +24,7 @@ type Sys struct {
 	//classificationSignature ClassificationSignatureResource
 	clock      ClockResource
 	cluster    ClusterResource
+	config     ConfigResource
 	connection ConnectionResource
 	console    ConsoleResource
 	cpuStats   CPUStatsResource
@@ -112,6 +115,7 @@ type Sys struct {
 	stateMirroring StateMirroringResource
 	syncSysFiles   SyncSysFilesResource
 	syslog         SyslogResource
+	uCS            UCSResource
 	//uRLDB                               URLDBResource
 	//uRLDBDownloadResult                 URLDBDownloadResultResource
 	//uRLDBDownloadSchedule               URLDBDownloadScheduleResource
@@ -128,6 +132,7 @@ func New(b *bigip.BigIP) Sys {
 		//classificationSignature: ClassificationSignatureResource{c: b},
 		clock: ClockResource{b: b},
 		//cluster:                 ClusterResource{c: b},
+		config:     ConfigResource{b: b},
 		connection: ConnectionResource{b: b},
 		console:    ConsoleResource{b: b},
 		cpuStats:   CPUStatsResource{b: b},
@@ -216,6 +221,7 @@ func New(b *bigip.BigIP) Sys {
 		stateMirroring: StateMirroringResource{b: b},
 		syncSysFiles:   SyncSysFilesResource{b: b},
 		syslog:         SyslogResource{b: b},
+		uCS:            UCSResource{b: b},
 		//uRLDB:                 URLDBResource{c: c},
 		//uRLDBDownloadResult:   URLDBDownloadResultResource{c: c},
 		//uRLDBDownloadSchedule: URLDBDownloadScheduleResource{c: c},
@@ -224,7 +230,7 @@ func New(b *bigip.BigIP) Sys {
 }
 
 // aOM returns a configured AOMResource.
-func (sys Sys) AOM() *AOMResource {
+func (sys Sys) AOM() AOMAPI {
 	return &sys.aOM
 }
 
@@ -239,7 +245,7 @@ func (sys Sys) AOM() *AOMResource {
 //}
 
 // application returns a configured ApplicationResource.
-func (sys Sys) Application() *application.ApplicationResource {
+func (sys Sys) Application() application.ApplicationAPI {
 	return &sys.application
 }
 
@@ -254,37 +260,42 @@ func (sys Sys) AutoscaleGroup() *AutoscaleGroupResource {
 //}
 
 // clock returns a configured ClockResource.
-func (sys Sys) Clock() *ClockResource {
+func (sys Sys) Clock() ClockAPI {
 	return &sys.clock
 }
 
 // cluster returns a configured ClusterResource.
-func (sys Sys) Cluster() *ClusterResource {
+func (sys Sys) Cluster() ClusterAPI {
 	return &sys.cluster
 }
 
+// config returns a configured ConfigResource.
+func (sys Sys) Config() ConfigAPI {
+	return &sys.config
+}
+
 // connection returns a configured ConnectionResource.
-func (sys Sys) Connection() *ConnectionResource {
+func (sys Sys) Connection() ConnectionAPI {
 	return &sys.connection
 }
 
 // console returns a configured ConsoleResource.
-func (sys Sys) Console() *ConsoleResource {
+func (sys Sys) Console() ConsoleAPI {
 	return &sys.console
 }
 
 // console returns a configured ConsoleResource.
-func (sys Sys) CPUStats() *CPUStatsResource {
+func (sys Sys) CPUStats() CPUStatsAPI {
 	return &sys.cpuStats
 }
 
 // crypto returns a configured CryptoResource.
-func (sys Sys) Crypto() *crypto.CryptoResource {
+func (sys Sys) Crypto() crypto.CryptoAPI {
 	return &sys.crypto
 }
 
 // dB returns a configured DBResource.
-func (sys Sys) DB() *DBResource {
+func (sys Sys) DB() DBAPI {
 	return &sys.dB
 }
 
@@ -339,7 +350,7 @@ func (sys Sys) DataStor() *DataStorResource {
 }
 
 // disk returns a configured DiskResource.
-func (sys Sys) Disk() *disk.DiskResource {
+func (sys Sys) Disk() disk.DiskAPI {
 	return &sys.disk
 }
 
@@ -381,7 +392,7 @@ func (sys Sys) Disk() *disk.DiskResource {
 //}
 
 // featureModule returns a configured FeatureModuleResource.
-func (sys Sys) FeatureModule() *FeatureModuleResource {
+func (sys Sys) FeatureModule() FeatureModuleAPI {
 	return &sys.featureModule
 }
 
@@ -492,7 +503,7 @@ func (sys Sys) HAGroup() *HAGroupResource {
 }
 
 // hTTPD returns a configured HTTPDResource.
-func (sys Sys) HTTPD() *HTTPDResource {
+func (sys Sys) HTTPD() HTTPDAPI {
 	return &sys.hTTPD
 }
 
@@ -528,12 +539,12 @@ func (sys Sys) HTTPD() *HTTPDResource {
 //}
 
 // iPFix returns a configured IPFixResource.
-func (sys Sys) IPFix() *ipfix.IPFixResource {
+func (sys Sys) IPFix() ipfix.IPFixAPI {
 	return &sys.iPFix
 }
 
 // iPFixElement returns a configured IPFixElementResource.
-func (sys Sys) IPFixElement() *ipfix.IPFixResource {
+func (sys Sys) IPFixElement() ipfix.IPFixAPI {
 	return &sys.iPFix
 }
 
@@ -559,7 +570,7 @@ func (sys Sys) IPFixElement() *ipfix.IPFixResource {
 //}
 
 // license returns a configured LicenseResource.
-func (sys Sys) License() *LicenseResource {
+func (sys Sys) License() LicenseAPI {
 	return &sys.license
 }
 
@@ -589,41 +600,41 @@ func (sys Sys) License() *LicenseResource {
 //}
 
 // managementDHCP returns a configured ManagementDHCPResource.
-func (sys Sys) ManagementDHCP() *ManagementDHCPResource {
+func (sys Sys) ManagementDHCP() ManagementDHCPAPI {
 	return &sys.managementDHCP
 }
 
 // managementIP returns a configured ManagementIPResource.
-func (sys Sys) ManagementIP() *ManagementIPResource {
+func (sys Sys) ManagementIP() ManagementIPAPI {
 	return &sys.managementIP
 }
 
 // managementOVSDB returns a configured ManagementOVSDBResource.
-func (sys Sys) ManagementOVSDB() *ManagementOVSDBResource {
+func (sys Sys) ManagementOVSDB() ManagementOVSDBAPI {
 	return &sys.managementOVSDB
 }
 
 // managementRoute returns a configured ManagementRouteResource.
-func (sys Sys) ManagementRoute() *ManagementRouteResource {
+func (sys Sys) ManagementRoute() ManagementRouteAPI {
 	return &sys.managementRoute
 }
 
-func (sys Sys) MemoryStats() *MemoryStatsResource {
+func (sys Sys) MemoryStats() MemoryStatsAPI {
 	return &sys.memoryStats
 }
 
 // nTP returns a configured NTPResource.
-func (sys Sys) NTP() *NTPResource {
+func (sys Sys) NTP() NTPAPI {
 	return &sys.nTP
 }
 
 // outboundSMTP returns a configured OutboundSMTPResource.
-func (sys Sys) OutboundSMTP() *OutboundSMTPResource {
+func (sys Sys) OutboundSMTP() OutboundSMTPAPI {
 	return &sys.outboundSMTP
 }
 
 // pFMan returns a configured PFManResource.
-func (sys Sys) PFMan() *pfman.PFManResource {
+func (sys Sys) PFMan() pfman.PFManAPI {
 	return &sys.pFMan
 }
 
@@ -638,12 +649,12 @@ func (sys Sys) PFMan() *pfman.PFManResource {
 //}
 
 // provision returns a configured ProvisionResource.
-func (sys Sys) Provision() *ProvisionResource {
+func (sys Sys) Provision() ProvisionAPI {
 	return &sys.provision
 }
 
 // rAID returns a configured RAIDResource.
-func (sys Sys) RAID() *raid.RAIDResource {
+func (sys Sys) RAID() raid.RAIDAPI {
 	return &sys.rAID
 }
 
@@ -664,50 +675,55 @@ func (sys Sys) RestrictedModule() *RestrictedModuleResource {
 //}
 
 // sMTPServer returns a configured SMTPServerResource.
-func (sys Sys) SMTPServer() *SMTPServerResource {
+func (sys Sys) SMTPServer() SMTPServerAPI {
 	return &sys.sMTPServer
 }
 
 // sNMP returns a configured SNMPResource.
-func (sys Sys) SNMP() *SNMPResource {
+func (sys Sys) SNMP() SNMPAPI {
 	return &sys.sNMP
 }
 
 // sSHD returns a configured SSHDResource.
-func (sys Sys) SSHD() *SSHDResource {
+func (sys Sys) SSHD() SSHDAPI {
 	return &sys.sSHD
 }
 
 // scriptd returns a configured ScriptdResource.
-func (sys Sys) Scriptd() *ScriptdResource {
+func (sys Sys) Scriptd() ScriptdAPI {
 	return &sys.scriptd
 }
 
 // server returns a configured ServerResource.
-func (sys Sys) Service() *ServiceResource {
+func (sys Sys) Service() ServiceAPI {
 	return &sys.service
 }
 
 // software returns a configured SoftwareResource.
-func (sys Sys) Software() *software.SoftwareResource {
+func (sys Sys) Software() software.SoftwareAPI {
 	return &sys.software
 }
 
 // stateMirroring returns a configured StateMirroringResource.
-func (sys Sys) StateMirroring() *StateMirroringResource {
+func (sys Sys) StateMirroring() StateMirroringAPI {
 	return &sys.stateMirroring
 }
 
 // syncSysFiles returns a configured SyncSysFilesResource.
-func (sys Sys) SyncSysFiles() *SyncSysFilesResource {
+func (sys Sys) SyncSysFiles() SyncSysFilesAPI {
 	return &sys.syncSysFiles
 }
 
 // syslog returns a configured SyslogResource.
-func (sys Sys) Syslog() *SyslogResource {
+func (sys Sys) Syslog() SyslogAPI {
 	return &sys.syslog
 }
 
+// uCS returns a configured UCSResource.
+func (sys Sys) UCS() UCSAPI {
+	return &sys.uCS
+}
+
 //// uRLDB returns a configured URLDBResource.
 //func (sys Sys) URLDB() *URLDBResource {
 //	return &sys.uRLDB
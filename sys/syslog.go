@@ -46,13 +46,13 @@ type RemoteServer struct {
 	RemotePort int    `json:"remotePort,omitempty"`
 }
 
-func (r *SyslogResource) AddRemoteServers(rs ...RemoteServer) error {
+func (r *SyslogResource) AddRemoteServers(ctx context.Context, rs ...RemoteServer) error {
 	if len(rs) == 0 {
 		return nil
 	}
 	var item Syslog
 	_, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).DoRaw(context.Background())
+		Resource(SyslogEndpoint).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,7 @@ func (r *SyslogResource) AddRemoteServers(rs ...RemoteServer) error {
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -81,10 +81,10 @@ type SyslogResource struct {
 }
 
 // List all syslog details
-func (r *SyslogResource) Get() (*Syslog, error) {
+func (r *SyslogResource) Get(ctx context.Context) (*Syslog, error) {
 	var item Syslog
 	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).DoRaw(context.Background())
+		Resource(SyslogEndpoint).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -95,14 +95,14 @@ func (r *SyslogResource) Get() (*Syslog, error) {
 }
 
 // Update the syslog item identified by the syslog name, otherwise an error will be reported.
-func (r *SyslogResource) Update(item Syslog) error {
+func (r *SyslogResource) Update(ctx context.Context, item Syslog) error {
 	jsonData, err := json.Marshal(item)
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON data: %w", err)
 	}
 	jsonString := string(jsonData)
 	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		Resource(SyslogEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -110,9 +110,9 @@ func (r *SyslogResource) Update(item Syslog) error {
 }
 
 // Delete a single syslog identified by the syslog name. if it is not exist return error
-func (r *SyslogResource) Delete(name string) error {
+func (r *SyslogResource) Delete(ctx context.Context, name string) error {
 	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(SyslogEndpoint).ResourceInstance(name).DoRaw(context.Background())
+		Resource(SyslogEndpoint).ResourceInstance(name).DoRaw(ctx)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,1967 @@
+// Code generated by apigen. DO NOT EDIT.
+
+// Package sysmock provides mocks of the interfaces of package sys, which record their calls.
+package sysmock
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/bigiptest"
+	"github.com/lefeck/go-bigip/rest"
+	"github.com/lefeck/go-bigip/sys"
+	"github.com/lefeck/go-bigip/sys/application"
+	"github.com/lefeck/go-bigip/sys/crypto"
+	"github.com/lefeck/go-bigip/sys/disk"
+	"github.com/lefeck/go-bigip/sys/ipfix"
+	"github.com/lefeck/go-bigip/sys/pfman"
+	"github.com/lefeck/go-bigip/sys/raid"
+	"github.com/lefeck/go-bigip/sys/software"
+)
+
+// AOMAPI is a mock of sys.AOMAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type AOMAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc   func(context.Context) (*sys.AOM, error)
+	UpdateFunc func(context.Context, string, sys.AOM) error
+}
+
+var _ sys.AOMAPI = &AOMAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *AOMAPI) Show(ctx context.Context) (r0 *sys.AOM, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *AOMAPI) Update(ctx context.Context, name string, item sys.AOM) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// CPUStatsAPI is a mock of sys.CPUStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type CPUStatsAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc func(context.Context) (*sys.CPUList, error)
+}
+
+var _ sys.CPUStatsAPI = &CPUStatsAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *CPUStatsAPI) Show(ctx context.Context) (r0 *sys.CPUList, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// ClockAPI is a mock of sys.ClockAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ClockAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc   func(context.Context) (*sys.Clock, error)
+	CreateFunc func(context.Context, sys.Clock) error
+	UpdateFunc func(context.Context, string, sys.Clock) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ClockAPI = &ClockAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *ClockAPI) Show(ctx context.Context) (r0 *sys.Clock, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ClockAPI) Create(ctx context.Context, item sys.Clock) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ClockAPI) Update(ctx context.Context, name string, item sys.Clock) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ClockAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ClusterAPI is a mock of sys.ClusterAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ClusterAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ClusterList, error)
+	GetFunc    func(context.Context, string) (*sys.Cluster, error)
+	CreateFunc func(context.Context, sys.Cluster) error
+	UpdateFunc func(context.Context, string, sys.Cluster) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ClusterAPI = &ClusterAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ClusterAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ClusterList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ClusterAPI) Get(ctx context.Context, name string) (r0 *sys.Cluster, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ClusterAPI) Create(ctx context.Context, item sys.Cluster) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ClusterAPI) Update(ctx context.Context, name string, item sys.Cluster) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ClusterAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ClusterAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ConfigAPI is a mock of sys.ConfigAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ConfigAPI struct {
+	bigiptest.CallRecorder
+
+	SaveFunc func(context.Context, ...map[string]interface{}) error
+	LoadFunc func(context.Context, ...map[string]interface{}) error
+	RunFunc  func(context.Context, sys.ConfigCommand) error
+}
+
+var _ sys.ConfigAPI = &ConfigAPI{}
+
+// Save records the call and calls SaveFunc if it is set.
+func (m *ConfigAPI) Save(ctx context.Context, options ...map[string]interface{}) (r0 error) {
+	m.CallRecorder.Record("Save", ctx, options)
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, options...)
+	}
+	return
+}
+
+// Load records the call and calls LoadFunc if it is set.
+func (m *ConfigAPI) Load(ctx context.Context, options ...map[string]interface{}) (r0 error) {
+	m.CallRecorder.Record("Load", ctx, options)
+	if m.LoadFunc != nil {
+		return m.LoadFunc(ctx, options...)
+	}
+	return
+}
+
+// Run records the call and calls RunFunc if it is set.
+func (m *ConfigAPI) Run(ctx context.Context, item sys.ConfigCommand) (r0 error) {
+	m.CallRecorder.Record("Run", ctx, item)
+	if m.RunFunc != nil {
+		return m.RunFunc(ctx, item)
+	}
+	return
+}
+
+// ConnectionAPI is a mock of sys.ConnectionAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ConnectionAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ConnectionList, error)
+	GetFunc    func(context.Context, string) (*sys.Connection, error)
+	CreateFunc func(context.Context, sys.Connection) error
+	UpdateFunc func(context.Context, string, sys.Connection) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ConnectionAPI = &ConnectionAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ConnectionAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ConnectionList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ConnectionAPI) Get(ctx context.Context, name string) (r0 *sys.Connection, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ConnectionAPI) Create(ctx context.Context, item sys.Connection) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ConnectionAPI) Update(ctx context.Context, name string, item sys.Connection) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ConnectionAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ConnectionAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ConsoleAPI is a mock of sys.ConsoleAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ConsoleAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ConsoleList, error)
+	GetFunc    func(context.Context, string) (*sys.Console, error)
+	CreateFunc func(context.Context, sys.Console) error
+	UpdateFunc func(context.Context, string, sys.Console) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ConsoleAPI = &ConsoleAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ConsoleAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ConsoleList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ConsoleAPI) Get(ctx context.Context, name string) (r0 *sys.Console, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ConsoleAPI) Create(ctx context.Context, item sys.Console) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ConsoleAPI) Update(ctx context.Context, name string, item sys.Console) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ConsoleAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ConsoleAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// DBAPI is a mock of sys.DBAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type DBAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.DBList, error)
+	GetFunc    func(context.Context, string) (*sys.DB, error)
+	CreateFunc func(context.Context, sys.DB) error
+	UpdateFunc func(context.Context, string, sys.DB) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.DBAPI = &DBAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *DBAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.DBList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *DBAPI) Get(ctx context.Context, fullPathName string) (r0 *sys.DB, r1 error) {
+	m.CallRecorder.Record("Get", ctx, fullPathName)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, fullPathName)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *DBAPI) Create(ctx context.Context, item sys.DB) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *DBAPI) Update(ctx context.Context, name string, item sys.DB) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *DBAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *DBAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// FeatureModuleAPI is a mock of sys.FeatureModuleAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type FeatureModuleAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.FeatureModuleList, error)
+	GetFunc    func(context.Context, string) (*sys.FeatureModule, error)
+	CreateFunc func(context.Context, sys.FeatureModule) error
+	UpdateFunc func(context.Context, string, sys.FeatureModule) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.FeatureModuleAPI = &FeatureModuleAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *FeatureModuleAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.FeatureModuleList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *FeatureModuleAPI) Get(ctx context.Context, name string) (r0 *sys.FeatureModule, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *FeatureModuleAPI) Create(ctx context.Context, item sys.FeatureModule) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *FeatureModuleAPI) Update(ctx context.Context, name string, item sys.FeatureModule) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *FeatureModuleAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *FeatureModuleAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// GlobalSettingsAPI is a mock of sys.GlobalSettingsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type GlobalSettingsAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc   func(context.Context) (*sys.GlobalSettings, error)
+	UpdateFunc func(context.Context, string, sys.GlobalSettings) error
+}
+
+var _ sys.GlobalSettingsAPI = &GlobalSettingsAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *GlobalSettingsAPI) Show(ctx context.Context) (r0 *sys.GlobalSettings, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *GlobalSettingsAPI) Update(ctx context.Context, name string, item sys.GlobalSettings) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// HTTPDAPI is a mock of sys.HTTPDAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type HTTPDAPI struct {
+	bigiptest.CallRecorder
+
+	GetFunc func(context.Context) (*sys.HTTPDConfig, error)
+}
+
+var _ sys.HTTPDAPI = &HTTPDAPI{}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *HTTPDAPI) Get(ctx context.Context) (r0 *sys.HTTPDConfig, r1 error) {
+	m.CallRecorder.Record("Get", ctx)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx)
+	}
+	return
+}
+
+// IControlSOAPAPI is a mock of sys.IControlSOAPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type IControlSOAPAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc func(context.Context) (*sys.IControlSOAP, error)
+}
+
+var _ sys.IControlSOAPAPI = &IControlSOAPAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *IControlSOAPAPI) Show(ctx context.Context) (r0 *sys.IControlSOAP, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// LicenseAPI is a mock of sys.LicenseAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type LicenseAPI struct {
+	bigiptest.CallRecorder
+
+	GetFunc      func(context.Context) (*sys.License, error)
+	ActivateFunc func(context.Context, string) error
+}
+
+var _ sys.LicenseAPI = &LicenseAPI{}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *LicenseAPI) Get(ctx context.Context) (r0 *sys.License, r1 error) {
+	m.CallRecorder.Record("Get", ctx)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx)
+	}
+	return
+}
+
+// Activate records the call and calls ActivateFunc if it is set.
+func (m *LicenseAPI) Activate(ctx context.Context, registrationKey string) (r0 error) {
+	m.CallRecorder.Record("Activate", ctx, registrationKey)
+	if m.ActivateFunc != nil {
+		return m.ActivateFunc(ctx, registrationKey)
+	}
+	return
+}
+
+// ManagementDHCPAPI is a mock of sys.ManagementDHCPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ManagementDHCPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ManagementDHCPList, error)
+	GetFunc    func(context.Context, string) (*sys.ManagementDHCP, error)
+	CreateFunc func(context.Context, sys.ManagementDHCP) error
+	UpdateFunc func(context.Context, string, sys.ManagementDHCP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ManagementDHCPAPI = &ManagementDHCPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ManagementDHCPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ManagementDHCPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ManagementDHCPAPI) Get(ctx context.Context, name string) (r0 *sys.ManagementDHCP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ManagementDHCPAPI) Create(ctx context.Context, item sys.ManagementDHCP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ManagementDHCPAPI) Update(ctx context.Context, name string, item sys.ManagementDHCP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ManagementDHCPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ManagementDHCPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ManagementIPAPI is a mock of sys.ManagementIPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ManagementIPAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ManagementIPList, error)
+	GetFunc    func(context.Context, string) (*sys.ManagementIP, error)
+	CreateFunc func(context.Context, sys.ManagementIP) error
+	UpdateFunc func(context.Context, string, sys.ManagementIP) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ManagementIPAPI = &ManagementIPAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ManagementIPAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ManagementIPList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ManagementIPAPI) Get(ctx context.Context, name string) (r0 *sys.ManagementIP, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ManagementIPAPI) Create(ctx context.Context, item sys.ManagementIP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ManagementIPAPI) Update(ctx context.Context, name string, item sys.ManagementIP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ManagementIPAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ManagementIPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ManagementOVSDBAPI is a mock of sys.ManagementOVSDBAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ManagementOVSDBAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ManagementOVSDB, error)
+	UpdateFunc func(context.Context, string, sys.ManagementOVSDB) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ManagementOVSDBAPI = &ManagementOVSDBAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ManagementOVSDBAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ManagementOVSDB, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ManagementOVSDBAPI) Update(ctx context.Context, name string, item sys.ManagementOVSDB) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ManagementOVSDBAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ManagementOVSDBAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ManagementRouteAPI is a mock of sys.ManagementRouteAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ManagementRouteAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ManagementRouteList, error)
+	GetFunc    func(context.Context, string) (*sys.ManagementRoute, error)
+	CreateFunc func(context.Context, sys.ManagementRoute) error
+	UpdateFunc func(context.Context, string, sys.ManagementRoute) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ManagementRouteAPI = &ManagementRouteAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ManagementRouteAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ManagementRouteList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ManagementRouteAPI) Get(ctx context.Context, name string) (r0 *sys.ManagementRoute, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ManagementRouteAPI) Create(ctx context.Context, item sys.ManagementRoute) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ManagementRouteAPI) Update(ctx context.Context, name string, item sys.ManagementRoute) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ManagementRouteAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ManagementRouteAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// MemoryStatsAPI is a mock of sys.MemoryStatsAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type MemoryStatsAPI struct {
+	bigiptest.CallRecorder
+
+	AllFunc func(context.Context) (*sys.MemoryStatsList, error)
+}
+
+var _ sys.MemoryStatsAPI = &MemoryStatsAPI{}
+
+// All records the call and calls AllFunc if it is set.
+func (m *MemoryStatsAPI) All(ctx context.Context) (r0 *sys.MemoryStatsList, r1 error) {
+	m.CallRecorder.Record("All", ctx)
+	if m.AllFunc != nil {
+		return m.AllFunc(ctx)
+	}
+	return
+}
+
+// NTPAPI is a mock of sys.NTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type NTPAPI struct {
+	bigiptest.CallRecorder
+
+	GetFunc              func(context.Context) (*sys.NTP, error)
+	CreateFunc           func(context.Context, sys.NTP) error
+	UpdateFunc           func(context.Context, string, sys.NTP) error
+	AddServersForNTPFunc func(context.Context, ...string) error
+	DeleteFunc           func(context.Context, string) error
+}
+
+var _ sys.NTPAPI = &NTPAPI{}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *NTPAPI) Get(ctx context.Context) (r0 *sys.NTP, r1 error) {
+	m.CallRecorder.Record("Get", ctx)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *NTPAPI) Create(ctx context.Context, item sys.NTP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *NTPAPI) Update(ctx context.Context, name string, item sys.NTP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// AddServersForNTP records the call and calls AddServersForNTPFunc if it is set.
+func (m *NTPAPI) AddServersForNTP(ctx context.Context, rs ...string) (r0 error) {
+	m.CallRecorder.Record("AddServersForNTP", ctx, rs)
+	if m.AddServersForNTPFunc != nil {
+		return m.AddServersForNTPFunc(ctx, rs...)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *NTPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// OutboundSMTPAPI is a mock of sys.OutboundSMTPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type OutboundSMTPAPI struct {
+	bigiptest.CallRecorder
+
+	ShowFunc func(context.Context) (*sys.OutboundSMTP, error)
+}
+
+var _ sys.OutboundSMTPAPI = &OutboundSMTPAPI{}
+
+// Show records the call and calls ShowFunc if it is set.
+func (m *OutboundSMTPAPI) Show(ctx context.Context) (r0 *sys.OutboundSMTP, r1 error) {
+	m.CallRecorder.Record("Show", ctx)
+	if m.ShowFunc != nil {
+		return m.ShowFunc(ctx)
+	}
+	return
+}
+
+// ProvisionAPI is a mock of sys.ProvisionAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ProvisionAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context, ...*rest.ListOptions) (*sys.ProvisionList, error)
+	GetFunc  func(context.Context, string) (*sys.Provision, error)
+}
+
+var _ sys.ProvisionAPI = &ProvisionAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ProvisionAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ProvisionList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ProvisionAPI) Get(ctx context.Context, name string) (r0 *sys.Provision, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// SMTPServerAPI is a mock of sys.SMTPServerAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SMTPServerAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.SMTPServerList, error)
+	GetFunc    func(context.Context, string) (*sys.SMTPServer, error)
+	CreateFunc func(context.Context, sys.SMTPServer) error
+	UpdateFunc func(context.Context, string, sys.SMTPServer) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.SMTPServerAPI = &SMTPServerAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SMTPServerAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.SMTPServerList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SMTPServerAPI) Get(ctx context.Context, name string) (r0 *sys.SMTPServer, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SMTPServerAPI) Create(ctx context.Context, item sys.SMTPServer) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SMTPServerAPI) Update(ctx context.Context, name string, item sys.SMTPServer) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SMTPServerAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SMTPServerAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SNMPAPI is a mock of sys.SNMPAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SNMPAPI struct {
+	bigiptest.CallRecorder
+
+	GetFunc    func(context.Context) (*sys.SNMP, error)
+	CreateFunc func(context.Context, sys.SNMP) error
+	UpdateFunc func(context.Context, string, sys.SNMP) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.SNMPAPI = &SNMPAPI{}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SNMPAPI) Get(ctx context.Context) (r0 *sys.SNMP, r1 error) {
+	m.CallRecorder.Record("Get", ctx)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SNMPAPI) Create(ctx context.Context, item sys.SNMP) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SNMPAPI) Update(ctx context.Context, name string, item sys.SNMP) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SNMPAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SSHDAPI is a mock of sys.SSHDAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SSHDAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc func(context.Context) (*sys.SSHD, error)
+}
+
+var _ sys.SSHDAPI = &SSHDAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SSHDAPI) List(ctx context.Context) (r0 *sys.SSHD, r1 error) {
+	m.CallRecorder.Record("List", ctx)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return
+}
+
+// ScriptdAPI is a mock of sys.ScriptdAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ScriptdAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ScriptdList, error)
+	GetFunc    func(context.Context, string) (*sys.Scriptd, error)
+	CreateFunc func(context.Context, sys.Scriptd) error
+	UpdateFunc func(context.Context, string, sys.Scriptd) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ScriptdAPI = &ScriptdAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ScriptdAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ScriptdList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ScriptdAPI) Get(ctx context.Context, name string) (r0 *sys.Scriptd, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ScriptdAPI) Create(ctx context.Context, item sys.Scriptd) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ScriptdAPI) Update(ctx context.Context, name string, item sys.Scriptd) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ScriptdAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ScriptdAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// ServiceAPI is a mock of sys.ServiceAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type ServiceAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.ServiceList, error)
+	GetFunc    func(context.Context, string) (*sys.Service, error)
+	CreateFunc func(context.Context, sys.Service) error
+	UpdateFunc func(context.Context, string, sys.Service) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.ServiceAPI = &ServiceAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *ServiceAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.ServiceList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *ServiceAPI) Get(ctx context.Context, name string) (r0 *sys.Service, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *ServiceAPI) Create(ctx context.Context, item sys.Service) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *ServiceAPI) Update(ctx context.Context, name string, item sys.Service) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *ServiceAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *ServiceAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// StateMirroringAPI is a mock of sys.StateMirroringAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type StateMirroringAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.StateMirroringList, error)
+	GetFunc    func(context.Context, string) (*sys.StateMirroring, error)
+	CreateFunc func(context.Context, sys.StateMirroring) error
+	UpdateFunc func(context.Context, string, sys.StateMirroring) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.StateMirroringAPI = &StateMirroringAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *StateMirroringAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.StateMirroringList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *StateMirroringAPI) Get(ctx context.Context, name string) (r0 *sys.StateMirroring, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *StateMirroringAPI) Create(ctx context.Context, item sys.StateMirroring) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *StateMirroringAPI) Update(ctx context.Context, name string, item sys.StateMirroring) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *StateMirroringAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *StateMirroringAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SyncSysFilesAPI is a mock of sys.SyncSysFilesAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SyncSysFilesAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.SyncSysFilesList, error)
+	GetFunc    func(context.Context, string) (*sys.SyncSysFiles, error)
+	CreateFunc func(context.Context, sys.SyncSysFiles) error
+	UpdateFunc func(context.Context, string, sys.SyncSysFiles) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+}
+
+var _ sys.SyncSysFilesAPI = &SyncSysFilesAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *SyncSysFilesAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.SyncSysFilesList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SyncSysFilesAPI) Get(ctx context.Context, name string) (r0 *sys.SyncSysFiles, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *SyncSysFilesAPI) Create(ctx context.Context, item sys.SyncSysFiles) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SyncSysFilesAPI) Update(ctx context.Context, name string, item sys.SyncSysFiles) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *SyncSysFilesAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SyncSysFilesAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// SysAPI is a mock of sys.SysAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SysAPI struct {
+	bigiptest.CallRecorder
+
+	AOMFunc              func() sys.AOMAPI
+	ApplicationFunc      func() application.ApplicationAPI
+	AutoscaleGroupFunc   func() *sys.AutoscaleGroupResource
+	ClockFunc            func() sys.ClockAPI
+	ClusterFunc          func() sys.ClusterAPI
+	ConfigFunc           func() sys.ConfigAPI
+	ConnectionFunc       func() sys.ConnectionAPI
+	ConsoleFunc          func() sys.ConsoleAPI
+	CPUStatsFunc         func() sys.CPUStatsAPI
+	CryptoFunc           func() crypto.CryptoAPI
+	DBFunc               func() sys.DBAPI
+	DNSFunc              func() *sys.DNSResource
+	DaemonHAFunc         func() *sys.DaemonHAResource
+	DataStorFunc         func() *sys.DataStorResource
+	DiskFunc             func() disk.DiskAPI
+	FeatureModuleFunc    func() sys.FeatureModuleAPI
+	FixConnectionFunc    func() *sys.FixConnectionResource
+	HAGroupFunc          func() *sys.HAGroupResource
+	HTTPDFunc            func() sys.HTTPDAPI
+	IPFixFunc            func() ipfix.IPFixAPI
+	IPFixElementFunc     func() ipfix.IPFixAPI
+	LicenseFunc          func() sys.LicenseAPI
+	ManagementDHCPFunc   func() sys.ManagementDHCPAPI
+	ManagementIPFunc     func() sys.ManagementIPAPI
+	ManagementOVSDBFunc  func() sys.ManagementOVSDBAPI
+	ManagementRouteFunc  func() sys.ManagementRouteAPI
+	MemoryStatsFunc      func() sys.MemoryStatsAPI
+	NTPFunc              func() sys.NTPAPI
+	OutboundSMTPFunc     func() sys.OutboundSMTPAPI
+	PFManFunc            func() pfman.PFManAPI
+	ProvisionFunc        func() sys.ProvisionAPI
+	RAIDFunc             func() raid.RAIDAPI
+	RestrictedModuleFunc func() *sys.RestrictedModuleResource
+	SMTPServerFunc       func() sys.SMTPServerAPI
+	SNMPFunc             func() sys.SNMPAPI
+	SSHDFunc             func() sys.SSHDAPI
+	ScriptdFunc          func() sys.ScriptdAPI
+	ServiceFunc          func() sys.ServiceAPI
+	SoftwareFunc         func() software.SoftwareAPI
+	StateMirroringFunc   func() sys.StateMirroringAPI
+	SyncSysFilesFunc     func() sys.SyncSysFilesAPI
+	SyslogFunc           func() sys.SyslogAPI
+	UCSFunc              func() sys.UCSAPI
+}
+
+var _ sys.SysAPI = &SysAPI{}
+
+// AOM records the call and calls AOMFunc if it is set.
+func (m *SysAPI) AOM() (r0 sys.AOMAPI) {
+	m.CallRecorder.Record("AOM")
+	if m.AOMFunc != nil {
+		return m.AOMFunc()
+	}
+	return
+}
+
+// Application records the call and calls ApplicationFunc if it is set.
+func (m *SysAPI) Application() (r0 application.ApplicationAPI) {
+	m.CallRecorder.Record("Application")
+	if m.ApplicationFunc != nil {
+		return m.ApplicationFunc()
+	}
+	return
+}
+
+// AutoscaleGroup records the call and calls AutoscaleGroupFunc if it is set.
+func (m *SysAPI) AutoscaleGroup() (r0 *sys.AutoscaleGroupResource) {
+	m.CallRecorder.Record("AutoscaleGroup")
+	if m.AutoscaleGroupFunc != nil {
+		return m.AutoscaleGroupFunc()
+	}
+	return
+}
+
+// Clock records the call and calls ClockFunc if it is set.
+func (m *SysAPI) Clock() (r0 sys.ClockAPI) {
+	m.CallRecorder.Record("Clock")
+	if m.ClockFunc != nil {
+		return m.ClockFunc()
+	}
+	return
+}
+
+// Cluster records the call and calls ClusterFunc if it is set.
+func (m *SysAPI) Cluster() (r0 sys.ClusterAPI) {
+	m.CallRecorder.Record("Cluster")
+	if m.ClusterFunc != nil {
+		return m.ClusterFunc()
+	}
+	return
+}
+
+// Config records the call and calls ConfigFunc if it is set.
+func (m *SysAPI) Config() (r0 sys.ConfigAPI) {
+	m.CallRecorder.Record("Config")
+	if m.ConfigFunc != nil {
+		return m.ConfigFunc()
+	}
+	return
+}
+
+// Connection records the call and calls ConnectionFunc if it is set.
+func (m *SysAPI) Connection() (r0 sys.ConnectionAPI) {
+	m.CallRecorder.Record("Connection")
+	if m.ConnectionFunc != nil {
+		return m.ConnectionFunc()
+	}
+	return
+}
+
+// Console records the call and calls ConsoleFunc if it is set.
+func (m *SysAPI) Console() (r0 sys.ConsoleAPI) {
+	m.CallRecorder.Record("Console")
+	if m.ConsoleFunc != nil {
+		return m.ConsoleFunc()
+	}
+	return
+}
+
+// CPUStats records the call and calls CPUStatsFunc if it is set.
+func (m *SysAPI) CPUStats() (r0 sys.CPUStatsAPI) {
+	m.CallRecorder.Record("CPUStats")
+	if m.CPUStatsFunc != nil {
+		return m.CPUStatsFunc()
+	}
+	return
+}
+
+// Crypto records the call and calls CryptoFunc if it is set.
+func (m *SysAPI) Crypto() (r0 crypto.CryptoAPI) {
+	m.CallRecorder.Record("Crypto")
+	if m.CryptoFunc != nil {
+		return m.CryptoFunc()
+	}
+	return
+}
+
+// DB records the call and calls DBFunc if it is set.
+func (m *SysAPI) DB() (r0 sys.DBAPI) {
+	m.CallRecorder.Record("DB")
+	if m.DBFunc != nil {
+		return m.DBFunc()
+	}
+	return
+}
+
+// DNS records the call and calls DNSFunc if it is set.
+func (m *SysAPI) DNS() (r0 *sys.DNSResource) {
+	m.CallRecorder.Record("DNS")
+	if m.DNSFunc != nil {
+		return m.DNSFunc()
+	}
+	return
+}
+
+// DaemonHA records the call and calls DaemonHAFunc if it is set.
+func (m *SysAPI) DaemonHA() (r0 *sys.DaemonHAResource) {
+	m.CallRecorder.Record("DaemonHA")
+	if m.DaemonHAFunc != nil {
+		return m.DaemonHAFunc()
+	}
+	return
+}
+
+// DataStor records the call and calls DataStorFunc if it is set.
+func (m *SysAPI) DataStor() (r0 *sys.DataStorResource) {
+	m.CallRecorder.Record("DataStor")
+	if m.DataStorFunc != nil {
+		return m.DataStorFunc()
+	}
+	return
+}
+
+// Disk records the call and calls DiskFunc if it is set.
+func (m *SysAPI) Disk() (r0 disk.DiskAPI) {
+	m.CallRecorder.Record("Disk")
+	if m.DiskFunc != nil {
+		return m.DiskFunc()
+	}
+	return
+}
+
+// FeatureModule records the call and calls FeatureModuleFunc if it is set.
+func (m *SysAPI) FeatureModule() (r0 sys.FeatureModuleAPI) {
+	m.CallRecorder.Record("FeatureModule")
+	if m.FeatureModuleFunc != nil {
+		return m.FeatureModuleFunc()
+	}
+	return
+}
+
+// FixConnection records the call and calls FixConnectionFunc if it is set.
+func (m *SysAPI) FixConnection() (r0 *sys.FixConnectionResource) {
+	m.CallRecorder.Record("FixConnection")
+	if m.FixConnectionFunc != nil {
+		return m.FixConnectionFunc()
+	}
+	return
+}
+
+// HAGroup records the call and calls HAGroupFunc if it is set.
+func (m *SysAPI) HAGroup() (r0 *sys.HAGroupResource) {
+	m.CallRecorder.Record("HAGroup")
+	if m.HAGroupFunc != nil {
+		return m.HAGroupFunc()
+	}
+	return
+}
+
+// HTTPD records the call and calls HTTPDFunc if it is set.
+func (m *SysAPI) HTTPD() (r0 sys.HTTPDAPI) {
+	m.CallRecorder.Record("HTTPD")
+	if m.HTTPDFunc != nil {
+		return m.HTTPDFunc()
+	}
+	return
+}
+
+// IPFix records the call and calls IPFixFunc if it is set.
+func (m *SysAPI) IPFix() (r0 ipfix.IPFixAPI) {
+	m.CallRecorder.Record("IPFix")
+	if m.IPFixFunc != nil {
+		return m.IPFixFunc()
+	}
+	return
+}
+
+// IPFixElement records the call and calls IPFixElementFunc if it is set.
+func (m *SysAPI) IPFixElement() (r0 ipfix.IPFixAPI) {
+	m.CallRecorder.Record("IPFixElement")
+	if m.IPFixElementFunc != nil {
+		return m.IPFixElementFunc()
+	}
+	return
+}
+
+// License records the call and calls LicenseFunc if it is set.
+func (m *SysAPI) License() (r0 sys.LicenseAPI) {
+	m.CallRecorder.Record("License")
+	if m.LicenseFunc != nil {
+		return m.LicenseFunc()
+	}
+	return
+}
+
+// ManagementDHCP records the call and calls ManagementDHCPFunc if it is set.
+func (m *SysAPI) ManagementDHCP() (r0 sys.ManagementDHCPAPI) {
+	m.CallRecorder.Record("ManagementDHCP")
+	if m.ManagementDHCPFunc != nil {
+		return m.ManagementDHCPFunc()
+	}
+	return
+}
+
+// ManagementIP records the call and calls ManagementIPFunc if it is set.
+func (m *SysAPI) ManagementIP() (r0 sys.ManagementIPAPI) {
+	m.CallRecorder.Record("ManagementIP")
+	if m.ManagementIPFunc != nil {
+		return m.ManagementIPFunc()
+	}
+	return
+}
+
+// ManagementOVSDB records the call and calls ManagementOVSDBFunc if it is set.
+func (m *SysAPI) ManagementOVSDB() (r0 sys.ManagementOVSDBAPI) {
+	m.CallRecorder.Record("ManagementOVSDB")
+	if m.ManagementOVSDBFunc != nil {
+		return m.ManagementOVSDBFunc()
+	}
+	return
+}
+
+// ManagementRoute records the call and calls ManagementRouteFunc if it is set.
+func (m *SysAPI) ManagementRoute() (r0 sys.ManagementRouteAPI) {
+	m.CallRecorder.Record("ManagementRoute")
+	if m.ManagementRouteFunc != nil {
+		return m.ManagementRouteFunc()
+	}
+	return
+}
+
+// MemoryStats records the call and calls MemoryStatsFunc if it is set.
+func (m *SysAPI) MemoryStats() (r0 sys.MemoryStatsAPI) {
+	m.CallRecorder.Record("MemoryStats")
+	if m.MemoryStatsFunc != nil {
+		return m.MemoryStatsFunc()
+	}
+	return
+}
+
+// NTP records the call and calls NTPFunc if it is set.
+func (m *SysAPI) NTP() (r0 sys.NTPAPI) {
+	m.CallRecorder.Record("NTP")
+	if m.NTPFunc != nil {
+		return m.NTPFunc()
+	}
+	return
+}
+
+// OutboundSMTP records the call and calls OutboundSMTPFunc if it is set.
+func (m *SysAPI) OutboundSMTP() (r0 sys.OutboundSMTPAPI) {
+	m.CallRecorder.Record("OutboundSMTP")
+	if m.OutboundSMTPFunc != nil {
+		return m.OutboundSMTPFunc()
+	}
+	return
+}
+
+// PFMan records the call and calls PFManFunc if it is set.
+func (m *SysAPI) PFMan() (r0 pfman.PFManAPI) {
+	m.CallRecorder.Record("PFMan")
+	if m.PFManFunc != nil {
+		return m.PFManFunc()
+	}
+	return
+}
+
+// Provision records the call and calls ProvisionFunc if it is set.
+func (m *SysAPI) Provision() (r0 sys.ProvisionAPI) {
+	m.CallRecorder.Record("Provision")
+	if m.ProvisionFunc != nil {
+		return m.ProvisionFunc()
+	}
+	return
+}
+
+// RAID records the call and calls RAIDFunc if it is set.
+func (m *SysAPI) RAID() (r0 raid.RAIDAPI) {
+	m.CallRecorder.Record("RAID")
+	if m.RAIDFunc != nil {
+		return m.RAIDFunc()
+	}
+	return
+}
+
+// RestrictedModule records the call and calls RestrictedModuleFunc if it is set.
+func (m *SysAPI) RestrictedModule() (r0 *sys.RestrictedModuleResource) {
+	m.CallRecorder.Record("RestrictedModule")
+	if m.RestrictedModuleFunc != nil {
+		return m.RestrictedModuleFunc()
+	}
+	return
+}
+
+// SMTPServer records the call and calls SMTPServerFunc if it is set.
+func (m *SysAPI) SMTPServer() (r0 sys.SMTPServerAPI) {
+	m.CallRecorder.Record("SMTPServer")
+	if m.SMTPServerFunc != nil {
+		return m.SMTPServerFunc()
+	}
+	return
+}
+
+// SNMP records the call and calls SNMPFunc if it is set.
+func (m *SysAPI) SNMP() (r0 sys.SNMPAPI) {
+	m.CallRecorder.Record("SNMP")
+	if m.SNMPFunc != nil {
+		return m.SNMPFunc()
+	}
+	return
+}
+
+// SSHD records the call and calls SSHDFunc if it is set.
+func (m *SysAPI) SSHD() (r0 sys.SSHDAPI) {
+	m.CallRecorder.Record("SSHD")
+	if m.SSHDFunc != nil {
+		return m.SSHDFunc()
+	}
+	return
+}
+
+// Scriptd records the call and calls ScriptdFunc if it is set.
+func (m *SysAPI) Scriptd() (r0 sys.ScriptdAPI) {
+	m.CallRecorder.Record("Scriptd")
+	if m.ScriptdFunc != nil {
+		return m.ScriptdFunc()
+	}
+	return
+}
+
+// Service records the call and calls ServiceFunc if it is set.
+func (m *SysAPI) Service() (r0 sys.ServiceAPI) {
+	m.CallRecorder.Record("Service")
+	if m.ServiceFunc != nil {
+		return m.ServiceFunc()
+	}
+	return
+}
+
+// Software records the call and calls SoftwareFunc if it is set.
+func (m *SysAPI) Software() (r0 software.SoftwareAPI) {
+	m.CallRecorder.Record("Software")
+	if m.SoftwareFunc != nil {
+		return m.SoftwareFunc()
+	}
+	return
+}
+
+// StateMirroring records the call and calls StateMirroringFunc if it is set.
+func (m *SysAPI) StateMirroring() (r0 sys.StateMirroringAPI) {
+	m.CallRecorder.Record("StateMirroring")
+	if m.StateMirroringFunc != nil {
+		return m.StateMirroringFunc()
+	}
+	return
+}
+
+// SyncSysFiles records the call and calls SyncSysFilesFunc if it is set.
+func (m *SysAPI) SyncSysFiles() (r0 sys.SyncSysFilesAPI) {
+	m.CallRecorder.Record("SyncSysFiles")
+	if m.SyncSysFilesFunc != nil {
+		return m.SyncSysFilesFunc()
+	}
+	return
+}
+
+// Syslog records the call and calls SyslogFunc if it is set.
+func (m *SysAPI) Syslog() (r0 sys.SyslogAPI) {
+	m.CallRecorder.Record("Syslog")
+	if m.SyslogFunc != nil {
+		return m.SyslogFunc()
+	}
+	return
+}
+
+// UCS records the call and calls UCSFunc if it is set.
+func (m *SysAPI) UCS() (r0 sys.UCSAPI) {
+	m.CallRecorder.Record("UCS")
+	if m.UCSFunc != nil {
+		return m.UCSFunc()
+	}
+	return
+}
+
+// SyslogAPI is a mock of sys.SyslogAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type SyslogAPI struct {
+	bigiptest.CallRecorder
+
+	AddRemoteServersFunc func(context.Context, ...sys.RemoteServer) error
+	GetFunc              func(context.Context) (*sys.Syslog, error)
+	UpdateFunc           func(context.Context, sys.Syslog) error
+	DeleteFunc           func(context.Context, string) error
+}
+
+var _ sys.SyslogAPI = &SyslogAPI{}
+
+// AddRemoteServers records the call and calls AddRemoteServersFunc if it is set.
+func (m *SyslogAPI) AddRemoteServers(ctx context.Context, rs ...sys.RemoteServer) (r0 error) {
+	m.CallRecorder.Record("AddRemoteServers", ctx, rs)
+	if m.AddRemoteServersFunc != nil {
+		return m.AddRemoteServersFunc(ctx, rs...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *SyslogAPI) Get(ctx context.Context) (r0 *sys.Syslog, r1 error) {
+	m.CallRecorder.Record("Get", ctx)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *SyslogAPI) Update(ctx context.Context, item sys.Syslog) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *SyslogAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// UCSAPI is a mock of sys.UCSAPI. Its methods record their calls and call the function
+// of the same name with a Func suffix if it is set, or return zero values.
+type UCSAPI struct {
+	bigiptest.CallRecorder
+
+	ListFunc   func(context.Context, ...*rest.ListOptions) (*sys.UCSList, error)
+	GetFunc    func(context.Context, string) (*sys.UCS, error)
+	CreateFunc func(context.Context, sys.UCS) error
+	UpdateFunc func(context.Context, string, sys.UCS) error
+	PatchFunc  func(context.Context, string, interface{}) error
+	DeleteFunc func(context.Context, string) error
+	SaveFunc   func(context.Context, string, ...map[string]interface{}) error
+	LoadFunc   func(context.Context, string, ...map[string]interface{}) error
+}
+
+var _ sys.UCSAPI = &UCSAPI{}
+
+// List records the call and calls ListFunc if it is set.
+func (m *UCSAPI) List(ctx context.Context, opts ...*rest.ListOptions) (r0 *sys.UCSList, r1 error) {
+	m.CallRecorder.Record("List", ctx, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opts...)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc if it is set.
+func (m *UCSAPI) Get(ctx context.Context, name string) (r0 *sys.UCS, r1 error) {
+	m.CallRecorder.Record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc if it is set.
+func (m *UCSAPI) Create(ctx context.Context, item sys.UCS) (r0 error) {
+	m.CallRecorder.Record("Create", ctx, item)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return
+}
+
+// Update records the call and calls UpdateFunc if it is set.
+func (m *UCSAPI) Update(ctx context.Context, name string, item sys.UCS) (r0 error) {
+	m.CallRecorder.Record("Update", ctx, name, item)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, name, item)
+	}
+	return
+}
+
+// Patch records the call and calls PatchFunc if it is set.
+func (m *UCSAPI) Patch(ctx context.Context, name string, fields interface{}) (r0 error) {
+	m.CallRecorder.Record("Patch", ctx, name, fields)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(ctx, name, fields)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc if it is set.
+func (m *UCSAPI) Delete(ctx context.Context, name string) (r0 error) {
+	m.CallRecorder.Record("Delete", ctx, name)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, name)
+	}
+	return
+}
+
+// Save records the call and calls SaveFunc if it is set.
+func (m *UCSAPI) Save(ctx context.Context, name string, options ...map[string]interface{}) (r0 error) {
+	m.CallRecorder.Record("Save", ctx, name, options)
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, name, options...)
+	}
+	return
+}
+
+// Load records the call and calls LoadFunc if it is set.
+func (m *UCSAPI) Load(ctx context.Context, name string, options ...map[string]interface{}) (r0 error) {
+	m.CallRecorder.Record("Load", ctx, name, options)
+	if m.LoadFunc != nil {
+		return m.LoadFunc(ctx, name, options...)
+	}
+	return
+}
@@ -2,10 +2,8 @@ package sys
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/lefeck/go-bigip"
-	"strings"
+	"github.com/lefeck/go-bigip/rest"
 	"time"
 )
 
@@ -46,72 +44,49 @@ type UCSResource struct {
 	b *bigip.BigIP
 }
 
-// List all ucs details
-func (r *UCSResource) List() (*UCSList, error) {
-	var items UCSList
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
+// collection returns the typed collection backing UCSResource.
+func (r *UCSResource) collection() *bigip.Collection[UCS, UCSList] {
+	return bigip.NewCollection[UCS, UCSList](r.b, SysManager, UCSEndpoint).WithoutPartition()
+}
 
-	if err := json.Unmarshal(res, &items); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &items, nil
+// List all ucs details
+func (r *UCSResource) List(ctx context.Context, opts ...*rest.ListOptions) (*UCSList, error) {
+	return r.collection().List(ctx, opts...)
 }
 
 // Get a single ucs details by the node name
-func (r *UCSResource) Get(name string) (*UCS, error) {
-	var item UCS
-	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(res, &item); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON data: %s\n", err)
-	}
-	return &item, nil
+func (r *UCSResource) Get(ctx context.Context, name string) (*UCS, error) {
+	return r.collection().Get(ctx, name)
 }
 
 // Create a new ucs item
-func (r *UCSResource) Create(item UCS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UCSResource) Create(ctx context.Context, item UCS) error {
+	return r.collection().Create(ctx, item)
 }
 
 // Update the ucs item identified by the ucs name, otherwise an error will be reported.
-func (r *UCSResource) Update(name string, item UCS) error {
-	jsonData, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON data: %w", err)
-	}
-	jsonString := string(jsonData)
-	_, err = r.b.RestClient.Put().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).ResourceInstance(name).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
-	return nil
+func (r *UCSResource) Update(ctx context.Context, name string, item UCS) error {
+	return r.collection().Update(ctx, name, item)
+}
+
+// Patch updates only the given fields of the UCS identified by name, see bigip.PatchBody.
+func (r *UCSResource) Patch(ctx context.Context, name string, fields interface{}) error {
+	return r.collection().Patch(ctx, name, fields)
 }
 
 // Delete a single ucs identified by the ucs name. if it is not exist return error
-func (r *UCSResource) Delete(name string) error {
-	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).ManagerName(SysManager).
-		Resource(UCSEndpoint).ResourceInstance(name).DoRaw(context.Background())
-	if err != nil {
-		return err
-	}
+func (r *UCSResource) Delete(ctx context.Context, name string) error {
+	return r.collection().Delete(ctx, name)
+}
+
+// Save saves the configuration into the UCS archive /var/local/ucs/<name>.
+// The command runs as an asynchronous task, as it usually takes longer than the request timeout.
+func (r *UCSResource) Save(ctx context.Context, name string, options ...map[string]interface{}) error {
+	return r.b.RunTask(ctx, SysManager, UCSEndpoint, ConfigCommand{Command: "save", Name: name, Options: options}, nil)
+}
 
-	return nil
+// Load restores the configuration from the UCS archive /var/local/ucs/<name>.
+// Options such as {"no-license": ""} or {"passphrase": "secret"} are passed to tmsh.
+func (r *UCSResource) Load(ctx context.Context, name string, options ...map[string]interface{}) error {
+	return r.b.RunTask(ctx, SysManager, UCSEndpoint, ConfigCommand{Command: "load", Name: name, Options: options}, nil)
 }
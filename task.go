@@ -0,0 +1,238 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TaskManager is the manager under which BIG-IP exposes asynchronous tasks,
+// for example /mgmt/tm/task/sys/config.
+const TaskManager = "task"
+
+// Task states reported by BIG-IP.
+const (
+	TaskCreated    = "CREATED"
+	TaskValidating = "VALIDATING"
+	TaskStarted    = "STARTED"
+	TaskCompleted  = "COMPLETED"
+	TaskFailed     = "FAILED"
+)
+
+// Delays between two status checks of a running task. The delay doubles after
+// every check, from taskMinPollInterval up to taskMaxPollInterval.
+var (
+	taskMinPollInterval = 500 * time.Millisecond
+	taskMaxPollInterval = 10 * time.Second
+)
+
+// taskCleanupTimeout bounds the deletion of a task once the caller's context is done.
+var taskCleanupTimeout = 10 * time.Second
+
+// TaskStatus holds the state of an asynchronous task.
+type TaskStatus struct {
+	ID                string        `json:"_id,omitempty"`
+	TaskState         string        `json:"_taskState,omitempty"`
+	TaskResultMessage string        `json:"_taskResultMessage,omitempty"`
+	TaskResultCode    int           `json:"_taskResultCode,omitempty"`
+	Command           string        `json:"command,omitempty"`
+	Name              string        `json:"name,omitempty"`
+	Options           []interface{} `json:"options,omitempty"`
+	StartTime         string        `json:"startTime,omitempty"`
+	EndTime           string        `json:"endTime,omitempty"`
+	Kind              string        `json:"kind,omitempty"`
+	SelfLink          string        `json:"selfLink,omitempty"`
+}
+
+// TaskError is returned when an asynchronous task fails.
+type TaskError struct {
+	ID      string
+	Command string
+	Message string
+}
+
+// Error implements the errors.Error interface
+func (err *TaskError) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("task %s (%s) failed", err.ID, err.Command)
+	}
+	return fmt.Sprintf("task %s (%s) failed: %s", err.ID, err.Command, err.Message)
+}
+
+// Task is an asynchronous task, such as /mgmt/tm/task/sys/config/<id>.
+//
+// Long-running commands like saving the configuration or a UCS archive can
+// exceed the timeout of a synchronous call. Running them as a task returns
+// immediately and lets the caller poll for the outcome:
+//
+//	task, err := b.CreateTask(ctx, "sys", "config", map[string]string{"command": "save"})
+//	...
+//	err = task.Start(ctx)
+//	status, err := task.Wait(ctx)
+type Task struct {
+	b        *BigIP
+	manager  string
+	resource string
+	id       string
+}
+
+// CreateTask creates a task for the command described by item under /mgmt/tm/task/<manager>/<resource>.
+// The task does not run until Start is called.
+func (b *BigIP) CreateTask(ctx context.Context, manager, resource string, item interface{}) (*Task, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	res, err := b.RestClient.Post().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TaskManager).Resource(manager).SubResource(resource).Body(data).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status TaskStatus
+	if err := json.Unmarshal(res, &status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	if status.ID == "" {
+		return nil, fmt.Errorf("task created under %s/%s has no id", manager, resource)
+	}
+	return b.GetTask(manager, resource, status.ID), nil
+}
+
+// GetTask returns the existing task identified by id.
+func (b *BigIP) GetTask(manager, resource, id string) *Task {
+	return &Task{b: b, manager: manager, resource: resource, id: id}
+}
+
+// RunTask creates and starts a task, waits for it to complete and deletes it.
+// If result is not nil, the task result is decoded into it.
+func (b *BigIP) RunTask(ctx context.Context, manager, resource string, item interface{}, result interface{}) error {
+	task, err := b.CreateTask(ctx, manager, resource, item)
+	if err != nil {
+		return err
+	}
+	defer task.cleanup(ctx)
+
+	if err := task.Start(ctx); err != nil {
+		return err
+	}
+	if _, err := task.Wait(ctx); err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return task.Result(ctx, result)
+}
+
+// ID returns the task identifier.
+func (t *Task) ID() string {
+	return t.id
+}
+
+// Start moves the task to the VALIDATING state, which makes BIG-IP run it.
+func (t *Task) Start(ctx context.Context) error {
+	data, err := json.Marshal(TaskStatus{TaskState: TaskValidating})
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	_, err = t.b.RestClient.Put().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TaskManager).Resource(t.manager).SubResource(t.resource).SubResourceInstance(t.id).
+		Body(data).DoRaw(ctx)
+	return err
+}
+
+// Status returns the current state of the task.
+func (t *Task) Status(ctx context.Context) (*TaskStatus, error) {
+	res, err := t.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TaskManager).Resource(t.manager).SubResource(t.resource).SubResourceInstance(t.id).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status TaskStatus
+	if err := json.Unmarshal(res, &status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &status, nil
+}
+
+// Wait polls the task with an increasing delay until it completes, fails or ctx is done.
+// A failed task is reported as a *TaskError.
+func (t *Task) Wait(ctx context.Context) (*TaskStatus, error) {
+	var status *TaskStatus
+	err := Poll(ctx, func(ctx context.Context) (bool, error) {
+		current, err := t.Status(ctx)
+		if err != nil {
+			return false, err
+		}
+		switch current.TaskState {
+		case TaskCompleted, TaskFailed:
+			status = current
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if status.TaskState == TaskFailed {
+		return status, &TaskError{ID: t.id, Command: status.Command, Message: status.TaskResultMessage}
+	}
+	return status, nil
+}
+
+// Poll calls done with the increasing delay of Task.Wait until it reports true, returns an
+// error or ctx is done. It waits for the operations that BIG-IP runs in the background
+// without a /mgmt/tm/task endpoint, such as a qkview or a software installation.
+func Poll(ctx context.Context, done func(ctx context.Context) (bool, error)) error {
+	delay := taskMinPollInterval
+	for {
+		if ok, err := done(ctx); ok || err != nil {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if delay *= 2; delay > taskMaxPollInterval {
+			delay = taskMaxPollInterval
+		}
+	}
+}
+
+// Result decodes the result of a completed task into v.
+func (t *Task) Result(ctx context.Context, v interface{}) error {
+	res, err := t.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TaskManager).Resource(t.manager).SubResource(t.resource).SubResourceInstance(t.id).
+		SubStatsResource("result").DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(res, v); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the task from BIG-IP.
+func (t *Task) Delete(ctx context.Context) error {
+	_, err := t.b.RestClient.Delete().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TaskManager).Resource(t.manager).SubResource(t.resource).SubResourceInstance(t.id).DoRaw(ctx)
+	return err
+}
+
+// cleanup deletes the task, even when ctx has already been cancelled.
+func (t *Task) cleanup(ctx context.Context) {
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), taskCleanupTimeout)
+		defer cancel()
+	}
+	t.Delete(ctx)
+}
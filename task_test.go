@@ -0,0 +1,121 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTaskServer fakes /mgmt/tm/task/sys/config. The task completes, or fails
+// when fail is set, after the given number of polls.
+func newTaskServer(t *testing.T, polls int, fail bool) (*BigIP, *[]string) {
+	var log []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		log = append(log, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mgmt/tm/task/sys/config":
+			if string(body) != `{"command":"save"}` {
+				t.Errorf("Unexpected task body %s", body)
+			}
+			w.Write([]byte(`{"_id":"1234","_taskState":"CREATED","command":"save"}`))
+		case "PUT /mgmt/tm/task/sys/config/1234":
+			if !strings.Contains(string(body), `"_taskState":"VALIDATING"`) {
+				t.Errorf("Unexpected task body %s", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"_id":"1234","_taskState":"VALIDATING"}`))
+		case "GET /mgmt/tm/task/sys/config/1234":
+			switch {
+			case polls > 0:
+				polls--
+				w.Write([]byte(`{"_id":"1234","_taskState":"STARTED","command":"save"}`))
+			case fail:
+				w.Write([]byte(`{"_id":"1234","_taskState":"FAILED","command":"save","_taskResultMessage":"disk full"}`))
+			default:
+				w.Write([]byte(`{"_id":"1234","_taskState":"COMPLETED","command":"save"}`))
+			}
+		case "GET /mgmt/tm/task/sys/config/1234/result":
+			w.Write([]byte(`{"_id":"1234","_taskState":"COMPLETED","_taskResultMessage":"Saving running configuration..."}`))
+		case "DELETE /mgmt/tm/task/sys/config/1234":
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b, &log
+}
+
+func withTaskPollInterval(t *testing.T, d time.Duration) {
+	minInterval, maxInterval := taskMinPollInterval, taskMaxPollInterval
+	taskMinPollInterval, taskMaxPollInterval = d, d
+	t.Cleanup(func() { taskMinPollInterval, taskMaxPollInterval = minInterval, maxInterval })
+}
+
+func TestRunTask(t *testing.T) {
+	withTaskPollInterval(t, time.Millisecond)
+	b, log := newTaskServer(t, 2, false)
+
+	var result TaskStatus
+	if err := b.RunTask(context.Background(), "sys", "config", map[string]string{"command": "save"}, &result); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.TaskResultMessage != "Saving running configuration..." {
+		t.Errorf("Unexpected task result %+v", result)
+	}
+	expected := []string{
+		"POST /mgmt/tm/task/sys/config",
+		"PUT /mgmt/tm/task/sys/config/1234",
+		"GET /mgmt/tm/task/sys/config/1234",
+		"GET /mgmt/tm/task/sys/config/1234",
+		"GET /mgmt/tm/task/sys/config/1234",
+		"GET /mgmt/tm/task/sys/config/1234/result",
+		"DELETE /mgmt/tm/task/sys/config/1234",
+	}
+	if strings.Join(*log, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected requests %v, got %v", expected, *log)
+	}
+}
+
+func TestRunTaskFailed(t *testing.T) {
+	withTaskPollInterval(t, time.Millisecond)
+	b, log := newTaskServer(t, 0, true)
+
+	err := b.RunTask(context.Background(), "sys", "config", map[string]string{"command": "save"}, nil)
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("Expected a TaskError, got %v", err)
+	}
+	if taskErr.ID != "1234" || taskErr.Message != "disk full" {
+		t.Errorf("Unexpected task error %+v", taskErr)
+	}
+	if last := (*log)[len(*log)-1]; last != "DELETE /mgmt/tm/task/sys/config/1234" {
+		t.Errorf("Expected the failed task to be deleted, last request was %q", last)
+	}
+}
+
+func TestRunTaskCancelled(t *testing.T) {
+	withTaskPollInterval(t, time.Hour)
+	b, log := newTaskServer(t, 1, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := b.RunTask(ctx, "sys", "config", map[string]string{"command": "save"}, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if last := (*log)[len(*log)-1]; last != "DELETE /mgmt/tm/task/sys/config/1234" {
+		t.Errorf("Expected the cancelled task to be deleted, last request was %q", last)
+	}
+}
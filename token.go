@@ -0,0 +1,109 @@
+package bigip
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip/rest"
+	"golang.org/x/oauth2"
+	"sync"
+	"time"
+)
+
+// MaxTokenTimeout is the longest lifetime BIG-IP accepts for a token.
+const MaxTokenTimeout = 36000 * time.Second
+
+// tokenRefreshWindow is how long before its expiry a token is renewed. Short-lived
+// tokens are renewed when half of their lifetime is over.
+var tokenRefreshWindow = time.Minute
+
+// TokenSource logs in to BIG-IP and keeps the credentials, so that the token can be
+// renewed before it expires or after BIG-IP rejected it. It is safe for concurrent use:
+// concurrent callers wait for a single login.
+type TokenSource struct {
+	mu   sync.Mutex
+	auth *authPayload
+}
+
+var _ oauth2.TokenSource = &TokenSource{}
+
+// NewTokenSource creates a TokenSource for the given credentials. No request is made until Token is called.
+func NewTokenSource(host, username, password, loginProviderName string, options ...Option) *TokenSource {
+	o := newOptions(host, append([]Option{WithTokenAuth(username, password), WithLoginProvider(loginProviderName)}, options...)...)
+	return &TokenSource{auth: newAuthPayload(o)}
+}
+
+// Token returns the current token, logging in again if it is missing or about to expire.
+func (s *TokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.expired() {
+		if _, _, err := s.auth.generateToken(); err != nil {
+			return nil, err
+		}
+	}
+	return &oauth2.Token{AccessToken: s.auth.token, Expiry: s.auth.tokenExpiresAt}, nil
+}
+
+// Invalidate discards token if it is still the current one, so that the next call to
+// Token logs in again. It is called when BIG-IP rejects a request with 401.
+func (s *TokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.auth.token == token {
+		s.auth.token = ""
+	}
+}
+
+// expired reports whether the token must be renewed. s.mu must be held.
+func (s *TokenSource) expired() bool {
+	if s.auth.token == "" {
+		return true
+	}
+	window := tokenRefreshWindow
+	if lifetime := s.auth.tokenExpiresAt.Sub(s.auth.tokenIssuedAt); window > lifetime/2 {
+		window = lifetime / 2
+	}
+	return !time.Now().Add(window).Before(s.auth.tokenExpiresAt)
+}
+
+// current returns the current token without logging in.
+func (s *TokenSource) current() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.auth.token
+}
+
+// Logout revokes the token of a session created with NewToken, so that tokens do not
+// pile up against the per-user limit of BIG-IP. A later request logs in again.
+// It does nothing for sessions using basic authentication.
+func (b *BigIP) Logout(ctx context.Context) error {
+	if b.tokenSource == nil {
+		return nil
+	}
+	token := b.tokenSource.current()
+	if token == "" {
+		return nil
+	}
+
+	// The token is set explicitly, otherwise the token source would be asked for one.
+	_, err := b.RestClient.Delete().Prefix(GetBaseResource()).ResourceCategory(GetShareResource()).
+		ManagerName("authz").Resource("tokens").ResourceInstance(token).
+		SetHeader("X-F5-Auth-Token", token).DoRaw(ctx)
+	if err != nil && !rest.IsNotFound(err) && !rest.IsUnauthorized(err) {
+		return err
+	}
+	b.tokenSource.Invalidate(token)
+	return nil
+}
+
+// Close revokes the session token like Logout and closes the idle connections.
+func (b *BigIP) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), loginTimeout)
+	defer cancel()
+	err := b.Logout(ctx)
+	if b.RestClient.Client != nil {
+		b.RestClient.Client.CloseIdleConnections()
+	}
+	return err
+}
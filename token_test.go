@@ -0,0 +1,181 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// tokenServer issues token-<n> on login and rejects revoked tokens with 401.
+type tokenServer struct {
+	mu        sync.Mutex
+	logins    int
+	started   time.Time
+	timeout   int
+	revoked   map[string]bool
+	timeouts  map[string]int
+	failLogin bool
+}
+
+func newTokenServer(t *testing.T, ts *tokenServer) string {
+	ts.revoked = map[string]bool{}
+	ts.timeouts = map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/mgmt/shared/authn/login":
+			if ts.failLogin {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			ts.logins++
+			started := ts.started
+			if started.IsZero() {
+				started = time.Now()
+			}
+			fmt.Fprintf(w, `{"username":"admin","token":{"token":"token-%d","timeout":%d,"startTime":%q}}`,
+				ts.logins, ts.timeout, started.Format(TimeFormat))
+		case ts.revoked[r.Header.Get("X-F5-Auth-Token")] || r.Header.Get("X-F5-Auth-Token") == "":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":401,"message":"X-F5-Auth-Token is invalid."}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/mgmt/shared/authz/tokens/"+r.Header.Get("X-F5-Auth-Token"):
+			var item struct{ Timeout int }
+			json.Unmarshal(body, &item)
+			ts.timeouts[r.Header.Get("X-F5-Auth-Token")] = item.Timeout
+		case r.Method == http.MethodDelete && r.URL.Path == "/mgmt/shared/authz/tokens/"+r.Header.Get("X-F5-Auth-Token"):
+			ts.revoked[r.Header.Get("X-F5-Auth-Token")] = true
+		default:
+			w.Write(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestNewTokenLoginFailure(t *testing.T) {
+	host := newTokenServer(t, &tokenServer{timeout: 1200, failLogin: true})
+
+	if _, err := NewToken(host, "admin", "wrong", "tmos"); err == nil {
+		t.Fatal("Expected an error when the login is rejected")
+	}
+}
+
+func TestNewTokenReloginOnUnauthorized(t *testing.T) {
+	ts := &tokenServer{timeout: 1200}
+	host := newTokenServer(t, ts)
+	b, err := NewToken(host, "admin", "admin", "tmos")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ts.mu.Lock()
+	ts.revoked["token-1"] = true
+	ts.mu.Unlock()
+
+	pools := NewCollection[testPool, testPoolList](b, "ltm", "pool")
+	if err := pools.Create(context.Background(), testPool{Name: "a"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ts.logins != 2 {
+		t.Errorf("Expected 2 logins, got %d", ts.logins)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ts := &tokenServer{timeout: 1200}
+	b, err := NewToken(newTokenServer(t, ts), "admin", "admin", "tmos")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := b.Logout(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !ts.revoked["token-1"] {
+		t.Error("Expected token-1 to be revoked")
+	}
+
+	pools := NewCollection[testPool, testPoolList](b, "ltm", "pool")
+	if err := pools.Create(context.Background(), testPool{Name: "a"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := b.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ts.logins != 2 || !ts.revoked["token-2"] {
+		t.Errorf("Expected a new login after Logout and token-2 to be revoked by Close, got %d logins", ts.logins)
+	}
+}
+
+func TestTokenSourceConcurrentUse(t *testing.T) {
+	ts := &tokenServer{timeout: 1200}
+	source := NewTokenSource(newTokenServer(t, ts), "admin", "admin", "tmos")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := source.Token(); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if ts.logins != 1 {
+		t.Errorf("Expected 1 login, got %d", ts.logins)
+	}
+}
+
+func TestTokenSourceRefreshBeforeExpiry(t *testing.T) {
+	ts := &tokenServer{timeout: 1200, started: time.Now().Add(-1190 * time.Second)}
+	source := NewTokenSource(newTokenServer(t, ts), "admin", "admin", "tmos")
+
+	for i := 0; i < 2; i++ {
+		if _, err := source.Token(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if ts.logins != 2 {
+		t.Errorf("Expected the token about to expire to be renewed, got %d logins", ts.logins)
+	}
+}
+
+func TestTokenSourceMaxTimeout(t *testing.T) {
+	ts := &tokenServer{timeout: 1200}
+	source := NewTokenSource(newTokenServer(t, ts), "admin", "admin", "tmos", WithTimeout(24*time.Hour))
+
+	if _, err := source.Token(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ts.timeouts["token-1"] != 36000 {
+		t.Errorf("Expected the token timeout to be lowered to 36000, got %d", ts.timeouts["token-1"])
+	}
+}
+
+func TestTokenSourceTimeout(t *testing.T) {
+	started := time.Now().Truncate(time.Millisecond)
+	ts := &tokenServer{timeout: 1200, started: started}
+	source := NewTokenSource(newTokenServer(t, ts), "admin", "admin", "tmos", WithTimeout(time.Hour))
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ts.timeouts["token-1"] != 3600 {
+		t.Errorf("Expected the token timeout to be set to 3600, got %d", ts.timeouts["token-1"])
+	}
+	if !token.Expiry.Equal(started.Add(time.Hour)) {
+		t.Errorf("Expected the token to expire at %v, got %v", started.Add(time.Hour), token.Expiry)
+	}
+}
@@ -0,0 +1,250 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TransactionEndpoint is the endpoint used to manage iControl REST transactions.
+const TransactionEndpoint = "transaction"
+
+// CoordinationIDHeader is the header that queues a request into a transaction instead of running it.
+const CoordinationIDHeader = "X-F5-REST-Coordination-Id"
+
+// Transaction states reported by BIG-IP.
+const (
+	TransactionStarted    = "STARTED"
+	TransactionValidating = "VALIDATING"
+	TransactionCompleted  = "COMPLETED"
+	TransactionFailed     = "FAILED"
+)
+
+// transactionPollInterval is the delay between two status checks of a transaction being validated.
+var transactionPollInterval = 500 * time.Millisecond
+
+// TransactionState holds the state of a transaction as returned by /mgmt/tm/transaction.
+type TransactionState struct {
+	TransID          int64  `json:"transId,omitempty"`
+	State            string `json:"state,omitempty"`
+	TimeoutSeconds   int64  `json:"timeoutSeconds,omitempty"`
+	AsyncExecution   bool   `json:"asyncExecution,omitempty"`
+	ValidateOnly     bool   `json:"validateOnly,omitempty"`
+	ExecutionTimeout int64  `json:"executionTimeout,omitempty"`
+	FailureReason    string `json:"failureReason,omitempty"`
+	Kind             string `json:"kind,omitempty"`
+	SelfLink         string `json:"selfLink,omitempty"`
+}
+
+// TransactionCommand is a request queued in a transaction.
+type TransactionCommand struct {
+	CommandID int64           `json:"commandId,omitempty"`
+	EvalOrder int64           `json:"evalOrder,omitempty"`
+	Method    string          `json:"method,omitempty"`
+	URI       string          `json:"uri,omitempty"`
+	Body      json.RawMessage `json:"body,omitempty"`
+	Status    string          `json:"status,omitempty"`
+	Message   string          `json:"message,omitempty"`
+	Kind      string          `json:"kind,omitempty"`
+	SelfLink  string          `json:"selfLink,omitempty"`
+}
+
+// TransactionCommandList holds the commands queued in a transaction.
+type TransactionCommandList struct {
+	Items    []TransactionCommand `json:"items,omitempty"`
+	Kind     string               `json:"kind,omitempty"`
+	SelfLink string               `json:"selfLink,omitempty"`
+}
+
+// TransactionError is returned when a transaction fails to commit.
+type TransactionError struct {
+	TransID int64
+	Reason  string
+	// Commands lists the queued commands reported as failed by BIG-IP.
+	Commands []TransactionCommand
+	Err      error
+}
+
+// Error implements the errors.Error interface
+func (err *TransactionError) Error() string {
+	reason := err.Reason
+	if reason == "" && err.Err != nil {
+		reason = err.Err.Error()
+	}
+	msg := fmt.Sprintf("transaction %d failed: %s", err.TransID, reason)
+	for _, cmd := range err.Commands {
+		msg += fmt.Sprintf("\n   command %d %s %s: %s", cmd.EvalOrder, cmd.Method, cmd.URI, cmd.Message)
+	}
+	return msg
+}
+
+func (err *TransactionError) Unwrap() error {
+	return err.Err
+}
+
+// Transaction groups changes so that BIG-IP applies all of them or none of them.
+//
+// Requests made through the session returned by BigIP are queued instead of being
+// executed, for example:
+//
+//	tx, err := b.Begin(ctx)
+//	...
+//	ltm.New(tx.BigIP()).Virtual().Update(ctx, "/Common/vs", vs)
+//	err = tx.Commit(ctx)
+type Transaction struct {
+	b     *BigIP
+	queue *BigIP
+	id    int64
+}
+
+// Begin starts a new transaction.
+func (b *BigIP) Begin(ctx context.Context) (*Transaction, error) {
+	res, err := b.RestClient.Post().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TransactionEndpoint).Body([]byte("{}")).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var state TransactionState
+	if err := json.Unmarshal(res, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return b.ResumeTransaction(state.TransID), nil
+}
+
+// ResumeTransaction returns the transaction identified by id, which must have been started with Begin.
+// The requests of the transaction are not retried, since a request that failed after it
+// was queued would be queued twice.
+func (b *BigIP) ResumeTransaction(id int64) *Transaction {
+	queue := *b
+	queue.RestClient = b.RestClient.WithHeader(CoordinationIDHeader, strconv.FormatInt(id, 10))
+	queue.RestClient.Retry = nil
+	return &Transaction{b: b, queue: &queue, id: id}
+}
+
+// Transaction runs fn within a new transaction. The transaction is committed if fn
+// succeeds and aborted otherwise.
+func (b *BigIP) Transaction(ctx context.Context, fn func(tx *BigIP) error) error {
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx.BigIP()); err != nil {
+		if abortErr := tx.Abort(ctx); abortErr != nil {
+			return fmt.Errorf("%w (abort transaction %d: %v)", err, tx.ID(), abortErr)
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ID returns the transaction identifier.
+func (tx *Transaction) ID() int64 {
+	return tx.id
+}
+
+// BigIP returns a session whose requests are queued into the transaction.
+func (tx *Transaction) BigIP() *BigIP {
+	return tx.queue
+}
+
+// State returns the current state of the transaction.
+func (tx *Transaction) State(ctx context.Context) (*TransactionState, error) {
+	res, err := tx.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TransactionEndpoint).Resource(tx.idString()).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var state TransactionState
+	if err := json.Unmarshal(res, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &state, nil
+}
+
+// Commands lists the commands queued in the transaction, in evaluation order.
+func (tx *Transaction) Commands(ctx context.Context) ([]TransactionCommand, error) {
+	res, err := tx.b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TransactionEndpoint).Resource(tx.idString()).SubResource("commands").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands TransactionCommandList
+	if err := json.Unmarshal(res, &commands); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return commands.Items, nil
+}
+
+// Validate checks the queued commands without applying them.
+func (tx *Transaction) Validate(ctx context.Context) error {
+	return tx.submit(ctx, TransactionState{State: TransactionValidating, ValidateOnly: true})
+}
+
+// Commit validates and applies the queued commands. If the transaction fails,
+// a *TransactionError describing the failed commands is returned.
+func (tx *Transaction) Commit(ctx context.Context) error {
+	return tx.submit(ctx, TransactionState{State: TransactionValidating})
+}
+
+// Abort discards the transaction and all its queued commands.
+func (tx *Transaction) Abort(ctx context.Context) error {
+	_, err := tx.b.RestClient.Delete().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TransactionEndpoint).Resource(tx.idString()).DoRaw(ctx)
+	return err
+}
+
+// submit moves the transaction to the VALIDATING state and waits for the outcome.
+func (tx *Transaction) submit(ctx context.Context, item TransactionState) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	res, err := tx.b.RestClient.Patch().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName(TransactionEndpoint).Resource(tx.idString()).Body(data).DoRaw(ctx)
+	if err != nil {
+		return tx.failure(ctx, "", err)
+	}
+
+	state := &TransactionState{}
+	if err := json.Unmarshal(res, state); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	for state.State == TransactionValidating && !item.ValidateOnly {
+		t := time.NewTimer(transactionPollInterval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+		if state, err = tx.State(ctx); err != nil {
+			return err
+		}
+	}
+	if state.State == TransactionFailed {
+		return tx.failure(ctx, state.FailureReason, nil)
+	}
+	return nil
+}
+
+// failure builds a TransactionError, looking up which commands failed.
+func (tx *Transaction) failure(ctx context.Context, reason string, err error) error {
+	txErr := &TransactionError{TransID: tx.id, Reason: reason, Err: err}
+	if commands, cmdErr := tx.Commands(ctx); cmdErr == nil {
+		for _, cmd := range commands {
+			if cmd.Message != "" || cmd.Status == TransactionFailed {
+				txErr.Commands = append(txErr.Commands, cmd)
+			}
+		}
+	}
+	return txErr
+}
+
+func (tx *Transaction) idString() string {
+	return strconv.FormatInt(tx.id, 10)
+}
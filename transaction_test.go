@@ -0,0 +1,172 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTransactionServer fakes /mgmt/tm/transaction. Commits fail when fail is set.
+func newTransactionServer(t *testing.T, fail bool) (*BigIP, *[]TransactionCommand, *[]string) {
+	var queued []TransactionCommand
+	var log []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		log = append(log, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if id := r.Header.Get(CoordinationIDHeader); id != "" {
+			if id != "42" {
+				t.Errorf("Unexpected transaction id %q", id)
+			}
+			queued = append(queued, TransactionCommand{EvalOrder: int64(len(queued) + 1), Method: r.Method, URI: r.URL.Path, Body: body})
+			w.Write(body)
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mgmt/tm/transaction":
+			w.Write([]byte(`{"transId":42,"state":"STARTED","timeoutSeconds":120}`))
+		case "GET /mgmt/tm/transaction/42/commands":
+			json.NewEncoder(w).Encode(TransactionCommandList{Items: queued})
+		case "PATCH /mgmt/tm/transaction/42":
+			if !fail {
+				w.Write([]byte(`{"transId":42,"state":"COMPLETED"}`))
+				return
+			}
+			queued[1].Message = "01020036:3: The requested monitor (/Common/missing) was not found."
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"transaction failed"}`))
+		case "DELETE /mgmt/tm/transaction/42":
+			queued = nil
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b, &queued, &log
+}
+
+func TestTransactionCommit(t *testing.T) {
+	b, queued, _ := newTransactionServer(t, false)
+	ctx := context.Background()
+
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pools := NewCollection[testPool, testPoolList](tx.BigIP(), "ltm", "pool")
+	if err := pools.Patch(ctx, "/Common/a", map[string]string{"monitor": "/Common/http"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := pools.Patch(ctx, "/Common/b", map[string]string{"monitor": "/Common/http"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	commands, err := tx.Commands(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(commands) != 2 || commands[1].URI != "/mgmt/tm/ltm/pool/~Common~b" {
+		t.Fatalf("Unexpected queued commands %+v", commands)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(*queued) != 2 {
+		t.Errorf("Expected 2 queued commands, got %d", len(*queued))
+	}
+
+	// The original session must not be bound to the transaction.
+	err = NewCollection[testPool, testPoolList](b, "ltm", "pool").Delete(ctx, "/Common/a")
+	if !rest.IsNotFound(err) {
+		t.Errorf("Expected the request outside the transaction to reach the server, got %v", err)
+	}
+}
+
+func TestTransactionCommitFailure(t *testing.T) {
+	b, _, log := newTransactionServer(t, true)
+	ctx := context.Background()
+
+	err := b.Transaction(ctx, func(tx *BigIP) error {
+		pools := NewCollection[testPool, testPoolList](tx, "ltm", "pool")
+		if err := pools.Create(ctx, testPool{Name: "a"}); err != nil {
+			return err
+		}
+		return pools.Patch(ctx, "/Common/a", map[string]string{"monitor": "/Common/missing"})
+	})
+
+	var txErr *TransactionError
+	if !errors.As(err, &txErr) {
+		t.Fatalf("Expected a TransactionError, got %v", err)
+	}
+	if txErr.TransID != 42 || len(txErr.Commands) != 1 || txErr.Commands[0].Method != http.MethodPatch {
+		t.Errorf("Unexpected transaction error %+v", txErr)
+	}
+	if last := (*log)[len(*log)-1]; last != "GET /mgmt/tm/transaction/42/commands" {
+		t.Errorf("Expected failed commands to be fetched, last request was %q", last)
+	}
+}
+
+func TestTransactionAbort(t *testing.T) {
+	b, queued, _ := newTransactionServer(t, false)
+	ctx := context.Background()
+	failure := errors.New("boom")
+
+	err := b.Transaction(ctx, func(tx *BigIP) error {
+		if err := NewCollection[testPool, testPoolList](tx, "ltm", "pool").Create(ctx, testPool{Name: "a"}); err != nil {
+			return err
+		}
+		return failure
+	})
+	if err != failure {
+		t.Fatalf("Expected %v, got %v", failure, err)
+	}
+	if len(*queued) != 0 {
+		t.Errorf("Expected the transaction to be aborted, %d commands left", len(*queued))
+	}
+}
+
+func TestTransactionNoRetry(t *testing.T) {
+	var queued int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get(CoordinationIDHeader) == "" {
+			w.Write([]byte(`{"transId":42,"state":"STARTED"}`))
+			return
+		}
+		// The command is queued, but the response is lost.
+		queued++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	b, err := NewSession(server.URL, "admin", "admin", WithRetry(&rest.RetryPolicy{MaxAttempts: 3}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pools := NewCollection[testPool, testPoolList](tx.BigIP(), "ltm", "pool")
+	if err := pools.Update(ctx, "/Common/a", testPool{Name: "a"}); err == nil {
+		t.Error("Expected the error of the queued request")
+	}
+	if queued != 1 {
+		t.Errorf("Expected the request to be queued once, got %d", queued)
+	}
+	if b.RestClient.Retry == nil {
+		t.Error("Expected the retry policy of the session to be kept")
+	}
+}
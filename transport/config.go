@@ -1,6 +1,12 @@
 package transport
 
-import "net/http"
+import (
+	"context"
+	"golang.org/x/oauth2"
+	"net"
+	"net/http"
+	"net/url"
+)
 
 // holds various options for establishing a transport.
 type Config struct {
@@ -16,6 +22,21 @@ type Config struct {
 	// The last successfully read value takes precedence over BearerToken.
 	BearerTokenFile string
 
+	// TokenSource returns the token of every request. It takes precedence over BearerToken.
+	TokenSource oauth2.TokenSource
+
+	// TLS holds the TLS settings of the connections.
+	TLS TLSConfig
+
+	// UserAgent is set on every request if not empty.
+	UserAgent string
+
+	// Proxy returns the proxy of a request, http.ProxyFromEnvironment if nil.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// DialContext opens the connections, for example through a SOCKS5 proxy.
+	DialContext func(ctx context.Context, network, address string) (net.Conn, error)
+
 	// WrapTransport for most client level operations.
 	Transport http.RoundTripper
 
@@ -31,5 +52,5 @@ func (c *Config) HasBasicAuth() bool {
 }
 
 func (c *Config) HasTokenAuth() bool {
-	return len(c.BearerToken) != 0 || len(c.BearerTokenFile) != 0
+	return len(c.BearerToken) != 0 || len(c.BearerTokenFile) != 0 || c.TokenSource != nil
 }
@@ -0,0 +1,423 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// EnvRecord is the environment variable that switches RecorderModeFromEnv to ModeRecord.
+const EnvRecord = "BIGIP_RECORD"
+
+// Redacted replaces the credentials and tokens saved in a cassette.
+const Redacted = "REDACTED"
+
+// RecorderMode tells a Recorder whether to replay a cassette or record a new one.
+type RecorderMode int
+
+const (
+	// ModeReplay serves the responses of a cassette without any network access.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends the requests to the device and saves the interactions in a cassette.
+	ModeRecord
+)
+
+// RecorderModeFromEnv returns ModeRecord if the BIGIP_RECORD environment variable
+// is set to a true value, such as 1 or true, and ModeReplay otherwise.
+func RecorderModeFromEnv() RecorderMode {
+	switch strings.ToLower(os.Getenv(EnvRecord)) {
+	case "1", "true", "yes", "on":
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// DefaultRedactedHeaders are the headers whose values are replaced by Redacted in a cassette.
+var DefaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "X-F5-Auth-Token", "Cookie", "Set-Cookie"}
+
+// DefaultRedactedFields are the properties of JSON bodies whose string values are replaced
+// by Redacted in a cassette, such as the password of a login request and the token of its
+// response. They are matched without regard to case.
+var DefaultRedactedFields = []string{"password", "token", "passphrase", "secret", "refreshToken"}
+
+// Cassette holds the interactions recorded by a Recorder, in the order they happened.
+type Cassette struct {
+	// Comment describes where the interactions come from. It is not set by a Recorder.
+	Comment      string         `json:"comment,omitempty"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response of the device.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of a cassette. Query is sorted by key and JSON bodies are
+// indented, so that requests compare equal regardless of their encoding.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+	// Encoding is "base64" when Body is not valid UTF-8, such as a file upload.
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// RecordedResponse is a response of a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	Encoding   string      `json:"encoding,omitempty"`
+}
+
+// LoadCassette reads a cassette from a JSON file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to a JSON file, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Recorder is an http.RoundTripper that records the interactions with a device in a
+// cassette file and replays them later, so that tests can run without the device:
+//
+//	rec, err := transport.NewRecorder("testdata/pool.json", transport.RecorderModeFromEnv())
+//	defer rec.Stop()
+//	b, err := bigip.NewSession(host, username, password, bigip.WithWrapTransport(rec.Wrap))
+//
+// A request is replayed with the first unused interaction of the cassette that has the
+// same method, path, query and body, so that a sequence of identical requests, such as
+// the polling of a task, returns the recorded responses in order. The headers and JSON
+// properties holding credentials and tokens are redacted before they are saved, and are
+// not compared. Their values are also redacted wherever else they appear, such as the
+// token in the path of a logout request.
+type Recorder struct {
+	// RedactHeaders and RedactFields default to DefaultRedactedHeaders and DefaultRedactedFields.
+	RedactHeaders []string
+	RedactFields  []string
+
+	path string
+	mode RecorderMode
+	rt   http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+
+	secretsMu sync.Mutex
+	// secrets are the values redacted from headers and JSON properties.
+	secrets []string
+}
+
+var _ RoundTripperWrapper = &Recorder{}
+
+// NewRecorder returns a Recorder for the cassette file at path. In ModeReplay the cassette
+// is loaded, in ModeRecord a new cassette is saved to path by Stop.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{
+		RedactHeaders: DefaultRedactedHeaders,
+		RedactFields:  DefaultRedactedFields,
+		path:          path,
+		mode:          mode,
+		cassette:      &Cassette{},
+	}
+	if mode == ModeReplay {
+		c, err := LoadCassette(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load cassette: %w", err)
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	}
+	return r, nil
+}
+
+// Wrap is a WrapperFunc that sets the transport used to record the interactions
+// and returns the Recorder.
+func (r *Recorder) Wrap(rt http.RoundTripper) http.RoundTripper {
+	r.rt = rt
+	return r
+}
+
+// Mode returns the mode of the Recorder.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Stop saves the cassette in ModeRecord. It does nothing in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// A secret may appear in an interaction recorded before the one it was redacted from.
+	for _, interaction := range r.cassette.Interactions {
+		r.redactRequest(&interaction.Request)
+		interaction.Response.Body = r.redactSecrets(interaction.Response.Body, interaction.Response.Encoding)
+	}
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := r.recordRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	if r.rt == nil {
+		return nil, fmt.Errorf("recorder has no transport, use Wrap to set one")
+	}
+	req = CloneRequest(req)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{Request: recorded, Response: RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     r.redactHeader(resp.Header),
+	}}
+	// The body is saved in another form, so its recorded length would be wrong.
+	interaction.Response.Header.Del("Content-Length")
+	interaction.Response.Body, interaction.Response.Encoding = r.encodeBody(respBody)
+	interaction.Response.Body = r.redactSecrets(interaction.Response.Body, interaction.Response.Encoding)
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction that matches the request.
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+		body, err := decodeBody(interaction.Response.Body, interaction.Response.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		header := CloneHeader(interaction.Response.Header)
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded response for %s %s in cassette %s", req.Method, req.URL.RequestURI(), r.path)
+}
+
+// matches reports whether two recorded requests have the same method, path, query and body.
+func matches(a, b RecordedRequest) bool {
+	return a.Method == b.Method && a.Path == b.Path && a.Query == b.Query && a.Body == b.Body && a.Encoding == b.Encoding
+}
+
+// recordRequest returns the redacted form of a request saved in a cassette.
+func (r *Recorder) recordRequest(req *http.Request, body []byte) RecordedRequest {
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Header: r.redactHeader(req.Header),
+	}
+	if query, err := url.ParseQuery(req.URL.RawQuery); err == nil {
+		// Encode sorts the query by key.
+		recorded.Query = query.Encode()
+	} else {
+		recorded.Query = req.URL.RawQuery
+	}
+	recorded.Body, recorded.Encoding = r.encodeBody(body)
+	r.redactRequest(&recorded)
+	return recorded
+}
+
+// redactRequest replaces the secrets found in the path, query and body of a recorded request.
+func (r *Recorder) redactRequest(recorded *RecordedRequest) {
+	recorded.Path = r.redactSecrets(recorded.Path, "")
+	recorded.Query = r.redactSecrets(recorded.Query, "")
+	recorded.Body = r.redactSecrets(recorded.Body, recorded.Encoding)
+}
+
+// readBody reads and closes the body of a request.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// redactHeader returns a copy of header whose sensitive values are replaced by Redacted.
+func (r *Recorder) redactHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	header = CloneHeader(header)
+	for _, key := range r.RedactHeaders {
+		if values := header.Values(key); len(values) != 0 {
+			for _, value := range values {
+				r.addSecret(value)
+			}
+			header.Set(key, Redacted)
+		}
+	}
+	return header
+}
+
+// encodeBody returns the redacted and indented form of a JSON body, the body itself for
+// another text, and the base64 encoding of a binary body.
+func (r *Recorder) encodeBody(body []byte) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err == nil && !decoder.More() {
+		if data, err := json.MarshalIndent(r.redactValue(value), "", "  "); err == nil {
+			return string(data), ""
+		}
+	}
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+// decodeBody returns the body saved by encodeBody.
+func decodeBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case "base64":
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return nil, fmt.Errorf("unknown body encoding %q", encoding)
+}
+
+// redactValue replaces the string values of the sensitive properties of a JSON value.
+// Objects held by a sensitive property, such as the token of a login response, are
+// redacted recursively so that their other properties are kept.
+func (r *Recorder) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if secret, ok := field.(string); ok && r.redacted(key) {
+				r.addSecret(secret)
+				v[key] = Redacted
+				continue
+			}
+			v[key] = r.redactValue(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = r.redactValue(v[i])
+		}
+	}
+	return value
+}
+
+// addSecret records a redacted value, so that redactSecrets replaces it elsewhere.
+func (r *Recorder) addSecret(secret string) {
+	if secret == "" || secret == Redacted {
+		return
+	}
+	r.secretsMu.Lock()
+	defer r.secretsMu.Unlock()
+	for _, s := range r.secrets {
+		if s == secret {
+			return
+		}
+	}
+	r.secrets = append(r.secrets, secret)
+	// The longest secrets are replaced first, in case one contains another.
+	sort.Slice(r.secrets, func(i, j int) bool { return len(r.secrets[i]) > len(r.secrets[j]) })
+}
+
+// redactSecrets replaces the known secrets in s, unless s is encoded in base64.
+func (r *Recorder) redactSecrets(s, encoding string) string {
+	if s == "" || encoding != "" {
+		return s
+	}
+	r.secretsMu.Lock()
+	defer r.secretsMu.Unlock()
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	return s
+}
+
+func (r *Recorder) redacted(field string) bool {
+	for _, name := range r.RedactFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recorder) CancelRequest(req *http.Request) {
+	if r.rt != nil {
+		tryCancelRequest(r.rt, req)
+	}
+}
+
+func (r *Recorder) WrappedRoundTripper() http.RoundTripper { return r.rt }
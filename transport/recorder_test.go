@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	var polls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/mgmt/shared/authn/login":
+			w.Write([]byte(`{"username":"admin","token":{"token":"T0K3N","timeout":1200}}`))
+		case "/mgmt/tm/ltm/pool":
+			w.Write([]byte(`{"items":[{"name":"web","generation":12345678901234}]}`))
+		case "/mgmt/tm/sys/task/1":
+			polls++
+			if polls == 1 {
+				w.Write([]byte(`{"status":"STARTED"}`))
+				return
+			}
+			w.Write([]byte(`{"status":"COMPLETED"}`))
+		case "/mgmt/shared/authz/tokens/T0K3N":
+			w.Write([]byte(`{"token":"T0K3N","selfLink":"https://localhost/mgmt/shared/authz/tokens/T0K3N"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	cassette := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	send := func(rt http.RoundTripper, method, path, body string) (int, string) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		req.Header.Set("X-F5-Auth-Token", "T0K3N")
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(data)
+	}
+
+	rec, err := NewRecorder(cassette, ModeRecord)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rt := rec.Wrap(http.DefaultTransport)
+	if _, body := send(rt, http.MethodPost, "/mgmt/shared/authn/login", `{"username":"admin","password":"s3cret"}`); !strings.Contains(body, "T0K3N") {
+		t.Errorf("Expected the token to be returned while recording, got %s", body)
+	}
+	send(rt, http.MethodGet, "/mgmt/tm/ltm/pool?expandSubcollections=true&$select=name", "")
+	send(rt, http.MethodGet, "/mgmt/tm/sys/task/1", "")
+	send(rt, http.MethodGet, "/mgmt/tm/sys/task/1", "")
+	// Logout deletes the token, which is part of the path.
+	send(rt, http.MethodDelete, "/mgmt/shared/authz/tokens/T0K3N", "")
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, secret := range []string{"s3cret", "T0K3N"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected %s to be redacted from the cassette", secret)
+		}
+	}
+
+	ts.Close()
+	rec, err = NewRecorder(cassette, ModeReplay)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rt = rec.Wrap(nil)
+	// The body is compared after redaction and the query regardless of its order.
+	if _, body := send(rt, http.MethodPost, "/mgmt/shared/authn/login", `{"password":"other","username":"admin"}`); !strings.Contains(body, `"timeout": 1200`) {
+		t.Errorf("Expected the recorded login response, got %s", body)
+	}
+	if _, body := send(rt, http.MethodGet, "/mgmt/tm/ltm/pool?$select=name&expandSubcollections=true", ""); !strings.Contains(body, "12345678901234") {
+		t.Errorf("Expected the recorded pool list, got %s", body)
+	}
+	for _, status := range []string{"STARTED", "COMPLETED"} {
+		if code, body := send(rt, http.MethodGet, "/mgmt/tm/sys/task/1", ""); code != http.StatusOK || !strings.Contains(body, status) {
+			t.Errorf("Expected %s, got %d %s", status, code, body)
+		}
+	}
+
+	// A replayed session only knows the redacted token of the login response.
+	if code, body := send(rt, http.MethodDelete, "/mgmt/shared/authz/tokens/"+Redacted, ""); code != http.StatusOK || !strings.Contains(body, "tokens/"+Redacted) {
+		t.Errorf("Expected the recorded logout response, got %d %s", code, body)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/mgmt/tm/sys/task/1", nil)
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Error("Expected an error once the recorded responses are used")
+	}
+	req, _ = http.NewRequest(http.MethodDelete, ts.URL+"/mgmt/tm/ltm/pool/~Common~web", nil)
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Error("Expected an error for a request that was not recorded")
+	}
+}
+
+func TestRecorderModeFromEnv(t *testing.T) {
+	t.Setenv(EnvRecord, "1")
+	if RecorderModeFromEnv() != ModeRecord {
+		t.Errorf("Expected ModeRecord")
+	}
+	t.Setenv(EnvRecord, "")
+	if RecorderModeFromEnv() != ModeReplay {
+		t.Errorf("Expected ModeReplay")
+	}
+}
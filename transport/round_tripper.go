@@ -13,15 +13,16 @@ func HTTPWrappersFor(config *Config, rt http.RoundTripper) (http.RoundTripper, e
 	switch {
 	case config.HasBasicAuth() && config.HasTokenAuth():
 		return nil, fmt.Errorf("username/password or bearer token may be set, but not both")
+	case config.TokenSource != nil:
+		rt = NewTokenSourceAuthRoundTripper(config.TokenSource, rt)
 	case config.HasTokenAuth():
-		var err error
 		rt = NewTokenAuthRoundTripper(config.BearerToken, rt)
-		if err != nil {
-			return nil, err
-		}
 	case config.HasBasicAuth():
 		rt = NewBasicAuthRoundTripper(config.Username, config.Password, rt)
 	}
+	if len(config.UserAgent) != 0 {
+		rt = NewUserAgentRoundTripper(config.UserAgent, rt)
+	}
 	return rt, nil
 }
 
@@ -61,6 +62,31 @@ func (rt *basicAuthRoundTripper) WrappedRoundTripper() http.RoundTripper {
 	return rt.rt
 }
 
+type userAgentRoundTripper struct {
+	agent string
+	rt    http.RoundTripper
+}
+
+var _ RoundTripperWrapper = &userAgentRoundTripper{}
+
+// NewUserAgentRoundTripper sets the User-Agent header of a request unless it has already been set.
+func NewUserAgentRoundTripper(agent string, rt http.RoundTripper) http.RoundTripper {
+	return &userAgentRoundTripper{agent: agent, rt: rt}
+}
+
+func (rt *userAgentRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get("User-Agent")) != 0 {
+		return rt.rt.RoundTrip(req)
+	}
+	req = CloneRequest(req)
+	req.Header.Set("User-Agent", rt.agent)
+	return rt.rt.RoundTrip(req)
+}
+
+func (rt *userAgentRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
+
 // token login
 type tokenAuthRoundTripper struct {
 	token  string
@@ -76,18 +102,61 @@ func NewTokenAuthRoundTripper(token string, rt http.RoundTripper) http.RoundTrip
 	return &tokenAuthRoundTripper{token, nil, rt}
 }
 
+// NewTokenSourceAuthRoundTripper adds the token returned by source to a request
+// unless the authorization header has already been set. If source implements
+// TokenInvalidator, a request rejected with 401 is sent once more with a new token.
+func NewTokenSourceAuthRoundTripper(source oauth2.TokenSource, rt http.RoundTripper) http.RoundTripper {
+	return &tokenAuthRoundTripper{source: source, rt: rt}
+}
+
+// TokenInvalidator is implemented by token sources that can discard a token rejected
+// by the server, so that the next call to Token logs in again.
+type TokenInvalidator interface {
+	Invalidate(token string)
+}
+
 func (rt *tokenAuthRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	if len(req.Header.Get("X-F5-Auth-Token")) != 0 {
 		return rt.rt.RoundTrip(req)
 	}
-	req = CloneRequest(req)
-	token := rt.token
-	if rt.source != nil {
-		if refreshedToken, err := rt.source.Token(); err == nil {
-			token = refreshedToken.AccessToken
+	if rt.source == nil {
+		req = CloneRequest(req)
+		req.Header.Set("X-F5-Auth-Token", rt.token)
+		return rt.rt.RoundTrip(req)
+	}
+
+	token, err := rt.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := rt.roundTripWithToken(req, token.AccessToken)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	invalidator, ok := rt.source.(TokenInvalidator)
+	if !ok || (req.Body != nil && req.GetBody == nil) {
+		return resp, nil
+	}
+	invalidator.Invalidate(token.AccessToken)
+	if token, err = rt.source.Token(); err != nil {
+		return resp, nil
+	}
+	retry := req
+	if req.Body != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
 		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
 	}
+	resp.Body.Close()
+	return rt.roundTripWithToken(retry, token.AccessToken)
+}
 
+func (rt *tokenAuthRoundTripper) roundTripWithToken(req *http.Request, token string) (*http.Response, error) {
+	req = CloneRequest(req)
 	req.Header.Set("X-F5-Auth-Token", token)
 	return rt.rt.RoundTrip(req)
 }
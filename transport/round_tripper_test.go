@@ -3,8 +3,11 @@ package transport
 import (
 	"encoding/base64"
 	"fmt"
+	"golang.org/x/oauth2"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -74,3 +77,49 @@ func TestBearerAuthRoundTripper(t *testing.T) {
 		t.Errorf("Expected status code %d, got %d", http.StatusOK, resp.StatusCode)
 	}
 }
+
+type revocableTokenSource struct {
+	tokens []string
+}
+
+func (s *revocableTokenSource) Token() (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: s.tokens[0]}, nil
+}
+
+func (s *revocableTokenSource) Invalidate(token string) {
+	if s.tokens[0] == token {
+		s.tokens = s.tokens[1:]
+	}
+}
+
+func TestTokenSourceAuthRoundTripper(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("X-F5-Auth-Token") != "fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write(body)
+	}))
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+
+	client := &http.Client{
+		Transport: NewTokenSourceAuthRoundTripper(&revocableTokenSource{tokens: []string{"stale", "fresh"}}, ts.Client().Transport),
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Error performing request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != "payload" {
+		t.Errorf("Expected status code %d with the replayed body, got %d %q", http.StatusOK, resp.StatusCode, body)
+	}
+}
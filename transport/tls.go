@@ -0,0 +1,185 @@
+package transport
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// TLSConfig holds the TLS settings used to connect to BIG-IP.
+type TLSConfig struct {
+	// Insecure skips the verification of the server certificate.
+	Insecure bool
+
+	// ServerName overrides the name checked against the server certificate,
+	// for example when connecting to the management address of a device.
+	ServerName string
+
+	// CAFile and CAData hold PEM encoded certificates of the trusted authorities.
+	// If neither is set, the system pool is used.
+	CAFile string
+	CAData []byte
+
+	// CertFile/KeyFile or CertData/KeyData hold a PEM encoded client certificate and key.
+	CertFile string
+	KeyFile  string
+	CertData []byte
+	KeyData  []byte
+
+	// MinVersion is the minimum TLS version accepted, tls.VersionTLS12 if zero.
+	MinVersion uint16
+
+	// PinnedSHA256 lists the accepted SHA-256 fingerprints of the server certificate,
+	// in hex with or without colons. When set without a CA, the certificate chain is
+	// not verified, which allows self-signed device certificates.
+	PinnedSHA256 []string
+
+	// TrustOnFirstUse pins the certificate presented by the server on the first
+	// connection when PinnedSHA256 is empty.
+	TrustOnFirstUse *TrustOnFirstUse
+}
+
+// HasCA reports whether trusted authorities are configured.
+func (c TLSConfig) HasCA() bool {
+	return len(c.CAFile) != 0 || len(c.CAData) != 0
+}
+
+// IsZero reports whether no TLS setting is set, not even Insecure.
+func (c TLSConfig) IsZero() bool {
+	return !c.Insecure && len(c.ServerName) == 0 && !c.HasCA() && !c.HasPins() && c.MinVersion == 0 &&
+		len(c.CertFile) == 0 && len(c.KeyFile) == 0 && len(c.CertData) == 0 && len(c.KeyData) == 0
+}
+
+// HasPins reports whether the server certificate is pinned.
+func (c TLSConfig) HasPins() bool {
+	return len(c.PinnedSHA256) != 0 || c.TrustOnFirstUse != nil
+}
+
+// TrustOnFirstUse remembers the fingerprint of the first certificate presented by a
+// server and then only accepts that certificate. The same value can be shared by
+// several clients so that they all trust the same certificate.
+type TrustOnFirstUse struct {
+	mu          sync.Mutex
+	fingerprint string
+	// OnPin is called with the fingerprint once it is pinned, for example to save it
+	// and use it as TLSConfig.PinnedSHA256 later.
+	OnPin func(fingerprint string)
+}
+
+// Fingerprint returns the pinned fingerprint, or an empty string if no connection was made yet.
+func (t *TrustOnFirstUse) Fingerprint() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.fingerprint
+}
+
+// verify pins fingerprint if it is the first one seen, otherwise checks it matches.
+func (t *TrustOnFirstUse) verify(fingerprint string) error {
+	t.mu.Lock()
+	if t.fingerprint == "" {
+		t.fingerprint = fingerprint
+		t.mu.Unlock()
+		if t.OnPin != nil {
+			t.OnPin(fingerprint)
+		}
+		return nil
+	}
+	pinned := t.fingerprint
+	t.mu.Unlock()
+	if pinned != fingerprint {
+		return fmt.Errorf("server certificate changed: pinned SHA-256 fingerprint %s, got %s", pinned, fingerprint)
+	}
+	return nil
+}
+
+// Fingerprint returns the SHA-256 fingerprint of a certificate in lower case hex.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprint removes colons and spaces from a hex fingerprint.
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(fingerprint))
+}
+
+// TLSConfigFor returns the tls.Config described by c.
+func TLSConfigFor(c TLSConfig) (*tls.Config, error) {
+	if c.Insecure && (c.HasCA() || c.HasPins()) {
+		return nil, errors.New("the insecure flag cannot be combined with a CA or pinned certificates")
+	}
+	cfg := &tls.Config{
+		ServerName:         c.ServerName,
+		MinVersion:         c.MinVersion,
+		InsecureSkipVerify: c.Insecure,
+	}
+	if cfg.MinVersion == 0 {
+		cfg.MinVersion = tls.VersionTLS12
+	}
+
+	if c.HasCA() {
+		caData := c.CAData
+		if len(c.CAFile) != 0 {
+			data, err := os.ReadFile(c.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read CA file: %w", err)
+			}
+			caData = append(append([]byte(nil), caData...), data...)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, errors.New("no valid certificate found in the CA data")
+		}
+		cfg.RootCAs = pool
+	}
+
+	certData, keyData := c.CertData, c.KeyData
+	if len(c.CertFile) != 0 || len(c.KeyFile) != 0 {
+		var err error
+		if certData, err = os.ReadFile(c.CertFile); err != nil {
+			return nil, fmt.Errorf("failed to read client certificate: %w", err)
+		}
+		if keyData, err = os.ReadFile(c.KeyFile); err != nil {
+			return nil, fmt.Errorf("failed to read client key: %w", err)
+		}
+	}
+	if len(certData) != 0 || len(keyData) != 0 {
+		cert, err := tls.X509KeyPair(certData, keyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.HasPins() {
+		// The chain of a self-signed device certificate cannot be verified, the pin is checked instead.
+		cfg.InsecureSkipVerify = !c.HasCA()
+		pins := make(map[string]bool, len(c.PinnedSHA256))
+		for _, pin := range c.PinnedSHA256 {
+			pins[normalizeFingerprint(pin)] = true
+		}
+		tofu := c.TrustOnFirstUse
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("server presented no certificate")
+			}
+			fingerprint := Fingerprint(cs.PeerCertificates[0])
+			switch {
+			case len(pins) != 0:
+				if !pins[fingerprint] {
+					return fmt.Errorf("server certificate SHA-256 fingerprint %s is not pinned", fingerprint)
+				}
+				return nil
+			default:
+				return tofu.verify(fingerprint)
+			}
+		}
+	}
+	return cfg, nil
+}
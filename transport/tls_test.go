@@ -0,0 +1,88 @@
+package transport
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTLSClient(t *testing.T, config TLSConfig) *http.Client {
+	rt, err := New(&Config{TLS: config})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return &http.Client{Transport: rt}
+}
+
+func TestTLSConfigVerifiesByDefault(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	if _, err := newTLSClient(t, TLSConfig{}).Get(ts.URL); err == nil {
+		t.Error("Expected the self-signed certificate to be rejected")
+	}
+	if _, err := newTLSClient(t, TLSConfig{Insecure: true}).Get(ts.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestTLSConfigCAData(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+
+	if _, err := newTLSClient(t, TLSConfig{CAData: caData}).Get(ts.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := newTLSClient(t, TLSConfig{CAData: caData, ServerName: "bigip.local"}).Get(ts.URL); err == nil {
+		t.Error("Expected the certificate to be rejected for another server name")
+	}
+}
+
+func TestTLSConfigPinnedCertificate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	fingerprint := Fingerprint(ts.Certificate())
+
+	// Fingerprints are often copied from openssl output, in upper case with colons.
+	var colons []string
+	for i := 0; i < len(fingerprint); i += 2 {
+		colons = append(colons, strings.ToUpper(fingerprint[i:i+2]))
+	}
+	if _, err := newTLSClient(t, TLSConfig{PinnedSHA256: []string{strings.Join(colons, ":")}}).Get(ts.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := newTLSClient(t, TLSConfig{PinnedSHA256: []string{strings.Repeat("00", 32)}}).Get(ts.URL); err == nil {
+		t.Error("Expected a certificate that is not pinned to be rejected")
+	}
+}
+
+func TestTLSConfigTrustOnFirstUse(t *testing.T) {
+	first := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer first.Close()
+
+	var pinned string
+	tofu := &TrustOnFirstUse{OnPin: func(fingerprint string) { pinned = fingerprint }}
+	client := newTLSClient(t, TLSConfig{TrustOnFirstUse: tofu})
+	if _, err := client.Get(first.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pinned != Fingerprint(first.Certificate()) || tofu.Fingerprint() != pinned {
+		t.Errorf("Expected the first certificate to be pinned, got %q", pinned)
+	}
+	if _, err := client.Get(first.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	// httptest servers share one certificate, so a replaced certificate is simulated.
+	if err := tofu.verify(strings.Repeat("00", 32)); err == nil {
+		t.Error("Expected another certificate to be rejected once the first one is pinned")
+	}
+}
+
+func TestTLSConfigInsecureWithPins(t *testing.T) {
+	if _, err := TLSConfigFor(TLSConfig{Insecure: true, PinnedSHA256: []string{"00"}}); err == nil {
+		t.Error("Expected the insecure flag and pinned certificates to be rejected")
+	}
+}
@@ -2,20 +2,33 @@ package transport
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 )
 
 func New(config *Config) (http.RoundTripper, error) {
 	if config.Transport != nil {
-		return nil, fmt.Errorf("using a custom transport with TLS certificate options or the insecure flag is not allowed")
+		// The custom transport brings its own connection settings.
+		if !config.TLS.IsZero() || config.Proxy != nil || config.DialContext != nil {
+			return nil, fmt.Errorf("using a custom transport with TLS certificate options, the insecure flag, a proxy or a dialer is not allowed")
+		}
+		return HTTPWrappersFor(config, config.Transport)
 	}
 
-	// clone a new http.Transport connect, and settting InsecureSkipVerify is true.
+	tlsConfig, err := TLSConfigFor(config.TLS)
+	if err != nil {
+		return nil, err
+	}
 
+	// clone a new http.Transport connect with the TLS settings of the config.
 	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	customTransport.TLSClientConfig = tlsConfig
+	if config.Proxy != nil {
+		customTransport.Proxy = config.Proxy
+	}
+	if config.DialContext != nil {
+		customTransport.DialContext = config.DialContext
+	}
 
 	// Use customTransport instead of http.DefaultTransport
 	return HTTPWrappersFor(config, customTransport)
@@ -0,0 +1,209 @@
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lefeck/go-bigip/rest"
+	"io"
+	"time"
+)
+
+// DefaultUploadChunkSize is the size of the chunks sent when UploadOptions.ChunkSize is not set.
+// BIG-IP rejects chunks larger than 1MB.
+const DefaultUploadChunkSize = 512 * 1024
+
+// DefaultUploadRetries is the number of times a failed chunk is sent again when UploadOptions.Retries is not set.
+const DefaultUploadRetries = 3
+
+// uploadRetryDelay is the delay before a failed chunk is sent again. It doubles after every attempt.
+var uploadRetryDelay = time.Second
+
+// transferEndpoint identifies a file transfer endpoint such as /mgmt/shared/file-transfer/uploads.
+type transferEndpoint struct {
+	category string
+	manager  string
+	resource string
+}
+
+var (
+	// files are written to /var/config/rest/downloads.
+	fileUploads = transferEndpoint{GetShareResource(), "file-transfer", "uploads"}
+	// files are written to /shared/images.
+	softwareImageUploads = transferEndpoint{GetCMResource(), "autodeploy", "software-image-uploads"}
+	// files are written to /var/local/ucs.
+	ucsUploads = transferEndpoint{GetShareResource(), "file-transfer", "ucs-uploads"}
+)
+
+// UploadProgress reports the progress of an upload after every chunk.
+type UploadProgress struct {
+	Name  string
+	Sent  int64
+	Total int64
+}
+
+// UploadOptions controls how a file is uploaded.
+type UploadOptions struct {
+	// ChunkSize is the size of every chunk, DefaultUploadChunkSize if zero.
+	ChunkSize int64
+	// Retries is the number of times a failed chunk is sent again, DefaultUploadRetries if zero.
+	// A negative value disables retries.
+	Retries int
+	// Offset resumes an interrupted upload from the given byte, usually UploadError.Offset.
+	// The reader is positioned at Offset by seeking it or by discarding the bytes before it.
+	Offset int64
+	// Progress is called after every chunk is accepted by BIG-IP.
+	Progress func(progress UploadProgress)
+}
+
+// UploadResult holds the state of the file on BIG-IP after the last chunk.
+type UploadResult struct {
+	RemainingByteCount int64            `json:"remainingByteCount"`
+	UsedChunks         map[string]int64 `json:"usedChunks,omitempty"`
+	TotalByteCount     int64            `json:"totalByteCount"`
+	LocalFilePath      string           `json:"localFilePath,omitempty"`
+	TemporaryFilePath  string           `json:"temporaryFilePath,omitempty"`
+	Generation         int64            `json:"generation,omitempty"`
+	LastUpdateMicros   int64            `json:"lastUpdateMicros,omitempty"`
+}
+
+// UploadError is returned when a chunk cannot be uploaded. The upload can be
+// resumed by setting UploadOptions.Offset to Offset.
+type UploadError struct {
+	Name   string
+	Offset int64
+	Err    error
+}
+
+// Error implements the errors.Error interface
+func (err *UploadError) Error() string {
+	return fmt.Sprintf("failed to upload %s at offset %d: %v", err.Name, err.Offset, err.Err)
+}
+
+func (err *UploadError) Unwrap() error {
+	return err.Err
+}
+
+// Upload sends size bytes read from r to /mgmt/shared/file-transfer/uploads/<name>.
+// The file is written to /var/config/rest/downloads/<name>, where it can be used to
+// create iFiles, install certificates and keys or import other files, for example:
+//
+//	f, err := os.Open("cert.pem")
+//	...
+//	info, err := f.Stat()
+//	...
+//	_, err = b.Upload(ctx, "cert.pem", f, info.Size(), nil)
+func (b *BigIP) Upload(ctx context.Context, name string, r io.Reader, size int64, opts *UploadOptions) (*UploadResult, error) {
+	return b.upload(ctx, fileUploads, name, r, size, opts)
+}
+
+// UploadImage sends a software image or hotfix to /mgmt/cm/autodeploy/software-image-uploads/<name>.
+// The file is written to /shared/images/<name>.
+func (b *BigIP) UploadImage(ctx context.Context, name string, r io.Reader, size int64, opts *UploadOptions) (*UploadResult, error) {
+	return b.upload(ctx, softwareImageUploads, name, r, size, opts)
+}
+
+// UploadUCS sends a UCS archive to /mgmt/shared/file-transfer/ucs-uploads/<name>.
+// The file is written to /var/local/ucs/<name>, from where it can be restored with sys.UCSResource.Load.
+func (b *BigIP) UploadUCS(ctx context.Context, name string, r io.Reader, size int64, opts *UploadOptions) (*UploadResult, error) {
+	return b.upload(ctx, ucsUploads, name, r, size, opts)
+}
+
+// upload sends the content of r in chunks with a Content-Range header.
+func (b *BigIP) upload(ctx context.Context, target transferEndpoint, name string, r io.Reader, size int64, opts *UploadOptions) (*UploadResult, error) {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+	if msgs := rest.IsValidPathSegmentName(name); len(msgs) != 0 {
+		return nil, fmt.Errorf("invalid file name %q: %v", name, msgs)
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid size %d for %s", size, name)
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultUploadChunkSize
+	}
+	retries := opts.Retries
+	if retries == 0 {
+		retries = DefaultUploadRetries
+	}
+
+	offset := opts.Offset
+	if err := skip(r, offset); err != nil {
+		return nil, &UploadError{Name: name, Offset: offset, Err: err}
+	}
+
+	result := &UploadResult{}
+	buf := make([]byte, chunkSize)
+	for offset < size {
+		n := chunkSize
+		if size-offset < n {
+			n = size - offset
+		}
+		if _, err := io.ReadFull(r, buf[:n]); err != nil {
+			return nil, &UploadError{Name: name, Offset: offset, Err: err}
+		}
+
+		var err error
+		for attempt := 0; ; attempt++ {
+			result, err = b.uploadChunk(ctx, target, name, buf[:n], offset, size)
+			if err == nil || attempt >= retries || ctx.Err() != nil {
+				break
+			}
+			t := time.NewTimer(uploadRetryDelay << attempt)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+			case <-t.C:
+			}
+		}
+		if err != nil {
+			return nil, &UploadError{Name: name, Offset: offset, Err: err}
+		}
+
+		offset += n
+		if opts.Progress != nil {
+			opts.Progress(UploadProgress{Name: name, Sent: offset, Total: size})
+		}
+	}
+	return result, nil
+}
+
+// uploadChunk sends the chunk starting at offset.
+func (b *BigIP) uploadChunk(ctx context.Context, target transferEndpoint, name string, chunk []byte, offset, size int64) (*UploadResult, error) {
+	contentRange := fmt.Sprintf("%d-%d/%d", offset, offset+int64(len(chunk))-1, size)
+	res, err := b.RestClient.Post().Prefix(GetBaseResource()).ResourceCategory(target.category).
+		ManagerName(target.manager).Resource(target.resource).ResourceInstance(name).
+		SetHeader("Content-Type", "application/octet-stream").SetHeader("Content-Range", contentRange).
+		Body(bytes.NewReader(chunk)).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UploadResult{}
+	if len(res) != 0 {
+		if err := json.Unmarshal(res, result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// skip positions r at offset, seeking when possible.
+func skip(r io.Reader, offset int64) error {
+	if offset <= 0 {
+		return nil
+	}
+	if seeker, ok := r.(io.Seeker); ok {
+		_, err := seeker.Seek(offset, io.SeekStart)
+		return err
+	}
+	n, err := io.CopyN(io.Discard, r, offset)
+	if n < offset && errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newUploadServer stores uploaded chunks in file. Requests listed in failures
+// (by their index) are answered with a 500.
+func newUploadServer(t *testing.T, path string, file *bytes.Buffer, ranges *[]string, failures ...int) *BigIP {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { requests++ }()
+		if r.Method != http.MethodPost || r.URL.Path != path {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/octet-stream" {
+			t.Errorf("Expected Content-Type application/octet-stream, got %s", contentType)
+		}
+		for _, failure := range failures {
+			if failure == requests {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+		var start, end, total int
+		fmt.Sscanf(r.Header.Get("Content-Range"), "%d-%d/%d", &start, &end, &total)
+		*ranges = append(*ranges, r.Header.Get("Content-Range"))
+		body, _ := io.ReadAll(r.Body)
+		if start != file.Len() || end-start+1 != len(body) {
+			t.Errorf("Unexpected chunk %s of %d bytes, %d bytes already received", r.Header.Get("Content-Range"), len(body), file.Len())
+		}
+		file.Write(body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"remainingByteCount":%d,"totalByteCount":%d,"localFilePath":"/var/config/rest/downloads/app.tar"}`, total-end-1, total)
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestUpload(t *testing.T) {
+	delay := uploadRetryDelay
+	uploadRetryDelay = time.Millisecond
+	t.Cleanup(func() { uploadRetryDelay = delay })
+	content := strings.Repeat("0123456789", 25)
+	var file bytes.Buffer
+	var ranges []string
+	b := newUploadServer(t, "/mgmt/shared/file-transfer/uploads/app.tar", &file, &ranges, 1)
+
+	var progress []int64
+	result, err := b.Upload(context.Background(), "app.tar", strings.NewReader(content), int64(len(content)), &UploadOptions{
+		ChunkSize: 100,
+		Progress:  func(p UploadProgress) { progress = append(progress, p.Sent) },
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if file.String() != content {
+		t.Errorf("Expected the uploaded file to match the content, got %q", file.String())
+	}
+	if fmt.Sprint(ranges) != "[0-99/250 100-199/250 200-249/250]" {
+		t.Errorf("Unexpected content ranges %v", ranges)
+	}
+	if fmt.Sprint(progress) != "[100 200 250]" {
+		t.Errorf("Unexpected progress %v", progress)
+	}
+	if result.RemainingByteCount != 0 || result.TotalByteCount != 250 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestUploadResume(t *testing.T) {
+	content := strings.Repeat("0123456789", 25)
+	var file bytes.Buffer
+	var ranges []string
+	b := newUploadServer(t, "/mgmt/shared/file-transfer/ucs-uploads/backup.ucs", &file, &ranges, 1)
+
+	_, err := b.UploadUCS(context.Background(), "backup.ucs", strings.NewReader(content), int64(len(content)), &UploadOptions{ChunkSize: 100, Retries: -1})
+	var uploadErr *UploadError
+	if !errors.As(err, &uploadErr) {
+		t.Fatalf("Expected an UploadError, got %v", err)
+	}
+	if uploadErr.Offset != 100 {
+		t.Fatalf("Expected the upload to fail at offset 100, got %d", uploadErr.Offset)
+	}
+
+	// io.MultiReader hides the Seeker, so the bytes before the offset are discarded.
+	r := io.MultiReader(strings.NewReader(content))
+	_, err = b.UploadUCS(context.Background(), "backup.ucs", r, int64(len(content)), &UploadOptions{ChunkSize: 100, Offset: uploadErr.Offset})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if file.String() != content {
+		t.Errorf("Expected the uploaded file to match the content, got %q", file.String())
+	}
+}
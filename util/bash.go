@@ -28,7 +28,7 @@ Provides a way to manipulate bigip via commands，for example:
 	"tmsh list ltm virtual"
 	"uptime"
 */
-func (br *BashResource) Run(item Bash) (*Bash, error) {
+func (br *BashResource) Run(ctx context.Context, item Bash) (*Bash, error) {
 
 	var bash Bash
 
@@ -52,7 +52,7 @@ func (br *BashResource) Run(item Bash) (*Bash, error) {
 	}
 	jsonString := string(jsonData)
 	res, err := br.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetTMResource()).
-		ManagerName(UtilManager).Resource(BashEndpoint).Body(strings.NewReader(jsonString)).DoRaw(context.Background())
+		ManagerName(UtilManager).Resource(BashEndpoint).Body(strings.NewReader(jsonString)).DoRaw(ctx)
 	if err != nil {
 		return nil, err
 	}
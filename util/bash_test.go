@@ -1,12 +1,32 @@
 package util
 
 import (
+	"context"
+	"encoding/json"
 	"github.com/lefeck/go-bigip"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
 func TestBashResource_Run(t *testing.T) {
-	bigIP, err := bigip.NewSession("192.168.12.21", "admin", "1qaz@WSX3edc")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/mgmt/tm/util/bash" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var bash Bash
+		if err := json.NewDecoder(r.Body).Decode(&bash); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if bash.Command != "run" || bash.UtilCmdArgs != "-c 'tmsh list ltm virtual'" {
+			t.Errorf("Unexpected command %+v", bash)
+		}
+		bash.CommandResult = "ltm virtual web {\n    destination 10.1.1.1:http\n}\n"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(bash)
+	}))
+	defer server.Close()
+	bigIP, err := bigip.NewSession(server.URL, "admin", "admin")
 	if err != nil {
 		t.Fatalf("connect to bigip failed: %v", err)
 	}
@@ -20,7 +40,7 @@ func TestBashResource_Run(t *testing.T) {
 		UtilCmdArgs: command,
 	}
 
-	result, err := bashResource.Run(bashCmd)
+	result, err := bashResource.Run(context.Background(), bashCmd)
 	if err != nil {
 		t.Fatalf("Error running command on BIG-IP device: %v", err)
 	}
@@ -0,0 +1,114 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/lefeck/go-bigip"
+)
+
+// Qkview states reported by BIG-IP.
+const (
+	QkviewInProgress = "IN_PROGRESS"
+	QkviewSucceeded  = "SUCCEEDED"
+	QkviewFailed     = "FAILED"
+)
+
+// Qkview holds a diagnostic archive generated by /mgmt/cm/autodeploy/qkview.
+type Qkview struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	Status           string   `json:"status,omitempty"`
+	Timeout          int      `json:"timeout,omitempty"`
+	MaxFileSize      int      `json:"maxFileSize,omitempty"`
+	ExcludeCores     bool     `json:"excludeCores,omitempty"`
+	Exclude          []string `json:"exclude,omitempty"`
+	Generation       int      `json:"generation,omitempty"`
+	LastUpdateMicros int64    `json:"lastUpdateMicros,omitempty"`
+	Kind             string   `json:"kind,omitempty"`
+	SelfLink         string   `json:"selfLink,omitempty"`
+}
+
+// AutodeployManager is the manager of the qkview endpoint, /mgmt/cm/autodeploy.
+const AutodeployManager = "autodeploy"
+
+// QkviewEndpoint is the path of the qkview API.
+const QkviewEndpoint = "qkview"
+
+// QkviewResource generates qkview archives.
+//
+// BIG-IP has no task endpoint for qkview: generating one is a task of its own under
+// /mgmt/cm/autodeploy/qkview, whose status is polled with bigip.Poll like a
+// /mgmt/tm/task task. Once it succeeded, the archive is downloaded from /var/tmp:
+//
+//	qkview, err := util.NewUtil(b).Qkview().Run(ctx, util.Qkview{Name: "case.qkview"})
+//	...
+//	_, err = b.DownloadQkview(ctx, qkview.Name, w, nil)
+type QkviewResource struct {
+	b *bigip.BigIP
+}
+
+// Run generates a qkview and waits for it to succeed or fail.
+func (r *QkviewResource) Run(ctx context.Context, item Qkview) (*Qkview, error) {
+	created, err := r.Create(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var qkview *Qkview
+	err = bigip.Poll(ctx, func(ctx context.Context) (bool, error) {
+		if qkview, err = r.Get(ctx, created.ID); err != nil {
+			return false, err
+		}
+		switch qkview.Status {
+		case QkviewSucceeded:
+			return true, nil
+		case QkviewFailed:
+			return false, fmt.Errorf("qkview %s (%s) failed", qkview.ID, qkview.Name)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return qkview, nil
+}
+
+// Create starts the generation of a qkview and returns at once.
+func (r *QkviewResource) Create(ctx context.Context, item Qkview) (*Qkview, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON data: %w", err)
+	}
+	res, err := r.b.RestClient.Post().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetCMResource()).
+		ManagerName(AutodeployManager).Resource(QkviewEndpoint).Body(data).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodeQkview(res)
+}
+
+// Get returns the qkview identified by id.
+func (r *QkviewResource) Get(ctx context.Context, id string) (*Qkview, error) {
+	res, err := r.b.RestClient.Get().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetCMResource()).
+		ManagerName(AutodeployManager).Resource(QkviewEndpoint).SubResource(id).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodeQkview(res)
+}
+
+// Delete removes the qkview identified by id and its archive.
+func (r *QkviewResource) Delete(ctx context.Context, id string) error {
+	_, err := r.b.RestClient.Delete().Prefix(bigip.GetBaseResource()).ResourceCategory(bigip.GetCMResource()).
+		ManagerName(AutodeployManager).Resource(QkviewEndpoint).SubResource(id).DoRaw(ctx)
+	return err
+}
+
+func decodeQkview(data []byte) (*Qkview, error) {
+	var qkview Qkview
+	if err := json.Unmarshal(data, &qkview); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	return &qkview, nil
+}
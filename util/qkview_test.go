@@ -0,0 +1,54 @@
+package util
+
+import (
+	"context"
+	"github.com/lefeck/go-bigip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newQkviewServer(t *testing.T, status string) *bigip.BigIP {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mgmt/cm/autodeploy/qkview":
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"name":"case.qkview"`) {
+				t.Errorf("Unexpected qkview body %s", body)
+			}
+			w.Write([]byte(`{"id":"42","name":"case.qkview","status":"IN_PROGRESS"}`))
+		case "GET /mgmt/cm/autodeploy/qkview/42":
+			w.Write([]byte(`{"id":"42","name":"case.qkview","status":"` + status + `"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	b, err := bigip.NewSession(server.URL, "admin", "admin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestQkviewRun(t *testing.T) {
+	b := newQkviewServer(t, QkviewSucceeded)
+	qkview, err := NewUtil(b).Qkview().Run(context.Background(), Qkview{Name: "case.qkview"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if qkview.ID != "42" || qkview.Status != QkviewSucceeded {
+		t.Errorf("Unexpected qkview %+v", qkview)
+	}
+}
+
+func TestQkviewRunFailed(t *testing.T) {
+	b := newQkviewServer(t, QkviewFailed)
+	if _, err := NewUtil(b).Qkview().Run(context.Background(), Qkview{Name: "case.qkview"}); err == nil {
+		t.Fatal("Expected an error for a failed qkview")
+	}
+}
@@ -8,15 +8,21 @@ import (
 const UtilManager = "util"
 
 type Util struct {
-	bash BashResource
+	bash   BashResource
+	qkview QkviewResource
 }
 
 func NewUtil(b *bigip.BigIP) Util {
 	return Util{
-		bash: BashResource{b: b},
+		bash:   BashResource{b: b},
+		qkview: QkviewResource{b: b},
 	}
 }
 
 func (util Util) Bash() *BashResource {
 	return &util.bash
 }
+
+func (util Util) Qkview() *QkviewResource {
+	return &util.qkview
+}
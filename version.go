@@ -0,0 +1,174 @@
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Version is a TMOS version such as 15.1.2.1, made of the major, minor, maintenance
+// and point release numbers.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	Point int
+}
+
+// ParseVersion parses a version such as "15.1", "15.1.2" or "15.1.2.1". Missing numbers are zero.
+func ParseVersion(s string) (Version, error) {
+	fields := strings.Split(strings.TrimSpace(s), ".")
+	if len(fields) > 4 || fields[0] == "" {
+		return Version{}, fmt.Errorf("invalid TMOS version %q", s)
+	}
+	var numbers [4]int
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid TMOS version %q", s)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2], Point: numbers[3]}, nil
+}
+
+// MustParseVersion is like ParseVersion but panics if s is not a valid version.
+// It simplifies declaring the minimum versions of resources.
+func MustParseVersion(s string) Version {
+	v, err := ParseVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// IsZero reports whether v is the zero Version.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower, equal or greater than o.
+func (v Version) Compare(o Version) int {
+	for _, d := range [4]int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch, v.Point - o.Point} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is equal to or greater than min.
+func (v Version) AtLeast(min Version) bool {
+	return v.Compare(min) >= 0
+}
+
+// String returns the version in its dotted form, without the trailing point release if it is zero.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Point != 0 {
+		s += fmt.Sprintf(".%d", v.Point)
+	}
+	return s
+}
+
+// UnsupportedError is returned when a resource is used on a device whose TMOS version is too old.
+type UnsupportedError struct {
+	// Feature names the resource, such as "ltm/profile/quic".
+	Feature string
+	// Required is the minimum version of the feature and Actual the version of the device.
+	Required Version
+	Actual   Version
+}
+
+// Error implements the errors.Error interface
+func (err *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s is unsupported on TMOS %s, it requires %s or later", err.Feature, err.Actual, err.Required)
+}
+
+// versionCache holds the version of a device once it is known. It is shared by the
+// copies of a BigIP, such as the one used to queue requests into a transaction.
+type versionCache struct {
+	mu      sync.Mutex
+	version *Version
+}
+
+// versionStats is the response of /mgmt/tm/sys/version.
+type versionStats struct {
+	Entries map[string]struct {
+		NestedStats struct {
+			Entries map[string]struct {
+				Description string `json:"description"`
+			} `json:"entries"`
+		} `json:"nestedStats"`
+	} `json:"entries"`
+}
+
+// Version returns the TMOS version of the device. It is read from /mgmt/tm/sys/version
+// on the first call and cached for the life of the BigIP.
+func (b *BigIP) Version(ctx context.Context) (Version, error) {
+	if b.version == nil {
+		return b.fetchVersion(ctx)
+	}
+	b.version.mu.Lock()
+	defer b.version.mu.Unlock()
+	if b.version.version != nil {
+		return *b.version.version, nil
+	}
+	v, err := b.fetchVersion(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+	b.version.version = &v
+	return v, nil
+}
+
+// SetVersion sets the cached version of the device, so that it is not read from the device.
+func (b *BigIP) SetVersion(v Version) {
+	if b.version == nil {
+		b.version = &versionCache{}
+	}
+	b.version.mu.Lock()
+	defer b.version.mu.Unlock()
+	b.version.version = &v
+}
+
+// fetchVersion reads the version of the device.
+func (b *BigIP) fetchVersion(ctx context.Context) (Version, error) {
+	res, err := b.RestClient.Get().Prefix(GetBaseResource()).ResourceCategory(GetTMResource()).
+		ManagerName("sys").Resource("version").DoRaw(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+
+	var stats versionStats
+	if err := json.Unmarshal(res, &stats); err != nil {
+		return Version{}, fmt.Errorf("failed to unmarshal JSON data: %w", err)
+	}
+	for _, entry := range stats.Entries {
+		if version, ok := entry.NestedStats.Entries["Version"]; ok {
+			return ParseVersion(version.Description)
+		}
+	}
+	return Version{}, fmt.Errorf("no version found in the response of the device")
+}
+
+// RequireVersion returns an *UnsupportedError if the device is older than min.
+func (b *BigIP) RequireVersion(ctx context.Context, feature string, min Version) error {
+	if min.IsZero() {
+		return nil
+	}
+	v, err := b.Version(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detect the TMOS version: %w", err)
+	}
+	if !v.AtLeast(min) {
+		return &UnsupportedError{Feature: feature, Required: min, Actual: v}
+	}
+	return nil
+}
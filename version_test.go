@@ -0,0 +1,109 @@
+package bigip
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("15.1.2.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v != (Version{15, 1, 2, 1}) || v.String() != "15.1.2.1" {
+		t.Errorf("Expected 15.1.2.1, got %v", v)
+	}
+	if v := MustParseVersion("13.1"); v.String() != "13.1.0" {
+		t.Errorf("Expected 13.1.0, got %v", v)
+	}
+	for _, s := range []string{"", "15.x", "1.2.3.4.5", "-1"} {
+		if _, err := ParseVersion(s); err == nil {
+			t.Errorf("Expected an error for %q", s)
+		}
+	}
+
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"15.1", "15.1.0.0", 0},
+		{"13.1.3", "15.1", -1},
+		{"16.1", "15.1.10", 1},
+		{"15.1.2.1", "15.1.2", 1},
+	} {
+		if got := MustParseVersion(tc.a).Compare(MustParseVersion(tc.b)); got != tc.want {
+			t.Errorf("Expected %s compared to %s to be %d, got %d", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func TestVersionCache(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mgmt/tm/sys/version" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Write([]byte(`{"kind":"tm:sys:version:versionstats","entries":{"https://localhost/mgmt/tm/sys/version/0":{"nestedStats":{"entries":{"Build":{"description":"0.0.7"},"Product":{"description":"BIG-IP"},"Version":{"description":"13.1.3.4"}}}}}}`))
+	}))
+	defer server.Close()
+
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		v, err := b.Version(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v != MustParseVersion("13.1.3.4") {
+			t.Errorf("Expected 13.1.3.4, got %v", v)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the version to be read once, got %d requests", requests)
+	}
+
+	// The collection is rejected before any request is sent.
+	quic := NewCollection[testPool, testPoolList](b, "ltm", "profile", "quic").WithMinVersion(MustParseVersion("15.1"))
+	_, err = quic.List(context.Background())
+	var unsupported *UnsupportedError
+	if !errors.As(err, &unsupported) || unsupported.Actual.String() != "13.1.3.4" {
+		t.Fatalf("Expected an UnsupportedError, got %v", err)
+	}
+	if want := "ltm/profile/quic is unsupported on TMOS 13.1.3.4, it requires 15.1.0 or later"; err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+	if err := quic.Delete(context.Background(), "/Common/quic"); !errors.As(err, &unsupported) {
+		t.Errorf("Expected an UnsupportedError, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected no request for an unsupported collection, got %d", requests)
+	}
+}
+
+func TestSetVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/mgmt/tm/sys/version" {
+			t.Error("Expected the version not to be read")
+		}
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	b, err := New(server.URL, WithBasicAuth("admin", "admin"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	b.SetVersion(MustParseVersion("16.1.3"))
+	quic := NewCollection[testPool, testPoolList](b, "ltm", "profile", "quic").WithMinVersion(MustParseVersion("15.1"))
+	if _, err := quic.List(context.Background()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}